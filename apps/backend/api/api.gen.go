@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -20,23 +21,57 @@ const (
 	SessionAuthScopes = "SessionAuth.Scopes"
 )
 
+// Defines values for ActivityItemStatus.
+const (
+	ActivityItemStatusConfirmed ActivityItemStatus = "confirmed"
+	ActivityItemStatusPending   ActivityItemStatus = "pending"
+)
+
 // Defines values for ActivityItemType.
 const (
 	ActivityItemTypePayment ActivityItemType = "payment"
 	ActivityItemTypePayout  ActivityItemType = "payout"
+	ActivityItemTypeSwap    ActivityItemType = "swap"
+)
+
+// Defines values for ActivityType.
+const (
+	ActivityTypePayment ActivityType = "payment"
+	ActivityTypePayout  ActivityType = "payout"
+	ActivityTypeSwap    ActivityType = "swap"
 )
 
 // Defines values for GroupMemberRole.
 const (
-	Member GroupMemberRole = "member"
-	Owner  GroupMemberRole = "owner"
+	GroupMemberRoleMember GroupMemberRole = "member"
+	GroupMemberRoleOwner  GroupMemberRole = "owner"
 )
 
 // Defines values for GroupMemberStatus.
 const (
-	Accepted GroupMemberStatus = "accepted"
-	Invited  GroupMemberStatus = "invited"
-	Removed  GroupMemberStatus = "removed"
+	GroupMemberStatusAccepted GroupMemberStatus = "accepted"
+	GroupMemberStatusInvited  GroupMemberStatus = "invited"
+	GroupMemberStatusRemoved  GroupMemberStatus = "removed"
+)
+
+// Defines values for GroupMemberProfileRole.
+const (
+	GroupMemberProfileRoleMember GroupMemberProfileRole = "member"
+	GroupMemberProfileRoleOwner  GroupMemberProfileRole = "owner"
+)
+
+// Defines values for GroupMemberProfileStatus.
+const (
+	GroupMemberProfileStatusAccepted GroupMemberProfileStatus = "accepted"
+	GroupMemberProfileStatusInvited  GroupMemberProfileStatus = "invited"
+	GroupMemberProfileStatusRemoved  GroupMemberProfileStatus = "removed"
+)
+
+// Defines values for IndexerBackfillStatus.
+const (
+	IndexerBackfillStatusCompleted IndexerBackfillStatus = "completed"
+	IndexerBackfillStatusFailed    IndexerBackfillStatus = "failed"
+	IndexerBackfillStatusRunning   IndexerBackfillStatus = "running"
 )
 
 // Defines values for InviteSummaryStatus.
@@ -47,17 +82,48 @@ const (
 	InviteSummaryStatusRevoked InviteSummaryStatus = "revoked"
 )
 
+// Defines values for MemberDelinquencyStatus.
+const (
+	Late   MemberDelinquencyStatus = "late"
+	Missed MemberDelinquencyStatus = "missed"
+)
+
+// Defines values for NotificationPreferencesReminderChannels.
+const (
+	Email NotificationPreferencesReminderChannels = "email"
+)
+
+// Defines values for PayoutOrderStatus.
+const (
+	Finalized PayoutOrderStatus = "finalized"
+	Open      PayoutOrderStatus = "open"
+)
+
+// Defines values for PayoutStrategy.
+const (
+	Auction   PayoutStrategy = "auction"
+	Consensus PayoutStrategy = "consensus"
+	Fixed     PayoutStrategy = "fixed"
+	Random    PayoutStrategy = "random"
+)
+
 // Defines values for RoundStatus.
 const (
 	RoundStatusActive    RoundStatus = "active"
+	RoundStatusCancelled RoundStatus = "cancelled"
 	RoundStatusCompleted RoundStatus = "completed"
+	RoundStatusDefaulted RoundStatus = "defaulted"
+	RoundStatusPaused    RoundStatus = "paused"
 	RoundStatusPending   RoundStatus = "pending"
 )
 
 // Defines values for RoundDetailStatus.
 const (
 	RoundDetailStatusActive    RoundDetailStatus = "active"
+	RoundDetailStatusCancelled RoundDetailStatus = "cancelled"
 	RoundDetailStatusCompleted RoundDetailStatus = "completed"
+	RoundDetailStatusDefaulted RoundDetailStatus = "defaulted"
+	RoundDetailStatusPaused    RoundDetailStatus = "paused"
 	RoundDetailStatusPending   RoundDetailStatus = "pending"
 )
 
@@ -71,28 +137,62 @@ const (
 // Defines values for RoundSummaryStatus.
 const (
 	RoundSummaryStatusActive    RoundSummaryStatus = "active"
+	RoundSummaryStatusCancelled RoundSummaryStatus = "cancelled"
 	RoundSummaryStatusCompleted RoundSummaryStatus = "completed"
+	RoundSummaryStatusDefaulted RoundSummaryStatus = "defaulted"
+	RoundSummaryStatusPaused    RoundSummaryStatus = "paused"
 	RoundSummaryStatusPending   RoundSummaryStatus = "pending"
 )
 
+// Defines values for RoundTransitionRequestAction.
+const (
+	Cancel  RoundTransitionRequestAction = "cancel"
+	Default RoundTransitionRequestAction = "default"
+	Pause   RoundTransitionRequestAction = "pause"
+	Resume  RoundTransitionRequestAction = "resume"
+)
+
+// Defines values for SlotSwapStatus.
+const (
+	SlotSwapStatusAccepted  SlotSwapStatus = "accepted"
+	SlotSwapStatusApplied   SlotSwapStatus = "applied"
+	SlotSwapStatusCancelled SlotSwapStatus = "cancelled"
+	SlotSwapStatusDeclined  SlotSwapStatus = "declined"
+	SlotSwapStatusExpired   SlotSwapStatus = "expired"
+	SlotSwapStatusProposed  SlotSwapStatus = "proposed"
+)
+
+// Defines values for TransactionRequestKind.
+const (
+	Approve     TransactionRequestKind = "approve"
+	ClaimPayout TransactionRequestKind = "claimPayout"
+	Contribute  TransactionRequestKind = "contribute"
+)
+
+// Defines values for GetInviteQrCodeParamsFormat.
+const (
+	Png GetInviteQrCodeParamsFormat = "png"
+	Svg GetInviteQrCodeParamsFormat = "svg"
+)
+
 // Defines values for ListGroupRoundsParamsStatus.
 const (
 	ListGroupRoundsParamsStatusActive    ListGroupRoundsParamsStatus = "active"
+	ListGroupRoundsParamsStatusCancelled ListGroupRoundsParamsStatus = "cancelled"
 	ListGroupRoundsParamsStatusCompleted ListGroupRoundsParamsStatus = "completed"
+	ListGroupRoundsParamsStatusDefaulted ListGroupRoundsParamsStatus = "defaulted"
+	ListGroupRoundsParamsStatusPaused    ListGroupRoundsParamsStatus = "paused"
 	ListGroupRoundsParamsStatusPending   ListGroupRoundsParamsStatus = "pending"
 )
 
 // Defines values for ListRoundsParamsStatus.
 const (
-	Active    ListRoundsParamsStatus = "active"
-	Completed ListRoundsParamsStatus = "completed"
-	Pending   ListRoundsParamsStatus = "pending"
-)
-
-// Defines values for GetRoundActivityParamsType.
-const (
-	GetRoundActivityParamsTypePayment GetRoundActivityParamsType = "payment"
-	GetRoundActivityParamsTypePayout  GetRoundActivityParamsType = "payout"
+	ListRoundsParamsStatusActive    ListRoundsParamsStatus = "active"
+	ListRoundsParamsStatusCancelled ListRoundsParamsStatus = "cancelled"
+	ListRoundsParamsStatusCompleted ListRoundsParamsStatus = "completed"
+	ListRoundsParamsStatusDefaulted ListRoundsParamsStatus = "defaulted"
+	ListRoundsParamsStatusPaused    ListRoundsParamsStatus = "paused"
+	ListRoundsParamsStatusPending   ListRoundsParamsStatus = "pending"
 )
 
 // AcceptInviteRequest defines model for AcceptInviteRequest.
@@ -106,6 +206,12 @@ type AcceptInviteResponse struct {
 	GroupId UUID `json:"groupId"`
 }
 
+// AcceptSlotSwapRequest defines model for AcceptSlotSwapRequest.
+type AcceptSlotSwapRequest struct {
+	// Signature 65-byte EIP-712 signature over the swap's typed data
+	Signature string `json:"signature"`
+}
+
 // ActivityItem defines model for ActivityItem.
 type ActivityItem struct {
 	// Address EVM address (0x-prefixed, 40 hex chars)
@@ -114,17 +220,34 @@ type ActivityItem struct {
 	// Amount Amount in smallest units
 	Amount      *string `json:"amount,omitempty"`
 	BlockNumber *int    `json:"blockNumber"`
-	Id          UUID    `json:"id"`
+
+	// CounterpartyAddress EVM address (0x-prefixed, 40 hex chars)
+	CounterpartyAddress *Address `json:"counterpartyAddress,omitempty"`
+
+	// DisplayName Display name of the address's Circa user, if any
+	DisplayName *string `json:"displayName"`
+
+	// FormattedAmount Amount for display, e.g. "12.5 USDC". Absent for swaps.
+	FormattedAmount *string `json:"formattedAmount,omitempty"`
+	Id              UUID    `json:"id"`
 
 	// Period Period number this activity belongs to
-	Period    *int      `json:"period"`
-	Timestamp Timestamp `json:"timestamp"`
+	Period *int `json:"period"`
+
+	// Status pending until the block is buried under the chain's confirmation
+	// depth. Pending items can still disappear if the chain reorganizes.
+	Status    ActivityItemStatus `json:"status"`
+	Timestamp Timestamp          `json:"timestamp"`
 
 	// TransactionHash On-chain transaction hash
 	TransactionHash *string          `json:"transactionHash,omitempty"`
 	Type            ActivityItemType `json:"type"`
 }
 
+// ActivityItemStatus pending until the block is buried under the chain's confirmation
+// depth. Pending items can still disappear if the chain reorganizes.
+type ActivityItemStatus string
+
 // ActivityItemType defines model for ActivityItem.Type.
 type ActivityItemType string
 
@@ -134,9 +257,17 @@ type ActivityPage struct {
 	NextCursor *string        `json:"nextCursor"`
 }
 
+// ActivityType defines model for ActivityType.
+type ActivityType string
+
 // Address EVM address (0x-prefixed, 40 hex chars)
 type Address = string
 
+// ApprovePayoutOrderRequest defines model for ApprovePayoutOrderRequest.
+type ApprovePayoutOrderRequest struct {
+	Revision int `json:"revision"`
+}
+
 // AuthLoginRequest defines model for AuthLoginRequest.
 type AuthLoginRequest struct {
 	Email openapi_types.Email `json:"email"`
@@ -214,6 +345,29 @@ type AuthVerifyWalletResponse struct {
 // ChainId EVM chain id
 type ChainId = int
 
+// CommitPayoutOrderBidRequest defines model for CommitPayoutOrderBidRequest.
+type CommitPayoutOrderBidRequest struct {
+	Commitment string `json:"commitment"`
+}
+
+// ContributionPlan defines model for ContributionPlan.
+type ContributionPlan struct {
+	// Allowance Amount the round contract may currently pull from the caller
+	Allowance string `json:"allowance"`
+
+	// Amount Contribution amount in token base units
+	Amount string `json:"amount"`
+
+	// Balance Caller's token balance
+	Balance string `json:"balance"`
+
+	// Token EVM address (0x-prefixed, 40 hex chars)
+	Token Address `json:"token"`
+
+	// Transactions Transactions to send in order
+	Transactions []TransactionRequest `json:"transactions"`
+}
+
 // CreateGroupRequest defines model for CreateGroupRequest.
 type CreateGroupRequest struct {
 	AvatarUrl   *string `json:"avatarUrl,omitempty"`
@@ -227,6 +381,22 @@ type CreateInviteRequest struct {
 	MaxUses   *int       `json:"maxUses,omitempty"`
 }
 
+// CreatePayoutOrderRequest defines model for CreatePayoutOrderRequest.
+type CreatePayoutOrderRequest struct {
+	BiddingEndsAt *Timestamp `json:"biddingEndsAt,omitempty"`
+
+	// ChainId EVM chain id
+	ChainId *ChainId `json:"chainId,omitempty"`
+
+	// Order Required for fixed; the first proposal for consensus
+	Order *[]Address `json:"order,omitempty"`
+
+	// Participants Defaults to the group's accepted members
+	Participants *[]Address     `json:"participants,omitempty"`
+	RevealEndsAt *Timestamp     `json:"revealEndsAt,omitempty"`
+	Strategy     PayoutStrategy `json:"strategy"`
+}
+
 // CreateRoundRequest defines model for CreateRoundRequest.
 type CreateRoundRequest struct {
 	// ChainId EVM chain id
@@ -240,12 +410,49 @@ type CreateRoundRequest struct {
 
 	// CurrencySymbol Optional currency symbol (e.g. USDC)
 	CurrencySymbol *string `json:"currencySymbol,omitempty"`
+
+	// DeploymentBlock Block the contract was deployed in, from which its events are indexed. Without it, indexing starts at the last confirmed block when the round is registered.
+	DeploymentBlock       *int64 `json:"deploymentBlock,omitempty"`
+	PeriodDurationSeconds int    `json:"periodDurationSeconds"`
+}
+
+// Currency ISO 4217 currency code
+type Currency = string
+
+// Dashboard defines model for Dashboard.
+type Dashboard struct {
+	// Groups Groups the user belongs to
+	Groups []GroupSummary `json:"groups"`
+
+	// Invites Pending invites received by the user
+	Invites []PendingInvite `json:"invites"`
+
+	// RecentActivity Recent activity across all accessible rounds
+	RecentActivity *[]ActivityItem `json:"recentActivity,omitempty"`
+
+	// Rounds Active rounds requiring action
+	Rounds []RoundSummary `json:"rounds"`
+
+	// UpcomingPayouts Upcoming payouts the user is eligible for
+	UpcomingPayouts *[]UpcomingPayout `json:"upcomingPayouts,omitempty"`
 }
 
 // ErrorBadRequest defines model for ErrorBadRequest.
 type ErrorBadRequest struct {
+	Code int `json:"code"`
+
+	// Fields Per-field validation errors, when the request was well formed but rejected
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Message string        `json:"message"`
+}
+
+// ErrorConflict defines model for ErrorConflict.
+type ErrorConflict struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+
+	// Outstanding What the member still owes or is owed in active rounds
+	Outstanding *[]MemberObligation `json:"outstanding,omitempty"`
 }
 
 // ErrorForbidden defines model for ErrorForbidden.
@@ -272,8 +479,23 @@ type ErrorUnauthorized struct {
 	Message string `json:"message"`
 }
 
+// FiatAmount Value of a token amount in a fiat currency, rounded to two decimals
+type FiatAmount struct {
+	// Currency ISO 4217 currency code
+	Currency Currency `json:"currency"`
+	Value    string   `json:"value"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Request field the error refers to
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
 // Group defines model for Group.
 type Group struct {
+	ArchivedAt  *Timestamp    `json:"archivedAt,omitempty"`
 	AvatarUrl   *string       `json:"avatarUrl"`
 	CreatedAt   Timestamp     `json:"createdAt"`
 	Description *string       `json:"description"`
@@ -287,6 +509,34 @@ type Group struct {
 	UpdatedAt    *Timestamp `json:"updatedAt,omitempty"`
 }
 
+// GroupAllowlist defines model for GroupAllowlist.
+type GroupAllowlist struct {
+	CreatedAt   Timestamp `json:"createdAt"`
+	MemberCount int       `json:"memberCount"`
+
+	// PayoutOrderId Finalized payout order the members are listed in; null when they are in address order
+	PayoutOrderId *UUID `json:"payoutOrderId"`
+
+	// Root Merkle root the Round contract is deployed with
+	Root    string `json:"root"`
+	Version int32  `json:"version"`
+}
+
+// GroupAllowlistProof defines model for GroupAllowlistProof.
+type GroupAllowlistProof struct {
+	// Address EVM address (0x-prefixed, 40 hex chars)
+	Address Address `json:"address"`
+	Leaf    string  `json:"leaf"`
+
+	// Position Zero-based payout position committed to in the leaf
+	Position int `json:"position"`
+
+	// Proof Sibling hashes from the leaf up to the root
+	Proof   []string `json:"proof"`
+	Root    string   `json:"root"`
+	Version int32    `json:"version"`
+}
+
 // GroupMember defines model for GroupMember.
 type GroupMember struct {
 	// Address EVM address (0x-prefixed, 40 hex chars)
@@ -303,6 +553,31 @@ type GroupMemberRole string
 // GroupMemberStatus defines model for GroupMember.Status.
 type GroupMemberStatus string
 
+// GroupMemberPage defines model for GroupMemberPage.
+type GroupMemberPage struct {
+	Items      []GroupMember `json:"items"`
+	NextCursor *string       `json:"nextCursor"`
+}
+
+// GroupMemberProfile defines model for GroupMemberProfile.
+type GroupMemberProfile struct {
+	// Address EVM address (0x-prefixed, 40 hex chars)
+	Address Address `json:"address"`
+
+	// Delinquencies Overdue contributions flagged for the member, most recent first
+	Delinquencies []MemberDelinquency      `json:"delinquencies"`
+	DisplayName   *string                  `json:"displayName"`
+	JoinedAt      *Timestamp               `json:"joinedAt,omitempty"`
+	Role          GroupMemberProfileRole   `json:"role"`
+	Status        GroupMemberProfileStatus `json:"status"`
+}
+
+// GroupMemberProfileRole defines model for GroupMemberProfile.Role.
+type GroupMemberProfileRole string
+
+// GroupMemberProfileStatus defines model for GroupMemberProfile.Status.
+type GroupMemberProfileStatus string
+
 // GroupPage defines model for GroupPage.
 type GroupPage struct {
 	Items      []GroupSummary `json:"items"`
@@ -311,6 +586,7 @@ type GroupPage struct {
 
 // GroupSummary defines model for GroupSummary.
 type GroupSummary struct {
+	ArchivedAt  *Timestamp `json:"archivedAt,omitempty"`
 	AvatarUrl   *string    `json:"avatarUrl"`
 	CreatedAt   Timestamp  `json:"createdAt"`
 	Description *string    `json:"description"`
@@ -320,16 +596,49 @@ type GroupSummary struct {
 	UpdatedAt   *Timestamp `json:"updatedAt,omitempty"`
 }
 
+// IndexerBackfill defines model for IndexerBackfill.
+type IndexerBackfill struct {
+	CompletedAt *Timestamp `json:"completedAt,omitempty"`
+	CreatedAt   Timestamp  `json:"createdAt"`
+
+	// ErrorMessage Why the backfill failed
+	ErrorMessage *string `json:"errorMessage"`
+
+	// EventsWritten Events the backfill stored, missing or corrected ones
+	EventsWritten int64 `json:"eventsWritten"`
+	FromBlock     int64 `json:"fromBlock"`
+	Id            UUID  `json:"id"`
+
+	// NextBlock First block still to backfill, past toBlock once completed
+	NextBlock int64                 `json:"nextBlock"`
+	RoundId   UUID                  `json:"roundId"`
+	Status    IndexerBackfillStatus `json:"status"`
+	ToBlock   int64                 `json:"toBlock"`
+	UpdatedAt *Timestamp            `json:"updatedAt,omitempty"`
+}
+
+// IndexerBackfillStatus defines model for IndexerBackfillStatus.
+type IndexerBackfillStatus string
+
 // Invite defines model for Invite.
 type Invite struct {
 	// Code Invite code (returned only at creation time)
-	Code      string     `json:"code"`
-	CreatedAt Timestamp  `json:"createdAt"`
+	Code      string    `json:"code"`
+	CreatedAt Timestamp `json:"createdAt"`
+
+	// DeepLink Frontend link that joins the group with this code (returned only at creation time)
+	DeepLink  *string    `json:"deepLink,omitempty"`
 	ExpiresAt *Timestamp `json:"expiresAt,omitempty"`
 	GroupId   UUID       `json:"groupId"`
 	Id        UUID       `json:"id"`
 	MaxUses   int        `json:"maxUses"`
-	Uses      int        `json:"uses"`
+
+	// QrCodePng Base64 PNG QR code of the deep link (returned only at creation time)
+	QrCodePng *[]byte `json:"qrCodePng,omitempty"`
+
+	// QrCodeSvg SVG QR code of the deep link (returned only at creation time)
+	QrCodeSvg *string `json:"qrCodeSvg,omitempty"`
+	Uses      int     `json:"uses"`
 }
 
 // InviteCodeRequest defines model for InviteCodeRequest.
@@ -359,6 +668,148 @@ type InviteSummary struct {
 // InviteSummaryStatus defines model for InviteSummary.Status.
 type InviteSummaryStatus string
 
+// MemberBalance defines model for MemberBalance.
+type MemberBalance struct {
+	// Address EVM address (0x-prefixed, 40 hex chars)
+	Address Address `json:"address"`
+
+	// Arrears Contributions due but not made (in smallest units)
+	Arrears     string `json:"arrears"`
+	Contributed string `json:"contributed"`
+	Fees        string `json:"fees"`
+
+	// Net Contributed less received and fees. Positive while the pot still owes the member.
+	Net      string `json:"net"`
+	Received string `json:"received"`
+}
+
+// MemberDelinquency defines model for MemberDelinquency.
+type MemberDelinquency struct {
+	FlaggedAt Timestamp `json:"flaggedAt"`
+
+	// Paid Whether the contribution has been made since it was flagged
+	Paid    bool `json:"paid"`
+	Period  int  `json:"period"`
+	RoundId UUID `json:"roundId"`
+
+	// Status late while the group's grace period runs, missed once it has passed
+	Status MemberDelinquencyStatus `json:"status"`
+}
+
+// MemberDelinquencyStatus late while the group's grace period runs, missed once it has passed
+type MemberDelinquencyStatus string
+
+// MemberObligation defines model for MemberObligation.
+type MemberObligation struct {
+	// AwaitingPayout Whether the member is still due to receive the payout in this round
+	AwaitingPayout bool `json:"awaitingPayout"`
+
+	// ContributionsOwed Contributions the member still has to make in this round
+	ContributionsOwed int  `json:"contributionsOwed"`
+	RoundId           UUID `json:"roundId"`
+}
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	// ReminderChannels Channels contribution reminders are sent on. Empty turns reminders off.
+	ReminderChannels []NotificationPreferencesReminderChannels `json:"reminderChannels"`
+
+	// ReminderLeadTimesSeconds How long before each contribution deadline to send a reminder, in seconds. Defaults to 72h, 24h and 2h. Reminders stop once the contribution is indexed.
+	ReminderLeadTimesSeconds []int64 `json:"reminderLeadTimesSeconds"`
+}
+
+// NotificationPreferencesReminderChannels defines model for NotificationPreferences.ReminderChannels.
+type NotificationPreferencesReminderChannels string
+
+// PayoutOrder defines model for PayoutOrder.
+type PayoutOrder struct {
+	Approvals      []PayoutOrderApproval `json:"approvals"`
+	BiddingEndsAt  *Timestamp            `json:"biddingEndsAt"`
+	Bids           []PayoutOrderBid      `json:"bids"`
+	ChainId        *ChainId              `json:"chainId"`
+	CreatedAt      Timestamp             `json:"createdAt"`
+	FinalDiscounts *[]string             `json:"finalDiscounts"`
+	FinalOrder     *[]Address            `json:"finalOrder"`
+	FinalizedAt    *Timestamp            `json:"finalizedAt"`
+	GroupId        UUID                  `json:"groupId"`
+	Id             UUID                  `json:"id"`
+	Participants   []Address             `json:"participants"`
+
+	// ProposedOrder Order under consensus discussion, or the owner's fixed order
+	ProposedOrder []Address  `json:"proposedOrder"`
+	RevealEndsAt  *Timestamp `json:"revealEndsAt"`
+
+	// Revision Consensus proposal revision approvals must reference
+	Revision        int               `json:"revision"`
+	SeedBlockHash   *string           `json:"seedBlockHash"`
+	SeedBlockNumber *int64            `json:"seedBlockNumber"`
+	Status          PayoutOrderStatus `json:"status"`
+	Strategy        PayoutStrategy    `json:"strategy"`
+}
+
+// PayoutOrderStatus defines model for PayoutOrder.Status.
+type PayoutOrderStatus string
+
+// PayoutOrderApproval defines model for PayoutOrderApproval.
+type PayoutOrderApproval struct {
+	// Address EVM address (0x-prefixed, 40 hex chars)
+	Address  Address `json:"address"`
+	Revision int     `json:"revision"`
+}
+
+// PayoutOrderBid defines model for PayoutOrderBid.
+type PayoutOrderBid struct {
+	// Address EVM address (0x-prefixed, 40 hex chars)
+	Address Address `json:"address"`
+
+	// Discount Revealed discount in token base units; hidden until the reveal deadline passes
+	Discount *string `json:"discount"`
+	Revealed bool    `json:"revealed"`
+}
+
+// PayoutOrderExport Constructor arguments for deploying the round's contract
+type PayoutOrderExport struct {
+	// Discounts Discount given up at each position, in token base units
+	Discounts []string `json:"discounts"`
+
+	// Members Checksummed addresses in payout order
+	Members         []Address      `json:"members"`
+	OrderId         UUID           `json:"orderId"`
+	SeedBlockHash   *string        `json:"seedBlockHash"`
+	SeedBlockNumber *int64         `json:"seedBlockNumber"`
+	Strategy        PayoutStrategy `json:"strategy"`
+}
+
+// PayoutStrategy defines model for PayoutStrategy.
+type PayoutStrategy string
+
+// PendingInvite defines model for PendingInvite.
+type PendingInvite struct {
+	CreatedAt      Timestamp `json:"createdAt"`
+	GroupAvatarUrl *string   `json:"groupAvatarUrl"`
+	GroupId        UUID      `json:"groupId"`
+	GroupName      string    `json:"groupName"`
+	Id             UUID      `json:"id"`
+}
+
+// ProposePayoutOrderRequest defines model for ProposePayoutOrderRequest.
+type ProposePayoutOrderRequest struct {
+	Order []Address `json:"order"`
+}
+
+// ProposeSlotSwapRequest defines model for ProposeSlotSwapRequest.
+type ProposeSlotSwapRequest struct {
+	// CounterpartyAddress EVM address (0x-prefixed, 40 hex chars)
+	CounterpartyAddress Address `json:"counterpartyAddress"`
+}
+
+// RevealPayoutOrderBidRequest defines model for RevealPayoutOrderBidRequest.
+type RevealPayoutOrderBidRequest struct {
+	// Discount Discount in token base units
+	Discount string `json:"discount"`
+	Salt     string `json:"salt"`
+}
+
 // Round defines model for Round.
 type Round struct {
 	// ChainId EVM chain id
@@ -372,16 +823,34 @@ type Round struct {
 	CreatedAt          Timestamp `json:"createdAt"`
 
 	// CurrencySymbol e.g. USDC (optional)
-	CurrencySymbol        *string     `json:"currencySymbol"`
-	GroupId               UUID        `json:"groupId"`
-	Id                    UUID        `json:"id"`
-	PeriodDurationSeconds int         `json:"periodDurationSeconds"`
-	Status                RoundStatus `json:"status"`
+	CurrencySymbol *string `json:"currencySymbol"`
+
+	// FormattedContributionAmount Contribution amount for display, e.g. "12.5 USDC". In smallest
+	// units when the round's token is not registered.
+	FormattedContributionAmount string      `json:"formattedContributionAmount"`
+	GroupId                     UUID        `json:"groupId"`
+	Id                          UUID        `json:"id"`
+	PeriodDurationSeconds       int         `json:"periodDurationSeconds"`
+	Status                      RoundStatus `json:"status"`
+
+	// Token EVM address (0x-prefixed, 40 hex chars)
+	Token *Address `json:"token,omitempty"`
 }
 
 // RoundStatus defines model for Round.Status.
 type RoundStatus string
 
+// RoundBalances defines model for RoundBalances.
+type RoundBalances struct {
+	Members []MemberBalance `json:"members"`
+
+	// Pot Amount the round's contract holds (in smallest units)
+	Pot              string `json:"pot"`
+	TotalContributed string `json:"totalContributed"`
+	TotalFees        string `json:"totalFees"`
+	TotalReceived    string `json:"totalReceived"`
+}
+
 // RoundDetail defines model for RoundDetail.
 type RoundDetail struct {
 	// ChainId EVM chain id
@@ -391,16 +860,23 @@ type RoundDetail struct {
 	ContractAddress Address `json:"contractAddress"`
 
 	// ContributionAmount Contribution amount in smallest units (string to avoid JSON number limits)
-	ContributionAmount string    `json:"contributionAmount"`
-	CreatedAt          Timestamp `json:"createdAt"`
+	ContributionAmount string `json:"contributionAmount"`
+
+	// ContributionAmountFiat Value of a token amount in a fiat currency, rounded to two decimals
+	ContributionAmountFiat *FiatAmount `json:"contributionAmountFiat,omitempty"`
+	CreatedAt              Timestamp   `json:"createdAt"`
 
 	// CurrencySymbol e.g. USDC (optional)
 	CurrencySymbol *string `json:"currencySymbol"`
 	CurrentPeriod  *int    `json:"currentPeriod"`
-	GroupId        UUID    `json:"groupId"`
-	GroupName      string  `json:"groupName"`
-	Id             UUID    `json:"id"`
-	MemberCount    int     `json:"memberCount"`
+
+	// FormattedContributionAmount Contribution amount for display, e.g. "12.5 USDC". In smallest
+	// units when the round's token is not registered.
+	FormattedContributionAmount string `json:"formattedContributionAmount"`
+	GroupId                     UUID   `json:"groupId"`
+	GroupName                   string `json:"groupName"`
+	Id                          UUID   `json:"id"`
+	MemberCount                 int    `json:"memberCount"`
 
 	// NextPayoutAddress EVM address (0x-prefixed, 40 hex chars)
 	NextPayoutAddress     *Address          `json:"nextPayoutAddress,omitempty"`
@@ -408,8 +884,14 @@ type RoundDetail struct {
 	PeriodDurationSeconds int               `json:"periodDurationSeconds"`
 	Status                RoundDetailStatus `json:"status"`
 
-	// TotalContributed Total amount contributed so far (in smallest units)
+	// Token EVM address (0x-prefixed, 40 hex chars)
+	Token *Address `json:"token,omitempty"`
+
+	// TotalContributed Total amount contributed so far (in smallest units), from the round's ledger
 	TotalContributed *string `json:"totalContributed"`
+
+	// TotalContributedFiat Value of a token amount in a fiat currency, rounded to two decimals
+	TotalContributedFiat *FiatAmount `json:"totalContributedFiat,omitempty"`
 }
 
 // RoundDetailStatus defines model for RoundDetail.Status.
@@ -425,6 +907,12 @@ type RoundPage struct {
 type RoundPeriodStatus struct {
 	EndTime *Timestamp `json:"endTime,omitempty"`
 
+	// LateAddresses Addresses that have not contributed and are within the group's grace period
+	LateAddresses *[]Address `json:"lateAddresses,omitempty"`
+
+	// MissedAddresses Addresses that have not contributed by the end of the grace period
+	MissedAddresses *[]Address `json:"missedAddresses,omitempty"`
+
 	// PaidAddresses Addresses that have contributed to this period
 	PaidAddresses *[]Address `json:"paidAddresses,omitempty"`
 
@@ -456,26 +944,145 @@ type RoundSummary struct {
 	CreatedAt          Timestamp `json:"createdAt"`
 
 	// CurrencySymbol e.g. USDC (optional)
-	CurrencySymbol        *string            `json:"currencySymbol"`
-	CurrentPeriod         *int               `json:"currentPeriod"`
-	GroupId               UUID               `json:"groupId"`
-	GroupName             *string            `json:"groupName,omitempty"`
-	Id                    UUID               `json:"id"`
-	MemberCount           *int               `json:"memberCount"`
-	PaidCount             *int               `json:"paidCount"`
-	PeriodDurationSeconds int                `json:"periodDurationSeconds"`
-	Status                RoundSummaryStatus `json:"status"`
+	CurrencySymbol *string `json:"currencySymbol"`
+	CurrentPeriod  *int    `json:"currentPeriod"`
+
+	// FormattedContributionAmount Contribution amount for display, e.g. "12.5 USDC". In smallest
+	// units when the round's token is not registered.
+	FormattedContributionAmount string             `json:"formattedContributionAmount"`
+	GroupId                     UUID               `json:"groupId"`
+	GroupName                   *string            `json:"groupName,omitempty"`
+	Id                          UUID               `json:"id"`
+	MemberCount                 *int               `json:"memberCount"`
+	PaidCount                   *int               `json:"paidCount"`
+	PeriodDurationSeconds       int                `json:"periodDurationSeconds"`
+	Status                      RoundSummaryStatus `json:"status"`
+
+	// Token EVM address (0x-prefixed, 40 hex chars)
+	Token *Address `json:"token,omitempty"`
 }
 
 // RoundSummaryStatus defines model for RoundSummary.Status.
 type RoundSummaryStatus string
 
+// RoundTransitionRequest defines model for RoundTransitionRequest.
+type RoundTransitionRequest struct {
+	Action RoundTransitionRequestAction `json:"action"`
+	Reason *string                      `json:"reason"`
+}
+
+// RoundTransitionRequestAction defines model for RoundTransitionRequest.Action.
+type RoundTransitionRequestAction string
+
+// SlotSwap defines model for SlotSwap.
+type SlotSwap struct {
+	AcceptedAt *Timestamp `json:"acceptedAt,omitempty"`
+	AppliedAt  *Timestamp `json:"appliedAt,omitempty"`
+
+	// CounterpartyAddress EVM address (0x-prefixed, 40 hex chars)
+	CounterpartyAddress Address `json:"counterpartyAddress"`
+
+	// CounterpartySlot Payout position the proposer takes
+	CounterpartySlot int       `json:"counterpartySlot"`
+	CreatedAt        Timestamp `json:"createdAt"`
+	Deadline         Timestamp `json:"deadline"`
+	Id               UUID      `json:"id"`
+	Nonce            string    `json:"nonce"`
+
+	// Payload Round contract call that applies an accepted swap
+	Payload *SlotSwapPayload `json:"payload,omitempty"`
+
+	// ProposerAddress EVM address (0x-prefixed, 40 hex chars)
+	ProposerAddress Address `json:"proposerAddress"`
+
+	// ProposerSlot Payout position the proposer gives up
+	ProposerSlot int  `json:"proposerSlot"`
+	RoundId      UUID `json:"roundId"`
+
+	// Status Open swaps past their deadline read as expired
+	Status SlotSwapStatus `json:"status"`
+
+	// TypedData EIP-712 typed data the counterparty signs with eth_signTypedData_v4
+	TypedData map[string]interface{} `json:"typedData"`
+}
+
+// SlotSwapPayload Round contract call that applies an accepted swap
+type SlotSwapPayload struct {
+	ChainId int64  `json:"chainId"`
+	Data    string `json:"data"`
+
+	// To EVM address (0x-prefixed, 40 hex chars)
+	To Address `json:"to"`
+}
+
+// SlotSwapStatus Open swaps past their deadline read as expired
+type SlotSwapStatus string
+
+// StartBackfillRequest defines model for StartBackfillRequest.
+type StartBackfillRequest struct {
+	// FromBlock First block to backfill, 0 for the whole round
+	FromBlock int64 `json:"fromBlock"`
+}
+
 // Timestamp defines model for Timestamp.
 type Timestamp = time.Time
 
+// Token defines model for Token.
+type Token struct {
+	// Address EVM address (0x-prefixed, 40 hex chars)
+	Address Address `json:"address"`
+
+	// ChainId EVM chain id
+	ChainId  ChainId `json:"chainId"`
+	Decimals int     `json:"decimals"`
+	LogoUrl  *string `json:"logoUrl"`
+	Symbol   string  `json:"symbol"`
+}
+
+// TransactionRequest Unsigned transaction in eth_sendTransaction form. Quantities are 0x-prefixed hex. Either maxFeePerGas and maxPriorityFeePerGas or, on chains without EIP-1559, gasPrice is set.
+type TransactionRequest struct {
+	ChainId int64  `json:"chainId"`
+	Data    string `json:"data"`
+
+	// From EVM address (0x-prefixed, 40 hex chars)
+	From Address `json:"from"`
+
+	// Gas Suggested gas limit, omitted until the transactions before it are mined
+	Gas                  *string                `json:"gas"`
+	GasPrice             *string                `json:"gasPrice"`
+	Kind                 TransactionRequestKind `json:"kind"`
+	MaxFeePerGas         *string                `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *string                `json:"maxPriorityFeePerGas"`
+
+	// To EVM address (0x-prefixed, 40 hex chars)
+	To    Address `json:"to"`
+	Value string  `json:"value"`
+}
+
+// TransactionRequestKind defines model for TransactionRequest.Kind.
+type TransactionRequestKind string
+
 // UUID defines model for UUID.
 type UUID = openapi_types.UUID
 
+// UpcomingPayout defines model for UpcomingPayout.
+type UpcomingPayout struct {
+	EstimatedTime *Timestamp `json:"estimatedTime,omitempty"`
+
+	// ExpectedAmount Expected payout amount in smallest units
+	ExpectedAmount string `json:"expectedAmount"`
+
+	// ExpectedAmountFiat Value of a token amount in a fiat currency, rounded to two decimals
+	ExpectedAmountFiat *FiatAmount `json:"expectedAmountFiat,omitempty"`
+
+	// FormattedExpectedAmount Expected payout amount for display, e.g. "50 USDC"
+	FormattedExpectedAmount string `json:"formattedExpectedAmount"`
+	GroupId                 UUID   `json:"groupId"`
+	GroupName               string `json:"groupName"`
+	Period                  int    `json:"period"`
+	RoundId                 UUID   `json:"roundId"`
+}
+
 // UpdateGroupRequest defines model for UpdateGroupRequest.
 type UpdateGroupRequest struct {
 	AvatarUrl   *string `json:"avatarUrl,omitempty"`
@@ -487,25 +1094,64 @@ type UpdateGroupRequest struct {
 type UpdateMeRequest struct {
 	AvatarUrl   *string `json:"avatarUrl,omitempty"`
 	DisplayName *string `json:"displayName,omitempty"`
+
+	// PreferredCurrency ISO 4217 currency code
+	PreferredCurrency *Currency `json:"preferredCurrency,omitempty"`
 }
 
 // User defines model for User.
 type User struct {
 	// Address EVM address (0x-prefixed, 40 hex chars)
-	Address     Address    `json:"address"`
-	AvatarUrl   *string    `json:"avatarUrl"`
-	CreatedAt   Timestamp  `json:"createdAt"`
-	DisplayName *string    `json:"displayName"`
-	Id          UUID       `json:"id"`
-	UpdatedAt   *Timestamp `json:"updatedAt,omitempty"`
+	Address     Address   `json:"address"`
+	AvatarUrl   *string   `json:"avatarUrl"`
+	CreatedAt   Timestamp `json:"createdAt"`
+	DisplayName *string   `json:"displayName"`
+	Id          UUID      `json:"id"`
+
+	// PreferredCurrency ISO 4217 currency code
+	PreferredCurrency *Currency  `json:"preferredCurrency,omitempty"`
+	UpdatedAt         *Timestamp `json:"updatedAt,omitempty"`
 }
 
 // ListGroupsParams defines parameters for ListGroups.
 type ListGroupsParams struct {
-	// Q Optional search string for group name
+	// Q Optional search over group name and description. Results are ordered by relevance.
 	Q      *string `form:"q,omitempty" json:"q,omitempty"`
 	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Archived List archived groups instead of active ones
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+}
+
+// GetGroupAllowlistProofParams defines parameters for GetGroupAllowlistProof.
+type GetGroupAllowlistProofParams struct {
+	// Version Allowlist version to prove against; defaults to the latest version
+	Version *int32 `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// GetInviteQrCodeParams defines parameters for GetInviteQrCode.
+type GetInviteQrCodeParams struct {
+	Format *GetInviteQrCodeParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetInviteQrCodeParamsFormat defines parameters for GetInviteQrCode.
+type GetInviteQrCodeParamsFormat string
+
+// SearchGroupMembersParams defines parameters for SearchGroupMembers.
+type SearchGroupMembersParams struct {
+	Q      string  `form:"q" json:"q"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// RemoveGroupMemberParams defines parameters for RemoveGroupMember.
+type RemoveGroupMemberParams struct {
+	// Force Remove the member even if they have outstanding obligations in an active round (requires reason)
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// Reason Reason recorded with a force removal
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
 }
 
 // ListGroupRoundsParams defines parameters for ListGroupRounds.
@@ -531,13 +1177,18 @@ type ListRoundsParamsStatus string
 
 // GetRoundActivityParams defines parameters for GetRoundActivity.
 type GetRoundActivityParams struct {
-	Limit  *int                        `form:"limit,omitempty" json:"limit,omitempty"`
-	Cursor *string                     `form:"cursor,omitempty" json:"cursor,omitempty"`
-	Type   *GetRoundActivityParamsType `form:"type,omitempty" json:"type,omitempty"`
+	Limit  *int          `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor *string       `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Type   *ActivityType `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// ExportRoundActivityParams defines parameters for ExportRoundActivity.
+type ExportRoundActivityParams struct {
+	Type *ActivityType `form:"type,omitempty" json:"type,omitempty"`
 }
 
-// GetRoundActivityParamsType defines parameters for GetRoundActivity.
-type GetRoundActivityParamsType string
+// StartRoundBackfillJSONRequestBody defines body for StartRoundBackfill for application/json ContentType.
+type StartRoundBackfillJSONRequestBody = StartBackfillRequest
 
 // AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
 type AuthLoginJSONRequestBody = AuthLoginRequest
@@ -563,6 +1214,21 @@ type UpdateGroupJSONRequestBody = UpdateGroupRequest
 // CreateInviteJSONRequestBody defines body for CreateInvite for application/json ContentType.
 type CreateInviteJSONRequestBody = CreateInviteRequest
 
+// CreatePayoutOrderJSONRequestBody defines body for CreatePayoutOrder for application/json ContentType.
+type CreatePayoutOrderJSONRequestBody = CreatePayoutOrderRequest
+
+// ApprovePayoutOrderJSONRequestBody defines body for ApprovePayoutOrder for application/json ContentType.
+type ApprovePayoutOrderJSONRequestBody = ApprovePayoutOrderRequest
+
+// CommitPayoutOrderBidJSONRequestBody defines body for CommitPayoutOrderBid for application/json ContentType.
+type CommitPayoutOrderBidJSONRequestBody = CommitPayoutOrderBidRequest
+
+// RevealPayoutOrderBidJSONRequestBody defines body for RevealPayoutOrderBid for application/json ContentType.
+type RevealPayoutOrderBidJSONRequestBody = RevealPayoutOrderBidRequest
+
+// ProposePayoutOrderJSONRequestBody defines body for ProposePayoutOrder for application/json ContentType.
+type ProposePayoutOrderJSONRequestBody = ProposePayoutOrderRequest
+
 // CreateRoundJSONRequestBody defines body for CreateRound for application/json ContentType.
 type CreateRoundJSONRequestBody = CreateRoundRequest
 
@@ -575,8 +1241,26 @@ type PreviewInviteJSONRequestBody = InviteCodeRequest
 // UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
 type UpdateMeJSONRequestBody = UpdateMeRequest
 
+// UpdateNotificationPreferencesJSONRequestBody defines body for UpdateNotificationPreferences for application/json ContentType.
+type UpdateNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// ProposeSlotSwapJSONRequestBody defines body for ProposeSlotSwap for application/json ContentType.
+type ProposeSlotSwapJSONRequestBody = ProposeSlotSwapRequest
+
+// AcceptSlotSwapJSONRequestBody defines body for AcceptSlotSwap for application/json ContentType.
+type AcceptSlotSwapJSONRequestBody = AcceptSlotSwapRequest
+
+// TransitionRoundJSONRequestBody defines body for TransitionRound for application/json ContentType.
+type TransitionRoundJSONRequestBody = RoundTransitionRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List a round's indexer backfills (admins only)
+	// (GET /admin/rounds/{roundId}/backfills)
+	ListRoundBackfills(ctx echo.Context, roundId UUID) error
+	// Backfill a round's events from the chain (admins only)
+	// (POST /admin/rounds/{roundId}/backfills)
+	StartRoundBackfill(ctx echo.Context, roundId UUID) error
 	// Request login magic link
 	// (POST /auth/login)
 	AuthLogin(ctx echo.Context) error
@@ -595,6 +1279,9 @@ type ServerInterface interface {
 	// Verify email token create a session (sets HttpOnly cookie)
 	// (POST /auth/verify)
 	AuthVerify(ctx echo.Context) error
+	// Get the user's dashboard
+	// (GET /dashboard)
+	GetDashboard(ctx echo.Context) error
 	// List groups the current user belongs to
 	// (GET /groups)
 	ListGroups(ctx echo.Context, params ListGroupsParams) error
@@ -607,6 +1294,15 @@ type ServerInterface interface {
 	// Update group metadata (owner only)
 	// (PATCH /groups/{groupId})
 	UpdateGroup(ctx echo.Context, groupId UUID) error
+	// Get the group's Merkle allowlist for contract deployment (members only)
+	// (GET /groups/{groupId}/allowlist)
+	GetGroupAllowlist(ctx echo.Context, groupId UUID) error
+	// Get the caller's Merkle proof of membership (members only)
+	// (GET /groups/{groupId}/allowlist/proof)
+	GetGroupAllowlistProof(ctx echo.Context, groupId UUID, params GetGroupAllowlistProofParams) error
+	// Archive a group (owner only; archived groups are read-only)
+	// (POST /groups/{groupId}/archive)
+	ArchiveGroup(ctx echo.Context, groupId UUID) error
 	// List invites for a group (owner only)
 	// (GET /groups/{groupId}/invites)
 	ListInvites(ctx echo.Context, groupId UUID) error
@@ -616,21 +1312,60 @@ type ServerInterface interface {
 	// Revoke an invite (owner only)
 	// (DELETE /groups/{groupId}/invites/{inviteId})
 	RevokeInvite(ctx echo.Context, groupId UUID, inviteId UUID) error
+	// Printable invite card with the group name, avatar and join page QR code (owner only)
+	// (GET /groups/{groupId}/invites/{inviteId}/card)
+	GetInviteCard(ctx echo.Context, groupId UUID, inviteId UUID) error
+	// QR code of the group join page for an invite (owner only)
+	// (GET /groups/{groupId}/invites/{inviteId}/qr)
+	GetInviteQrCode(ctx echo.Context, groupId UUID, inviteId UUID, params GetInviteQrCodeParams) error
 	// Leave a group (member only; owner cannot leave unless transfer ownership is supported)
 	// (POST /groups/{groupId}/leave)
 	LeaveGroup(ctx echo.Context, groupId UUID) error
 	// List group members (members only)
 	// (GET /groups/{groupId}/members)
 	ListGroupMembers(ctx echo.Context, groupId UUID) error
+	// Search group members by display name or address prefix (members only)
+	// (GET /groups/{groupId}/members/search)
+	SearchGroupMembers(ctx echo.Context, groupId UUID, params SearchGroupMembersParams) error
 	// Remove a member from a group (owner only)
 	// (DELETE /groups/{groupId}/members/{memberAddress})
-	RemoveGroupMember(ctx echo.Context, groupId UUID, memberAddress Address) error
+	RemoveGroupMember(ctx echo.Context, groupId UUID, memberAddress Address, params RemoveGroupMemberParams) error
+	// Get a member's profile in a group, including flagged contributions (members only)
+	// (GET /groups/{groupId}/members/{memberAddress})
+	GetGroupMember(ctx echo.Context, groupId UUID, memberAddress Address) error
+	// Start deciding the payout order of the next round (group owner only)
+	// (POST /groups/{groupId}/payout-orders)
+	CreatePayoutOrder(ctx echo.Context, groupId UUID) error
+	// Get a payout order (members only)
+	// (GET /groups/{groupId}/payout-orders/{orderId})
+	GetPayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error
+	// Approve the current consensus proposal (participants only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/approvals)
+	ApprovePayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error
+	// Commit a sealed auction bid (participants only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/bids)
+	CommitPayoutOrderBid(ctx echo.Context, groupId UUID, orderId UUID) error
+	// Reveal a sealed auction bid (participants only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/bids/reveal)
+	RevealPayoutOrderBid(ctx echo.Context, groupId UUID, orderId UUID) error
+	// Export a finalized payout order for contract deployment (members only)
+	// (GET /groups/{groupId}/payout-orders/{orderId}/export)
+	ExportPayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error
+	// Finalize a payout order with its strategy (group owner only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/finalize)
+	FinalizePayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error
+	// Propose a payout order
+	// (PUT /groups/{groupId}/payout-orders/{orderId}/order)
+	ProposePayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error
 	// List rounds for a group (members only)
 	// (GET /groups/{groupId}/rounds)
 	ListGroupRounds(ctx echo.Context, groupId UUID, params ListGroupRoundsParams) error
 	// Create a round record for a group (members/owner; stores on-chain mapping)
 	// (POST /groups/{groupId}/rounds)
 	CreateRound(ctx echo.Context, groupId UUID) error
+	// Restore an archived group (owner only)
+	// (POST /groups/{groupId}/unarchive)
+	UnarchiveGroup(ctx echo.Context, groupId UUID) error
 	// Accept an invite code to join a private group
 	// (POST /invites/accept)
 	AcceptInvite(ctx echo.Context) error
@@ -643,6 +1378,12 @@ type ServerInterface interface {
 	// Update current user profile
 	// (PATCH /me)
 	UpdateMe(ctx echo.Context) error
+	// Get the current user's notification preferences
+	// (GET /me/notification-preferences)
+	GetNotificationPreferences(ctx echo.Context) error
+	// Replace the current user's notification preferences
+	// (PUT /me/notification-preferences)
+	UpdateNotificationPreferences(ctx echo.Context) error
 	// List rounds accessible to the current user
 	// (GET /rounds)
 	ListRounds(ctx echo.Context, params ListRoundsParams) error
@@ -652,9 +1393,39 @@ type ServerInterface interface {
 	// Get round activity feed (payments + payouts) (members only)
 	// (GET /rounds/{roundId}/activity)
 	GetRoundActivity(ctx echo.Context, roundId UUID, params GetRoundActivityParams) error
+	// Export the round activity feed as CSV (members only)
+	// (GET /rounds/{roundId}/activity/export)
+	ExportRoundActivity(ctx echo.Context, roundId UUID, params ExportRoundActivityParams) error
+	// Get ledger balances of the pot and every member (members only)
+	// (GET /rounds/{roundId}/balances)
+	GetRoundBalances(ctx echo.Context, roundId UUID) error
 	// Get per-period contribution status (members only)
 	// (GET /rounds/{roundId}/periods)
 	GetRoundPeriods(ctx echo.Context, roundId UUID) error
+	// List payout slot swaps (members only)
+	// (GET /rounds/{roundId}/swaps)
+	ListSlotSwaps(ctx echo.Context, roundId UUID) error
+	// Propose swapping payout slots with another member
+	// (POST /rounds/{roundId}/swaps)
+	ProposeSlotSwap(ctx echo.Context, roundId UUID) error
+	// Accept a slot swap (counterparty only)
+	// (POST /rounds/{roundId}/swaps/{swapId}/accept)
+	AcceptSlotSwap(ctx echo.Context, roundId UUID, swapId UUID) error
+	// Cancel or decline a slot swap
+	// (POST /rounds/{roundId}/swaps/{swapId}/cancel)
+	CancelSlotSwap(ctx echo.Context, roundId UUID, swapId UUID) error
+	// Build the caller's contribution transactions
+	// (GET /rounds/{roundId}/transactions/contribution)
+	BuildContribution(ctx echo.Context, roundId UUID) error
+	// Build the caller's payout claim transaction
+	// (GET /rounds/{roundId}/transactions/payout-claim)
+	BuildPayoutClaim(ctx echo.Context, roundId UUID) error
+	// Change a round's status (group owner only)
+	// (POST /rounds/{roundId}/transitions)
+	TransitionRound(ctx echo.Context, roundId UUID) error
+	// List the registered tokens
+	// (GET /tokens)
+	ListTokens(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -662,12 +1433,48 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// AuthLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) AuthLogin(ctx echo.Context) error {
+// ListRoundBackfills converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRoundBackfills(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
+
+	ctx.Set(SessionAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AuthLogin(ctx)
+	err = w.Handler.ListRoundBackfills(ctx, roundId)
+	return err
+}
+
+// StartRoundBackfill converts echo context to params.
+func (w *ServerInterfaceWrapper) StartRoundBackfill(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
+
+	ctx.Set(SessionAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartRoundBackfill(ctx, roundId)
+	return err
+}
+
+// AuthLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) AuthLogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AuthLogin(ctx)
 	return err
 }
 
@@ -718,6 +1525,17 @@ func (w *ServerInterfaceWrapper) AuthVerify(ctx echo.Context) error {
 	return err
 }
 
+// GetDashboard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboard(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDashboard(ctx)
+	return err
+}
+
 // ListGroups converts echo context to params.
 func (w *ServerInterfaceWrapper) ListGroups(ctx echo.Context) error {
 	var err error
@@ -747,6 +1565,13 @@ func (w *ServerInterfaceWrapper) ListGroups(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", ctx.QueryParams(), &params.Archived)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter archived: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListGroups(ctx, params)
 	return err
@@ -799,6 +1624,69 @@ func (w *ServerInterfaceWrapper) UpdateGroup(ctx echo.Context) error {
 	return err
 }
 
+// GetGroupAllowlist converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupAllowlist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	ctx.Set(SessionAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupAllowlist(ctx, groupId)
+	return err
+}
+
+// GetGroupAllowlistProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupAllowlistProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	ctx.Set(SessionAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupAllowlistProofParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetGroupAllowlistProof(ctx, groupId, params)
+	return err
+}
+
+// ArchiveGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) ArchiveGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	ctx.Set(SessionAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ArchiveGroup(ctx, groupId)
+	return err
+}
+
 // ListInvites converts echo context to params.
 func (w *ServerInterfaceWrapper) ListInvites(ctx echo.Context) error {
 	var err error
@@ -861,8 +1749,8 @@ func (w *ServerInterfaceWrapper) RevokeInvite(ctx echo.Context) error {
 	return err
 }
 
-// LeaveGroup converts echo context to params.
-func (w *ServerInterfaceWrapper) LeaveGroup(ctx echo.Context) error {
+// GetInviteCard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInviteCard(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "groupId" -------------
 	var groupId UUID
@@ -872,15 +1760,23 @@ func (w *ServerInterfaceWrapper) LeaveGroup(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
+	// ------------- Path parameter "inviteId" -------------
+	var inviteId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inviteId", ctx.Param("inviteId"), &inviteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inviteId: %s", err))
+	}
+
 	ctx.Set(SessionAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LeaveGroup(ctx, groupId)
+	err = w.Handler.GetInviteCard(ctx, groupId, inviteId)
 	return err
 }
 
-// ListGroupMembers converts echo context to params.
-func (w *ServerInterfaceWrapper) ListGroupMembers(ctx echo.Context) error {
+// GetInviteQrCode converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInviteQrCode(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "groupId" -------------
 	var groupId UUID
@@ -890,15 +1786,32 @@ func (w *ServerInterfaceWrapper) ListGroupMembers(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
+	// ------------- Path parameter "inviteId" -------------
+	var inviteId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inviteId", ctx.Param("inviteId"), &inviteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inviteId: %s", err))
+	}
+
 	ctx.Set(SessionAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInviteQrCodeParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListGroupMembers(ctx, groupId)
+	err = w.Handler.GetInviteQrCode(ctx, groupId, inviteId, params)
 	return err
 }
 
-// RemoveGroupMember converts echo context to params.
-func (w *ServerInterfaceWrapper) RemoveGroupMember(ctx echo.Context) error {
+// LeaveGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) LeaveGroup(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "groupId" -------------
 	var groupId UUID
@@ -908,23 +1821,33 @@ func (w *ServerInterfaceWrapper) RemoveGroupMember(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
-	// ------------- Path parameter "memberAddress" -------------
-	var memberAddress Address
+	ctx.Set(SessionAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "memberAddress", ctx.Param("memberAddress"), &memberAddress, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LeaveGroup(ctx, groupId)
+	return err
+}
+
+// ListGroupMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListGroupMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter memberAddress: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
 	ctx.Set(SessionAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RemoveGroupMember(ctx, groupId, memberAddress)
+	err = w.Handler.ListGroupMembers(ctx, groupId)
 	return err
 }
 
-// ListGroupRounds converts echo context to params.
-func (w *ServerInterfaceWrapper) ListGroupRounds(ctx echo.Context) error {
+// SearchGroupMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchGroupMembers(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "groupId" -------------
 	var groupId UUID
@@ -937,12 +1860,12 @@ func (w *ServerInterfaceWrapper) ListGroupRounds(ctx echo.Context) error {
 	ctx.Set(SessionAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListGroupRoundsParams
-	// ------------- Optional query parameter "status" -------------
+	var params SearchGroupMembersParams
+	// ------------- Required query parameter "q" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
 
 	// ------------- Optional query parameter "limit" -------------
@@ -960,12 +1883,12 @@ func (w *ServerInterfaceWrapper) ListGroupRounds(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListGroupRounds(ctx, groupId, params)
+	err = w.Handler.SearchGroupMembers(ctx, groupId, params)
 	return err
 }
 
-// CreateRound converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateRound(ctx echo.Context) error {
+// RemoveGroupMember converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveGroupMember(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "groupId" -------------
 	var groupId UUID
@@ -975,2221 +1898,5728 @@ func (w *ServerInterfaceWrapper) CreateRound(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
+	// ------------- Path parameter "memberAddress" -------------
+	var memberAddress Address
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberAddress", ctx.Param("memberAddress"), &memberAddress, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter memberAddress: %s", err))
+	}
+
 	ctx.Set(SessionAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateRound(ctx, groupId)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveGroupMemberParams
+	// ------------- Optional query parameter "force" -------------
 
-// AcceptInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) AcceptInvite(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
 
-	ctx.Set(SessionAuthScopes, []string{})
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", ctx.QueryParams(), &params.Reason)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reason: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.AcceptInvite(ctx)
+	err = w.Handler.RemoveGroupMember(ctx, groupId, memberAddress, params)
 	return err
 }
 
-// PreviewInvite converts echo context to params.
-func (w *ServerInterfaceWrapper) PreviewInvite(ctx echo.Context) error {
+// GetGroupMember converts echo context to params.
+func (w *ServerInterfaceWrapper) GetGroupMember(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-	ctx.Set(SessionAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PreviewInvite(ctx)
-	return err
-}
+	// ------------- Path parameter "memberAddress" -------------
+	var memberAddress Address
 
-// GetMe converts echo context to params.
-func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithOptions("simple", "memberAddress", ctx.Param("memberAddress"), &memberAddress, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter memberAddress: %s", err))
+	}
 
 	ctx.Set(SessionAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMe(ctx)
+	err = w.Handler.GetGroupMember(ctx, groupId, memberAddress)
 	return err
 }
 
-// UpdateMe converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateMe(ctx echo.Context) error {
+// CreatePayoutOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePayoutOrder(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
 
 	ctx.Set(SessionAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateMe(ctx)
+	err = w.Handler.CreatePayoutOrder(ctx, groupId)
 	return err
 }
 
-// ListRounds converts echo context to params.
-func (w *ServerInterfaceWrapper) ListRounds(ctx echo.Context) error {
+// GetPayoutOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPayoutOrder(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-	ctx.Set(SessionAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRoundsParams
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
-	// ------------- Optional query parameter "groupId" -------------
+	// ------------- Path parameter "orderId" -------------
+	var orderId UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "groupId", ctx.QueryParams(), &params.GroupId)
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx.Set(SessionAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPayoutOrder(ctx, groupId, orderId)
+	return err
+}
+
+// ApprovePayoutOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) ApprovePayoutOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
-	// ------------- Optional query parameter "cursor" -------------
+	// ------------- Path parameter "orderId" -------------
+	var orderId UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
 	}
 
+	ctx.Set(SessionAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListRounds(ctx, params)
+	err = w.Handler.ApprovePayoutOrder(ctx, groupId, orderId)
 	return err
 }
 
-// GetRound converts echo context to params.
-func (w *ServerInterfaceWrapper) GetRound(ctx echo.Context) error {
+// CommitPayoutOrderBid converts echo context to params.
+func (w *ServerInterfaceWrapper) CommitPayoutOrderBid(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "roundId" -------------
-	var roundId UUID
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	// ------------- Path parameter "orderId" -------------
+	var orderId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
 	}
 
 	ctx.Set(SessionAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetRound(ctx, roundId)
+	err = w.Handler.CommitPayoutOrderBid(ctx, groupId, orderId)
 	return err
 }
 
-// GetRoundActivity converts echo context to params.
-func (w *ServerInterfaceWrapper) GetRoundActivity(ctx echo.Context) error {
+// RevealPayoutOrderBid converts echo context to params.
+func (w *ServerInterfaceWrapper) RevealPayoutOrderBid(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "roundId" -------------
-	var roundId UUID
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
-	ctx.Set(SessionAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRoundActivityParams
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "orderId" -------------
+	var orderId UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
 	}
 
-	// ------------- Optional query parameter "cursor" -------------
+	ctx.Set(SessionAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevealPayoutOrderBid(ctx, groupId, orderId)
+	return err
+}
+
+// ExportPayoutOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportPayoutOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
 	}
 
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Path parameter "orderId" -------------
+	var orderId UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
 	}
 
+	ctx.Set(SessionAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetRoundActivity(ctx, roundId, params)
+	err = w.Handler.ExportPayoutOrder(ctx, groupId, orderId)
 	return err
 }
 
-// GetRoundPeriods converts echo context to params.
-func (w *ServerInterfaceWrapper) GetRoundPeriods(ctx echo.Context) error {
+// FinalizePayoutOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) FinalizePayoutOrder(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "roundId" -------------
-	var roundId UUID
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	// ------------- Path parameter "orderId" -------------
+	var orderId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
 	}
 
 	ctx.Set(SessionAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetRoundPeriods(ctx, roundId)
+	err = w.Handler.FinalizePayoutOrder(ctx, groupId, orderId)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// ProposePayoutOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) ProposePayoutOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	// ------------- Path parameter "orderId" -------------
+	var orderId UUID
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", ctx.Param("orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderId: %s", err))
 	}
 
-	router.POST(baseURL+"/auth/login", wrapper.AuthLogin)
-	router.POST(baseURL+"/auth/logout", wrapper.AuthLogout)
-	router.POST(baseURL+"/auth/nonce", wrapper.AuthNonce)
-	router.POST(baseURL+"/auth/signup", wrapper.AuthSignup)
-	router.POST(baseURL+"/auth/signup/complete", wrapper.AuthSignupComplete)
-	router.POST(baseURL+"/auth/verify", wrapper.AuthVerify)
-	router.GET(baseURL+"/groups", wrapper.ListGroups)
-	router.POST(baseURL+"/groups", wrapper.CreateGroup)
-	router.GET(baseURL+"/groups/:groupId", wrapper.GetGroup)
-	router.PATCH(baseURL+"/groups/:groupId", wrapper.UpdateGroup)
-	router.GET(baseURL+"/groups/:groupId/invites", wrapper.ListInvites)
-	router.POST(baseURL+"/groups/:groupId/invites", wrapper.CreateInvite)
-	router.DELETE(baseURL+"/groups/:groupId/invites/:inviteId", wrapper.RevokeInvite)
-	router.POST(baseURL+"/groups/:groupId/leave", wrapper.LeaveGroup)
-	router.GET(baseURL+"/groups/:groupId/members", wrapper.ListGroupMembers)
-	router.DELETE(baseURL+"/groups/:groupId/members/:memberAddress", wrapper.RemoveGroupMember)
-	router.GET(baseURL+"/groups/:groupId/rounds", wrapper.ListGroupRounds)
-	router.POST(baseURL+"/groups/:groupId/rounds", wrapper.CreateRound)
-	router.POST(baseURL+"/invites/accept", wrapper.AcceptInvite)
-	router.POST(baseURL+"/invites/preview", wrapper.PreviewInvite)
-	router.GET(baseURL+"/me", wrapper.GetMe)
-	router.PATCH(baseURL+"/me", wrapper.UpdateMe)
-	router.GET(baseURL+"/rounds", wrapper.ListRounds)
-	router.GET(baseURL+"/rounds/:roundId", wrapper.GetRound)
-	router.GET(baseURL+"/rounds/:roundId/activity", wrapper.GetRoundActivity)
-	router.GET(baseURL+"/rounds/:roundId/periods", wrapper.GetRoundPeriods)
-
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type AuthLoginRequestObject struct {
-	Body *AuthLoginJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProposePayoutOrder(ctx, groupId, orderId)
+	return err
 }
 
-type AuthLoginResponseObject interface {
-	VisitAuthLoginResponse(w http.ResponseWriter) error
-}
+// ListGroupRounds converts echo context to params.
+func (w *ServerInterfaceWrapper) ListGroupRounds(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-type AuthLogin200JSONResponse AuthLoginResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
 
-func (response AuthLogin200JSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGroupRoundsParams
+	// ------------- Optional query parameter "status" -------------
 
-type AuthLogin400JSONResponse ErrorBadRequest
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
 
-func (response AuthLogin400JSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type AuthLogoutRequestObject struct {
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type AuthLogoutResponseObject interface {
-	VisitAuthLogoutResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-type AuthLogout204Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListGroupRounds(ctx, groupId, params)
+	return err
 }
 
-func (response AuthLogout204Response) VisitAuthLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// CreateRound converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateRound(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-type AuthLogout401JSONResponse ErrorUnauthorized
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
 
-func (response AuthLogout401JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateRound(ctx, groupId)
+	return err
 }
 
-type AuthLogout500JSONResponse ErrorInternalServerError
-
-func (response AuthLogout500JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// UnarchiveGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) UnarchiveGroup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", ctx.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
 
-type AuthNonceRequestObject struct {
-	Body *AuthNonceJSONRequestBody
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type AuthNonceResponseObject interface {
-	VisitAuthNonceResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnarchiveGroup(ctx, groupId)
+	return err
 }
 
-type AuthNonce200JSONResponse AuthNonceResponse
+// AcceptInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) AcceptInvite(ctx echo.Context) error {
+	var err error
 
-func (response AuthNonce200JSONResponse) VisitAuthNonceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AcceptInvite(ctx)
+	return err
 }
 
-type AuthNonce400JSONResponse ErrorBadRequest
+// PreviewInvite converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewInvite(ctx echo.Context) error {
+	var err error
 
-func (response AuthNonce400JSONResponse) VisitAuthNonceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewInvite(ctx)
+	return err
 }
 
-type AuthSignupRequestObject struct {
-	Body *AuthSignupJSONRequestBody
-}
+// GetMe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
+	var err error
 
-type AuthSignupResponseObject interface {
-	VisitAuthSignupResponse(w http.ResponseWriter) error
+	ctx.Set(SessionAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMe(ctx)
+	return err
 }
 
-type AuthSignup200JSONResponse AuthSignupResponse
+// UpdateMe converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMe(ctx echo.Context) error {
+	var err error
 
-func (response AuthSignup200JSONResponse) VisitAuthSignupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMe(ctx)
+	return err
 }
 
-type AuthSignup400JSONResponse ErrorBadRequest
-
-func (response AuthSignup400JSONResponse) VisitAuthSignupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotificationPreferences(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type AuthSignupCompleteRequestObject struct {
-	Body *AuthSignupCompleteJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNotificationPreferences(ctx)
+	return err
 }
 
-type AuthSignupCompleteResponseObject interface {
-	VisitAuthSignupCompleteResponse(w http.ResponseWriter) error
-}
+// UpdateNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNotificationPreferences(ctx echo.Context) error {
+	var err error
 
-type AuthSignupComplete200ResponseHeaders struct {
-	SetCookie string
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type AuthSignupComplete200JSONResponse struct {
-	Body    AuthVerifyWalletResponse
-	Headers AuthSignupComplete200ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateNotificationPreferences(ctx)
+	return err
 }
 
-func (response AuthSignupComplete200JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// ListRounds converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRounds(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type AuthSignupComplete400JSONResponse ErrorBadRequest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRoundsParams
+	// ------------- Optional query parameter "status" -------------
 
-func (response AuthSignupComplete400JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "groupId" -------------
 
-type AuthSignupComplete401JSONResponse ErrorUnauthorized
+	err = runtime.BindQueryParameter("form", true, false, "groupId", ctx.QueryParams(), &params.GroupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
 
-func (response AuthSignupComplete401JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type AuthSignupComplete409JSONResponse ErrorBadRequest
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response AuthSignupComplete409JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListRounds(ctx, params)
+	return err
 }
 
-type AuthVerifyRequestObject struct {
-	Body *AuthVerifyJSONRequestBody
-}
+// GetRound converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRound(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-type AuthVerifyResponseObject interface {
-	VisitAuthVerifyResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-type AuthVerify200ResponseHeaders struct {
-	SetCookie string
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type AuthVerify200JSONResponse struct {
-	Body    AuthVerifyResponse
-	Headers AuthVerify200ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRound(ctx, roundId)
+	return err
 }
 
-func (response AuthVerify200JSONResponse) VisitAuthVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// GetRoundActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoundActivity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-type AuthVerify400JSONResponse ErrorBadRequest
+	ctx.Set(SessionAuthScopes, []string{})
 
-func (response AuthVerify400JSONResponse) VisitAuthVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRoundActivityParams
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type AuthVerify401JSONResponse ErrorUnauthorized
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response AuthVerify401JSONResponse) VisitAuthVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "type" -------------
 
-type ListGroupsRequestObject struct {
-	Params ListGroupsParams
-}
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-type ListGroupsResponseObject interface {
-	VisitListGroupsResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoundActivity(ctx, roundId, params)
+	return err
 }
 
-type ListGroups200JSONResponse GroupPage
+// ExportRoundActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportRoundActivity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-func (response ListGroups200JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type ListGroups401JSONResponse ErrorUnauthorized
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportRoundActivityParams
+	// ------------- Optional query parameter "type" -------------
 
-func (response ListGroups401JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportRoundActivity(ctx, roundId, params)
+	return err
 }
 
-type ListGroups500JSONResponse ErrorInternalServerError
+// GetRoundBalances converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoundBalances(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-func (response ListGroups500JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type CreateGroupRequestObject struct {
-	Body *CreateGroupJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoundBalances(ctx, roundId)
+	return err
 }
 
-type CreateGroupResponseObject interface {
-	VisitCreateGroupResponse(w http.ResponseWriter) error
-}
+// GetRoundPeriods converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoundPeriods(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-type CreateGroup201JSONResponse Group
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoundPeriods(ctx, roundId)
+	return err
 }
 
-type CreateGroup400JSONResponse ErrorBadRequest
+// ListSlotSwaps converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSlotSwaps(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(SessionAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSlotSwaps(ctx, roundId)
+	return err
 }
 
-type CreateGroup401JSONResponse ErrorUnauthorized
+// ProposeSlotSwap converts echo context to params.
+func (w *ServerInterfaceWrapper) ProposeSlotSwap(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-func (response CreateGroup401JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type CreateGroup500JSONResponse ErrorInternalServerError
-
-func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetGroupRequestObject struct {
-	GroupId UUID `json:"groupId"`
-}
-
-type GetGroupResponseObject interface {
-	VisitGetGroupResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProposeSlotSwap(ctx, roundId)
+	return err
 }
 
-type GetGroup200JSONResponse Group
+// AcceptSlotSwap converts echo context to params.
+func (w *ServerInterfaceWrapper) AcceptSlotSwap(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-func (response GetGroup200JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "swapId" -------------
+	var swapId UUID
 
-type GetGroup401JSONResponse ErrorUnauthorized
+	err = runtime.BindStyledParameterWithOptions("simple", "swapId", ctx.Param("swapId"), &swapId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter swapId: %s", err))
+	}
 
-func (response GetGroup401JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AcceptSlotSwap(ctx, roundId, swapId)
+	return err
 }
 
-type GetGroup403JSONResponse ErrorForbidden
+// CancelSlotSwap converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelSlotSwap(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-func (response GetGroup403JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "swapId" -------------
+	var swapId UUID
 
-type GetGroup404JSONResponse ErrorNotFound
+	err = runtime.BindStyledParameterWithOptions("simple", "swapId", ctx.Param("swapId"), &swapId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter swapId: %s", err))
+	}
 
-func (response GetGroup404JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelSlotSwap(ctx, roundId, swapId)
+	return err
 }
 
-type GetGroup500JSONResponse ErrorInternalServerError
+// BuildContribution converts echo context to params.
+func (w *ServerInterfaceWrapper) BuildContribution(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-func (response GetGroup500JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(SessionAuthScopes, []string{})
 
-type UpdateGroupRequestObject struct {
-	GroupId UUID `json:"groupId"`
-	Body    *UpdateGroupJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BuildContribution(ctx, roundId)
+	return err
 }
 
-type UpdateGroupResponseObject interface {
-	VisitUpdateGroupResponse(w http.ResponseWriter) error
-}
+// BuildPayoutClaim converts echo context to params.
+func (w *ServerInterfaceWrapper) BuildPayoutClaim(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-type UpdateGroup200JSONResponse Group
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BuildPayoutClaim(ctx, roundId)
+	return err
 }
 
-type UpdateGroup400JSONResponse ErrorBadRequest
-
-func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// TransitionRound converts echo context to params.
+func (w *ServerInterfaceWrapper) TransitionRound(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "roundId" -------------
+	var roundId UUID
 
-type UpdateGroup401JSONResponse ErrorUnauthorized
+	err = runtime.BindStyledParameterWithOptions("simple", "roundId", ctx.Param("roundId"), &roundId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter roundId: %s", err))
+	}
 
-func (response UpdateGroup401JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TransitionRound(ctx, roundId)
+	return err
 }
 
-type UpdateGroup403JSONResponse ErrorForbidden
+// ListTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListTokens(ctx echo.Context) error {
+	var err error
 
-func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListTokens(ctx)
+	return err
 }
 
-type UpdateGroup404JSONResponse ErrorNotFound
-
-func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
 
-	return json.NewEncoder(w).Encode(response)
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
 }
 
-type UpdateGroup500JSONResponse ErrorInternalServerError
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
 
-func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/admin/rounds/:roundId/backfills", wrapper.ListRoundBackfills)
+	router.POST(baseURL+"/admin/rounds/:roundId/backfills", wrapper.StartRoundBackfill)
+	router.POST(baseURL+"/auth/login", wrapper.AuthLogin)
+	router.POST(baseURL+"/auth/logout", wrapper.AuthLogout)
+	router.POST(baseURL+"/auth/nonce", wrapper.AuthNonce)
+	router.POST(baseURL+"/auth/signup", wrapper.AuthSignup)
+	router.POST(baseURL+"/auth/signup/complete", wrapper.AuthSignupComplete)
+	router.POST(baseURL+"/auth/verify", wrapper.AuthVerify)
+	router.GET(baseURL+"/dashboard", wrapper.GetDashboard)
+	router.GET(baseURL+"/groups", wrapper.ListGroups)
+	router.POST(baseURL+"/groups", wrapper.CreateGroup)
+	router.GET(baseURL+"/groups/:groupId", wrapper.GetGroup)
+	router.PATCH(baseURL+"/groups/:groupId", wrapper.UpdateGroup)
+	router.GET(baseURL+"/groups/:groupId/allowlist", wrapper.GetGroupAllowlist)
+	router.GET(baseURL+"/groups/:groupId/allowlist/proof", wrapper.GetGroupAllowlistProof)
+	router.POST(baseURL+"/groups/:groupId/archive", wrapper.ArchiveGroup)
+	router.GET(baseURL+"/groups/:groupId/invites", wrapper.ListInvites)
+	router.POST(baseURL+"/groups/:groupId/invites", wrapper.CreateInvite)
+	router.DELETE(baseURL+"/groups/:groupId/invites/:inviteId", wrapper.RevokeInvite)
+	router.GET(baseURL+"/groups/:groupId/invites/:inviteId/card", wrapper.GetInviteCard)
+	router.GET(baseURL+"/groups/:groupId/invites/:inviteId/qr", wrapper.GetInviteQrCode)
+	router.POST(baseURL+"/groups/:groupId/leave", wrapper.LeaveGroup)
+	router.GET(baseURL+"/groups/:groupId/members", wrapper.ListGroupMembers)
+	router.GET(baseURL+"/groups/:groupId/members/search", wrapper.SearchGroupMembers)
+	router.DELETE(baseURL+"/groups/:groupId/members/:memberAddress", wrapper.RemoveGroupMember)
+	router.GET(baseURL+"/groups/:groupId/members/:memberAddress", wrapper.GetGroupMember)
+	router.POST(baseURL+"/groups/:groupId/payout-orders", wrapper.CreatePayoutOrder)
+	router.GET(baseURL+"/groups/:groupId/payout-orders/:orderId", wrapper.GetPayoutOrder)
+	router.POST(baseURL+"/groups/:groupId/payout-orders/:orderId/approvals", wrapper.ApprovePayoutOrder)
+	router.POST(baseURL+"/groups/:groupId/payout-orders/:orderId/bids", wrapper.CommitPayoutOrderBid)
+	router.POST(baseURL+"/groups/:groupId/payout-orders/:orderId/bids/reveal", wrapper.RevealPayoutOrderBid)
+	router.GET(baseURL+"/groups/:groupId/payout-orders/:orderId/export", wrapper.ExportPayoutOrder)
+	router.POST(baseURL+"/groups/:groupId/payout-orders/:orderId/finalize", wrapper.FinalizePayoutOrder)
+	router.PUT(baseURL+"/groups/:groupId/payout-orders/:orderId/order", wrapper.ProposePayoutOrder)
+	router.GET(baseURL+"/groups/:groupId/rounds", wrapper.ListGroupRounds)
+	router.POST(baseURL+"/groups/:groupId/rounds", wrapper.CreateRound)
+	router.POST(baseURL+"/groups/:groupId/unarchive", wrapper.UnarchiveGroup)
+	router.POST(baseURL+"/invites/accept", wrapper.AcceptInvite)
+	router.POST(baseURL+"/invites/preview", wrapper.PreviewInvite)
+	router.GET(baseURL+"/me", wrapper.GetMe)
+	router.PATCH(baseURL+"/me", wrapper.UpdateMe)
+	router.GET(baseURL+"/me/notification-preferences", wrapper.GetNotificationPreferences)
+	router.PUT(baseURL+"/me/notification-preferences", wrapper.UpdateNotificationPreferences)
+	router.GET(baseURL+"/rounds", wrapper.ListRounds)
+	router.GET(baseURL+"/rounds/:roundId", wrapper.GetRound)
+	router.GET(baseURL+"/rounds/:roundId/activity", wrapper.GetRoundActivity)
+	router.GET(baseURL+"/rounds/:roundId/activity/export", wrapper.ExportRoundActivity)
+	router.GET(baseURL+"/rounds/:roundId/balances", wrapper.GetRoundBalances)
+	router.GET(baseURL+"/rounds/:roundId/periods", wrapper.GetRoundPeriods)
+	router.GET(baseURL+"/rounds/:roundId/swaps", wrapper.ListSlotSwaps)
+	router.POST(baseURL+"/rounds/:roundId/swaps", wrapper.ProposeSlotSwap)
+	router.POST(baseURL+"/rounds/:roundId/swaps/:swapId/accept", wrapper.AcceptSlotSwap)
+	router.POST(baseURL+"/rounds/:roundId/swaps/:swapId/cancel", wrapper.CancelSlotSwap)
+	router.GET(baseURL+"/rounds/:roundId/transactions/contribution", wrapper.BuildContribution)
+	router.GET(baseURL+"/rounds/:roundId/transactions/payout-claim", wrapper.BuildPayoutClaim)
+	router.POST(baseURL+"/rounds/:roundId/transitions", wrapper.TransitionRound)
+	router.GET(baseURL+"/tokens", wrapper.ListTokens)
 
-	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInvitesRequestObject struct {
-	GroupId UUID `json:"groupId"`
+type ListRoundBackfillsRequestObject struct {
+	RoundId UUID `json:"roundId"`
 }
 
-type ListInvitesResponseObject interface {
-	VisitListInvitesResponse(w http.ResponseWriter) error
+type ListRoundBackfillsResponseObject interface {
+	VisitListRoundBackfillsResponse(w http.ResponseWriter) error
 }
 
-type ListInvites200JSONResponse []InviteSummary
+type ListRoundBackfills200JSONResponse []IndexerBackfill
 
-func (response ListInvites200JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+func (response ListRoundBackfills200JSONResponse) VisitListRoundBackfillsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInvites401JSONResponse ErrorUnauthorized
+type ListRoundBackfills401JSONResponse ErrorUnauthorized
 
-func (response ListInvites401JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+func (response ListRoundBackfills401JSONResponse) VisitListRoundBackfillsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInvites403JSONResponse ErrorForbidden
+type ListRoundBackfills403JSONResponse ErrorForbidden
 
-func (response ListInvites403JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+func (response ListRoundBackfills403JSONResponse) VisitListRoundBackfillsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInvites404JSONResponse ErrorNotFound
+type ListRoundBackfills404JSONResponse ErrorNotFound
 
-func (response ListInvites404JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+func (response ListRoundBackfills404JSONResponse) VisitListRoundBackfillsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListInvites500JSONResponse ErrorInternalServerError
+type ListRoundBackfills500JSONResponse ErrorInternalServerError
 
-func (response ListInvites500JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+func (response ListRoundBackfills500JSONResponse) VisitListRoundBackfillsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInviteRequestObject struct {
-	GroupId UUID `json:"groupId"`
-	Body    *CreateInviteJSONRequestBody
+type StartRoundBackfillRequestObject struct {
+	RoundId UUID `json:"roundId"`
+	Body    *StartRoundBackfillJSONRequestBody
 }
 
-type CreateInviteResponseObject interface {
-	VisitCreateInviteResponse(w http.ResponseWriter) error
+type StartRoundBackfillResponseObject interface {
+	VisitStartRoundBackfillResponse(w http.ResponseWriter) error
 }
 
-type CreateInvite201JSONResponse Invite
+type StartRoundBackfill202JSONResponse IndexerBackfill
 
-func (response CreateInvite201JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+func (response StartRoundBackfill202JSONResponse) VisitStartRoundBackfillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(202)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvite400JSONResponse ErrorBadRequest
+type StartRoundBackfill400JSONResponse ErrorBadRequest
 
-func (response CreateInvite400JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+func (response StartRoundBackfill400JSONResponse) VisitStartRoundBackfillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvite401JSONResponse ErrorUnauthorized
+type StartRoundBackfill401JSONResponse ErrorUnauthorized
 
-func (response CreateInvite401JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+func (response StartRoundBackfill401JSONResponse) VisitStartRoundBackfillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvite403JSONResponse ErrorForbidden
+type StartRoundBackfill403JSONResponse ErrorForbidden
 
-func (response CreateInvite403JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+func (response StartRoundBackfill403JSONResponse) VisitStartRoundBackfillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvite404JSONResponse ErrorNotFound
+type StartRoundBackfill404JSONResponse ErrorNotFound
 
-func (response CreateInvite404JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+func (response StartRoundBackfill404JSONResponse) VisitStartRoundBackfillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateInvite500JSONResponse ErrorInternalServerError
+type StartRoundBackfill409JSONResponse ErrorConflict
 
-func (response CreateInvite500JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+func (response StartRoundBackfill409JSONResponse) VisitStartRoundBackfillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeInviteRequestObject struct {
-	GroupId  UUID `json:"groupId"`
-	InviteId UUID `json:"inviteId"`
-}
+type StartRoundBackfill500JSONResponse ErrorInternalServerError
 
-type RevokeInviteResponseObject interface {
-	VisitRevokeInviteResponse(w http.ResponseWriter) error
+func (response StartRoundBackfill500JSONResponse) VisitStartRoundBackfillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeInvite204Response struct {
+type AuthLoginRequestObject struct {
+	Body *AuthLoginJSONRequestBody
 }
 
-func (response RevokeInvite204Response) VisitRevokeInviteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type AuthLoginResponseObject interface {
+	VisitAuthLoginResponse(w http.ResponseWriter) error
 }
 
-type RevokeInvite401JSONResponse ErrorUnauthorized
+type AuthLogin200JSONResponse AuthLoginResponse
 
-func (response RevokeInvite401JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+func (response AuthLogin200JSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeInvite403JSONResponse ErrorForbidden
+type AuthLogin400JSONResponse ErrorBadRequest
 
-func (response RevokeInvite403JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+func (response AuthLogin400JSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeInvite404JSONResponse ErrorNotFound
+type AuthLogoutRequestObject struct {
+}
 
-func (response RevokeInvite404JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type AuthLogoutResponseObject interface {
+	VisitAuthLogoutResponse(w http.ResponseWriter) error
+}
 
-	return json.NewEncoder(w).Encode(response)
+type AuthLogout204Response struct {
 }
 
-type RevokeInvite500JSONResponse ErrorInternalServerError
+func (response AuthLogout204Response) VisitAuthLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-func (response RevokeInvite500JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+type AuthLogout401JSONResponse ErrorUnauthorized
+
+func (response AuthLogout401JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LeaveGroupRequestObject struct {
-	GroupId UUID `json:"groupId"`
-}
+type AuthLogout500JSONResponse ErrorInternalServerError
 
-type LeaveGroupResponseObject interface {
-	VisitLeaveGroupResponse(w http.ResponseWriter) error
+func (response AuthLogout500JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LeaveGroup204Response struct {
+type AuthNonceRequestObject struct {
+	Body *AuthNonceJSONRequestBody
 }
 
-func (response LeaveGroup204Response) VisitLeaveGroupResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type AuthNonceResponseObject interface {
+	VisitAuthNonceResponse(w http.ResponseWriter) error
 }
 
-type LeaveGroup401JSONResponse ErrorUnauthorized
+type AuthNonce200JSONResponse AuthNonceResponse
 
-func (response LeaveGroup401JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+func (response AuthNonce200JSONResponse) VisitAuthNonceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LeaveGroup403JSONResponse ErrorForbidden
+type AuthNonce400JSONResponse ErrorBadRequest
 
-func (response LeaveGroup403JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+func (response AuthNonce400JSONResponse) VisitAuthNonceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LeaveGroup404JSONResponse ErrorNotFound
+type AuthSignupRequestObject struct {
+	Body *AuthSignupJSONRequestBody
+}
 
-func (response LeaveGroup404JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+type AuthSignupResponseObject interface {
+	VisitAuthSignupResponse(w http.ResponseWriter) error
+}
+
+type AuthSignup200JSONResponse AuthSignupResponse
+
+func (response AuthSignup200JSONResponse) VisitAuthSignupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LeaveGroup500JSONResponse ErrorInternalServerError
+type AuthSignup400JSONResponse ErrorBadRequest
 
-func (response LeaveGroup500JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+func (response AuthSignup400JSONResponse) VisitAuthSignupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembersRequestObject struct {
-	GroupId UUID `json:"groupId"`
+type AuthSignupCompleteRequestObject struct {
+	Body *AuthSignupCompleteJSONRequestBody
 }
 
-type ListGroupMembersResponseObject interface {
-	VisitListGroupMembersResponse(w http.ResponseWriter) error
+type AuthSignupCompleteResponseObject interface {
+	VisitAuthSignupCompleteResponse(w http.ResponseWriter) error
 }
 
-type ListGroupMembers200JSONResponse []GroupMember
+type AuthSignupComplete200ResponseHeaders struct {
+	SetCookie string
+}
 
-func (response ListGroupMembers200JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+type AuthSignupComplete200JSONResponse struct {
+	Body    AuthVerifyWalletResponse
+	Headers AuthSignupComplete200ResponseHeaders
+}
+
+func (response AuthSignupComplete200JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type ListGroupMembers401JSONResponse ErrorUnauthorized
+type AuthSignupComplete400JSONResponse ErrorBadRequest
 
-func (response ListGroupMembers401JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response AuthSignupComplete400JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembers403JSONResponse ErrorForbidden
+type AuthSignupComplete401JSONResponse ErrorUnauthorized
 
-func (response ListGroupMembers403JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response AuthSignupComplete401JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembers404JSONResponse ErrorNotFound
+type AuthSignupComplete409JSONResponse ErrorBadRequest
 
-func (response ListGroupMembers404JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response AuthSignupComplete409JSONResponse) VisitAuthSignupCompleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembers500JSONResponse ErrorInternalServerError
-
-func (response ListGroupMembers500JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+type AuthVerifyRequestObject struct {
+	Body *AuthVerifyJSONRequestBody
+}
 
-	return json.NewEncoder(w).Encode(response)
+type AuthVerifyResponseObject interface {
+	VisitAuthVerifyResponse(w http.ResponseWriter) error
 }
 
-type RemoveGroupMemberRequestObject struct {
-	GroupId       UUID    `json:"groupId"`
-	MemberAddress Address `json:"memberAddress"`
+type AuthVerify200ResponseHeaders struct {
+	SetCookie string
 }
 
-type RemoveGroupMemberResponseObject interface {
-	VisitRemoveGroupMemberResponse(w http.ResponseWriter) error
+type AuthVerify200JSONResponse struct {
+	Body    AuthVerifyResponse
+	Headers AuthVerify200ResponseHeaders
 }
 
-type RemoveGroupMember204Response struct {
+func (response AuthVerify200JSONResponse) VisitAuthVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (response RemoveGroupMember204Response) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type AuthVerify400JSONResponse ErrorBadRequest
+
+func (response AuthVerify400JSONResponse) VisitAuthVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember401JSONResponse ErrorUnauthorized
+type AuthVerify401JSONResponse ErrorUnauthorized
 
-func (response RemoveGroupMember401JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response AuthVerify401JSONResponse) VisitAuthVerifyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember403JSONResponse ErrorForbidden
+type GetDashboardRequestObject struct {
+}
 
-func (response RemoveGroupMember403JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+type GetDashboardResponseObject interface {
+	VisitGetDashboardResponse(w http.ResponseWriter) error
+}
+
+type GetDashboard200JSONResponse Dashboard
+
+func (response GetDashboard200JSONResponse) VisitGetDashboardResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember404JSONResponse ErrorNotFound
+type GetDashboard401JSONResponse ErrorUnauthorized
 
-func (response RemoveGroupMember404JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetDashboard401JSONResponse) VisitGetDashboardResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember500JSONResponse ErrorInternalServerError
+type GetDashboard500JSONResponse ErrorInternalServerError
 
-func (response RemoveGroupMember500JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetDashboard500JSONResponse) VisitGetDashboardResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupRoundsRequestObject struct {
-	GroupId UUID `json:"groupId"`
-	Params  ListGroupRoundsParams
+type ListGroupsRequestObject struct {
+	Params ListGroupsParams
 }
 
-type ListGroupRoundsResponseObject interface {
-	VisitListGroupRoundsResponse(w http.ResponseWriter) error
+type ListGroupsResponseObject interface {
+	VisitListGroupsResponse(w http.ResponseWriter) error
 }
 
-type ListGroupRounds200JSONResponse RoundPage
+type ListGroups200JSONResponse GroupPage
 
-func (response ListGroupRounds200JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+func (response ListGroups200JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupRounds401JSONResponse ErrorUnauthorized
-
-func (response ListGroupRounds401JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListGroupRounds403JSONResponse ErrorForbidden
+type ListGroups400JSONResponse ErrorBadRequest
 
-func (response ListGroupRounds403JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+func (response ListGroups400JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupRounds404JSONResponse ErrorNotFound
+type ListGroups401JSONResponse ErrorUnauthorized
 
-func (response ListGroupRounds404JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+func (response ListGroups401JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupRounds500JSONResponse ErrorInternalServerError
+type ListGroups500JSONResponse ErrorInternalServerError
 
-func (response ListGroupRounds500JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+func (response ListGroups500JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRoundRequestObject struct {
-	GroupId UUID `json:"groupId"`
-	Body    *CreateRoundJSONRequestBody
+type CreateGroupRequestObject struct {
+	Body *CreateGroupJSONRequestBody
 }
 
-type CreateRoundResponseObject interface {
-	VisitCreateRoundResponse(w http.ResponseWriter) error
+type CreateGroupResponseObject interface {
+	VisitCreateGroupResponse(w http.ResponseWriter) error
 }
 
-type CreateRound201JSONResponse Round
+type CreateGroup201JSONResponse Group
 
-func (response CreateRound201JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRound400JSONResponse ErrorBadRequest
+type CreateGroup400JSONResponse ErrorBadRequest
 
-func (response CreateRound400JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRound401JSONResponse ErrorUnauthorized
+type CreateGroup401JSONResponse ErrorUnauthorized
 
-func (response CreateRound401JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+func (response CreateGroup401JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRound403JSONResponse ErrorForbidden
+type CreateGroup500JSONResponse ErrorInternalServerError
 
-func (response CreateRound403JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRound404JSONResponse ErrorNotFound
+type GetGroupRequestObject struct {
+	GroupId UUID `json:"groupId"`
+}
 
-func (response CreateRound404JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+type GetGroupResponseObject interface {
+	VisitGetGroupResponse(w http.ResponseWriter) error
+}
+
+type GetGroup200JSONResponse Group
+
+func (response GetGroup200JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRound500JSONResponse ErrorInternalServerError
+type GetGroup401JSONResponse ErrorUnauthorized
 
-func (response CreateRound500JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+func (response GetGroup401JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AcceptInviteRequestObject struct {
-	Body *AcceptInviteJSONRequestBody
+type GetGroup403JSONResponse ErrorForbidden
+
+func (response GetGroup403JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AcceptInviteResponseObject interface {
-	VisitAcceptInviteResponse(w http.ResponseWriter) error
+type GetGroup404JSONResponse ErrorNotFound
+
+func (response GetGroup404JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AcceptInvite200JSONResponse AcceptInviteResponse
+type GetGroup500JSONResponse ErrorInternalServerError
 
-func (response AcceptInvite200JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+func (response GetGroup500JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroupRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	Body    *UpdateGroupJSONRequestBody
+}
+
+type UpdateGroupResponseObject interface {
+	VisitUpdateGroupResponse(w http.ResponseWriter) error
+}
+
+type UpdateGroup200JSONResponse Group
+
+func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AcceptInvite400JSONResponse ErrorBadRequest
+type UpdateGroup400JSONResponse ErrorBadRequest
 
-func (response AcceptInvite400JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AcceptInvite401JSONResponse ErrorUnauthorized
+type UpdateGroup401JSONResponse ErrorUnauthorized
 
-func (response AcceptInvite401JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+func (response UpdateGroup401JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AcceptInvite404JSONResponse ErrorNotFound
+type UpdateGroup403JSONResponse ErrorForbidden
 
-func (response AcceptInvite404JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup404JSONResponse ErrorNotFound
+
+func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AcceptInvite500JSONResponse ErrorInternalServerError
+type UpdateGroup500JSONResponse ErrorInternalServerError
 
-func (response AcceptInvite500JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewInviteRequestObject struct {
-	Body *PreviewInviteJSONRequestBody
+type GetGroupAllowlistRequestObject struct {
+	GroupId UUID `json:"groupId"`
 }
 
-type PreviewInviteResponseObject interface {
-	VisitPreviewInviteResponse(w http.ResponseWriter) error
+type GetGroupAllowlistResponseObject interface {
+	VisitGetGroupAllowlistResponse(w http.ResponseWriter) error
 }
 
-type PreviewInvite200JSONResponse InvitePreview
+type GetGroupAllowlist200JSONResponse GroupAllowlist
 
-func (response PreviewInvite200JSONResponse) VisitPreviewInviteResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlist200JSONResponse) VisitGetGroupAllowlistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewInvite400JSONResponse ErrorBadRequest
+type GetGroupAllowlist401JSONResponse ErrorUnauthorized
 
-func (response PreviewInvite400JSONResponse) VisitPreviewInviteResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlist401JSONResponse) VisitGetGroupAllowlistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewInvite404JSONResponse ErrorNotFound
+type GetGroupAllowlist403JSONResponse ErrorForbidden
 
-func (response PreviewInvite404JSONResponse) VisitPreviewInviteResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlist403JSONResponse) VisitGetGroupAllowlistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMeRequestObject struct {
-}
-
-type GetMeResponseObject interface {
-	VisitGetMeResponse(w http.ResponseWriter) error
-}
-
-type GetMe200JSONResponse User
+type GetGroupAllowlist404JSONResponse ErrorNotFound
 
-func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlist404JSONResponse) VisitGetGroupAllowlistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMe401JSONResponse ErrorUnauthorized
+type GetGroupAllowlist500JSONResponse ErrorInternalServerError
 
-func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlist500JSONResponse) VisitGetGroupAllowlistResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateMeRequestObject struct {
-	Body *UpdateMeJSONRequestBody
+type GetGroupAllowlistProofRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	Params  GetGroupAllowlistProofParams
 }
 
-type UpdateMeResponseObject interface {
-	VisitUpdateMeResponse(w http.ResponseWriter) error
+type GetGroupAllowlistProofResponseObject interface {
+	VisitGetGroupAllowlistProofResponse(w http.ResponseWriter) error
 }
 
-type UpdateMe200JSONResponse User
+type GetGroupAllowlistProof200JSONResponse GroupAllowlistProof
 
-func (response UpdateMe200JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlistProof200JSONResponse) VisitGetGroupAllowlistProofResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateMe400JSONResponse ErrorBadRequest
+type GetGroupAllowlistProof400JSONResponse ErrorBadRequest
 
-func (response UpdateMe400JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlistProof400JSONResponse) VisitGetGroupAllowlistProofResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateMe401JSONResponse ErrorUnauthorized
+type GetGroupAllowlistProof401JSONResponse ErrorUnauthorized
 
-func (response UpdateMe401JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlistProof401JSONResponse) VisitGetGroupAllowlistProofResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateMe500JSONResponse ErrorInternalServerError
+type GetGroupAllowlistProof403JSONResponse ErrorForbidden
 
-func (response UpdateMe500JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+func (response GetGroupAllowlistProof403JSONResponse) VisitGetGroupAllowlistProofResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupAllowlistProof404JSONResponse ErrorNotFound
+
+func (response GetGroupAllowlistProof404JSONResponse) VisitGetGroupAllowlistProofResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupAllowlistProof500JSONResponse ErrorInternalServerError
+
+func (response GetGroupAllowlistProof500JSONResponse) VisitGetGroupAllowlistProofResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRoundsRequestObject struct {
-	Params ListRoundsParams
+type ArchiveGroupRequestObject struct {
+	GroupId UUID `json:"groupId"`
 }
 
-type ListRoundsResponseObject interface {
-	VisitListRoundsResponse(w http.ResponseWriter) error
+type ArchiveGroupResponseObject interface {
+	VisitArchiveGroupResponse(w http.ResponseWriter) error
 }
 
-type ListRounds200JSONResponse RoundPage
+type ArchiveGroup204Response struct {
+}
 
-func (response ListRounds200JSONResponse) VisitListRoundsResponse(w http.ResponseWriter) error {
+func (response ArchiveGroup204Response) VisitArchiveGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ArchiveGroup401JSONResponse ErrorUnauthorized
+
+func (response ArchiveGroup401JSONResponse) VisitArchiveGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRounds401JSONResponse ErrorUnauthorized
+type ArchiveGroup403JSONResponse ErrorForbidden
 
-func (response ListRounds401JSONResponse) VisitListRoundsResponse(w http.ResponseWriter) error {
+func (response ArchiveGroup403JSONResponse) VisitArchiveGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRounds500JSONResponse ErrorInternalServerError
+type ArchiveGroup404JSONResponse ErrorNotFound
 
-func (response ListRounds500JSONResponse) VisitListRoundsResponse(w http.ResponseWriter) error {
+func (response ArchiveGroup404JSONResponse) VisitArchiveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveGroup409JSONResponse ErrorConflict
+
+func (response ArchiveGroup409JSONResponse) VisitArchiveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveGroup500JSONResponse ErrorInternalServerError
+
+func (response ArchiveGroup500JSONResponse) VisitArchiveGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundRequestObject struct {
-	RoundId UUID `json:"roundId"`
+type ListInvitesRequestObject struct {
+	GroupId UUID `json:"groupId"`
 }
 
-type GetRoundResponseObject interface {
-	VisitGetRoundResponse(w http.ResponseWriter) error
+type ListInvitesResponseObject interface {
+	VisitListInvitesResponse(w http.ResponseWriter) error
 }
 
-type GetRound200JSONResponse RoundDetail
+type ListInvites200JSONResponse []InviteSummary
 
-func (response GetRound200JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+func (response ListInvites200JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRound401JSONResponse ErrorUnauthorized
+type ListInvites401JSONResponse ErrorUnauthorized
 
-func (response GetRound401JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+func (response ListInvites401JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRound403JSONResponse ErrorForbidden
+type ListInvites403JSONResponse ErrorForbidden
 
-func (response GetRound403JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+func (response ListInvites403JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRound404JSONResponse ErrorNotFound
+type ListInvites404JSONResponse ErrorNotFound
 
-func (response GetRound404JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+func (response ListInvites404JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRound500JSONResponse ErrorInternalServerError
+type ListInvites500JSONResponse ErrorInternalServerError
 
-func (response GetRound500JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+func (response ListInvites500JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundActivityRequestObject struct {
-	RoundId UUID `json:"roundId"`
-	Params  GetRoundActivityParams
+type CreateInviteRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	Body    *CreateInviteJSONRequestBody
 }
 
-type GetRoundActivityResponseObject interface {
-	VisitGetRoundActivityResponse(w http.ResponseWriter) error
+type CreateInviteResponseObject interface {
+	VisitCreateInviteResponse(w http.ResponseWriter) error
 }
 
-type GetRoundActivity200JSONResponse ActivityPage
+type CreateInvite201JSONResponse Invite
 
-func (response GetRoundActivity200JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+func (response CreateInvite201JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundActivity401JSONResponse ErrorUnauthorized
+type CreateInvite400JSONResponse ErrorBadRequest
 
-func (response GetRoundActivity401JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+func (response CreateInvite400JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvite401JSONResponse ErrorUnauthorized
+
+func (response CreateInvite401JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundActivity403JSONResponse ErrorForbidden
+type CreateInvite403JSONResponse ErrorForbidden
 
-func (response GetRoundActivity403JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+func (response CreateInvite403JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundActivity404JSONResponse ErrorNotFound
+type CreateInvite404JSONResponse ErrorNotFound
 
-func (response GetRoundActivity404JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+func (response CreateInvite404JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundActivity500JSONResponse ErrorInternalServerError
+type CreateInvite500JSONResponse ErrorInternalServerError
 
-func (response GetRoundActivity500JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+func (response CreateInvite500JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundPeriodsRequestObject struct {
-	RoundId UUID `json:"roundId"`
+type RevokeInviteRequestObject struct {
+	GroupId  UUID `json:"groupId"`
+	InviteId UUID `json:"inviteId"`
 }
 
-type GetRoundPeriodsResponseObject interface {
-	VisitGetRoundPeriodsResponse(w http.ResponseWriter) error
+type RevokeInviteResponseObject interface {
+	VisitRevokeInviteResponse(w http.ResponseWriter) error
 }
 
-type GetRoundPeriods200JSONResponse []RoundPeriodStatus
+type RevokeInvite204Response struct {
+}
 
-func (response GetRoundPeriods200JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
+func (response RevokeInvite204Response) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeInvite401JSONResponse ErrorUnauthorized
+
+func (response RevokeInvite401JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRoundPeriods401JSONResponse ErrorUnauthorized
+type RevokeInvite403JSONResponse ErrorForbidden
 
-func (response GetRoundPeriods401JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
+func (response RevokeInvite403JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvite404JSONResponse ErrorNotFound
+
+func (response RevokeInvite404JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvite500JSONResponse ErrorInternalServerError
+
+func (response RevokeInvite500JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteCardRequestObject struct {
+	GroupId  UUID `json:"groupId"`
+	InviteId UUID `json:"inviteId"`
+}
+
+type GetInviteCardResponseObject interface {
+	VisitGetInviteCardResponse(w http.ResponseWriter) error
+}
+
+type GetInviteCard200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetInviteCard200TexthtmlResponse) VisitGetInviteCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetInviteCard401JSONResponse ErrorUnauthorized
+
+func (response GetInviteCard401JSONResponse) VisitGetInviteCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteCard403JSONResponse ErrorForbidden
+
+func (response GetInviteCard403JSONResponse) VisitGetInviteCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteCard404JSONResponse ErrorNotFound
+
+func (response GetInviteCard404JSONResponse) VisitGetInviteCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteCard500JSONResponse ErrorInternalServerError
+
+func (response GetInviteCard500JSONResponse) VisitGetInviteCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteQrCodeRequestObject struct {
+	GroupId  UUID `json:"groupId"`
+	InviteId UUID `json:"inviteId"`
+	Params   GetInviteQrCodeParams
+}
+
+type GetInviteQrCodeResponseObject interface {
+	VisitGetInviteQrCodeResponse(w http.ResponseWriter) error
+}
+
+type GetInviteQrCode200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetInviteQrCode200ImagepngResponse) VisitGetInviteQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetInviteQrCode200ImagesvgXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetInviteQrCode200ImagesvgXmlResponse) VisitGetInviteQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetInviteQrCode401JSONResponse ErrorUnauthorized
+
+func (response GetInviteQrCode401JSONResponse) VisitGetInviteQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteQrCode403JSONResponse ErrorForbidden
+
+func (response GetInviteQrCode403JSONResponse) VisitGetInviteQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteQrCode404JSONResponse ErrorNotFound
+
+func (response GetInviteQrCode404JSONResponse) VisitGetInviteQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInviteQrCode500JSONResponse ErrorInternalServerError
+
+func (response GetInviteQrCode500JSONResponse) VisitGetInviteQrCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveGroupRequestObject struct {
+	GroupId UUID `json:"groupId"`
+}
+
+type LeaveGroupResponseObject interface {
+	VisitLeaveGroupResponse(w http.ResponseWriter) error
+}
+
+type LeaveGroup204Response struct {
+}
+
+func (response LeaveGroup204Response) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type LeaveGroup401JSONResponse ErrorUnauthorized
+
+func (response LeaveGroup401JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveGroup403JSONResponse ErrorForbidden
+
+func (response LeaveGroup403JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveGroup404JSONResponse ErrorNotFound
+
+func (response LeaveGroup404JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveGroup409JSONResponse ErrorConflict
+
+func (response LeaveGroup409JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveGroup500JSONResponse ErrorInternalServerError
+
+func (response LeaveGroup500JSONResponse) VisitLeaveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembersRequestObject struct {
+	GroupId UUID `json:"groupId"`
+}
+
+type ListGroupMembersResponseObject interface {
+	VisitListGroupMembersResponse(w http.ResponseWriter) error
+}
+
+type ListGroupMembers200JSONResponse []GroupMember
+
+func (response ListGroupMembers200JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembers401JSONResponse ErrorUnauthorized
+
+func (response ListGroupMembers401JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembers403JSONResponse ErrorForbidden
+
+func (response ListGroupMembers403JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembers404JSONResponse ErrorNotFound
+
+func (response ListGroupMembers404JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembers500JSONResponse ErrorInternalServerError
+
+func (response ListGroupMembers500JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchGroupMembersRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	Params  SearchGroupMembersParams
+}
+
+type SearchGroupMembersResponseObject interface {
+	VisitSearchGroupMembersResponse(w http.ResponseWriter) error
+}
+
+type SearchGroupMembers200JSONResponse GroupMemberPage
+
+func (response SearchGroupMembers200JSONResponse) VisitSearchGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchGroupMembers400JSONResponse ErrorBadRequest
+
+func (response SearchGroupMembers400JSONResponse) VisitSearchGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchGroupMembers401JSONResponse ErrorUnauthorized
+
+func (response SearchGroupMembers401JSONResponse) VisitSearchGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchGroupMembers403JSONResponse ErrorForbidden
+
+func (response SearchGroupMembers403JSONResponse) VisitSearchGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchGroupMembers404JSONResponse ErrorNotFound
+
+func (response SearchGroupMembers404JSONResponse) VisitSearchGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchGroupMembers500JSONResponse ErrorInternalServerError
+
+func (response SearchGroupMembers500JSONResponse) VisitSearchGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMemberRequestObject struct {
+	GroupId       UUID    `json:"groupId"`
+	MemberAddress Address `json:"memberAddress"`
+	Params        RemoveGroupMemberParams
+}
+
+type RemoveGroupMemberResponseObject interface {
+	VisitRemoveGroupMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveGroupMember204Response struct {
+}
+
+func (response RemoveGroupMember204Response) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveGroupMember400JSONResponse ErrorBadRequest
+
+func (response RemoveGroupMember400JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember401JSONResponse ErrorUnauthorized
+
+func (response RemoveGroupMember401JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember403JSONResponse ErrorForbidden
+
+func (response RemoveGroupMember403JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember404JSONResponse ErrorNotFound
+
+func (response RemoveGroupMember404JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember409JSONResponse ErrorConflict
+
+func (response RemoveGroupMember409JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember500JSONResponse ErrorInternalServerError
+
+func (response RemoveGroupMember500JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupMemberRequestObject struct {
+	GroupId       UUID    `json:"groupId"`
+	MemberAddress Address `json:"memberAddress"`
+}
+
+type GetGroupMemberResponseObject interface {
+	VisitGetGroupMemberResponse(w http.ResponseWriter) error
+}
+
+type GetGroupMember200JSONResponse GroupMemberProfile
+
+func (response GetGroupMember200JSONResponse) VisitGetGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupMember401JSONResponse ErrorUnauthorized
+
+func (response GetGroupMember401JSONResponse) VisitGetGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupMember403JSONResponse ErrorForbidden
+
+func (response GetGroupMember403JSONResponse) VisitGetGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupMember404JSONResponse ErrorNotFound
+
+func (response GetGroupMember404JSONResponse) VisitGetGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupMember500JSONResponse ErrorInternalServerError
+
+func (response GetGroupMember500JSONResponse) VisitGetGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayoutOrderRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	Body    *CreatePayoutOrderJSONRequestBody
+}
+
+type CreatePayoutOrderResponseObject interface {
+	VisitCreatePayoutOrderResponse(w http.ResponseWriter) error
+}
+
+type CreatePayoutOrder201JSONResponse PayoutOrder
+
+func (response CreatePayoutOrder201JSONResponse) VisitCreatePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayoutOrder400JSONResponse ErrorBadRequest
+
+func (response CreatePayoutOrder400JSONResponse) VisitCreatePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayoutOrder401JSONResponse ErrorUnauthorized
+
+func (response CreatePayoutOrder401JSONResponse) VisitCreatePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayoutOrder403JSONResponse ErrorForbidden
+
+func (response CreatePayoutOrder403JSONResponse) VisitCreatePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayoutOrder404JSONResponse ErrorNotFound
+
+func (response CreatePayoutOrder404JSONResponse) VisitCreatePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayoutOrder409JSONResponse ErrorConflict
+
+func (response CreatePayoutOrder409JSONResponse) VisitCreatePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayoutOrder500JSONResponse ErrorInternalServerError
+
+func (response CreatePayoutOrder500JSONResponse) VisitCreatePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayoutOrderRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	OrderId UUID `json:"orderId"`
+}
+
+type GetPayoutOrderResponseObject interface {
+	VisitGetPayoutOrderResponse(w http.ResponseWriter) error
+}
+
+type GetPayoutOrder200JSONResponse PayoutOrder
+
+func (response GetPayoutOrder200JSONResponse) VisitGetPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayoutOrder401JSONResponse ErrorUnauthorized
+
+func (response GetPayoutOrder401JSONResponse) VisitGetPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayoutOrder403JSONResponse ErrorForbidden
+
+func (response GetPayoutOrder403JSONResponse) VisitGetPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayoutOrder404JSONResponse ErrorNotFound
+
+func (response GetPayoutOrder404JSONResponse) VisitGetPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayoutOrder500JSONResponse ErrorInternalServerError
+
+func (response GetPayoutOrder500JSONResponse) VisitGetPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePayoutOrderRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	OrderId UUID `json:"orderId"`
+	Body    *ApprovePayoutOrderJSONRequestBody
+}
+
+type ApprovePayoutOrderResponseObject interface {
+	VisitApprovePayoutOrderResponse(w http.ResponseWriter) error
+}
+
+type ApprovePayoutOrder200JSONResponse PayoutOrder
+
+func (response ApprovePayoutOrder200JSONResponse) VisitApprovePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePayoutOrder400JSONResponse ErrorBadRequest
+
+func (response ApprovePayoutOrder400JSONResponse) VisitApprovePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePayoutOrder401JSONResponse ErrorUnauthorized
+
+func (response ApprovePayoutOrder401JSONResponse) VisitApprovePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePayoutOrder403JSONResponse ErrorForbidden
+
+func (response ApprovePayoutOrder403JSONResponse) VisitApprovePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePayoutOrder404JSONResponse ErrorNotFound
+
+func (response ApprovePayoutOrder404JSONResponse) VisitApprovePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePayoutOrder409JSONResponse ErrorConflict
+
+func (response ApprovePayoutOrder409JSONResponse) VisitApprovePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovePayoutOrder500JSONResponse ErrorInternalServerError
+
+func (response ApprovePayoutOrder500JSONResponse) VisitApprovePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitPayoutOrderBidRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	OrderId UUID `json:"orderId"`
+	Body    *CommitPayoutOrderBidJSONRequestBody
+}
+
+type CommitPayoutOrderBidResponseObject interface {
+	VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error
+}
+
+type CommitPayoutOrderBid200JSONResponse PayoutOrder
+
+func (response CommitPayoutOrderBid200JSONResponse) VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitPayoutOrderBid400JSONResponse ErrorBadRequest
+
+func (response CommitPayoutOrderBid400JSONResponse) VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitPayoutOrderBid401JSONResponse ErrorUnauthorized
+
+func (response CommitPayoutOrderBid401JSONResponse) VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitPayoutOrderBid403JSONResponse ErrorForbidden
+
+func (response CommitPayoutOrderBid403JSONResponse) VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitPayoutOrderBid404JSONResponse ErrorNotFound
+
+func (response CommitPayoutOrderBid404JSONResponse) VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitPayoutOrderBid409JSONResponse ErrorConflict
+
+func (response CommitPayoutOrderBid409JSONResponse) VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitPayoutOrderBid500JSONResponse ErrorInternalServerError
+
+func (response CommitPayoutOrderBid500JSONResponse) VisitCommitPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealPayoutOrderBidRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	OrderId UUID `json:"orderId"`
+	Body    *RevealPayoutOrderBidJSONRequestBody
+}
+
+type RevealPayoutOrderBidResponseObject interface {
+	VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error
+}
+
+type RevealPayoutOrderBid200JSONResponse PayoutOrder
+
+func (response RevealPayoutOrderBid200JSONResponse) VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealPayoutOrderBid400JSONResponse ErrorBadRequest
+
+func (response RevealPayoutOrderBid400JSONResponse) VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealPayoutOrderBid401JSONResponse ErrorUnauthorized
+
+func (response RevealPayoutOrderBid401JSONResponse) VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealPayoutOrderBid403JSONResponse ErrorForbidden
+
+func (response RevealPayoutOrderBid403JSONResponse) VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealPayoutOrderBid404JSONResponse ErrorNotFound
+
+func (response RevealPayoutOrderBid404JSONResponse) VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealPayoutOrderBid409JSONResponse ErrorConflict
+
+func (response RevealPayoutOrderBid409JSONResponse) VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealPayoutOrderBid500JSONResponse ErrorInternalServerError
+
+func (response RevealPayoutOrderBid500JSONResponse) VisitRevealPayoutOrderBidResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPayoutOrderRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	OrderId UUID `json:"orderId"`
+}
+
+type ExportPayoutOrderResponseObject interface {
+	VisitExportPayoutOrderResponse(w http.ResponseWriter) error
+}
+
+type ExportPayoutOrder200JSONResponse PayoutOrderExport
+
+func (response ExportPayoutOrder200JSONResponse) VisitExportPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPayoutOrder401JSONResponse ErrorUnauthorized
+
+func (response ExportPayoutOrder401JSONResponse) VisitExportPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPayoutOrder403JSONResponse ErrorForbidden
+
+func (response ExportPayoutOrder403JSONResponse) VisitExportPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPayoutOrder404JSONResponse ErrorNotFound
+
+func (response ExportPayoutOrder404JSONResponse) VisitExportPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPayoutOrder409JSONResponse ErrorConflict
+
+func (response ExportPayoutOrder409JSONResponse) VisitExportPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPayoutOrder500JSONResponse ErrorInternalServerError
+
+func (response ExportPayoutOrder500JSONResponse) VisitExportPayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinalizePayoutOrderRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	OrderId UUID `json:"orderId"`
+}
+
+type FinalizePayoutOrderResponseObject interface {
+	VisitFinalizePayoutOrderResponse(w http.ResponseWriter) error
+}
+
+type FinalizePayoutOrder200JSONResponse PayoutOrder
+
+func (response FinalizePayoutOrder200JSONResponse) VisitFinalizePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinalizePayoutOrder401JSONResponse ErrorUnauthorized
+
+func (response FinalizePayoutOrder401JSONResponse) VisitFinalizePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinalizePayoutOrder403JSONResponse ErrorForbidden
+
+func (response FinalizePayoutOrder403JSONResponse) VisitFinalizePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinalizePayoutOrder404JSONResponse ErrorNotFound
+
+func (response FinalizePayoutOrder404JSONResponse) VisitFinalizePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinalizePayoutOrder409JSONResponse ErrorConflict
+
+func (response FinalizePayoutOrder409JSONResponse) VisitFinalizePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinalizePayoutOrder500JSONResponse ErrorInternalServerError
+
+func (response FinalizePayoutOrder500JSONResponse) VisitFinalizePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposePayoutOrderRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	OrderId UUID `json:"orderId"`
+	Body    *ProposePayoutOrderJSONRequestBody
+}
+
+type ProposePayoutOrderResponseObject interface {
+	VisitProposePayoutOrderResponse(w http.ResponseWriter) error
+}
+
+type ProposePayoutOrder200JSONResponse PayoutOrder
+
+func (response ProposePayoutOrder200JSONResponse) VisitProposePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposePayoutOrder400JSONResponse ErrorBadRequest
+
+func (response ProposePayoutOrder400JSONResponse) VisitProposePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposePayoutOrder401JSONResponse ErrorUnauthorized
+
+func (response ProposePayoutOrder401JSONResponse) VisitProposePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposePayoutOrder403JSONResponse ErrorForbidden
+
+func (response ProposePayoutOrder403JSONResponse) VisitProposePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposePayoutOrder404JSONResponse ErrorNotFound
+
+func (response ProposePayoutOrder404JSONResponse) VisitProposePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposePayoutOrder409JSONResponse ErrorConflict
+
+func (response ProposePayoutOrder409JSONResponse) VisitProposePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposePayoutOrder500JSONResponse ErrorInternalServerError
+
+func (response ProposePayoutOrder500JSONResponse) VisitProposePayoutOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRoundsRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	Params  ListGroupRoundsParams
+}
+
+type ListGroupRoundsResponseObject interface {
+	VisitListGroupRoundsResponse(w http.ResponseWriter) error
+}
+
+type ListGroupRounds200JSONResponse RoundPage
+
+func (response ListGroupRounds200JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRounds401JSONResponse ErrorUnauthorized
+
+func (response ListGroupRounds401JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRounds403JSONResponse ErrorForbidden
+
+func (response ListGroupRounds403JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRounds404JSONResponse ErrorNotFound
+
+func (response ListGroupRounds404JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRounds500JSONResponse ErrorInternalServerError
+
+func (response ListGroupRounds500JSONResponse) VisitListGroupRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRoundRequestObject struct {
+	GroupId UUID `json:"groupId"`
+	Body    *CreateRoundJSONRequestBody
+}
+
+type CreateRoundResponseObject interface {
+	VisitCreateRoundResponse(w http.ResponseWriter) error
+}
+
+type CreateRound201JSONResponse Round
+
+func (response CreateRound201JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRound400JSONResponse ErrorBadRequest
+
+func (response CreateRound400JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRound401JSONResponse ErrorUnauthorized
+
+func (response CreateRound401JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRound403JSONResponse ErrorForbidden
+
+func (response CreateRound403JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRound404JSONResponse ErrorNotFound
+
+func (response CreateRound404JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRound409JSONResponse ErrorConflict
+
+func (response CreateRound409JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRound500JSONResponse ErrorInternalServerError
+
+func (response CreateRound500JSONResponse) VisitCreateRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveGroupRequestObject struct {
+	GroupId UUID `json:"groupId"`
+}
+
+type UnarchiveGroupResponseObject interface {
+	VisitUnarchiveGroupResponse(w http.ResponseWriter) error
+}
+
+type UnarchiveGroup204Response struct {
+}
+
+func (response UnarchiveGroup204Response) VisitUnarchiveGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnarchiveGroup401JSONResponse ErrorUnauthorized
+
+func (response UnarchiveGroup401JSONResponse) VisitUnarchiveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveGroup403JSONResponse ErrorForbidden
+
+func (response UnarchiveGroup403JSONResponse) VisitUnarchiveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveGroup404JSONResponse ErrorNotFound
+
+func (response UnarchiveGroup404JSONResponse) VisitUnarchiveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveGroup500JSONResponse ErrorInternalServerError
+
+func (response UnarchiveGroup500JSONResponse) VisitUnarchiveGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInviteRequestObject struct {
+	Body *AcceptInviteJSONRequestBody
+}
+
+type AcceptInviteResponseObject interface {
+	VisitAcceptInviteResponse(w http.ResponseWriter) error
+}
+
+type AcceptInvite200JSONResponse AcceptInviteResponse
+
+func (response AcceptInvite200JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvite400JSONResponse ErrorBadRequest
+
+func (response AcceptInvite400JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvite401JSONResponse ErrorUnauthorized
+
+func (response AcceptInvite401JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvite404JSONResponse ErrorNotFound
+
+func (response AcceptInvite404JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvite500JSONResponse ErrorInternalServerError
+
+func (response AcceptInvite500JSONResponse) VisitAcceptInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewInviteRequestObject struct {
+	Body *PreviewInviteJSONRequestBody
+}
+
+type PreviewInviteResponseObject interface {
+	VisitPreviewInviteResponse(w http.ResponseWriter) error
+}
+
+type PreviewInvite200JSONResponse InvitePreview
+
+func (response PreviewInvite200JSONResponse) VisitPreviewInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewInvite400JSONResponse ErrorBadRequest
+
+func (response PreviewInvite400JSONResponse) VisitPreviewInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewInvite404JSONResponse ErrorNotFound
+
+func (response PreviewInvite404JSONResponse) VisitPreviewInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeRequestObject struct {
+}
+
+type GetMeResponseObject interface {
+	VisitGetMeResponse(w http.ResponseWriter) error
+}
+
+type GetMe200JSONResponse User
+
+func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMe401JSONResponse ErrorUnauthorized
+
+func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMeRequestObject struct {
+	Body *UpdateMeJSONRequestBody
+}
+
+type UpdateMeResponseObject interface {
+	VisitUpdateMeResponse(w http.ResponseWriter) error
+}
+
+type UpdateMe200JSONResponse User
+
+func (response UpdateMe200JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMe400JSONResponse ErrorBadRequest
+
+func (response UpdateMe400JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMe401JSONResponse ErrorUnauthorized
+
+func (response UpdateMe401JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMe500JSONResponse ErrorInternalServerError
+
+func (response UpdateMe500JSONResponse) VisitUpdateMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationPreferencesRequestObject struct {
+}
+
+type GetNotificationPreferencesResponseObject interface {
+	VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response GetNotificationPreferences200JSONResponse) VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationPreferences401JSONResponse ErrorUnauthorized
+
+func (response GetNotificationPreferences401JSONResponse) VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationPreferences500JSONResponse ErrorInternalServerError
+
+func (response GetNotificationPreferences500JSONResponse) VisitGetNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferencesRequestObject struct {
+	Body *UpdateNotificationPreferencesJSONRequestBody
+}
+
+type UpdateNotificationPreferencesResponseObject interface {
+	VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type UpdateNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response UpdateNotificationPreferences200JSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferences400JSONResponse ErrorBadRequest
+
+func (response UpdateNotificationPreferences400JSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferences401JSONResponse ErrorUnauthorized
+
+func (response UpdateNotificationPreferences401JSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateNotificationPreferences500JSONResponse ErrorInternalServerError
+
+func (response UpdateNotificationPreferences500JSONResponse) VisitUpdateNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoundsRequestObject struct {
+	Params ListRoundsParams
+}
+
+type ListRoundsResponseObject interface {
+	VisitListRoundsResponse(w http.ResponseWriter) error
+}
+
+type ListRounds200JSONResponse RoundPage
+
+func (response ListRounds200JSONResponse) VisitListRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRounds400JSONResponse ErrorBadRequest
+
+func (response ListRounds400JSONResponse) VisitListRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRounds401JSONResponse ErrorUnauthorized
+
+func (response ListRounds401JSONResponse) VisitListRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRounds500JSONResponse ErrorInternalServerError
+
+func (response ListRounds500JSONResponse) VisitListRoundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundRequestObject struct {
+	RoundId UUID `json:"roundId"`
+}
+
+type GetRoundResponseObject interface {
+	VisitGetRoundResponse(w http.ResponseWriter) error
+}
+
+type GetRound200JSONResponse RoundDetail
+
+func (response GetRound200JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRound401JSONResponse ErrorUnauthorized
+
+func (response GetRound401JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRound403JSONResponse ErrorForbidden
+
+func (response GetRound403JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRound404JSONResponse ErrorNotFound
+
+func (response GetRound404JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRound500JSONResponse ErrorInternalServerError
+
+func (response GetRound500JSONResponse) VisitGetRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundActivityRequestObject struct {
+	RoundId UUID `json:"roundId"`
+	Params  GetRoundActivityParams
+}
+
+type GetRoundActivityResponseObject interface {
+	VisitGetRoundActivityResponse(w http.ResponseWriter) error
+}
+
+type GetRoundActivity200JSONResponse ActivityPage
+
+func (response GetRoundActivity200JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundActivity400JSONResponse ErrorBadRequest
+
+func (response GetRoundActivity400JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundActivity401JSONResponse ErrorUnauthorized
+
+func (response GetRoundActivity401JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundActivity403JSONResponse ErrorForbidden
+
+func (response GetRoundActivity403JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundActivity404JSONResponse ErrorNotFound
+
+func (response GetRoundActivity404JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundActivity500JSONResponse ErrorInternalServerError
+
+func (response GetRoundActivity500JSONResponse) VisitGetRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRoundActivityRequestObject struct {
+	RoundId UUID `json:"roundId"`
+	Params  ExportRoundActivityParams
+}
+
+type ExportRoundActivityResponseObject interface {
+	VisitExportRoundActivityResponse(w http.ResponseWriter) error
+}
+
+type ExportRoundActivity200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportRoundActivity200TextcsvResponse) VisitExportRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportRoundActivity400JSONResponse ErrorBadRequest
+
+func (response ExportRoundActivity400JSONResponse) VisitExportRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRoundActivity401JSONResponse ErrorUnauthorized
+
+func (response ExportRoundActivity401JSONResponse) VisitExportRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRoundActivity403JSONResponse ErrorForbidden
+
+func (response ExportRoundActivity403JSONResponse) VisitExportRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRoundActivity404JSONResponse ErrorNotFound
+
+func (response ExportRoundActivity404JSONResponse) VisitExportRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRoundActivity500JSONResponse ErrorInternalServerError
+
+func (response ExportRoundActivity500JSONResponse) VisitExportRoundActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundBalancesRequestObject struct {
+	RoundId UUID `json:"roundId"`
+}
+
+type GetRoundBalancesResponseObject interface {
+	VisitGetRoundBalancesResponse(w http.ResponseWriter) error
+}
+
+type GetRoundBalances200JSONResponse RoundBalances
+
+func (response GetRoundBalances200JSONResponse) VisitGetRoundBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundBalances401JSONResponse ErrorUnauthorized
+
+func (response GetRoundBalances401JSONResponse) VisitGetRoundBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundBalances403JSONResponse ErrorForbidden
+
+func (response GetRoundBalances403JSONResponse) VisitGetRoundBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundBalances404JSONResponse ErrorNotFound
+
+func (response GetRoundBalances404JSONResponse) VisitGetRoundBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundBalances500JSONResponse ErrorInternalServerError
+
+func (response GetRoundBalances500JSONResponse) VisitGetRoundBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundPeriodsRequestObject struct {
+	RoundId UUID `json:"roundId"`
+}
+
+type GetRoundPeriodsResponseObject interface {
+	VisitGetRoundPeriodsResponse(w http.ResponseWriter) error
+}
+
+type GetRoundPeriods200JSONResponse []RoundPeriodStatus
+
+func (response GetRoundPeriods200JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundPeriods401JSONResponse ErrorUnauthorized
+
+func (response GetRoundPeriods401JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundPeriods403JSONResponse ErrorForbidden
+
+func (response GetRoundPeriods403JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundPeriods404JSONResponse ErrorNotFound
+
+func (response GetRoundPeriods404JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRoundPeriods500JSONResponse ErrorInternalServerError
+
+func (response GetRoundPeriods500JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlotSwapsRequestObject struct {
+	RoundId UUID `json:"roundId"`
+}
+
+type ListSlotSwapsResponseObject interface {
+	VisitListSlotSwapsResponse(w http.ResponseWriter) error
+}
+
+type ListSlotSwaps200JSONResponse []SlotSwap
+
+func (response ListSlotSwaps200JSONResponse) VisitListSlotSwapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlotSwaps400JSONResponse ErrorBadRequest
+
+func (response ListSlotSwaps400JSONResponse) VisitListSlotSwapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlotSwaps401JSONResponse ErrorUnauthorized
+
+func (response ListSlotSwaps401JSONResponse) VisitListSlotSwapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlotSwaps403JSONResponse ErrorForbidden
+
+func (response ListSlotSwaps403JSONResponse) VisitListSlotSwapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlotSwaps404JSONResponse ErrorNotFound
+
+func (response ListSlotSwaps404JSONResponse) VisitListSlotSwapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlotSwaps500JSONResponse ErrorInternalServerError
+
+func (response ListSlotSwaps500JSONResponse) VisitListSlotSwapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposeSlotSwapRequestObject struct {
+	RoundId UUID `json:"roundId"`
+	Body    *ProposeSlotSwapJSONRequestBody
+}
+
+type ProposeSlotSwapResponseObject interface {
+	VisitProposeSlotSwapResponse(w http.ResponseWriter) error
+}
+
+type ProposeSlotSwap201JSONResponse SlotSwap
+
+func (response ProposeSlotSwap201JSONResponse) VisitProposeSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposeSlotSwap400JSONResponse ErrorBadRequest
+
+func (response ProposeSlotSwap400JSONResponse) VisitProposeSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposeSlotSwap401JSONResponse ErrorUnauthorized
+
+func (response ProposeSlotSwap401JSONResponse) VisitProposeSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposeSlotSwap403JSONResponse ErrorForbidden
+
+func (response ProposeSlotSwap403JSONResponse) VisitProposeSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposeSlotSwap404JSONResponse ErrorNotFound
+
+func (response ProposeSlotSwap404JSONResponse) VisitProposeSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposeSlotSwap409JSONResponse ErrorConflict
+
+func (response ProposeSlotSwap409JSONResponse) VisitProposeSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProposeSlotSwap500JSONResponse ErrorInternalServerError
+
+func (response ProposeSlotSwap500JSONResponse) VisitProposeSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSlotSwapRequestObject struct {
+	RoundId UUID `json:"roundId"`
+	SwapId  UUID `json:"swapId"`
+	Body    *AcceptSlotSwapJSONRequestBody
+}
+
+type AcceptSlotSwapResponseObject interface {
+	VisitAcceptSlotSwapResponse(w http.ResponseWriter) error
+}
+
+type AcceptSlotSwap200JSONResponse SlotSwap
+
+func (response AcceptSlotSwap200JSONResponse) VisitAcceptSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSlotSwap400JSONResponse ErrorBadRequest
+
+func (response AcceptSlotSwap400JSONResponse) VisitAcceptSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSlotSwap401JSONResponse ErrorUnauthorized
+
+func (response AcceptSlotSwap401JSONResponse) VisitAcceptSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSlotSwap403JSONResponse ErrorForbidden
+
+func (response AcceptSlotSwap403JSONResponse) VisitAcceptSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSlotSwap404JSONResponse ErrorNotFound
+
+func (response AcceptSlotSwap404JSONResponse) VisitAcceptSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSlotSwap409JSONResponse ErrorConflict
+
+func (response AcceptSlotSwap409JSONResponse) VisitAcceptSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptSlotSwap500JSONResponse ErrorInternalServerError
+
+func (response AcceptSlotSwap500JSONResponse) VisitAcceptSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSlotSwapRequestObject struct {
+	RoundId UUID `json:"roundId"`
+	SwapId  UUID `json:"swapId"`
+}
+
+type CancelSlotSwapResponseObject interface {
+	VisitCancelSlotSwapResponse(w http.ResponseWriter) error
+}
+
+type CancelSlotSwap200JSONResponse SlotSwap
+
+func (response CancelSlotSwap200JSONResponse) VisitCancelSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSlotSwap400JSONResponse ErrorBadRequest
+
+func (response CancelSlotSwap400JSONResponse) VisitCancelSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSlotSwap401JSONResponse ErrorUnauthorized
+
+func (response CancelSlotSwap401JSONResponse) VisitCancelSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSlotSwap403JSONResponse ErrorForbidden
+
+func (response CancelSlotSwap403JSONResponse) VisitCancelSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSlotSwap404JSONResponse ErrorNotFound
+
+func (response CancelSlotSwap404JSONResponse) VisitCancelSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSlotSwap409JSONResponse ErrorConflict
+
+func (response CancelSlotSwap409JSONResponse) VisitCancelSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSlotSwap500JSONResponse ErrorInternalServerError
+
+func (response CancelSlotSwap500JSONResponse) VisitCancelSlotSwapResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildContributionRequestObject struct {
+	RoundId UUID `json:"roundId"`
+}
+
+type BuildContributionResponseObject interface {
+	VisitBuildContributionResponse(w http.ResponseWriter) error
+}
+
+type BuildContribution200JSONResponse ContributionPlan
+
+func (response BuildContribution200JSONResponse) VisitBuildContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildContribution400JSONResponse ErrorBadRequest
+
+func (response BuildContribution400JSONResponse) VisitBuildContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildContribution401JSONResponse ErrorUnauthorized
+
+func (response BuildContribution401JSONResponse) VisitBuildContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildContribution403JSONResponse ErrorForbidden
+
+func (response BuildContribution403JSONResponse) VisitBuildContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildContribution404JSONResponse ErrorNotFound
+
+func (response BuildContribution404JSONResponse) VisitBuildContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildContribution409JSONResponse ErrorConflict
+
+func (response BuildContribution409JSONResponse) VisitBuildContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildContribution500JSONResponse ErrorInternalServerError
+
+func (response BuildContribution500JSONResponse) VisitBuildContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildPayoutClaimRequestObject struct {
+	RoundId UUID `json:"roundId"`
+}
+
+type BuildPayoutClaimResponseObject interface {
+	VisitBuildPayoutClaimResponse(w http.ResponseWriter) error
+}
+
+type BuildPayoutClaim200JSONResponse TransactionRequest
+
+func (response BuildPayoutClaim200JSONResponse) VisitBuildPayoutClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildPayoutClaim400JSONResponse ErrorBadRequest
+
+func (response BuildPayoutClaim400JSONResponse) VisitBuildPayoutClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildPayoutClaim401JSONResponse ErrorUnauthorized
+
+func (response BuildPayoutClaim401JSONResponse) VisitBuildPayoutClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildPayoutClaim403JSONResponse ErrorForbidden
+
+func (response BuildPayoutClaim403JSONResponse) VisitBuildPayoutClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildPayoutClaim404JSONResponse ErrorNotFound
+
+func (response BuildPayoutClaim404JSONResponse) VisitBuildPayoutClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildPayoutClaim409JSONResponse ErrorConflict
+
+func (response BuildPayoutClaim409JSONResponse) VisitBuildPayoutClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildPayoutClaim500JSONResponse ErrorInternalServerError
+
+func (response BuildPayoutClaim500JSONResponse) VisitBuildPayoutClaimResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionRoundRequestObject struct {
+	RoundId UUID `json:"roundId"`
+	Body    *TransitionRoundJSONRequestBody
+}
+
+type TransitionRoundResponseObject interface {
+	VisitTransitionRoundResponse(w http.ResponseWriter) error
+}
+
+type TransitionRound200JSONResponse Round
+
+func (response TransitionRound200JSONResponse) VisitTransitionRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionRound400JSONResponse ErrorBadRequest
+
+func (response TransitionRound400JSONResponse) VisitTransitionRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionRound401JSONResponse ErrorUnauthorized
+
+func (response TransitionRound401JSONResponse) VisitTransitionRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionRound403JSONResponse ErrorForbidden
+
+func (response TransitionRound403JSONResponse) VisitTransitionRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionRound404JSONResponse ErrorNotFound
+
+func (response TransitionRound404JSONResponse) VisitTransitionRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionRound409JSONResponse ErrorConflict
+
+func (response TransitionRound409JSONResponse) VisitTransitionRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransitionRound500JSONResponse ErrorInternalServerError
+
+func (response TransitionRound500JSONResponse) VisitTransitionRoundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTokensRequestObject struct {
+}
+
+type ListTokensResponseObject interface {
+	VisitListTokensResponse(w http.ResponseWriter) error
+}
+
+type ListTokens200JSONResponse []Token
+
+func (response ListTokens200JSONResponse) VisitListTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTokens401JSONResponse ErrorUnauthorized
+
+func (response ListTokens401JSONResponse) VisitListTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTokens500JSONResponse ErrorInternalServerError
+
+func (response ListTokens500JSONResponse) VisitListTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List a round's indexer backfills (admins only)
+	// (GET /admin/rounds/{roundId}/backfills)
+	ListRoundBackfills(ctx context.Context, request ListRoundBackfillsRequestObject) (ListRoundBackfillsResponseObject, error)
+	// Backfill a round's events from the chain (admins only)
+	// (POST /admin/rounds/{roundId}/backfills)
+	StartRoundBackfill(ctx context.Context, request StartRoundBackfillRequestObject) (StartRoundBackfillResponseObject, error)
+	// Request login magic link
+	// (POST /auth/login)
+	AuthLogin(ctx context.Context, request AuthLoginRequestObject) (AuthLoginResponseObject, error)
+	// Logout (clears session)
+	// (POST /auth/logout)
+	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
+	// Request a nonce for wallet sign-in
+	// (POST /auth/nonce)
+	AuthNonce(ctx context.Context, request AuthNonceRequestObject) (AuthNonceResponseObject, error)
+	// Sign up a new user
+	// (POST /auth/signup)
+	AuthSignup(ctx context.Context, request AuthSignupRequestObject) (AuthSignupResponseObject, error)
+	// Complete signup with wallet signature (creates user and main session)
+	// (POST /auth/signup/complete)
+	AuthSignupComplete(ctx context.Context, request AuthSignupCompleteRequestObject) (AuthSignupCompleteResponseObject, error)
+	// Verify email token create a session (sets HttpOnly cookie)
+	// (POST /auth/verify)
+	AuthVerify(ctx context.Context, request AuthVerifyRequestObject) (AuthVerifyResponseObject, error)
+	// Get the user's dashboard
+	// (GET /dashboard)
+	GetDashboard(ctx context.Context, request GetDashboardRequestObject) (GetDashboardResponseObject, error)
+	// List groups the current user belongs to
+	// (GET /groups)
+	ListGroups(ctx context.Context, request ListGroupsRequestObject) (ListGroupsResponseObject, error)
+	// Create a private group
+	// (POST /groups)
+	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
+	// Get a group (members only)
+	// (GET /groups/{groupId})
+	GetGroup(ctx context.Context, request GetGroupRequestObject) (GetGroupResponseObject, error)
+	// Update group metadata (owner only)
+	// (PATCH /groups/{groupId})
+	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
+	// Get the group's Merkle allowlist for contract deployment (members only)
+	// (GET /groups/{groupId}/allowlist)
+	GetGroupAllowlist(ctx context.Context, request GetGroupAllowlistRequestObject) (GetGroupAllowlistResponseObject, error)
+	// Get the caller's Merkle proof of membership (members only)
+	// (GET /groups/{groupId}/allowlist/proof)
+	GetGroupAllowlistProof(ctx context.Context, request GetGroupAllowlistProofRequestObject) (GetGroupAllowlistProofResponseObject, error)
+	// Archive a group (owner only; archived groups are read-only)
+	// (POST /groups/{groupId}/archive)
+	ArchiveGroup(ctx context.Context, request ArchiveGroupRequestObject) (ArchiveGroupResponseObject, error)
+	// List invites for a group (owner only)
+	// (GET /groups/{groupId}/invites)
+	ListInvites(ctx context.Context, request ListInvitesRequestObject) (ListInvitesResponseObject, error)
+	// Create an invite code (group owner only)
+	// (POST /groups/{groupId}/invites)
+	CreateInvite(ctx context.Context, request CreateInviteRequestObject) (CreateInviteResponseObject, error)
+	// Revoke an invite (owner only)
+	// (DELETE /groups/{groupId}/invites/{inviteId})
+	RevokeInvite(ctx context.Context, request RevokeInviteRequestObject) (RevokeInviteResponseObject, error)
+	// Printable invite card with the group name, avatar and join page QR code (owner only)
+	// (GET /groups/{groupId}/invites/{inviteId}/card)
+	GetInviteCard(ctx context.Context, request GetInviteCardRequestObject) (GetInviteCardResponseObject, error)
+	// QR code of the group join page for an invite (owner only)
+	// (GET /groups/{groupId}/invites/{inviteId}/qr)
+	GetInviteQrCode(ctx context.Context, request GetInviteQrCodeRequestObject) (GetInviteQrCodeResponseObject, error)
+	// Leave a group (member only; owner cannot leave unless transfer ownership is supported)
+	// (POST /groups/{groupId}/leave)
+	LeaveGroup(ctx context.Context, request LeaveGroupRequestObject) (LeaveGroupResponseObject, error)
+	// List group members (members only)
+	// (GET /groups/{groupId}/members)
+	ListGroupMembers(ctx context.Context, request ListGroupMembersRequestObject) (ListGroupMembersResponseObject, error)
+	// Search group members by display name or address prefix (members only)
+	// (GET /groups/{groupId}/members/search)
+	SearchGroupMembers(ctx context.Context, request SearchGroupMembersRequestObject) (SearchGroupMembersResponseObject, error)
+	// Remove a member from a group (owner only)
+	// (DELETE /groups/{groupId}/members/{memberAddress})
+	RemoveGroupMember(ctx context.Context, request RemoveGroupMemberRequestObject) (RemoveGroupMemberResponseObject, error)
+	// Get a member's profile in a group, including flagged contributions (members only)
+	// (GET /groups/{groupId}/members/{memberAddress})
+	GetGroupMember(ctx context.Context, request GetGroupMemberRequestObject) (GetGroupMemberResponseObject, error)
+	// Start deciding the payout order of the next round (group owner only)
+	// (POST /groups/{groupId}/payout-orders)
+	CreatePayoutOrder(ctx context.Context, request CreatePayoutOrderRequestObject) (CreatePayoutOrderResponseObject, error)
+	// Get a payout order (members only)
+	// (GET /groups/{groupId}/payout-orders/{orderId})
+	GetPayoutOrder(ctx context.Context, request GetPayoutOrderRequestObject) (GetPayoutOrderResponseObject, error)
+	// Approve the current consensus proposal (participants only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/approvals)
+	ApprovePayoutOrder(ctx context.Context, request ApprovePayoutOrderRequestObject) (ApprovePayoutOrderResponseObject, error)
+	// Commit a sealed auction bid (participants only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/bids)
+	CommitPayoutOrderBid(ctx context.Context, request CommitPayoutOrderBidRequestObject) (CommitPayoutOrderBidResponseObject, error)
+	// Reveal a sealed auction bid (participants only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/bids/reveal)
+	RevealPayoutOrderBid(ctx context.Context, request RevealPayoutOrderBidRequestObject) (RevealPayoutOrderBidResponseObject, error)
+	// Export a finalized payout order for contract deployment (members only)
+	// (GET /groups/{groupId}/payout-orders/{orderId}/export)
+	ExportPayoutOrder(ctx context.Context, request ExportPayoutOrderRequestObject) (ExportPayoutOrderResponseObject, error)
+	// Finalize a payout order with its strategy (group owner only)
+	// (POST /groups/{groupId}/payout-orders/{orderId}/finalize)
+	FinalizePayoutOrder(ctx context.Context, request FinalizePayoutOrderRequestObject) (FinalizePayoutOrderResponseObject, error)
+	// Propose a payout order
+	// (PUT /groups/{groupId}/payout-orders/{orderId}/order)
+	ProposePayoutOrder(ctx context.Context, request ProposePayoutOrderRequestObject) (ProposePayoutOrderResponseObject, error)
+	// List rounds for a group (members only)
+	// (GET /groups/{groupId}/rounds)
+	ListGroupRounds(ctx context.Context, request ListGroupRoundsRequestObject) (ListGroupRoundsResponseObject, error)
+	// Create a round record for a group (members/owner; stores on-chain mapping)
+	// (POST /groups/{groupId}/rounds)
+	CreateRound(ctx context.Context, request CreateRoundRequestObject) (CreateRoundResponseObject, error)
+	// Restore an archived group (owner only)
+	// (POST /groups/{groupId}/unarchive)
+	UnarchiveGroup(ctx context.Context, request UnarchiveGroupRequestObject) (UnarchiveGroupResponseObject, error)
+	// Accept an invite code to join a private group
+	// (POST /invites/accept)
+	AcceptInvite(ctx context.Context, request AcceptInviteRequestObject) (AcceptInviteResponseObject, error)
+	// Preview an invite code (returns group info without joining)
+	// (POST /invites/preview)
+	PreviewInvite(ctx context.Context, request PreviewInviteRequestObject) (PreviewInviteResponseObject, error)
+	// Get current user profile
+	// (GET /me)
+	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
+	// Update current user profile
+	// (PATCH /me)
+	UpdateMe(ctx context.Context, request UpdateMeRequestObject) (UpdateMeResponseObject, error)
+	// Get the current user's notification preferences
+	// (GET /me/notification-preferences)
+	GetNotificationPreferences(ctx context.Context, request GetNotificationPreferencesRequestObject) (GetNotificationPreferencesResponseObject, error)
+	// Replace the current user's notification preferences
+	// (PUT /me/notification-preferences)
+	UpdateNotificationPreferences(ctx context.Context, request UpdateNotificationPreferencesRequestObject) (UpdateNotificationPreferencesResponseObject, error)
+	// List rounds accessible to the current user
+	// (GET /rounds)
+	ListRounds(ctx context.Context, request ListRoundsRequestObject) (ListRoundsResponseObject, error)
+	// Get round details (members only)
+	// (GET /rounds/{roundId})
+	GetRound(ctx context.Context, request GetRoundRequestObject) (GetRoundResponseObject, error)
+	// Get round activity feed (payments + payouts) (members only)
+	// (GET /rounds/{roundId}/activity)
+	GetRoundActivity(ctx context.Context, request GetRoundActivityRequestObject) (GetRoundActivityResponseObject, error)
+	// Export the round activity feed as CSV (members only)
+	// (GET /rounds/{roundId}/activity/export)
+	ExportRoundActivity(ctx context.Context, request ExportRoundActivityRequestObject) (ExportRoundActivityResponseObject, error)
+	// Get ledger balances of the pot and every member (members only)
+	// (GET /rounds/{roundId}/balances)
+	GetRoundBalances(ctx context.Context, request GetRoundBalancesRequestObject) (GetRoundBalancesResponseObject, error)
+	// Get per-period contribution status (members only)
+	// (GET /rounds/{roundId}/periods)
+	GetRoundPeriods(ctx context.Context, request GetRoundPeriodsRequestObject) (GetRoundPeriodsResponseObject, error)
+	// List payout slot swaps (members only)
+	// (GET /rounds/{roundId}/swaps)
+	ListSlotSwaps(ctx context.Context, request ListSlotSwapsRequestObject) (ListSlotSwapsResponseObject, error)
+	// Propose swapping payout slots with another member
+	// (POST /rounds/{roundId}/swaps)
+	ProposeSlotSwap(ctx context.Context, request ProposeSlotSwapRequestObject) (ProposeSlotSwapResponseObject, error)
+	// Accept a slot swap (counterparty only)
+	// (POST /rounds/{roundId}/swaps/{swapId}/accept)
+	AcceptSlotSwap(ctx context.Context, request AcceptSlotSwapRequestObject) (AcceptSlotSwapResponseObject, error)
+	// Cancel or decline a slot swap
+	// (POST /rounds/{roundId}/swaps/{swapId}/cancel)
+	CancelSlotSwap(ctx context.Context, request CancelSlotSwapRequestObject) (CancelSlotSwapResponseObject, error)
+	// Build the caller's contribution transactions
+	// (GET /rounds/{roundId}/transactions/contribution)
+	BuildContribution(ctx context.Context, request BuildContributionRequestObject) (BuildContributionResponseObject, error)
+	// Build the caller's payout claim transaction
+	// (GET /rounds/{roundId}/transactions/payout-claim)
+	BuildPayoutClaim(ctx context.Context, request BuildPayoutClaimRequestObject) (BuildPayoutClaimResponseObject, error)
+	// Change a round's status (group owner only)
+	// (POST /rounds/{roundId}/transitions)
+	TransitionRound(ctx context.Context, request TransitionRoundRequestObject) (TransitionRoundResponseObject, error)
+	// List the registered tokens
+	// (GET /tokens)
+	ListTokens(ctx context.Context, request ListTokensRequestObject) (ListTokensResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// ListRoundBackfills operation middleware
+func (sh *strictHandler) ListRoundBackfills(ctx echo.Context, roundId UUID) error {
+	var request ListRoundBackfillsRequestObject
+
+	request.RoundId = roundId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRoundBackfills(ctx.Request().Context(), request.(ListRoundBackfillsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRoundBackfills")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListRoundBackfillsResponseObject); ok {
+		return validResponse.VisitListRoundBackfillsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// StartRoundBackfill operation middleware
+func (sh *strictHandler) StartRoundBackfill(ctx echo.Context, roundId UUID) error {
+	var request StartRoundBackfillRequestObject
+
+	request.RoundId = roundId
+
+	var body StartRoundBackfillJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartRoundBackfill(ctx.Request().Context(), request.(StartRoundBackfillRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartRoundBackfill")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StartRoundBackfillResponseObject); ok {
+		return validResponse.VisitStartRoundBackfillResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AuthLogin operation middleware
+func (sh *strictHandler) AuthLogin(ctx echo.Context) error {
+	var request AuthLoginRequestObject
+
+	var body AuthLoginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthLogin(ctx.Request().Context(), request.(AuthLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuthLoginResponseObject); ok {
+		return validResponse.VisitAuthLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AuthLogout operation middleware
+func (sh *strictHandler) AuthLogout(ctx echo.Context) error {
+	var request AuthLogoutRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthLogout(ctx.Request().Context(), request.(AuthLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthLogout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuthLogoutResponseObject); ok {
+		return validResponse.VisitAuthLogoutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AuthNonce operation middleware
+func (sh *strictHandler) AuthNonce(ctx echo.Context) error {
+	var request AuthNonceRequestObject
+
+	var body AuthNonceJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthNonce(ctx.Request().Context(), request.(AuthNonceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthNonce")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuthNonceResponseObject); ok {
+		return validResponse.VisitAuthNonceResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AuthSignup operation middleware
+func (sh *strictHandler) AuthSignup(ctx echo.Context) error {
+	var request AuthSignupRequestObject
+
+	var body AuthSignupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthSignup(ctx.Request().Context(), request.(AuthSignupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthSignup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuthSignupResponseObject); ok {
+		return validResponse.VisitAuthSignupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AuthSignupComplete operation middleware
+func (sh *strictHandler) AuthSignupComplete(ctx echo.Context) error {
+	var request AuthSignupCompleteRequestObject
+
+	var body AuthSignupCompleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthSignupComplete(ctx.Request().Context(), request.(AuthSignupCompleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthSignupComplete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuthSignupCompleteResponseObject); ok {
+		return validResponse.VisitAuthSignupCompleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AuthVerify operation middleware
+func (sh *strictHandler) AuthVerify(ctx echo.Context) error {
+	var request AuthVerifyRequestObject
+
+	var body AuthVerifyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthVerify(ctx.Request().Context(), request.(AuthVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AuthVerifyResponseObject); ok {
+		return validResponse.VisitAuthVerifyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetDashboard operation middleware
+func (sh *strictHandler) GetDashboard(ctx echo.Context) error {
+	var request GetDashboardRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDashboard(ctx.Request().Context(), request.(GetDashboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDashboard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetDashboardResponseObject); ok {
+		return validResponse.VisitGetDashboardResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListGroups operation middleware
+func (sh *strictHandler) ListGroups(ctx echo.Context, params ListGroupsParams) error {
+	var request ListGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGroups(ctx.Request().Context(), request.(ListGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGroups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
+		return validResponse.VisitListGroupsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(ctx echo.Context) error {
+	var request CreateGroupRequestObject
+
+	var body CreateGroupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGroup(ctx.Request().Context(), request.(CreateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		return validResponse.VisitCreateGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetGroup operation middleware
+func (sh *strictHandler) GetGroup(ctx echo.Context, groupId UUID) error {
+	var request GetGroupRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroup(ctx.Request().Context(), request.(GetGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
+		return validResponse.VisitGetGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(ctx echo.Context, groupId UUID) error {
+	var request UpdateGroupRequestObject
+
+	request.GroupId = groupId
+
+	var body UpdateGroupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGroup(ctx.Request().Context(), request.(UpdateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		return validResponse.VisitUpdateGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetGroupAllowlist operation middleware
+func (sh *strictHandler) GetGroupAllowlist(ctx echo.Context, groupId UUID) error {
+	var request GetGroupAllowlistRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroupAllowlist(ctx.Request().Context(), request.(GetGroupAllowlistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroupAllowlist")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetGroupAllowlistResponseObject); ok {
+		return validResponse.VisitGetGroupAllowlistResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetGroupAllowlistProof operation middleware
+func (sh *strictHandler) GetGroupAllowlistProof(ctx echo.Context, groupId UUID, params GetGroupAllowlistProofParams) error {
+	var request GetGroupAllowlistProofRequestObject
+
+	request.GroupId = groupId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroupAllowlistProof(ctx.Request().Context(), request.(GetGroupAllowlistProofRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroupAllowlistProof")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetGroupAllowlistProofResponseObject); ok {
+		return validResponse.VisitGetGroupAllowlistProofResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ArchiveGroup operation middleware
+func (sh *strictHandler) ArchiveGroup(ctx echo.Context, groupId UUID) error {
+	var request ArchiveGroupRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveGroup(ctx.Request().Context(), request.(ArchiveGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ArchiveGroupResponseObject); ok {
+		return validResponse.VisitArchiveGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListInvites operation middleware
+func (sh *strictHandler) ListInvites(ctx echo.Context, groupId UUID) error {
+	var request ListInvitesRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvites(ctx.Request().Context(), request.(ListInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvites")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListInvitesResponseObject); ok {
+		return validResponse.VisitListInvitesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateInvite operation middleware
+func (sh *strictHandler) CreateInvite(ctx echo.Context, groupId UUID) error {
+	var request CreateInviteRequestObject
+
+	request.GroupId = groupId
+
+	var body CreateInviteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateInvite(ctx.Request().Context(), request.(CreateInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateInvite")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateInviteResponseObject); ok {
+		return validResponse.VisitCreateInviteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RevokeInvite operation middleware
+func (sh *strictHandler) RevokeInvite(ctx echo.Context, groupId UUID, inviteId UUID) error {
+	var request RevokeInviteRequestObject
+
+	request.GroupId = groupId
+	request.InviteId = inviteId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeInvite(ctx.Request().Context(), request.(RevokeInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeInvite")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RevokeInviteResponseObject); ok {
+		return validResponse.VisitRevokeInviteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetInviteCard operation middleware
+func (sh *strictHandler) GetInviteCard(ctx echo.Context, groupId UUID, inviteId UUID) error {
+	var request GetInviteCardRequestObject
+
+	request.GroupId = groupId
+	request.InviteId = inviteId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInviteCard(ctx.Request().Context(), request.(GetInviteCardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInviteCard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetInviteCardResponseObject); ok {
+		return validResponse.VisitGetInviteCardResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetInviteQrCode operation middleware
+func (sh *strictHandler) GetInviteQrCode(ctx echo.Context, groupId UUID, inviteId UUID, params GetInviteQrCodeParams) error {
+	var request GetInviteQrCodeRequestObject
+
+	request.GroupId = groupId
+	request.InviteId = inviteId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInviteQrCode(ctx.Request().Context(), request.(GetInviteQrCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInviteQrCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetInviteQrCodeResponseObject); ok {
+		return validResponse.VisitGetInviteQrCodeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// LeaveGroup operation middleware
+func (sh *strictHandler) LeaveGroup(ctx echo.Context, groupId UUID) error {
+	var request LeaveGroupRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LeaveGroup(ctx.Request().Context(), request.(LeaveGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LeaveGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LeaveGroupResponseObject); ok {
+		return validResponse.VisitLeaveGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListGroupMembers operation middleware
+func (sh *strictHandler) ListGroupMembers(ctx echo.Context, groupId UUID) error {
+	var request ListGroupMembersRequestObject
+
+	request.GroupId = groupId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGroupMembers(ctx.Request().Context(), request.(ListGroupMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGroupMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListGroupMembersResponseObject); ok {
+		return validResponse.VisitListGroupMembersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SearchGroupMembers operation middleware
+func (sh *strictHandler) SearchGroupMembers(ctx echo.Context, groupId UUID, params SearchGroupMembersParams) error {
+	var request SearchGroupMembersRequestObject
+
+	request.GroupId = groupId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchGroupMembers(ctx.Request().Context(), request.(SearchGroupMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchGroupMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SearchGroupMembersResponseObject); ok {
+		return validResponse.VisitSearchGroupMembersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RemoveGroupMember operation middleware
+func (sh *strictHandler) RemoveGroupMember(ctx echo.Context, groupId UUID, memberAddress Address, params RemoveGroupMemberParams) error {
+	var request RemoveGroupMemberRequestObject
+
+	request.GroupId = groupId
+	request.MemberAddress = memberAddress
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveGroupMember(ctx.Request().Context(), request.(RemoveGroupMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveGroupMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RemoveGroupMemberResponseObject); ok {
+		return validResponse.VisitRemoveGroupMemberResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetGroupMember operation middleware
+func (sh *strictHandler) GetGroupMember(ctx echo.Context, groupId UUID, memberAddress Address) error {
+	var request GetGroupMemberRequestObject
+
+	request.GroupId = groupId
+	request.MemberAddress = memberAddress
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroupMember(ctx.Request().Context(), request.(GetGroupMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroupMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetGroupMemberResponseObject); ok {
+		return validResponse.VisitGetGroupMemberResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreatePayoutOrder operation middleware
+func (sh *strictHandler) CreatePayoutOrder(ctx echo.Context, groupId UUID) error {
+	var request CreatePayoutOrderRequestObject
+
+	request.GroupId = groupId
+
+	var body CreatePayoutOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePayoutOrder(ctx.Request().Context(), request.(CreatePayoutOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePayoutOrder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreatePayoutOrderResponseObject); ok {
+		return validResponse.VisitCreatePayoutOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPayoutOrder operation middleware
+func (sh *strictHandler) GetPayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error {
+	var request GetPayoutOrderRequestObject
+
+	request.GroupId = groupId
+	request.OrderId = orderId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPayoutOrder(ctx.Request().Context(), request.(GetPayoutOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPayoutOrder")
+	}
+
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPayoutOrderResponseObject); ok {
+		return validResponse.VisitGetPayoutOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetRoundPeriods403JSONResponse ErrorForbidden
+// ApprovePayoutOrder operation middleware
+func (sh *strictHandler) ApprovePayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error {
+	var request ApprovePayoutOrderRequestObject
 
-func (response GetRoundPeriods403JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.GroupId = groupId
+	request.OrderId = orderId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ApprovePayoutOrderJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type GetRoundPeriods404JSONResponse ErrorNotFound
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApprovePayoutOrder(ctx.Request().Context(), request.(ApprovePayoutOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApprovePayoutOrder")
+	}
 
-func (response GetRoundPeriods404JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApprovePayoutOrderResponseObject); ok {
+		return validResponse.VisitApprovePayoutOrderResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetRoundPeriods500JSONResponse ErrorInternalServerError
+// CommitPayoutOrderBid operation middleware
+func (sh *strictHandler) CommitPayoutOrderBid(ctx echo.Context, groupId UUID, orderId UUID) error {
+	var request CommitPayoutOrderBidRequestObject
 
-func (response GetRoundPeriods500JSONResponse) VisitGetRoundPeriodsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.GroupId = groupId
+	request.OrderId = orderId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CommitPayoutOrderBidJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Request login magic link
-	// (POST /auth/login)
-	AuthLogin(ctx context.Context, request AuthLoginRequestObject) (AuthLoginResponseObject, error)
-	// Logout (clears session)
-	// (POST /auth/logout)
-	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
-	// Request a nonce for wallet sign-in
-	// (POST /auth/nonce)
-	AuthNonce(ctx context.Context, request AuthNonceRequestObject) (AuthNonceResponseObject, error)
-	// Sign up a new user
-	// (POST /auth/signup)
-	AuthSignup(ctx context.Context, request AuthSignupRequestObject) (AuthSignupResponseObject, error)
-	// Complete signup with wallet signature (creates user and main session)
-	// (POST /auth/signup/complete)
-	AuthSignupComplete(ctx context.Context, request AuthSignupCompleteRequestObject) (AuthSignupCompleteResponseObject, error)
-	// Verify email token create a session (sets HttpOnly cookie)
-	// (POST /auth/verify)
-	AuthVerify(ctx context.Context, request AuthVerifyRequestObject) (AuthVerifyResponseObject, error)
-	// List groups the current user belongs to
-	// (GET /groups)
-	ListGroups(ctx context.Context, request ListGroupsRequestObject) (ListGroupsResponseObject, error)
-	// Create a private group
-	// (POST /groups)
-	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
-	// Get a group (members only)
-	// (GET /groups/{groupId})
-	GetGroup(ctx context.Context, request GetGroupRequestObject) (GetGroupResponseObject, error)
-	// Update group metadata (owner only)
-	// (PATCH /groups/{groupId})
-	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
-	// List invites for a group (owner only)
-	// (GET /groups/{groupId}/invites)
-	ListInvites(ctx context.Context, request ListInvitesRequestObject) (ListInvitesResponseObject, error)
-	// Create an invite code (group owner only)
-	// (POST /groups/{groupId}/invites)
-	CreateInvite(ctx context.Context, request CreateInviteRequestObject) (CreateInviteResponseObject, error)
-	// Revoke an invite (owner only)
-	// (DELETE /groups/{groupId}/invites/{inviteId})
-	RevokeInvite(ctx context.Context, request RevokeInviteRequestObject) (RevokeInviteResponseObject, error)
-	// Leave a group (member only; owner cannot leave unless transfer ownership is supported)
-	// (POST /groups/{groupId}/leave)
-	LeaveGroup(ctx context.Context, request LeaveGroupRequestObject) (LeaveGroupResponseObject, error)
-	// List group members (members only)
-	// (GET /groups/{groupId}/members)
-	ListGroupMembers(ctx context.Context, request ListGroupMembersRequestObject) (ListGroupMembersResponseObject, error)
-	// Remove a member from a group (owner only)
-	// (DELETE /groups/{groupId}/members/{memberAddress})
-	RemoveGroupMember(ctx context.Context, request RemoveGroupMemberRequestObject) (RemoveGroupMemberResponseObject, error)
-	// List rounds for a group (members only)
-	// (GET /groups/{groupId}/rounds)
-	ListGroupRounds(ctx context.Context, request ListGroupRoundsRequestObject) (ListGroupRoundsResponseObject, error)
-	// Create a round record for a group (members/owner; stores on-chain mapping)
-	// (POST /groups/{groupId}/rounds)
-	CreateRound(ctx context.Context, request CreateRoundRequestObject) (CreateRoundResponseObject, error)
-	// Accept an invite code to join a private group
-	// (POST /invites/accept)
-	AcceptInvite(ctx context.Context, request AcceptInviteRequestObject) (AcceptInviteResponseObject, error)
-	// Preview an invite code (returns group info without joining)
-	// (POST /invites/preview)
-	PreviewInvite(ctx context.Context, request PreviewInviteRequestObject) (PreviewInviteResponseObject, error)
-	// Get current user profile
-	// (GET /me)
-	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
-	// Update current user profile
-	// (PATCH /me)
-	UpdateMe(ctx context.Context, request UpdateMeRequestObject) (UpdateMeResponseObject, error)
-	// List rounds accessible to the current user
-	// (GET /rounds)
-	ListRounds(ctx context.Context, request ListRoundsRequestObject) (ListRoundsResponseObject, error)
-	// Get round details (members only)
-	// (GET /rounds/{roundId})
-	GetRound(ctx context.Context, request GetRoundRequestObject) (GetRoundResponseObject, error)
-	// Get round activity feed (payments + payouts) (members only)
-	// (GET /rounds/{roundId}/activity)
-	GetRoundActivity(ctx context.Context, request GetRoundActivityRequestObject) (GetRoundActivityResponseObject, error)
-	// Get per-period contribution status (members only)
-	// (GET /rounds/{roundId}/periods)
-	GetRoundPeriods(ctx context.Context, request GetRoundPeriodsRequestObject) (GetRoundPeriodsResponseObject, error)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CommitPayoutOrderBid(ctx.Request().Context(), request.(CommitPayoutOrderBidRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CommitPayoutOrderBid")
+	}
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	response, err := handler(ctx, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CommitPayoutOrderBidResponseObject); ok {
+		return validResponse.VisitCommitPayoutOrderBidResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// RevealPayoutOrderBid operation middleware
+func (sh *strictHandler) RevealPayoutOrderBid(ctx echo.Context, groupId UUID, orderId UUID) error {
+	var request RevealPayoutOrderBidRequestObject
 
-// AuthLogin operation middleware
-func (sh *strictHandler) AuthLogin(ctx echo.Context) error {
-	var request AuthLoginRequestObject
+	request.GroupId = groupId
+	request.OrderId = orderId
 
-	var body AuthLoginJSONRequestBody
+	var body RevealPayoutOrderBidJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthLogin(ctx.Request().Context(), request.(AuthLoginRequestObject))
+		return sh.ssi.RevealPayoutOrderBid(ctx.Request().Context(), request.(RevealPayoutOrderBidRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthLogin")
+		handler = middleware(handler, "RevealPayoutOrderBid")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AuthLoginResponseObject); ok {
-		return validResponse.VisitAuthLoginResponse(ctx.Response())
+	} else if validResponse, ok := response.(RevealPayoutOrderBidResponseObject); ok {
+		return validResponse.VisitRevealPayoutOrderBidResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AuthLogout operation middleware
-func (sh *strictHandler) AuthLogout(ctx echo.Context) error {
-	var request AuthLogoutRequestObject
+// ExportPayoutOrder operation middleware
+func (sh *strictHandler) ExportPayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error {
+	var request ExportPayoutOrderRequestObject
+
+	request.GroupId = groupId
+	request.OrderId = orderId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthLogout(ctx.Request().Context(), request.(AuthLogoutRequestObject))
+		return sh.ssi.ExportPayoutOrder(ctx.Request().Context(), request.(ExportPayoutOrderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthLogout")
+		handler = middleware(handler, "ExportPayoutOrder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AuthLogoutResponseObject); ok {
-		return validResponse.VisitAuthLogoutResponse(ctx.Response())
+	} else if validResponse, ok := response.(ExportPayoutOrderResponseObject); ok {
+		return validResponse.VisitExportPayoutOrderResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AuthNonce operation middleware
-func (sh *strictHandler) AuthNonce(ctx echo.Context) error {
-	var request AuthNonceRequestObject
+// FinalizePayoutOrder operation middleware
+func (sh *strictHandler) FinalizePayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error {
+	var request FinalizePayoutOrderRequestObject
 
-	var body AuthNonceJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.GroupId = groupId
+	request.OrderId = orderId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthNonce(ctx.Request().Context(), request.(AuthNonceRequestObject))
+		return sh.ssi.FinalizePayoutOrder(ctx.Request().Context(), request.(FinalizePayoutOrderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthNonce")
+		handler = middleware(handler, "FinalizePayoutOrder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AuthNonceResponseObject); ok {
-		return validResponse.VisitAuthNonceResponse(ctx.Response())
+	} else if validResponse, ok := response.(FinalizePayoutOrderResponseObject); ok {
+		return validResponse.VisitFinalizePayoutOrderResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AuthSignup operation middleware
-func (sh *strictHandler) AuthSignup(ctx echo.Context) error {
-	var request AuthSignupRequestObject
+// ProposePayoutOrder operation middleware
+func (sh *strictHandler) ProposePayoutOrder(ctx echo.Context, groupId UUID, orderId UUID) error {
+	var request ProposePayoutOrderRequestObject
 
-	var body AuthSignupJSONRequestBody
+	request.GroupId = groupId
+	request.OrderId = orderId
+
+	var body ProposePayoutOrderJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthSignup(ctx.Request().Context(), request.(AuthSignupRequestObject))
+		return sh.ssi.ProposePayoutOrder(ctx.Request().Context(), request.(ProposePayoutOrderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthSignup")
+		handler = middleware(handler, "ProposePayoutOrder")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AuthSignupResponseObject); ok {
-		return validResponse.VisitAuthSignupResponse(ctx.Response())
+	} else if validResponse, ok := response.(ProposePayoutOrderResponseObject); ok {
+		return validResponse.VisitProposePayoutOrderResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AuthSignupComplete operation middleware
-func (sh *strictHandler) AuthSignupComplete(ctx echo.Context) error {
-	var request AuthSignupCompleteRequestObject
+// ListGroupRounds operation middleware
+func (sh *strictHandler) ListGroupRounds(ctx echo.Context, groupId UUID, params ListGroupRoundsParams) error {
+	var request ListGroupRoundsRequestObject
 
-	var body AuthSignupCompleteJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.GroupId = groupId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthSignupComplete(ctx.Request().Context(), request.(AuthSignupCompleteRequestObject))
+		return sh.ssi.ListGroupRounds(ctx.Request().Context(), request.(ListGroupRoundsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthSignupComplete")
+		handler = middleware(handler, "ListGroupRounds")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AuthSignupCompleteResponseObject); ok {
-		return validResponse.VisitAuthSignupCompleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(ListGroupRoundsResponseObject); ok {
+		return validResponse.VisitListGroupRoundsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AuthVerify operation middleware
-func (sh *strictHandler) AuthVerify(ctx echo.Context) error {
-	var request AuthVerifyRequestObject
+// CreateRound operation middleware
+func (sh *strictHandler) CreateRound(ctx echo.Context, groupId UUID) error {
+	var request CreateRoundRequestObject
 
-	var body AuthVerifyJSONRequestBody
+	request.GroupId = groupId
+
+	var body CreateRoundJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthVerify(ctx.Request().Context(), request.(AuthVerifyRequestObject))
+		return sh.ssi.CreateRound(ctx.Request().Context(), request.(CreateRoundRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthVerify")
+		handler = middleware(handler, "CreateRound")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AuthVerifyResponseObject); ok {
-		return validResponse.VisitAuthVerifyResponse(ctx.Response())
+	} else if validResponse, ok := response.(CreateRoundResponseObject); ok {
+		return validResponse.VisitCreateRoundResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListGroups operation middleware
-func (sh *strictHandler) ListGroups(ctx echo.Context, params ListGroupsParams) error {
-	var request ListGroupsRequestObject
+// UnarchiveGroup operation middleware
+func (sh *strictHandler) UnarchiveGroup(ctx echo.Context, groupId UUID) error {
+	var request UnarchiveGroupRequestObject
 
-	request.Params = params
+	request.GroupId = groupId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroups(ctx.Request().Context(), request.(ListGroupsRequestObject))
+		return sh.ssi.UnarchiveGroup(ctx.Request().Context(), request.(UnarchiveGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnarchiveGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UnarchiveGroupResponseObject); ok {
+		return validResponse.VisitUnarchiveGroupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AcceptInvite operation middleware
+func (sh *strictHandler) AcceptInvite(ctx echo.Context) error {
+	var request AcceptInviteRequestObject
+
+	var body AcceptInviteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptInvite(ctx.Request().Context(), request.(AcceptInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroups")
+		handler = middleware(handler, "AcceptInvite")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
-		return validResponse.VisitListGroupsResponse(ctx.Response())
+	} else if validResponse, ok := response.(AcceptInviteResponseObject); ok {
+		return validResponse.VisitAcceptInviteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CreateGroup operation middleware
-func (sh *strictHandler) CreateGroup(ctx echo.Context) error {
-	var request CreateGroupRequestObject
+// PreviewInvite operation middleware
+func (sh *strictHandler) PreviewInvite(ctx echo.Context) error {
+	var request PreviewInviteRequestObject
 
-	var body CreateGroupJSONRequestBody
+	var body PreviewInviteJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateGroup(ctx.Request().Context(), request.(CreateGroupRequestObject))
+		return sh.ssi.PreviewInvite(ctx.Request().Context(), request.(PreviewInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateGroup")
+		handler = middleware(handler, "PreviewInvite")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
-		return validResponse.VisitCreateGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(PreviewInviteResponseObject); ok {
+		return validResponse.VisitPreviewInviteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetGroup operation middleware
-func (sh *strictHandler) GetGroup(ctx echo.Context, groupId UUID) error {
-	var request GetGroupRequestObject
-
-	request.GroupId = groupId
+// GetMe operation middleware
+func (sh *strictHandler) GetMe(ctx echo.Context) error {
+	var request GetMeRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroup(ctx.Request().Context(), request.(GetGroupRequestObject))
+		return sh.ssi.GetMe(ctx.Request().Context(), request.(GetMeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroup")
+		handler = middleware(handler, "GetMe")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
-		return validResponse.VisitGetGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetMeResponseObject); ok {
+		return validResponse.VisitGetMeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UpdateGroup operation middleware
-func (sh *strictHandler) UpdateGroup(ctx echo.Context, groupId UUID) error {
-	var request UpdateGroupRequestObject
-
-	request.GroupId = groupId
+// UpdateMe operation middleware
+func (sh *strictHandler) UpdateMe(ctx echo.Context) error {
+	var request UpdateMeRequestObject
 
-	var body UpdateGroupJSONRequestBody
+	var body UpdateMeJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateGroup(ctx.Request().Context(), request.(UpdateGroupRequestObject))
+		return sh.ssi.UpdateMe(ctx.Request().Context(), request.(UpdateMeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateGroup")
+		handler = middleware(handler, "UpdateMe")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
-		return validResponse.VisitUpdateGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(UpdateMeResponseObject); ok {
+		return validResponse.VisitUpdateMeResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListInvites operation middleware
-func (sh *strictHandler) ListInvites(ctx echo.Context, groupId UUID) error {
-	var request ListInvitesRequestObject
-
-	request.GroupId = groupId
+// GetNotificationPreferences operation middleware
+func (sh *strictHandler) GetNotificationPreferences(ctx echo.Context) error {
+	var request GetNotificationPreferencesRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListInvites(ctx.Request().Context(), request.(ListInvitesRequestObject))
+		return sh.ssi.GetNotificationPreferences(ctx.Request().Context(), request.(GetNotificationPreferencesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListInvites")
+		handler = middleware(handler, "GetNotificationPreferences")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListInvitesResponseObject); ok {
-		return validResponse.VisitListInvitesResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetNotificationPreferencesResponseObject); ok {
+		return validResponse.VisitGetNotificationPreferencesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CreateInvite operation middleware
-func (sh *strictHandler) CreateInvite(ctx echo.Context, groupId UUID) error {
-	var request CreateInviteRequestObject
-
-	request.GroupId = groupId
+// UpdateNotificationPreferences operation middleware
+func (sh *strictHandler) UpdateNotificationPreferences(ctx echo.Context) error {
+	var request UpdateNotificationPreferencesRequestObject
 
-	var body CreateInviteJSONRequestBody
+	var body UpdateNotificationPreferencesJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateInvite(ctx.Request().Context(), request.(CreateInviteRequestObject))
+		return sh.ssi.UpdateNotificationPreferences(ctx.Request().Context(), request.(UpdateNotificationPreferencesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateInvite")
+		handler = middleware(handler, "UpdateNotificationPreferences")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateInviteResponseObject); ok {
-		return validResponse.VisitCreateInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(UpdateNotificationPreferencesResponseObject); ok {
+		return validResponse.VisitUpdateNotificationPreferencesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// RevokeInvite operation middleware
-func (sh *strictHandler) RevokeInvite(ctx echo.Context, groupId UUID, inviteId UUID) error {
-	var request RevokeInviteRequestObject
+// ListRounds operation middleware
+func (sh *strictHandler) ListRounds(ctx echo.Context, params ListRoundsParams) error {
+	var request ListRoundsRequestObject
 
-	request.GroupId = groupId
-	request.InviteId = inviteId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeInvite(ctx.Request().Context(), request.(RevokeInviteRequestObject))
+		return sh.ssi.ListRounds(ctx.Request().Context(), request.(ListRoundsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeInvite")
+		handler = middleware(handler, "ListRounds")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(RevokeInviteResponseObject); ok {
-		return validResponse.VisitRevokeInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(ListRoundsResponseObject); ok {
+		return validResponse.VisitListRoundsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// LeaveGroup operation middleware
-func (sh *strictHandler) LeaveGroup(ctx echo.Context, groupId UUID) error {
-	var request LeaveGroupRequestObject
+// GetRound operation middleware
+func (sh *strictHandler) GetRound(ctx echo.Context, roundId UUID) error {
+	var request GetRoundRequestObject
 
-	request.GroupId = groupId
+	request.RoundId = roundId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LeaveGroup(ctx.Request().Context(), request.(LeaveGroupRequestObject))
+		return sh.ssi.GetRound(ctx.Request().Context(), request.(GetRoundRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LeaveGroup")
+		handler = middleware(handler, "GetRound")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LeaveGroupResponseObject); ok {
-		return validResponse.VisitLeaveGroupResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetRoundResponseObject); ok {
+		return validResponse.VisitGetRoundResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListGroupMembers operation middleware
-func (sh *strictHandler) ListGroupMembers(ctx echo.Context, groupId UUID) error {
-	var request ListGroupMembersRequestObject
+// GetRoundActivity operation middleware
+func (sh *strictHandler) GetRoundActivity(ctx echo.Context, roundId UUID, params GetRoundActivityParams) error {
+	var request GetRoundActivityRequestObject
 
-	request.GroupId = groupId
+	request.RoundId = roundId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroupMembers(ctx.Request().Context(), request.(ListGroupMembersRequestObject))
+		return sh.ssi.GetRoundActivity(ctx.Request().Context(), request.(GetRoundActivityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroupMembers")
+		handler = middleware(handler, "GetRoundActivity")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListGroupMembersResponseObject); ok {
-		return validResponse.VisitListGroupMembersResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetRoundActivityResponseObject); ok {
+		return validResponse.VisitGetRoundActivityResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// RemoveGroupMember operation middleware
-func (sh *strictHandler) RemoveGroupMember(ctx echo.Context, groupId UUID, memberAddress Address) error {
-	var request RemoveGroupMemberRequestObject
+// ExportRoundActivity operation middleware
+func (sh *strictHandler) ExportRoundActivity(ctx echo.Context, roundId UUID, params ExportRoundActivityParams) error {
+	var request ExportRoundActivityRequestObject
 
-	request.GroupId = groupId
-	request.MemberAddress = memberAddress
+	request.RoundId = roundId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveGroupMember(ctx.Request().Context(), request.(RemoveGroupMemberRequestObject))
+		return sh.ssi.ExportRoundActivity(ctx.Request().Context(), request.(ExportRoundActivityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveGroupMember")
+		handler = middleware(handler, "ExportRoundActivity")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(RemoveGroupMemberResponseObject); ok {
-		return validResponse.VisitRemoveGroupMemberResponse(ctx.Response())
+	} else if validResponse, ok := response.(ExportRoundActivityResponseObject); ok {
+		return validResponse.VisitExportRoundActivityResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListGroupRounds operation middleware
-func (sh *strictHandler) ListGroupRounds(ctx echo.Context, groupId UUID, params ListGroupRoundsParams) error {
-	var request ListGroupRoundsRequestObject
+// GetRoundBalances operation middleware
+func (sh *strictHandler) GetRoundBalances(ctx echo.Context, roundId UUID) error {
+	var request GetRoundBalancesRequestObject
 
-	request.GroupId = groupId
-	request.Params = params
+	request.RoundId = roundId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroupRounds(ctx.Request().Context(), request.(ListGroupRoundsRequestObject))
+		return sh.ssi.GetRoundBalances(ctx.Request().Context(), request.(GetRoundBalancesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroupRounds")
+		handler = middleware(handler, "GetRoundBalances")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListGroupRoundsResponseObject); ok {
-		return validResponse.VisitListGroupRoundsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetRoundBalancesResponseObject); ok {
+		return validResponse.VisitGetRoundBalancesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CreateRound operation middleware
-func (sh *strictHandler) CreateRound(ctx echo.Context, groupId UUID) error {
-	var request CreateRoundRequestObject
-
-	request.GroupId = groupId
+// GetRoundPeriods operation middleware
+func (sh *strictHandler) GetRoundPeriods(ctx echo.Context, roundId UUID) error {
+	var request GetRoundPeriodsRequestObject
 
-	var body CreateRoundJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.RoundId = roundId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRound(ctx.Request().Context(), request.(CreateRoundRequestObject))
+		return sh.ssi.GetRoundPeriods(ctx.Request().Context(), request.(GetRoundPeriodsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRound")
+		handler = middleware(handler, "GetRoundPeriods")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CreateRoundResponseObject); ok {
-		return validResponse.VisitCreateRoundResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetRoundPeriodsResponseObject); ok {
+		return validResponse.VisitGetRoundPeriodsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AcceptInvite operation middleware
-func (sh *strictHandler) AcceptInvite(ctx echo.Context) error {
-	var request AcceptInviteRequestObject
+// ListSlotSwaps operation middleware
+func (sh *strictHandler) ListSlotSwaps(ctx echo.Context, roundId UUID) error {
+	var request ListSlotSwapsRequestObject
 
-	var body AcceptInviteJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.RoundId = roundId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AcceptInvite(ctx.Request().Context(), request.(AcceptInviteRequestObject))
+		return sh.ssi.ListSlotSwaps(ctx.Request().Context(), request.(ListSlotSwapsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AcceptInvite")
+		handler = middleware(handler, "ListSlotSwaps")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AcceptInviteResponseObject); ok {
-		return validResponse.VisitAcceptInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(ListSlotSwapsResponseObject); ok {
+		return validResponse.VisitListSlotSwapsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PreviewInvite operation middleware
-func (sh *strictHandler) PreviewInvite(ctx echo.Context) error {
-	var request PreviewInviteRequestObject
+// ProposeSlotSwap operation middleware
+func (sh *strictHandler) ProposeSlotSwap(ctx echo.Context, roundId UUID) error {
+	var request ProposeSlotSwapRequestObject
 
-	var body PreviewInviteJSONRequestBody
+	request.RoundId = roundId
+
+	var body ProposeSlotSwapJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PreviewInvite(ctx.Request().Context(), request.(PreviewInviteRequestObject))
+		return sh.ssi.ProposeSlotSwap(ctx.Request().Context(), request.(ProposeSlotSwapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PreviewInvite")
+		handler = middleware(handler, "ProposeSlotSwap")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PreviewInviteResponseObject); ok {
-		return validResponse.VisitPreviewInviteResponse(ctx.Response())
+	} else if validResponse, ok := response.(ProposeSlotSwapResponseObject); ok {
+		return validResponse.VisitProposeSlotSwapResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetMe operation middleware
-func (sh *strictHandler) GetMe(ctx echo.Context) error {
-	var request GetMeRequestObject
+// AcceptSlotSwap operation middleware
+func (sh *strictHandler) AcceptSlotSwap(ctx echo.Context, roundId UUID, swapId UUID) error {
+	var request AcceptSlotSwapRequestObject
+
+	request.RoundId = roundId
+	request.SwapId = swapId
+
+	var body AcceptSlotSwapJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMe(ctx.Request().Context(), request.(GetMeRequestObject))
+		return sh.ssi.AcceptSlotSwap(ctx.Request().Context(), request.(AcceptSlotSwapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMe")
+		handler = middleware(handler, "AcceptSlotSwap")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetMeResponseObject); ok {
-		return validResponse.VisitGetMeResponse(ctx.Response())
+	} else if validResponse, ok := response.(AcceptSlotSwapResponseObject); ok {
+		return validResponse.VisitAcceptSlotSwapResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// UpdateMe operation middleware
-func (sh *strictHandler) UpdateMe(ctx echo.Context) error {
-	var request UpdateMeRequestObject
+// CancelSlotSwap operation middleware
+func (sh *strictHandler) CancelSlotSwap(ctx echo.Context, roundId UUID, swapId UUID) error {
+	var request CancelSlotSwapRequestObject
 
-	var body UpdateMeJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.RoundId = roundId
+	request.SwapId = swapId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateMe(ctx.Request().Context(), request.(UpdateMeRequestObject))
+		return sh.ssi.CancelSlotSwap(ctx.Request().Context(), request.(CancelSlotSwapRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateMe")
+		handler = middleware(handler, "CancelSlotSwap")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UpdateMeResponseObject); ok {
-		return validResponse.VisitUpdateMeResponse(ctx.Response())
+	} else if validResponse, ok := response.(CancelSlotSwapResponseObject); ok {
+		return validResponse.VisitCancelSlotSwapResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListRounds operation middleware
-func (sh *strictHandler) ListRounds(ctx echo.Context, params ListRoundsParams) error {
-	var request ListRoundsRequestObject
+// BuildContribution operation middleware
+func (sh *strictHandler) BuildContribution(ctx echo.Context, roundId UUID) error {
+	var request BuildContributionRequestObject
 
-	request.Params = params
+	request.RoundId = roundId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRounds(ctx.Request().Context(), request.(ListRoundsRequestObject))
+		return sh.ssi.BuildContribution(ctx.Request().Context(), request.(BuildContributionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRounds")
+		handler = middleware(handler, "BuildContribution")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListRoundsResponseObject); ok {
-		return validResponse.VisitListRoundsResponse(ctx.Response())
+	} else if validResponse, ok := response.(BuildContributionResponseObject); ok {
+		return validResponse.VisitBuildContributionResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetRound operation middleware
-func (sh *strictHandler) GetRound(ctx echo.Context, roundId UUID) error {
-	var request GetRoundRequestObject
+// BuildPayoutClaim operation middleware
+func (sh *strictHandler) BuildPayoutClaim(ctx echo.Context, roundId UUID) error {
+	var request BuildPayoutClaimRequestObject
 
 	request.RoundId = roundId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRound(ctx.Request().Context(), request.(GetRoundRequestObject))
+		return sh.ssi.BuildPayoutClaim(ctx.Request().Context(), request.(BuildPayoutClaimRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRound")
+		handler = middleware(handler, "BuildPayoutClaim")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetRoundResponseObject); ok {
-		return validResponse.VisitGetRoundResponse(ctx.Response())
+	} else if validResponse, ok := response.(BuildPayoutClaimResponseObject); ok {
+		return validResponse.VisitBuildPayoutClaimResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetRoundActivity operation middleware
-func (sh *strictHandler) GetRoundActivity(ctx echo.Context, roundId UUID, params GetRoundActivityParams) error {
-	var request GetRoundActivityRequestObject
+// TransitionRound operation middleware
+func (sh *strictHandler) TransitionRound(ctx echo.Context, roundId UUID) error {
+	var request TransitionRoundRequestObject
 
 	request.RoundId = roundId
-	request.Params = params
+
+	var body TransitionRoundJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRoundActivity(ctx.Request().Context(), request.(GetRoundActivityRequestObject))
+		return sh.ssi.TransitionRound(ctx.Request().Context(), request.(TransitionRoundRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRoundActivity")
+		handler = middleware(handler, "TransitionRound")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetRoundActivityResponseObject); ok {
-		return validResponse.VisitGetRoundActivityResponse(ctx.Response())
+	} else if validResponse, ok := response.(TransitionRoundResponseObject); ok {
+		return validResponse.VisitTransitionRoundResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetRoundPeriods operation middleware
-func (sh *strictHandler) GetRoundPeriods(ctx echo.Context, roundId UUID) error {
-	var request GetRoundPeriodsRequestObject
-
-	request.RoundId = roundId
+// ListTokens operation middleware
+func (sh *strictHandler) ListTokens(ctx echo.Context) error {
+	var request ListTokensRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRoundPeriods(ctx.Request().Context(), request.(GetRoundPeriodsRequestObject))
+		return sh.ssi.ListTokens(ctx.Request().Context(), request.(ListTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRoundPeriods")
+		handler = middleware(handler, "ListTokens")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetRoundPeriodsResponseObject); ok {
-		return validResponse.VisitGetRoundPeriodsResponse(ctx.Response())
+	} else if validResponse, ok := response.(ListTokensResponseObject); ok {
+		return validResponse.VisitListTokensResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
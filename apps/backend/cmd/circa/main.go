@@ -0,0 +1,352 @@
+// Command circa runs administrative tasks against the Circa database and
+// chains, with the same configuration as the server.
+//
+//	circa reconcile [-round <id>]
+//	circa resync -round <id> [-from-block <n>]
+//	circa index backfill -round <id> [-from-block <n>] | -resume <backfill id>
+//	circa index status -round <id>
+//	circa allowlist sync [-group <id>]
+package main
+
+import (
+	"circa/internal/config"
+	"circa/internal/contracts"
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	"circa/internal/indexer"
+	"circa/internal/pagination"
+	"circa/internal/queue"
+	"circa/internal/service/backfill"
+	"circa/internal/service/group"
+	"circa/internal/service/reconcile"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const usage = `Usage:
+  circa reconcile [-round <id>]                     compare rounds with their contracts and list open discrepancies
+  circa resync -round <id> [-from-block n]          re-index a round's events from the chain
+  circa index backfill -round <id> [-from-block n]  backfill a round's events in the background, while indexing goes on
+  circa index backfill -resume <backfill id>        resume a failed backfill
+  circa index status -round <id>                    show a round's backfills
+  circa allowlist sync [-group <id>]                store allowlist versions for groups whose members changed
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var err error
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "reconcile":
+		err = runReconcile(ctx, args)
+	case "resync":
+		err = runResync(ctx, args)
+	case "index":
+		err = runIndex(ctx, args)
+	case "allowlist":
+		err = runAllowlist(ctx, args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Str("command", os.Args[1]).Msg("Command failed")
+	}
+}
+
+func runReconcile(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	round := flags.String("round", "", "only reconcile this round, whatever its status")
+	flags.Parse(args)
+
+	var roundID *uuid.UUID
+	if *round != "" {
+		id, err := uuid.Parse(*round)
+		if err != nil {
+			return fmt.Errorf("invalid round ID %q", *round)
+		}
+		roundID = &id
+	}
+
+	service, closeService, err := newReconcileService()
+	if err != nil {
+		return err
+	}
+	defer closeService()
+
+	opened, err := service.Reconcile(ctx, roundID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	discrepancies, err := service.ListOpen(ctx, roundID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d new, %d open discrepancies\n", opened, len(discrepancies))
+	if len(discrepancies) == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ROUND\tKEY\tON CHAIN\tOFF CHAIN\tBLOCK\tDETECTED")
+	for _, d := range discrepancies {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n",
+			d.RoundID, d.Key, d.OnChain, d.OffChain, d.BlockNumber, d.DetectedAt.Time.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func runResync(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("resync", flag.ExitOnError)
+	round := flags.String("round", "", "round to re-index")
+	fromBlock := flags.Uint64("from-block", 0, "first block to re-index, 0 for the whole round")
+	flags.Parse(args)
+
+	roundID, err := uuid.Parse(*round)
+	if err != nil {
+		return fmt.Errorf("invalid round ID %q", *round)
+	}
+
+	service, closeService, err := newReconcileService()
+	if err != nil {
+		return err
+	}
+	defer closeService()
+
+	if err := service.Resync(ctx, roundID, *fromBlock); err != nil {
+		return err
+	}
+	fmt.Printf("Round %s will be re-indexed from block %d on the indexer's next poll\n", roundID, *fromBlock)
+	return nil
+}
+
+func runIndex(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch subcommand, args := args[0], args[1:]; subcommand {
+	case "backfill":
+		return runBackfill(ctx, args)
+	case "status":
+		return runIndexStatus(ctx, args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	return nil
+}
+
+func runBackfill(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("index backfill", flag.ExitOnError)
+	round := flags.String("round", "", "round to backfill")
+	fromBlock := flags.Uint64("from-block", 0, "first block to backfill, 0 for the whole round")
+	resume := flags.String("resume", "", "failed backfill to resume instead of starting one")
+	flags.Parse(args)
+
+	service, closeService, err := newBackfillService()
+	if err != nil {
+		return err
+	}
+	defer closeService()
+
+	var backfill *sqlc.IndexerBackfill
+	if *resume != "" {
+		backfillID, err := uuid.Parse(*resume)
+		if err != nil {
+			return fmt.Errorf("invalid backfill ID %q", *resume)
+		}
+		if backfill, err = service.Resume(ctx, backfillID); err != nil {
+			return err
+		}
+	} else {
+		roundID, err := uuid.Parse(*round)
+		if err != nil {
+			return fmt.Errorf("invalid round ID %q", *round)
+		}
+		if backfill, err = service.Start(ctx, roundID, *fromBlock, nil); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Backfill %s of blocks %d-%d queued from block %d; the server's worker runs it\n",
+		backfill.ID, backfill.FromBlock, backfill.ToBlock, backfill.NextBlock)
+	return nil
+}
+
+func runIndexStatus(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("index status", flag.ExitOnError)
+	round := flags.String("round", "", "round to show the backfills of")
+	flags.Parse(args)
+
+	roundID, err := uuid.Parse(*round)
+	if err != nil {
+		return fmt.Errorf("invalid round ID %q", *round)
+	}
+
+	service, closeService, err := newBackfillService()
+	if err != nil {
+		return err
+	}
+	defer closeService()
+
+	backfills, err := service.List(ctx, roundID)
+	if err != nil {
+		return err
+	}
+	if len(backfills) == 0 {
+		fmt.Println("No backfills")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "BACKFILL\tSTATUS\tBLOCKS\tNEXT BLOCK\tEVENTS\tCREATED\tERROR")
+	for _, b := range backfills {
+		errorMessage := ""
+		if b.ErrorMessage != nil {
+			errorMessage = *b.ErrorMessage
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d-%d\t%d\t%d\t%s\t%s\n",
+			b.ID, b.Status, b.FromBlock, b.ToBlock, b.NextBlock, b.EventsWritten, b.CreatedAt.Time.Format(time.RFC3339), errorMessage)
+	}
+	return w.Flush()
+}
+
+func runAllowlist(ctx context.Context, args []string) error {
+	if len(args) == 0 || args[0] != "sync" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	flags := flag.NewFlagSet("allowlist sync", flag.ExitOnError)
+	groupFlag := flags.String("group", "", "only sync this group")
+	flags.Parse(args[1:])
+
+	var groupID *uuid.UUID
+	if *groupFlag != "" {
+		id, err := uuid.Parse(*groupFlag)
+		if err != nil {
+			return fmt.Errorf("invalid group ID %q", *groupFlag)
+		}
+		groupID = &id
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return err
+	}
+	store, err := db.InitPostgres(cfg.DatabaseURL)
+	if err != nil {
+		return err
+	}
+
+	service := group.NewService(store, pagination.New(cfg.SecretKey), nil)
+	allowlists, err := service.SyncAllowlists(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "GROUP\tVERSION\tMEMBERS\tROOT")
+	for _, a := range allowlists {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", a.GroupID, a.Version, len(a.Members), a.Root)
+	}
+	return w.Flush()
+}
+
+// newReconcileService connects to the database and every configured chain.
+// Alerts are queued for the server's worker to send.
+func newReconcileService() (*reconcile.Service, func(), error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, err := db.InitPostgres(cfg.DatabaseURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clients, closeAll, err := dialChains(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	callers := map[int64]contracts.Caller{}
+	for chainID, client := range clients {
+		callers[chainID] = client
+	}
+
+	queueService := queue.NewService(store)
+	emailService := email.NewService(cfg.ResendAPIKey)
+	repository := indexer.NewRepository(store, nil, nil)
+	return reconcile.NewService(store, queueService, emailService, callers, repository, cfg.OperatorEmail), closeAll, nil
+}
+
+// newBackfillService connects to the database and every configured chain.
+// Chunks are queued for the server's worker to run.
+func newBackfillService() (*backfill.Service, func(), error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store, err := db.InitPostgres(cfg.DatabaseURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clients, closeAll, err := dialChains(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	chains := map[int64]indexer.Chain{}
+	for chainID, client := range clients {
+		confirmations, ok := cfg.ChainConfirmations[chainID]
+		if !ok {
+			confirmations = indexer.DefaultConfirmations
+		}
+		chains[chainID] = indexer.Chain{Client: client, Confirmations: confirmations}
+	}
+
+	queueService := queue.NewService(store)
+	repository := indexer.NewRepository(store, nil, nil)
+	return backfill.NewService(store, queueService, chains, repository, cfg.BackfillBlockRange, cfg.BackfillChunkDelay), closeAll, nil
+}
+
+// dialChains connects to the RPC endpoint of every configured chain.
+func dialChains(cfg config.Config) (map[int64]*ethclient.Client, func(), error) {
+	clients := map[int64]*ethclient.Client{}
+	closeAll := func() {
+		for _, client := range clients {
+			client.Close()
+		}
+	}
+	for chainID, url := range cfg.ChainRPCURLs {
+		client, err := ethclient.Dial(url)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("connect to chain %d: %w", chainID, err)
+		}
+		clients[chainID] = client
+	}
+	return clients, closeAll, nil
+}
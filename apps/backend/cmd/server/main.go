@@ -3,12 +3,29 @@ package main
 import (
 	"circa/api"
 	"circa/internal/config"
+	"circa/internal/contracts"
 	"circa/internal/db"
 	"circa/internal/email"
 	"circa/internal/handler"
+	"circa/internal/indexer"
+	"circa/internal/pagination"
+	"circa/internal/prices"
 	"circa/internal/queue"
 	"circa/internal/redis"
 	"circa/internal/service/auth"
+	"circa/internal/service/backfill"
+	"circa/internal/service/dashboard"
+	"circa/internal/service/delinquency"
+	"circa/internal/service/discovery"
+	"circa/internal/service/group"
+	"circa/internal/service/invite"
+	"circa/internal/service/payoutorder"
+	"circa/internal/service/reconcile"
+	"circa/internal/service/reminder"
+	"circa/internal/service/round"
+	"circa/internal/service/slotswap"
+	"circa/internal/service/txbuilder"
+	"circa/internal/tokens"
 	"context"
 	"net/http"
 	"os"
@@ -16,6 +33,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/rs/zerolog/log"
@@ -51,10 +70,121 @@ func main() {
 	emailService := email.NewService(cfg.ResendAPIKey)
 	queueWorker := queue.NewWorker(queueService, emailService)
 
+	paginator := pagination.New(cfg.SecretKey)
+
+	var tokenRegistry *tokens.Registry
+	if cfg.TokenRegistryFile != "" {
+		tokenRegistry, err = tokens.LoadFile(cfg.TokenRegistryFile)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Error loading token registry")
+		}
+		log.Info().Int("tokens", len(tokenRegistry.Tokens())).Msg("Token registry loaded")
+	}
+
+	var priceFeed prices.Feed
+	switch {
+	case cfg.PriceFeedURL != "":
+		priceFeed = prices.NewCachedFeed(prices.NewHTTPFeed(cfg.PriceFeedURL, nil), redis.RedisClient, cfg.PriceCacheTTL)
+	case cfg.PriceFeedFile != "":
+		staticFeed, err := prices.LoadStaticFile(cfg.PriceFeedFile)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Error loading price feed")
+		}
+		priceFeed = staticFeed
+	}
+	fiatCurrency := cfg.DefaultFiatCurrency
+	if fiatCurrency != "" {
+		fiatCurrency, err = prices.NormalizeCurrency(fiatCurrency)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid DEFAULT_FIAT_CURRENCY")
+		}
+	}
+	priceConverter := prices.NewConverter(priceFeed, tokenRegistry, fiatCurrency)
+
+	dashboardCache := dashboard.NewCache(redis.RedisClient, cfg.DashboardCacheTTL)
+
 	authService := auth.NewService(store, queueService, cfg.FrontendURL, 15*time.Minute)
+	groupService := group.NewService(store, paginator, dashboardCache)
+
+	queueWorker.Register(group.PurgeDeletedGroupsJob, groupService.HandlePurgeDeletedGroupsJob)
+	queueWorker.Schedule(group.PurgeDeletedGroupsJob, queue.JobPayload{
+		"retention_days": cfg.GroupPurgeRetentionDays,
+	}, 24*time.Hour)
+
+	delinquencyService := delinquency.NewService(store, queueService, emailService, tokenRegistry, priceConverter)
+	queueWorker.Register(delinquency.CheckDelinquenciesJob, delinquencyService.HandleCheckDelinquenciesJob)
+	queueWorker.Register(delinquency.SendNoticeJob, delinquencyService.HandleSendNoticeJob)
+	queueWorker.Schedule(delinquency.CheckDelinquenciesJob, queue.JobPayload{}, time.Hour)
+
+	reminderService := reminder.NewService(store, queueService, emailService, tokenRegistry, priceConverter)
+	queueWorker.Register(reminder.SendRemindersJob, reminderService.HandleSendRemindersJob)
+	queueWorker.Register(reminder.SendReminderEmailJob, reminderService.HandleSendReminderEmailJob)
+	queueWorker.Schedule(reminder.SendRemindersJob, queue.JobPayload{}, 10*time.Minute)
+
+	chains := map[int64]indexer.Chain{}
+	callers := map[int64]contracts.Caller{}
+	txClients := map[int64]txbuilder.ChainClient{}
+	for chainID, url := range cfg.ChainRPCURLs {
+		client, err := ethclient.Dial(url)
+		if err != nil {
+			log.Fatal().Err(err).Int64("chain_id", chainID).Msg("Error connecting to chain RPC")
+		}
+		defer client.Close()
+
+		confirmations, ok := cfg.ChainConfirmations[chainID]
+		if !ok {
+			confirmations = indexer.DefaultConfirmations
+		}
+		chains[chainID] = indexer.Chain{Client: client, Confirmations: confirmations}
+		callers[chainID] = client
+		txClients[chainID] = client
+	}
+	if cfg.VerifyTokenRegistry {
+		verifyCtx, cancelVerify := context.WithTimeout(context.Background(), 30*time.Second)
+		err := tokenRegistry.Verify(verifyCtx, callers)
+		cancelVerify()
+		if err != nil {
+			log.Fatal().Err(err).Msg("Token registry does not match the chain")
+		}
+	}
+	verification := round.Verification{Factories: map[int64][]common.Address{}}
+	for _, hash := range cfg.RoundCodeHashes {
+		verification.CodeHashes = append(verification.CodeHashes, common.HexToHash(hash))
+	}
+	for chainID, factories := range cfg.RoundFactories {
+		for _, factory := range factories {
+			verification.Factories[chainID] = append(verification.Factories[chainID], common.HexToAddress(factory))
+		}
+	}
+	for chainID, chain := range chains {
+		chain.Factories = verification.Factories[chainID]
+		chain.FactoryStartBlock = cfg.RoundFactoryStartBlocks[chainID]
+		chains[chainID] = chain
+	}
+	roundService := round.NewService(store, paginator, callers, chains, verification, tokenRegistry, priceConverter, dashboardCache)
+
+	indexerRepository := indexer.NewRepository(store, dashboardCache, roundService)
+	roundIndexer := indexer.New(indexerRepository, chains, cfg.IndexerPollInterval)
+
+	discoveryService := discovery.NewService(store, queueService, emailService, roundService, tokenRegistry, cfg.FrontendURL)
+	roundIndexer.SetDiscoverer(discoveryService)
+	queueWorker.Register(discovery.SendRoundReadyJob, discoveryService.HandleSendRoundReadyJob)
+
+	reconcileService := reconcile.NewService(store, queueService, emailService, callers, indexerRepository, cfg.OperatorEmail)
+	queueWorker.Register(reconcile.ReconcileRoundsJob, reconcileService.HandleReconcileRoundsJob)
+	queueWorker.Register(reconcile.SendAlertJob, reconcileService.HandleSendAlertJob)
+	queueWorker.Schedule(reconcile.ReconcileRoundsJob, queue.JobPayload{}, cfg.ReconcileInterval)
+
+	backfillService := backfill.NewService(store, queueService, chains, indexerRepository, cfg.BackfillBlockRange, cfg.BackfillChunkDelay)
+	queueWorker.Register(backfill.ChunkJob, backfillService.HandleChunkJob)
 
 	// Initialize handlers
-	h := handler.NewHandler(authService, cfg)
+	inviteService := invite.NewService(store, cfg.FrontendURL)
+	dashboardService := dashboard.NewService(store, dashboardCache, tokenRegistry, priceConverter)
+	payoutOrderService := payoutorder.NewService(store, chains)
+	slotSwapService := slotswap.NewService(store)
+	txBuilderService := txbuilder.NewService(store, txClients)
+	h := handler.NewHandler(authService, groupService, inviteService, roundService, dashboardService, reminderService, payoutOrderService, slotSwapService, txBuilderService, backfillService, tokenRegistry, cfg)
 
 	// Create Echo instance
 	e := echo.New()
@@ -84,6 +214,15 @@ func main() {
 		return nil
 	})
 
+	if len(chains) > 0 {
+		g.Go(func() error {
+			roundIndexer.Start(ctx)
+			return nil
+		})
+	} else {
+		log.Warn().Msg("No CHAIN_RPC_URLS configured, on-chain indexer disabled")
+	}
+
 	// Start HTTP server
 	g.Go(func() error {
 		serverAddr := ":" + cfg.Port
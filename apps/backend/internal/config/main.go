@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -31,6 +33,66 @@ type Config struct {
 	EmailFrom     string
 	EmailFromName string
 	IsProduction  bool
+
+	// GroupPurgeRetentionDays is how long soft-deleted groups are kept
+	// before the purge job removes them for good, or anonymizes those that
+	// rounds still belong to.
+	GroupPurgeRetentionDays int
+
+	// ChainRPCURLs maps a chain ID to the JSON-RPC endpoint the indexer
+	// reads from. Rounds on chains without an endpoint are not indexed.
+	ChainRPCURLs map[int64]string
+	// ChainConfirmations is the number of blocks, counting its own, before
+	// an indexed event is shown as confirmed. Chains without an entry use
+	// the indexer default.
+	ChainConfirmations  map[int64]uint64
+	IndexerPollInterval time.Duration
+
+	// RoundCodeHashes and RoundFactories identify genuine Round contracts:
+	// a registered round must match one of the runtime bytecode hashes or
+	// have been deployed by one of its chain's factories. RoundFactories maps
+	// a chain ID to the factory addresses watched on it, and
+	// RoundFactoryStartBlocks to the block watching starts at the first
+	// time, no later than the factories' deployment.
+	RoundCodeHashes         []string
+	RoundFactories          map[int64][]string
+	RoundFactoryStartBlocks map[int64]uint64
+
+	// TokenRegistryFile is a JSON file listing the ERC-20 tokens rounds are
+	// denominated in. Without it amounts are shown in base units.
+	TokenRegistryFile string
+	// VerifyTokenRegistry checks each registered token's symbol and
+	// decimals on chain at startup.
+	VerifyTokenRegistry bool
+
+	// PriceFeedURL is a price service to get fiat prices from, and
+	// PriceFeedFile a JSON file of fixed prices used when it is unset.
+	// Without either no fiat amounts are shown.
+	PriceFeedURL  string
+	PriceFeedFile string
+	// PriceCacheTTL is how long prices are kept in Redis.
+	PriceCacheTTL time.Duration
+	// DefaultFiatCurrency is the ISO 4217 currency shown to users who have
+	// not chosen one.
+	DefaultFiatCurrency string
+
+	// DashboardCacheTTL bounds how long a cached dashboard is served.
+	// Indexer, group and round writes invalidate cached dashboards earlier.
+	DashboardCacheTTL time.Duration
+	// ReconcileInterval is how often rounds are compared with their
+	// contracts, and OperatorEmail who is alerted about discrepancies
+	// besides group owners.
+	ReconcileInterval time.Duration
+	OperatorEmail     string
+
+	// AdminAddresses are the wallet addresses allowed to use the admin
+	// endpoints.
+	AdminAddresses []string
+	// BackfillBlockRange is the number of blocks a backfill reads per
+	// chunk, and BackfillChunkDelay the pause between chunks, so backfills
+	// stay within the RPC provider's limits.
+	BackfillBlockRange uint64
+	BackfillChunkDelay time.Duration
 }
 
 func LoadConfig() (Config, error) {
@@ -62,9 +124,149 @@ func LoadConfig() (Config, error) {
 		return config, fmt.Errorf("invalid port number: %w", err)
 	}
 
+	config.GroupPurgeRetentionDays = 30
+	if v := os.Getenv("GROUP_PURGE_RETENTION_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 1 {
+			return config, fmt.Errorf("invalid GROUP_PURGE_RETENTION_DAYS: %q", v)
+		}
+		config.GroupPurgeRetentionDays = days
+	}
+
+	config.ChainRPCURLs = map[int64]string{}
+	if v := os.Getenv("CHAIN_RPC_URLS"); v != "" {
+		for _, entry := range strings.Split(v, ",") {
+			id, url, ok := strings.Cut(strings.TrimSpace(entry), "=")
+			chainID, err := strconv.ParseInt(id, 10, 64)
+			if !ok || err != nil || url == "" {
+				return config, fmt.Errorf("invalid CHAIN_RPC_URLS entry: %q", entry)
+			}
+			config.ChainRPCURLs[chainID] = url
+		}
+	}
+
+	config.ChainConfirmations = map[int64]uint64{}
+	if v := os.Getenv("CHAIN_CONFIRMATIONS"); v != "" {
+		for _, entry := range strings.Split(v, ",") {
+			id, depth, ok := strings.Cut(strings.TrimSpace(entry), "=")
+			chainID, err := strconv.ParseInt(id, 10, 64)
+			confirmations, depthErr := strconv.ParseUint(depth, 10, 64)
+			if !ok || err != nil || depthErr != nil || confirmations < 1 {
+				return config, fmt.Errorf("invalid CHAIN_CONFIRMATIONS entry: %q", entry)
+			}
+			config.ChainConfirmations[chainID] = confirmations
+		}
+	}
+
+	config.IndexerPollInterval = 15 * time.Second
+	if v := os.Getenv("INDEXER_POLL_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			return config, fmt.Errorf("invalid INDEXER_POLL_INTERVAL: %q", v)
+		}
+		config.IndexerPollInterval = interval
+	}
+
+	config.RoundCodeHashes = splitList(os.Getenv("ROUND_CODE_HASHES"))
+	config.RoundFactories = map[int64][]string{}
+	for _, entry := range splitList(os.Getenv("ROUND_FACTORY_ADDRESSES")) {
+		id, address, ok := strings.Cut(entry, "=")
+		chainID, err := strconv.ParseInt(id, 10, 64)
+		if !ok || err != nil || address == "" {
+			return config, fmt.Errorf("invalid ROUND_FACTORY_ADDRESSES entry: %q", entry)
+		}
+		config.RoundFactories[chainID] = append(config.RoundFactories[chainID], address)
+	}
+
+	config.RoundFactoryStartBlocks = map[int64]uint64{}
+	for _, entry := range splitList(os.Getenv("ROUND_FACTORY_START_BLOCKS")) {
+		id, block, ok := strings.Cut(entry, "=")
+		chainID, err := strconv.ParseInt(id, 10, 64)
+		startBlock, blockErr := strconv.ParseUint(block, 10, 64)
+		if !ok || err != nil || blockErr != nil {
+			return config, fmt.Errorf("invalid ROUND_FACTORY_START_BLOCKS entry: %q", entry)
+		}
+		config.RoundFactoryStartBlocks[chainID] = startBlock
+	}
+	for chainID := range config.RoundFactories {
+		if _, ok := config.RoundFactoryStartBlocks[chainID]; !ok {
+			return config, fmt.Errorf("ROUND_FACTORY_START_BLOCKS has no entry for chain %d", chainID)
+		}
+	}
+
+	config.TokenRegistryFile = os.Getenv("TOKEN_REGISTRY_FILE")
+	if v := os.Getenv("VERIFY_TOKEN_REGISTRY"); v != "" {
+		verify, err := strconv.ParseBool(v)
+		if err != nil {
+			return config, fmt.Errorf("invalid VERIFY_TOKEN_REGISTRY: %q", v)
+		}
+		config.VerifyTokenRegistry = verify
+	}
+
+	config.PriceFeedURL = os.Getenv("PRICE_FEED_URL")
+	config.PriceFeedFile = os.Getenv("PRICE_FEED_FILE")
+	config.DefaultFiatCurrency = os.Getenv("DEFAULT_FIAT_CURRENCY")
+	config.PriceCacheTTL = time.Hour
+	if v := os.Getenv("PRICE_CACHE_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl < 0 {
+			return config, fmt.Errorf("invalid PRICE_CACHE_TTL: %q", v)
+		}
+		config.PriceCacheTTL = ttl
+	}
+
+	config.DashboardCacheTTL = 30 * time.Second
+	if v := os.Getenv("DASHBOARD_CACHE_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl < 0 {
+			return config, fmt.Errorf("invalid DASHBOARD_CACHE_TTL: %q", v)
+		}
+		config.DashboardCacheTTL = ttl
+	}
+
+	config.ReconcileInterval = time.Hour
+	if v := os.Getenv("RECONCILE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			return config, fmt.Errorf("invalid RECONCILE_INTERVAL: %q", v)
+		}
+		config.ReconcileInterval = interval
+	}
+	config.OperatorEmail = os.Getenv("OPERATOR_EMAIL")
+
+	config.AdminAddresses = splitList(os.Getenv("ADMIN_ADDRESSES"))
+
+	config.BackfillBlockRange = 2000
+	if v := os.Getenv("BACKFILL_BLOCK_RANGE"); v != "" {
+		blockRange, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || blockRange == 0 {
+			return config, fmt.Errorf("invalid BACKFILL_BLOCK_RANGE: %q", v)
+		}
+		config.BackfillBlockRange = blockRange
+	}
+	config.BackfillChunkDelay = time.Second
+	if v := os.Getenv("BACKFILL_CHUNK_DELAY"); v != "" {
+		delay, err := time.ParseDuration(v)
+		if err != nil || delay < 0 {
+			return config, fmt.Errorf("invalid BACKFILL_CHUNK_DELAY: %q", v)
+		}
+		config.BackfillChunkDelay = delay
+	}
+
 	return config, nil
 }
 
+// splitList parses a comma separated list, ignoring empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func mustGetEnv(key string) string {
 	value := os.Getenv(key)
 	if value == "" {
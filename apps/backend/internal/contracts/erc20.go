@@ -0,0 +1,62 @@
+package contracts
+
+import (
+	"context"
+	_ "embed"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+//go:embed erc20.abi.json
+var erc20ABIJSON []byte
+
+// ERC20ABI is the part of the ERC-20 ABI the backend reads and builds
+// calls for.
+var ERC20ABI = mustParseABI("ERC-20", erc20ABIJSON)
+
+// ReadAllowance returns how much of token spender may still pull from owner.
+func ReadAllowance(ctx context.Context, caller Caller, token, owner, spender common.Address) (*big.Int, error) {
+	var allowance *big.Int
+	if err := call(ctx, caller, ERC20ABI, token, "allowance", &allowance, owner, spender); err != nil {
+		return nil, err
+	}
+	return allowance, nil
+}
+
+// ReadBalance returns the token balance of account.
+func ReadBalance(ctx context.Context, caller Caller, token, account common.Address) (*big.Int, error) {
+	return ReadBalanceAt(ctx, caller, token, account, nil)
+}
+
+// ReadBalanceAt returns the token balance of account as of block.
+func ReadBalanceAt(ctx context.Context, caller Caller, token, account common.Address, block *big.Int) (*big.Int, error) {
+	var balance *big.Int
+	if err := callAt(ctx, caller, ERC20ABI, token, block, "balanceOf", &balance, account); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
+// TokenMetadata is what an ERC-20 token reports about itself.
+type TokenMetadata struct {
+	Symbol   string
+	Decimals uint8
+}
+
+// ReadTokenMetadata reads the symbol and decimals of token.
+func ReadTokenMetadata(ctx context.Context, caller Caller, token common.Address) (*TokenMetadata, error) {
+	var metadata TokenMetadata
+	if err := call(ctx, caller, ERC20ABI, token, "symbol", &metadata.Symbol); err != nil {
+		return nil, err
+	}
+	if err := call(ctx, caller, ERC20ABI, token, "decimals", &metadata.Decimals); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// PackApprove returns the calldata of approve(spender, amount).
+func PackApprove(spender common.Address, amount *big.Int) ([]byte, error) {
+	return ERC20ABI.Pack("approve", spender, amount)
+}
@@ -0,0 +1,35 @@
+package contracts
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+//go:embed factory.abi.json
+var factoryABIJSON []byte
+
+// FactoryABI is the ABI of the RoundFactory contract. Each deployment emits
+// RoundCreated with the ID of the Circa group the round is for, as the 16
+// bytes of its UUID, and is recorded in the factory's isRound registry.
+var FactoryABI = mustParseABI("RoundFactory", factoryABIJSON)
+
+// GroupIDTopic returns the RoundCreated topic of a group ID: an indexed
+// bytes16 is left-aligned in its 32-byte topic.
+func GroupIDTopic(groupID uuid.UUID) common.Hash {
+	var topic common.Hash
+	copy(topic[:], groupID[:])
+	return topic
+}
+
+// IsFactoryRound reports whether factory deployed round, by asking the
+// factory rather than trusting the round's own factory() getter.
+func IsFactoryRound(ctx context.Context, caller Caller, factory, round common.Address) (bool, error) {
+	var deployed bool
+	if err := call(ctx, caller, FactoryABI, factory, "isRound", &deployed, round); err != nil {
+		return false, err
+	}
+	return deployed, nil
+}
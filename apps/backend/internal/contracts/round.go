@@ -0,0 +1,130 @@
+// Package contracts holds the ABIs of the Circa contracts and typed reads of
+// their on-chain state.
+package contracts
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+//go:embed round.abi.json
+var roundABIJSON []byte
+
+// RoundABI is the ABI of the Round contract.
+var RoundABI = mustParseABI("Round", roundABIJSON)
+
+// Caller reads contract code and state. Both ethclient.Client and the
+// simulated backend satisfy it.
+type Caller interface {
+	ethereum.ContractCaller
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// RoundState is the configuration a Round contract was deployed with.
+type RoundState struct {
+	Members            []common.Address
+	ContributionAmount *big.Int
+	PeriodDuration     *big.Int
+	// Factory is nil when the contract was not deployed by a factory.
+	Factory *common.Address
+	// Token is nil for contracts that do not expose their ERC-20 token.
+	Token *common.Address
+}
+
+// ReadRound reads the configuration of the Round contract at address.
+func ReadRound(ctx context.Context, caller Caller, address common.Address) (*RoundState, error) {
+	var state RoundState
+	if err := call(ctx, caller, RoundABI, address, "members", &state.Members); err != nil {
+		return nil, err
+	}
+	if err := call(ctx, caller, RoundABI, address, "contributionAmount", &state.ContributionAmount); err != nil {
+		return nil, err
+	}
+	if err := call(ctx, caller, RoundABI, address, "periodDuration", &state.PeriodDuration); err != nil {
+		return nil, err
+	}
+
+	var factory common.Address
+	if err := call(ctx, caller, RoundABI, address, "factory", &factory); err == nil {
+		state.Factory = &factory
+	}
+	if token, err := ReadRoundToken(ctx, caller, address); err == nil {
+		state.Token = &token
+	}
+	return &state, nil
+}
+
+// ReadRoundToken returns the ERC-20 token a Round contract collects
+// contributions in.
+func ReadRoundToken(ctx context.Context, caller Caller, address common.Address) (common.Address, error) {
+	var token common.Address
+	if err := call(ctx, caller, RoundABI, address, "token", &token); err != nil {
+		return common.Address{}, err
+	}
+	return token, nil
+}
+
+// ReadPaid reports whether member has paid the contribution for period, as
+// of block. A nil block reads the latest state.
+func ReadPaid(ctx context.Context, caller Caller, address common.Address, period uint64, member common.Address, block *big.Int) (bool, error) {
+	var paid bool
+	if err := callAt(ctx, caller, RoundABI, address, block, "hasPaid", &paid, new(big.Int).SetUint64(period), member); err != nil {
+		return false, err
+	}
+	return paid, nil
+}
+
+// ReadPayoutIndex returns the number of payouts the contract has released,
+// which is the period it pays out next, as of block.
+func ReadPayoutIndex(ctx context.Context, caller Caller, address common.Address, block *big.Int) (*big.Int, error) {
+	var index *big.Int
+	if err := callAt(ctx, caller, RoundABI, address, block, "payoutIndex", &index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// PackContribute returns the calldata of contribute(), which pulls one
+// contribution from the caller's approved token allowance.
+func PackContribute() ([]byte, error) {
+	return RoundABI.Pack("contribute")
+}
+
+// PackClaimPayout returns the calldata of claimPayout().
+func PackClaimPayout() ([]byte, error) {
+	return RoundABI.Pack("claimPayout")
+}
+
+func call(ctx context.Context, caller Caller, contract abi.ABI, address common.Address, method string, out any, args ...any) error {
+	return callAt(ctx, caller, contract, address, nil, method, out, args...)
+}
+
+func callAt(ctx context.Context, caller Caller, contract abi.ABI, address common.Address, block *big.Int, method string, out any, args ...any) error {
+	data, err := contract.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	result, err := caller.CallContract(ctx, ethereum.CallMsg{To: &address, Data: data}, block)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", method, err)
+	}
+	if err := contract.UnpackIntoInterface(out, method, result); err != nil {
+		return fmt.Errorf("decode %s: %w", method, err)
+	}
+	return nil
+}
+
+func mustParseABI(name string, data []byte) abi.ABI {
+	parsed, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		panic(fmt.Sprintf("invalid %s ABI: %v", name, err))
+	}
+	return parsed
+}
@@ -0,0 +1,106 @@
+package contracts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// EIP-712 domain the Round contract verifies slot swap signatures under.
+// The verifying contract is the round's own address.
+const (
+	SlotSwapDomainName    = "Circa Round"
+	SlotSwapDomainVersion = "1"
+)
+
+// SlotSwap exchanges the payout slots of two members of a Round contract.
+// The counterparty consents by signing its typed data; the member then
+// submits it with swapSlots, which reverts if either slot has changed, the
+// nonce was used or the deadline has passed.
+type SlotSwap struct {
+	Member           common.Address
+	Counterparty     common.Address
+	MemberSlot       *big.Int
+	CounterpartySlot *big.Int
+	Nonce            *big.Int
+	// Deadline is a unix timestamp in seconds.
+	Deadline *big.Int
+}
+
+// SlotSwapTypedData returns the EIP-712 typed data the counterparty signs,
+// as accepted by eth_signTypedData_v4.
+func SlotSwapTypedData(chainID int64, contract common.Address, swap SlotSwap) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"SlotSwap": {
+				{Name: "member", Type: "address"},
+				{Name: "counterparty", Type: "address"},
+				{Name: "memberSlot", Type: "uint256"},
+				{Name: "counterpartySlot", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SlotSwap",
+		Domain: apitypes.TypedDataDomain{
+			Name:              SlotSwapDomainName,
+			Version:           SlotSwapDomainVersion,
+			ChainId:           math.NewHexOrDecimal256(chainID),
+			VerifyingContract: contract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"member":           swap.Member.Hex(),
+			"counterparty":     swap.Counterparty.Hex(),
+			"memberSlot":       swap.MemberSlot.String(),
+			"counterpartySlot": swap.CounterpartySlot.String(),
+			"nonce":            swap.Nonce.String(),
+			"deadline":         swap.Deadline.String(),
+		},
+	}
+}
+
+// SlotSwapHash returns the EIP-712 digest of a slot swap.
+func SlotSwapHash(chainID int64, contract common.Address, swap SlotSwap) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(SlotSwapTypedData(chainID, contract, swap))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("hash slot swap: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// RecoverSlotSwapSigner returns the address that signed a slot swap. Both
+// 0/1 and 27/28 recovery ids are accepted, since wallets differ.
+func RecoverSlotSwapSigner(chainID int64, contract common.Address, swap SlotSwap, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes", crypto.SignatureLength)
+	}
+	hash, err := SlotSwapHash(chainID, contract, swap)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// PackSwapSlots returns the calldata of swapSlots(swap, signature).
+func PackSwapSlots(swap SlotSwap, signature []byte) ([]byte, error) {
+	return RoundABI.Pack("swapSlots", swap, signature)
+}
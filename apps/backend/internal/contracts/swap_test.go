@@ -0,0 +1,90 @@
+package contracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRound = common.HexToAddress("0x5555555555555555555555555555555555555555")
+
+func testSwap(counterparty common.Address) SlotSwap {
+	return SlotSwap{
+		Member:           common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Counterparty:     counterparty,
+		MemberSlot:       big.NewInt(4),
+		CounterpartySlot: big.NewInt(1),
+		Nonce:            big.NewInt(7),
+		Deadline:         big.NewInt(1767225600),
+	}
+}
+
+func TestRecoverSlotSwapSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	swap := testSwap(signer)
+
+	hash, err := SlotSwapHash(8453, testRound, swap)
+	require.NoError(t, err)
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	require.NoError(t, err)
+
+	t.Run("raw recovery id", func(t *testing.T) {
+		recovered, err := RecoverSlotSwapSigner(8453, testRound, swap, signature)
+		require.NoError(t, err)
+		assert.Equal(t, signer, recovered)
+	})
+
+	t.Run("wallet recovery id", func(t *testing.T) {
+		walletSig := common.CopyBytes(signature)
+		walletSig[64] += 27
+		recovered, err := RecoverSlotSwapSigner(8453, testRound, swap, walletSig)
+		require.NoError(t, err)
+		assert.Equal(t, signer, recovered)
+	})
+
+	t.Run("bound to chain and contract", func(t *testing.T) {
+		recovered, err := RecoverSlotSwapSigner(1, testRound, swap, signature)
+		require.NoError(t, err)
+		assert.NotEqual(t, signer, recovered)
+
+		recovered, err = RecoverSlotSwapSigner(8453, common.HexToAddress("0x6666666666666666666666666666666666666666"), swap, signature)
+		require.NoError(t, err)
+		assert.NotEqual(t, signer, recovered)
+	})
+
+	t.Run("bound to slots", func(t *testing.T) {
+		changed := swap
+		changed.CounterpartySlot = big.NewInt(0)
+		recovered, err := RecoverSlotSwapSigner(8453, testRound, changed, signature)
+		require.NoError(t, err)
+		assert.NotEqual(t, signer, recovered)
+	})
+
+	t.Run("malformed signature", func(t *testing.T) {
+		_, err := RecoverSlotSwapSigner(8453, testRound, swap, signature[:64])
+		assert.Error(t, err)
+	})
+}
+
+func TestPackSwapSlots(t *testing.T) {
+	swap := testSwap(common.HexToAddress("0x2222222222222222222222222222222222222222"))
+	signature := make([]byte, 65)
+	signature[0] = 0xab
+
+	data, err := PackSwapSlots(swap, signature)
+	require.NoError(t, err)
+
+	method := RoundABI.Methods["swapSlots"]
+	assert.Equal(t, method.ID, data[:4])
+
+	args, err := method.Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	require.Len(t, args, 2)
+	assert.Equal(t, signature, args[1])
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.5. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package db
 
@@ -27,26 +27,26 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
-// CreateJob provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateJob(ctx context.Context, arg sqlc.CreateJobParams) (sqlc.Job, error) {
+// AcceptSlotSwap provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AcceptSlotSwap(ctx context.Context, arg sqlc.AcceptSlotSwapParams) (sqlc.SlotSwap, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateJob")
+		panic("no return value specified for AcceptSlotSwap")
 	}
 
-	var r0 sqlc.Job
+	var r0 sqlc.SlotSwap
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateJobParams) (sqlc.Job, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AcceptSlotSwapParams) (sqlc.SlotSwap, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateJobParams) sqlc.Job); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AcceptSlotSwapParams) sqlc.SlotSwap); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.Job)
+		r0 = ret.Get(0).(sqlc.SlotSwap)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateJobParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.AcceptSlotSwapParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -55,169 +55,149 @@ func (_m *MockStore) CreateJob(ctx context.Context, arg sqlc.CreateJobParams) (s
 	return r0, r1
 }
 
-// MockStore_CreateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJob'
-type MockStore_CreateJob_Call struct {
+// MockStore_AcceptSlotSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptSlotSwap'
+type MockStore_AcceptSlotSwap_Call struct {
 	*mock.Call
 }
 
-// CreateJob is a helper method to define mock.On call
+// AcceptSlotSwap is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.CreateJobParams
-func (_e *MockStore_Expecter) CreateJob(ctx interface{}, arg interface{}) *MockStore_CreateJob_Call {
-	return &MockStore_CreateJob_Call{Call: _e.mock.On("CreateJob", ctx, arg)}
+//   - arg sqlc.AcceptSlotSwapParams
+func (_e *MockStore_Expecter) AcceptSlotSwap(ctx interface{}, arg interface{}) *MockStore_AcceptSlotSwap_Call {
+	return &MockStore_AcceptSlotSwap_Call{Call: _e.mock.On("AcceptSlotSwap", ctx, arg)}
 }
 
-func (_c *MockStore_CreateJob_Call) Run(run func(ctx context.Context, arg sqlc.CreateJobParams)) *MockStore_CreateJob_Call {
+func (_c *MockStore_AcceptSlotSwap_Call) Run(run func(ctx context.Context, arg sqlc.AcceptSlotSwapParams)) *MockStore_AcceptSlotSwap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.CreateJobParams))
+		run(args[0].(context.Context), args[1].(sqlc.AcceptSlotSwapParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateJob_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_CreateJob_Call {
+func (_c *MockStore_AcceptSlotSwap_Call) Return(_a0 sqlc.SlotSwap, _a1 error) *MockStore_AcceptSlotSwap_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateJob_Call) RunAndReturn(run func(context.Context, sqlc.CreateJobParams) (sqlc.Job, error)) *MockStore_CreateJob_Call {
+func (_c *MockStore_AcceptSlotSwap_Call) RunAndReturn(run func(context.Context, sqlc.AcceptSlotSwapParams) (sqlc.SlotSwap, error)) *MockStore_AcceptSlotSwap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateMagicLink provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateMagicLink(ctx context.Context, arg sqlc.CreateMagicLinkParams) (sqlc.MagicLink, error) {
+// AddLedgerAccountBalance provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddLedgerAccountBalance(ctx context.Context, arg sqlc.AddLedgerAccountBalanceParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateMagicLink")
+		panic("no return value specified for AddLedgerAccountBalance")
 	}
 
-	var r0 sqlc.MagicLink
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateMagicLinkParams) (sqlc.MagicLink, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateMagicLinkParams) sqlc.MagicLink); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AddLedgerAccountBalanceParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.MagicLink)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateMagicLinkParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CreateMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMagicLink'
-type MockStore_CreateMagicLink_Call struct {
+// MockStore_AddLedgerAccountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLedgerAccountBalance'
+type MockStore_AddLedgerAccountBalance_Call struct {
 	*mock.Call
 }
 
-// CreateMagicLink is a helper method to define mock.On call
+// AddLedgerAccountBalance is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.CreateMagicLinkParams
-func (_e *MockStore_Expecter) CreateMagicLink(ctx interface{}, arg interface{}) *MockStore_CreateMagicLink_Call {
-	return &MockStore_CreateMagicLink_Call{Call: _e.mock.On("CreateMagicLink", ctx, arg)}
+//   - arg sqlc.AddLedgerAccountBalanceParams
+func (_e *MockStore_Expecter) AddLedgerAccountBalance(ctx interface{}, arg interface{}) *MockStore_AddLedgerAccountBalance_Call {
+	return &MockStore_AddLedgerAccountBalance_Call{Call: _e.mock.On("AddLedgerAccountBalance", ctx, arg)}
 }
 
-func (_c *MockStore_CreateMagicLink_Call) Run(run func(ctx context.Context, arg sqlc.CreateMagicLinkParams)) *MockStore_CreateMagicLink_Call {
+func (_c *MockStore_AddLedgerAccountBalance_Call) Run(run func(ctx context.Context, arg sqlc.AddLedgerAccountBalanceParams)) *MockStore_AddLedgerAccountBalance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.CreateMagicLinkParams))
+		run(args[0].(context.Context), args[1].(sqlc.AddLedgerAccountBalanceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateMagicLink_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_CreateMagicLink_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_AddLedgerAccountBalance_Call) Return(_a0 error) *MockStore_AddLedgerAccountBalance_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CreateMagicLink_Call) RunAndReturn(run func(context.Context, sqlc.CreateMagicLinkParams) (sqlc.MagicLink, error)) *MockStore_CreateMagicLink_Call {
+func (_c *MockStore_AddLedgerAccountBalance_Call) RunAndReturn(run func(context.Context, sqlc.AddLedgerAccountBalanceParams) error) *MockStore_AddLedgerAccountBalance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePendingSignup provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreatePendingSignup(ctx context.Context, arg sqlc.CreatePendingSignupParams) (sqlc.PendingSignup, error) {
+// AddLedgerHourlyContribution provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AddLedgerHourlyContribution(ctx context.Context, arg sqlc.AddLedgerHourlyContributionParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePendingSignup")
+		panic("no return value specified for AddLedgerHourlyContribution")
 	}
 
-	var r0 sqlc.PendingSignup
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreatePendingSignupParams) (sqlc.PendingSignup, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreatePendingSignupParams) sqlc.PendingSignup); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AddLedgerHourlyContributionParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.PendingSignup)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreatePendingSignupParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_CreatePendingSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePendingSignup'
-type MockStore_CreatePendingSignup_Call struct {
+// MockStore_AddLedgerHourlyContribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLedgerHourlyContribution'
+type MockStore_AddLedgerHourlyContribution_Call struct {
 	*mock.Call
 }
 
-// CreatePendingSignup is a helper method to define mock.On call
+// AddLedgerHourlyContribution is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.CreatePendingSignupParams
-func (_e *MockStore_Expecter) CreatePendingSignup(ctx interface{}, arg interface{}) *MockStore_CreatePendingSignup_Call {
-	return &MockStore_CreatePendingSignup_Call{Call: _e.mock.On("CreatePendingSignup", ctx, arg)}
+//   - arg sqlc.AddLedgerHourlyContributionParams
+func (_e *MockStore_Expecter) AddLedgerHourlyContribution(ctx interface{}, arg interface{}) *MockStore_AddLedgerHourlyContribution_Call {
+	return &MockStore_AddLedgerHourlyContribution_Call{Call: _e.mock.On("AddLedgerHourlyContribution", ctx, arg)}
 }
 
-func (_c *MockStore_CreatePendingSignup_Call) Run(run func(ctx context.Context, arg sqlc.CreatePendingSignupParams)) *MockStore_CreatePendingSignup_Call {
+func (_c *MockStore_AddLedgerHourlyContribution_Call) Run(run func(ctx context.Context, arg sqlc.AddLedgerHourlyContributionParams)) *MockStore_AddLedgerHourlyContribution_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.CreatePendingSignupParams))
+		run(args[0].(context.Context), args[1].(sqlc.AddLedgerHourlyContributionParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreatePendingSignup_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_CreatePendingSignup_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_AddLedgerHourlyContribution_Call) Return(_a0 error) *MockStore_AddLedgerHourlyContribution_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_CreatePendingSignup_Call) RunAndReturn(run func(context.Context, sqlc.CreatePendingSignupParams) (sqlc.PendingSignup, error)) *MockStore_CreatePendingSignup_Call {
+func (_c *MockStore_AddLedgerHourlyContribution_Call) RunAndReturn(run func(context.Context, sqlc.AddLedgerHourlyContributionParams) error) *MockStore_AddLedgerHourlyContribution_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *MockStore) CreateUser(ctx context.Context, arg sqlc.CreateUserParams) (sqlc.User, error) {
+// AdvanceIndexerBackfill provides a mock function with given fields: ctx, arg
+func (_m *MockStore) AdvanceIndexerBackfill(ctx context.Context, arg sqlc.AdvanceIndexerBackfillParams) (sqlc.IndexerBackfill, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for AdvanceIndexerBackfill")
 	}
 
-	var r0 sqlc.User
+	var r0 sqlc.IndexerBackfill
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateUserParams) (sqlc.User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AdvanceIndexerBackfillParams) (sqlc.IndexerBackfill, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateUserParams) sqlc.User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AdvanceIndexerBackfillParams) sqlc.IndexerBackfill); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.User)
+		r0 = ret.Get(0).(sqlc.IndexerBackfill)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.AdvanceIndexerBackfillParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -226,113 +206,103 @@ func (_m *MockStore) CreateUser(ctx context.Context, arg sqlc.CreateUserParams)
 	return r0, r1
 }
 
-// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockStore_CreateUser_Call struct {
+// MockStore_AdvanceIndexerBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdvanceIndexerBackfill'
+type MockStore_AdvanceIndexerBackfill_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// AdvanceIndexerBackfill is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.CreateUserParams
-func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
-	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg sqlc.AdvanceIndexerBackfillParams
+func (_e *MockStore_Expecter) AdvanceIndexerBackfill(ctx interface{}, arg interface{}) *MockStore_AdvanceIndexerBackfill_Call {
+	return &MockStore_AdvanceIndexerBackfill_Call{Call: _e.mock.On("AdvanceIndexerBackfill", ctx, arg)}
 }
 
-func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg sqlc.CreateUserParams)) *MockStore_CreateUser_Call {
+func (_c *MockStore_AdvanceIndexerBackfill_Call) Run(run func(ctx context.Context, arg sqlc.AdvanceIndexerBackfillParams)) *MockStore_AdvanceIndexerBackfill_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.CreateUserParams))
+		run(args[0].(context.Context), args[1].(sqlc.AdvanceIndexerBackfillParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_CreateUser_Call {
+func (_c *MockStore_AdvanceIndexerBackfill_Call) Return(_a0 sqlc.IndexerBackfill, _a1 error) *MockStore_AdvanceIndexerBackfill_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, sqlc.CreateUserParams) (sqlc.User, error)) *MockStore_CreateUser_Call {
+func (_c *MockStore_AdvanceIndexerBackfill_Call) RunAndReturn(run func(context.Context, sqlc.AdvanceIndexerBackfillParams) (sqlc.IndexerBackfill, error)) *MockStore_AdvanceIndexerBackfill_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetJobByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetJobByID(ctx context.Context, id uuid.UUID) (sqlc.Job, error) {
+// AnonymizeGroup provides a mock function with given fields: ctx, id
+func (_m *MockStore) AnonymizeGroup(ctx context.Context, id uuid.UUID) error {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetJobByID")
+		panic("no return value specified for AnonymizeGroup")
 	}
 
-	var r0 sqlc.Job
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Job, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Job); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
 		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(sqlc.Job)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetJobByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobByID'
-type MockStore_GetJobByID_Call struct {
+// MockStore_AnonymizeGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnonymizeGroup'
+type MockStore_AnonymizeGroup_Call struct {
 	*mock.Call
 }
 
-// GetJobByID is a helper method to define mock.On call
+// AnonymizeGroup is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockStore_Expecter) GetJobByID(ctx interface{}, id interface{}) *MockStore_GetJobByID_Call {
-	return &MockStore_GetJobByID_Call{Call: _e.mock.On("GetJobByID", ctx, id)}
+func (_e *MockStore_Expecter) AnonymizeGroup(ctx interface{}, id interface{}) *MockStore_AnonymizeGroup_Call {
+	return &MockStore_AnonymizeGroup_Call{Call: _e.mock.On("AnonymizeGroup", ctx, id)}
 }
 
-func (_c *MockStore_GetJobByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetJobByID_Call {
+func (_c *MockStore_AnonymizeGroup_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_AnonymizeGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetJobByID_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_GetJobByID_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_AnonymizeGroup_Call) Return(_a0 error) *MockStore_AnonymizeGroup_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetJobByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.Job, error)) *MockStore_GetJobByID_Call {
+func (_c *MockStore_AnonymizeGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_AnonymizeGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMagicLinkByPendingSignupID provides a mock function with given fields: ctx, pendingSignupID
-func (_m *MockStore) GetMagicLinkByPendingSignupID(ctx context.Context, pendingSignupID uuid.UUID) (sqlc.MagicLink, error) {
-	ret := _m.Called(ctx, pendingSignupID)
+// ArchiveGroup provides a mock function with given fields: ctx, id
+func (_m *MockStore) ArchiveGroup(ctx context.Context, id uuid.UUID) (sqlc.Group, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMagicLinkByPendingSignupID")
+		panic("no return value specified for ArchiveGroup")
 	}
 
-	var r0 sqlc.MagicLink
+	var r0 sqlc.Group
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.MagicLink, error)); ok {
-		return rf(ctx, pendingSignupID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Group, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.MagicLink); ok {
-		r0 = rf(ctx, pendingSignupID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Group); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(sqlc.MagicLink)
+		r0 = ret.Get(0).(sqlc.Group)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, pendingSignupID)
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -340,56 +310,56 @@ func (_m *MockStore) GetMagicLinkByPendingSignupID(ctx context.Context, pendingS
 	return r0, r1
 }
 
-// MockStore_GetMagicLinkByPendingSignupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMagicLinkByPendingSignupID'
-type MockStore_GetMagicLinkByPendingSignupID_Call struct {
+// MockStore_ArchiveGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveGroup'
+type MockStore_ArchiveGroup_Call struct {
 	*mock.Call
 }
 
-// GetMagicLinkByPendingSignupID is a helper method to define mock.On call
+// ArchiveGroup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pendingSignupID uuid.UUID
-func (_e *MockStore_Expecter) GetMagicLinkByPendingSignupID(ctx interface{}, pendingSignupID interface{}) *MockStore_GetMagicLinkByPendingSignupID_Call {
-	return &MockStore_GetMagicLinkByPendingSignupID_Call{Call: _e.mock.On("GetMagicLinkByPendingSignupID", ctx, pendingSignupID)}
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) ArchiveGroup(ctx interface{}, id interface{}) *MockStore_ArchiveGroup_Call {
+	return &MockStore_ArchiveGroup_Call{Call: _e.mock.On("ArchiveGroup", ctx, id)}
 }
 
-func (_c *MockStore_GetMagicLinkByPendingSignupID_Call) Run(run func(ctx context.Context, pendingSignupID uuid.UUID)) *MockStore_GetMagicLinkByPendingSignupID_Call {
+func (_c *MockStore_ArchiveGroup_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_ArchiveGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetMagicLinkByPendingSignupID_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_GetMagicLinkByPendingSignupID_Call {
+func (_c *MockStore_ArchiveGroup_Call) Return(_a0 sqlc.Group, _a1 error) *MockStore_ArchiveGroup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetMagicLinkByPendingSignupID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.MagicLink, error)) *MockStore_GetMagicLinkByPendingSignupID_Call {
+func (_c *MockStore_ArchiveGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.Group, error)) *MockStore_ArchiveGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMagicLinkByTokenHash provides a mock function with given fields: ctx, tokenHash
-func (_m *MockStore) GetMagicLinkByTokenHash(ctx context.Context, tokenHash string) (sqlc.MagicLink, error) {
-	ret := _m.Called(ctx, tokenHash)
+// CloseSlotSwap provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CloseSlotSwap(ctx context.Context, arg sqlc.CloseSlotSwapParams) (sqlc.SlotSwap, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMagicLinkByTokenHash")
+		panic("no return value specified for CloseSlotSwap")
 	}
 
-	var r0 sqlc.MagicLink
+	var r0 sqlc.SlotSwap
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.MagicLink, error)); ok {
-		return rf(ctx, tokenHash)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CloseSlotSwapParams) (sqlc.SlotSwap, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.MagicLink); ok {
-		r0 = rf(ctx, tokenHash)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CloseSlotSwapParams) sqlc.SlotSwap); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.MagicLink)
+		r0 = ret.Get(0).(sqlc.SlotSwap)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, tokenHash)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CloseSlotSwapParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -397,56 +367,56 @@ func (_m *MockStore) GetMagicLinkByTokenHash(ctx context.Context, tokenHash stri
 	return r0, r1
 }
 
-// MockStore_GetMagicLinkByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMagicLinkByTokenHash'
-type MockStore_GetMagicLinkByTokenHash_Call struct {
+// MockStore_CloseSlotSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSlotSwap'
+type MockStore_CloseSlotSwap_Call struct {
 	*mock.Call
 }
 
-// GetMagicLinkByTokenHash is a helper method to define mock.On call
+// CloseSlotSwap is a helper method to define mock.On call
 //   - ctx context.Context
-//   - tokenHash string
-func (_e *MockStore_Expecter) GetMagicLinkByTokenHash(ctx interface{}, tokenHash interface{}) *MockStore_GetMagicLinkByTokenHash_Call {
-	return &MockStore_GetMagicLinkByTokenHash_Call{Call: _e.mock.On("GetMagicLinkByTokenHash", ctx, tokenHash)}
+//   - arg sqlc.CloseSlotSwapParams
+func (_e *MockStore_Expecter) CloseSlotSwap(ctx interface{}, arg interface{}) *MockStore_CloseSlotSwap_Call {
+	return &MockStore_CloseSlotSwap_Call{Call: _e.mock.On("CloseSlotSwap", ctx, arg)}
 }
 
-func (_c *MockStore_GetMagicLinkByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockStore_GetMagicLinkByTokenHash_Call {
+func (_c *MockStore_CloseSlotSwap_Call) Run(run func(ctx context.Context, arg sqlc.CloseSlotSwapParams)) *MockStore_CloseSlotSwap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(sqlc.CloseSlotSwapParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetMagicLinkByTokenHash_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_GetMagicLinkByTokenHash_Call {
+func (_c *MockStore_CloseSlotSwap_Call) Return(_a0 sqlc.SlotSwap, _a1 error) *MockStore_CloseSlotSwap_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetMagicLinkByTokenHash_Call) RunAndReturn(run func(context.Context, string) (sqlc.MagicLink, error)) *MockStore_GetMagicLinkByTokenHash_Call {
+func (_c *MockStore_CloseSlotSwap_Call) RunAndReturn(run func(context.Context, sqlc.CloseSlotSwapParams) (sqlc.SlotSwap, error)) *MockStore_CloseSlotSwap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNextPendingJob provides a mock function with given fields: ctx
-func (_m *MockStore) GetNextPendingJob(ctx context.Context) (sqlc.Job, error) {
-	ret := _m.Called(ctx)
+// ConfirmChainRoundEvents provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ConfirmChainRoundEvents(ctx context.Context, arg sqlc.ConfirmChainRoundEventsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNextPendingJob")
+		panic("no return value specified for ConfirmChainRoundEvents")
 	}
 
-	var r0 sqlc.Job
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (sqlc.Job, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ConfirmChainRoundEventsParams) (int64, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) sqlc.Job); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ConfirmChainRoundEventsParams) int64); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.Job)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ConfirmChainRoundEventsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -454,55 +424,56 @@ func (_m *MockStore) GetNextPendingJob(ctx context.Context) (sqlc.Job, error) {
 	return r0, r1
 }
 
-// MockStore_GetNextPendingJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextPendingJob'
-type MockStore_GetNextPendingJob_Call struct {
+// MockStore_ConfirmChainRoundEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmChainRoundEvents'
+type MockStore_ConfirmChainRoundEvents_Call struct {
 	*mock.Call
 }
 
-// GetNextPendingJob is a helper method to define mock.On call
+// ConfirmChainRoundEvents is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetNextPendingJob(ctx interface{}) *MockStore_GetNextPendingJob_Call {
-	return &MockStore_GetNextPendingJob_Call{Call: _e.mock.On("GetNextPendingJob", ctx)}
+//   - arg sqlc.ConfirmChainRoundEventsParams
+func (_e *MockStore_Expecter) ConfirmChainRoundEvents(ctx interface{}, arg interface{}) *MockStore_ConfirmChainRoundEvents_Call {
+	return &MockStore_ConfirmChainRoundEvents_Call{Call: _e.mock.On("ConfirmChainRoundEvents", ctx, arg)}
 }
 
-func (_c *MockStore_GetNextPendingJob_Call) Run(run func(ctx context.Context)) *MockStore_GetNextPendingJob_Call {
+func (_c *MockStore_ConfirmChainRoundEvents_Call) Run(run func(ctx context.Context, arg sqlc.ConfirmChainRoundEventsParams)) *MockStore_ConfirmChainRoundEvents_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(sqlc.ConfirmChainRoundEventsParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetNextPendingJob_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_GetNextPendingJob_Call {
+func (_c *MockStore_ConfirmChainRoundEvents_Call) Return(_a0 int64, _a1 error) *MockStore_ConfirmChainRoundEvents_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetNextPendingJob_Call) RunAndReturn(run func(context.Context) (sqlc.Job, error)) *MockStore_GetNextPendingJob_Call {
+func (_c *MockStore_ConfirmChainRoundEvents_Call) RunAndReturn(run func(context.Context, sqlc.ConfirmChainRoundEventsParams) (int64, error)) *MockStore_ConfirmChainRoundEvents_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPendingSignupByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) GetPendingSignupByEmail(ctx context.Context, email pgtype.Text) (sqlc.PendingSignup, error) {
-	ret := _m.Called(ctx, email)
+// CountUnfinishedGroupRounds provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) CountUnfinishedGroupRounds(ctx context.Context, groupID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, groupID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPendingSignupByEmail")
+		panic("no return value specified for CountUnfinishedGroupRounds")
 	}
 
-	var r0 sqlc.PendingSignup
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (sqlc.PendingSignup, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, groupID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) sqlc.PendingSignup); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, groupID)
 	} else {
-		r0 = ret.Get(0).(sqlc.PendingSignup)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, groupID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -510,56 +481,56 @@ func (_m *MockStore) GetPendingSignupByEmail(ctx context.Context, email pgtype.T
 	return r0, r1
 }
 
-// MockStore_GetPendingSignupByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingSignupByEmail'
-type MockStore_GetPendingSignupByEmail_Call struct {
+// MockStore_CountUnfinishedGroupRounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnfinishedGroupRounds'
+type MockStore_CountUnfinishedGroupRounds_Call struct {
 	*mock.Call
 }
 
-// GetPendingSignupByEmail is a helper method to define mock.On call
+// CountUnfinishedGroupRounds is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email pgtype.Text
-func (_e *MockStore_Expecter) GetPendingSignupByEmail(ctx interface{}, email interface{}) *MockStore_GetPendingSignupByEmail_Call {
-	return &MockStore_GetPendingSignupByEmail_Call{Call: _e.mock.On("GetPendingSignupByEmail", ctx, email)}
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) CountUnfinishedGroupRounds(ctx interface{}, groupID interface{}) *MockStore_CountUnfinishedGroupRounds_Call {
+	return &MockStore_CountUnfinishedGroupRounds_Call{Call: _e.mock.On("CountUnfinishedGroupRounds", ctx, groupID)}
 }
 
-func (_c *MockStore_GetPendingSignupByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_GetPendingSignupByEmail_Call {
+func (_c *MockStore_CountUnfinishedGroupRounds_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_CountUnfinishedGroupRounds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPendingSignupByEmail_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_GetPendingSignupByEmail_Call {
+func (_c *MockStore_CountUnfinishedGroupRounds_Call) Return(_a0 int64, _a1 error) *MockStore_CountUnfinishedGroupRounds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetPendingSignupByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) (sqlc.PendingSignup, error)) *MockStore_GetPendingSignupByEmail_Call {
+func (_c *MockStore_CountUnfinishedGroupRounds_Call) RunAndReturn(run func(context.Context, uuid.UUID) (int64, error)) *MockStore_CountUnfinishedGroupRounds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPendingSignupByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetPendingSignupByID(ctx context.Context, id uuid.UUID) (sqlc.PendingSignup, error) {
-	ret := _m.Called(ctx, id)
+// CreateGroupAllowlist provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateGroupAllowlist(ctx context.Context, arg sqlc.CreateGroupAllowlistParams) (sqlc.GroupAllowlist, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPendingSignupByID")
+		panic("no return value specified for CreateGroupAllowlist")
 	}
 
-	var r0 sqlc.PendingSignup
+	var r0 sqlc.GroupAllowlist
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.PendingSignup, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateGroupAllowlistParams) (sqlc.GroupAllowlist, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.PendingSignup); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateGroupAllowlistParams) sqlc.GroupAllowlist); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.PendingSignup)
+		r0 = ret.Get(0).(sqlc.GroupAllowlist)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateGroupAllowlistParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -567,31 +538,2925 @@ func (_m *MockStore) GetPendingSignupByID(ctx context.Context, id uuid.UUID) (sq
 	return r0, r1
 }
 
-// MockStore_GetPendingSignupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingSignupByID'
-type MockStore_GetPendingSignupByID_Call struct {
+// MockStore_CreateGroupAllowlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGroupAllowlist'
+type MockStore_CreateGroupAllowlist_Call struct {
 	*mock.Call
 }
 
-// GetPendingSignupByID is a helper method to define mock.On call
+// CreateGroupAllowlist is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetPendingSignupByID(ctx interface{}, id interface{}) *MockStore_GetPendingSignupByID_Call {
-	return &MockStore_GetPendingSignupByID_Call{Call: _e.mock.On("GetPendingSignupByID", ctx, id)}
+//   - arg sqlc.CreateGroupAllowlistParams
+func (_e *MockStore_Expecter) CreateGroupAllowlist(ctx interface{}, arg interface{}) *MockStore_CreateGroupAllowlist_Call {
+	return &MockStore_CreateGroupAllowlist_Call{Call: _e.mock.On("CreateGroupAllowlist", ctx, arg)}
 }
 
-func (_c *MockStore_GetPendingSignupByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetPendingSignupByID_Call {
+func (_c *MockStore_CreateGroupAllowlist_Call) Run(run func(ctx context.Context, arg sqlc.CreateGroupAllowlistParams)) *MockStore_CreateGroupAllowlist_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(sqlc.CreateGroupAllowlistParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPendingSignupByID_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_GetPendingSignupByID_Call {
+func (_c *MockStore_CreateGroupAllowlist_Call) Return(_a0 sqlc.GroupAllowlist, _a1 error) *MockStore_CreateGroupAllowlist_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetPendingSignupByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.PendingSignup, error)) *MockStore_GetPendingSignupByID_Call {
+func (_c *MockStore_CreateGroupAllowlist_Call) RunAndReturn(run func(context.Context, sqlc.CreateGroupAllowlistParams) (sqlc.GroupAllowlist, error)) *MockStore_CreateGroupAllowlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGroupMemberRemoval provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateGroupMemberRemoval(ctx context.Context, arg sqlc.CreateGroupMemberRemovalParams) (sqlc.GroupMemberRemoval, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGroupMemberRemoval")
+	}
+
+	var r0 sqlc.GroupMemberRemoval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateGroupMemberRemovalParams) (sqlc.GroupMemberRemoval, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateGroupMemberRemovalParams) sqlc.GroupMemberRemoval); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.GroupMemberRemoval)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateGroupMemberRemovalParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateGroupMemberRemoval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGroupMemberRemoval'
+type MockStore_CreateGroupMemberRemoval_Call struct {
+	*mock.Call
+}
+
+// CreateGroupMemberRemoval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateGroupMemberRemovalParams
+func (_e *MockStore_Expecter) CreateGroupMemberRemoval(ctx interface{}, arg interface{}) *MockStore_CreateGroupMemberRemoval_Call {
+	return &MockStore_CreateGroupMemberRemoval_Call{Call: _e.mock.On("CreateGroupMemberRemoval", ctx, arg)}
+}
+
+func (_c *MockStore_CreateGroupMemberRemoval_Call) Run(run func(ctx context.Context, arg sqlc.CreateGroupMemberRemovalParams)) *MockStore_CreateGroupMemberRemoval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateGroupMemberRemovalParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateGroupMemberRemoval_Call) Return(_a0 sqlc.GroupMemberRemoval, _a1 error) *MockStore_CreateGroupMemberRemoval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateGroupMemberRemoval_Call) RunAndReturn(run func(context.Context, sqlc.CreateGroupMemberRemovalParams) (sqlc.GroupMemberRemoval, error)) *MockStore_CreateGroupMemberRemoval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateIndexerBackfill provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateIndexerBackfill(ctx context.Context, arg sqlc.CreateIndexerBackfillParams) (sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIndexerBackfill")
+	}
+
+	var r0 sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateIndexerBackfillParams) (sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateIndexerBackfillParams) sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.IndexerBackfill)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateIndexerBackfillParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateIndexerBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIndexerBackfill'
+type MockStore_CreateIndexerBackfill_Call struct {
+	*mock.Call
+}
+
+// CreateIndexerBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateIndexerBackfillParams
+func (_e *MockStore_Expecter) CreateIndexerBackfill(ctx interface{}, arg interface{}) *MockStore_CreateIndexerBackfill_Call {
+	return &MockStore_CreateIndexerBackfill_Call{Call: _e.mock.On("CreateIndexerBackfill", ctx, arg)}
+}
+
+func (_c *MockStore_CreateIndexerBackfill_Call) Run(run func(ctx context.Context, arg sqlc.CreateIndexerBackfillParams)) *MockStore_CreateIndexerBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateIndexerBackfillParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateIndexerBackfill_Call) Return(_a0 sqlc.IndexerBackfill, _a1 error) *MockStore_CreateIndexerBackfill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateIndexerBackfill_Call) RunAndReturn(run func(context.Context, sqlc.CreateIndexerBackfillParams) (sqlc.IndexerBackfill, error)) *MockStore_CreateIndexerBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInvite provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateInvite(ctx context.Context, arg sqlc.CreateInviteParams) (sqlc.Invite, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvite")
+	}
+
+	var r0 sqlc.Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateInviteParams) (sqlc.Invite, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateInviteParams) sqlc.Invite); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Invite)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateInviteParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvite'
+type MockStore_CreateInvite_Call struct {
+	*mock.Call
+}
+
+// CreateInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateInviteParams
+func (_e *MockStore_Expecter) CreateInvite(ctx interface{}, arg interface{}) *MockStore_CreateInvite_Call {
+	return &MockStore_CreateInvite_Call{Call: _e.mock.On("CreateInvite", ctx, arg)}
+}
+
+func (_c *MockStore_CreateInvite_Call) Run(run func(ctx context.Context, arg sqlc.CreateInviteParams)) *MockStore_CreateInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateInviteParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateInvite_Call) Return(_a0 sqlc.Invite, _a1 error) *MockStore_CreateInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateInvite_Call) RunAndReturn(run func(context.Context, sqlc.CreateInviteParams) (sqlc.Invite, error)) *MockStore_CreateInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJob provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateJob(ctx context.Context, arg sqlc.CreateJobParams) (sqlc.Job, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+
+	var r0 sqlc.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateJobParams) (sqlc.Job, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateJobParams) sqlc.Job); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateJobParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJob'
+type MockStore_CreateJob_Call struct {
+	*mock.Call
+}
+
+// CreateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateJobParams
+func (_e *MockStore_Expecter) CreateJob(ctx interface{}, arg interface{}) *MockStore_CreateJob_Call {
+	return &MockStore_CreateJob_Call{Call: _e.mock.On("CreateJob", ctx, arg)}
+}
+
+func (_c *MockStore_CreateJob_Call) Run(run func(ctx context.Context, arg sqlc.CreateJobParams)) *MockStore_CreateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateJobParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateJob_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_CreateJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateJob_Call) RunAndReturn(run func(context.Context, sqlc.CreateJobParams) (sqlc.Job, error)) *MockStore_CreateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMagicLink provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateMagicLink(ctx context.Context, arg sqlc.CreateMagicLinkParams) (sqlc.MagicLink, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMagicLink")
+	}
+
+	var r0 sqlc.MagicLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateMagicLinkParams) (sqlc.MagicLink, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateMagicLinkParams) sqlc.MagicLink); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.MagicLink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateMagicLinkParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMagicLink'
+type MockStore_CreateMagicLink_Call struct {
+	*mock.Call
+}
+
+// CreateMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateMagicLinkParams
+func (_e *MockStore_Expecter) CreateMagicLink(ctx interface{}, arg interface{}) *MockStore_CreateMagicLink_Call {
+	return &MockStore_CreateMagicLink_Call{Call: _e.mock.On("CreateMagicLink", ctx, arg)}
+}
+
+func (_c *MockStore_CreateMagicLink_Call) Run(run func(ctx context.Context, arg sqlc.CreateMagicLinkParams)) *MockStore_CreateMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateMagicLinkParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateMagicLink_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_CreateMagicLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateMagicLink_Call) RunAndReturn(run func(context.Context, sqlc.CreateMagicLinkParams) (sqlc.MagicLink, error)) *MockStore_CreateMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePayoutOrder provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreatePayoutOrder(ctx context.Context, arg sqlc.CreatePayoutOrderParams) (sqlc.PayoutOrder, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePayoutOrder")
+	}
+
+	var r0 sqlc.PayoutOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreatePayoutOrderParams) (sqlc.PayoutOrder, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreatePayoutOrderParams) sqlc.PayoutOrder); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.PayoutOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreatePayoutOrderParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreatePayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePayoutOrder'
+type MockStore_CreatePayoutOrder_Call struct {
+	*mock.Call
+}
+
+// CreatePayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreatePayoutOrderParams
+func (_e *MockStore_Expecter) CreatePayoutOrder(ctx interface{}, arg interface{}) *MockStore_CreatePayoutOrder_Call {
+	return &MockStore_CreatePayoutOrder_Call{Call: _e.mock.On("CreatePayoutOrder", ctx, arg)}
+}
+
+func (_c *MockStore_CreatePayoutOrder_Call) Run(run func(ctx context.Context, arg sqlc.CreatePayoutOrderParams)) *MockStore_CreatePayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreatePayoutOrderParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreatePayoutOrder_Call) Return(_a0 sqlc.PayoutOrder, _a1 error) *MockStore_CreatePayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreatePayoutOrder_Call) RunAndReturn(run func(context.Context, sqlc.CreatePayoutOrderParams) (sqlc.PayoutOrder, error)) *MockStore_CreatePayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePendingSignup provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreatePendingSignup(ctx context.Context, arg sqlc.CreatePendingSignupParams) (sqlc.PendingSignup, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePendingSignup")
+	}
+
+	var r0 sqlc.PendingSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreatePendingSignupParams) (sqlc.PendingSignup, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreatePendingSignupParams) sqlc.PendingSignup); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.PendingSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreatePendingSignupParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreatePendingSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePendingSignup'
+type MockStore_CreatePendingSignup_Call struct {
+	*mock.Call
+}
+
+// CreatePendingSignup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreatePendingSignupParams
+func (_e *MockStore_Expecter) CreatePendingSignup(ctx interface{}, arg interface{}) *MockStore_CreatePendingSignup_Call {
+	return &MockStore_CreatePendingSignup_Call{Call: _e.mock.On("CreatePendingSignup", ctx, arg)}
+}
+
+func (_c *MockStore_CreatePendingSignup_Call) Run(run func(ctx context.Context, arg sqlc.CreatePendingSignupParams)) *MockStore_CreatePendingSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreatePendingSignupParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreatePendingSignup_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_CreatePendingSignup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreatePendingSignup_Call) RunAndReturn(run func(context.Context, sqlc.CreatePendingSignupParams) (sqlc.PendingSignup, error)) *MockStore_CreatePendingSignup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRound provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateRound(ctx context.Context, arg sqlc.CreateRoundParams) (sqlc.Round, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRound")
+	}
+
+	var r0 sqlc.Round
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateRoundParams) (sqlc.Round, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateRoundParams) sqlc.Round); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Round)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateRoundParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateRound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRound'
+type MockStore_CreateRound_Call struct {
+	*mock.Call
+}
+
+// CreateRound is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateRoundParams
+func (_e *MockStore_Expecter) CreateRound(ctx interface{}, arg interface{}) *MockStore_CreateRound_Call {
+	return &MockStore_CreateRound_Call{Call: _e.mock.On("CreateRound", ctx, arg)}
+}
+
+func (_c *MockStore_CreateRound_Call) Run(run func(ctx context.Context, arg sqlc.CreateRoundParams)) *MockStore_CreateRound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateRoundParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRound_Call) Return(_a0 sqlc.Round, _a1 error) *MockStore_CreateRound_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateRound_Call) RunAndReturn(run func(context.Context, sqlc.CreateRoundParams) (sqlc.Round, error)) *MockStore_CreateRound_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRoundMember provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateRoundMember(ctx context.Context, arg sqlc.CreateRoundMemberParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRoundMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateRoundMemberParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateRoundMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoundMember'
+type MockStore_CreateRoundMember_Call struct {
+	*mock.Call
+}
+
+// CreateRoundMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateRoundMemberParams
+func (_e *MockStore_Expecter) CreateRoundMember(ctx interface{}, arg interface{}) *MockStore_CreateRoundMember_Call {
+	return &MockStore_CreateRoundMember_Call{Call: _e.mock.On("CreateRoundMember", ctx, arg)}
+}
+
+func (_c *MockStore_CreateRoundMember_Call) Run(run func(ctx context.Context, arg sqlc.CreateRoundMemberParams)) *MockStore_CreateRoundMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateRoundMemberParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRoundMember_Call) Return(_a0 error) *MockStore_CreateRoundMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateRoundMember_Call) RunAndReturn(run func(context.Context, sqlc.CreateRoundMemberParams) error) *MockStore_CreateRoundMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRoundStatusHistory provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateRoundStatusHistory(ctx context.Context, arg sqlc.CreateRoundStatusHistoryParams) (sqlc.RoundStatusHistory, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRoundStatusHistory")
+	}
+
+	var r0 sqlc.RoundStatusHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateRoundStatusHistoryParams) (sqlc.RoundStatusHistory, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateRoundStatusHistoryParams) sqlc.RoundStatusHistory); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.RoundStatusHistory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateRoundStatusHistoryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateRoundStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoundStatusHistory'
+type MockStore_CreateRoundStatusHistory_Call struct {
+	*mock.Call
+}
+
+// CreateRoundStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateRoundStatusHistoryParams
+func (_e *MockStore_Expecter) CreateRoundStatusHistory(ctx interface{}, arg interface{}) *MockStore_CreateRoundStatusHistory_Call {
+	return &MockStore_CreateRoundStatusHistory_Call{Call: _e.mock.On("CreateRoundStatusHistory", ctx, arg)}
+}
+
+func (_c *MockStore_CreateRoundStatusHistory_Call) Run(run func(ctx context.Context, arg sqlc.CreateRoundStatusHistoryParams)) *MockStore_CreateRoundStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateRoundStatusHistoryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRoundStatusHistory_Call) Return(_a0 sqlc.RoundStatusHistory, _a1 error) *MockStore_CreateRoundStatusHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateRoundStatusHistory_Call) RunAndReturn(run func(context.Context, sqlc.CreateRoundStatusHistoryParams) (sqlc.RoundStatusHistory, error)) *MockStore_CreateRoundStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSlotSwap provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateSlotSwap(ctx context.Context, arg sqlc.CreateSlotSwapParams) (sqlc.SlotSwap, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSlotSwap")
+	}
+
+	var r0 sqlc.SlotSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateSlotSwapParams) (sqlc.SlotSwap, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateSlotSwapParams) sqlc.SlotSwap); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.SlotSwap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateSlotSwapParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSlotSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSlotSwap'
+type MockStore_CreateSlotSwap_Call struct {
+	*mock.Call
+}
+
+// CreateSlotSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateSlotSwapParams
+func (_e *MockStore_Expecter) CreateSlotSwap(ctx interface{}, arg interface{}) *MockStore_CreateSlotSwap_Call {
+	return &MockStore_CreateSlotSwap_Call{Call: _e.mock.On("CreateSlotSwap", ctx, arg)}
+}
+
+func (_c *MockStore_CreateSlotSwap_Call) Run(run func(ctx context.Context, arg sqlc.CreateSlotSwapParams)) *MockStore_CreateSlotSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateSlotSwapParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSlotSwap_Call) Return(_a0 sqlc.SlotSwap, _a1 error) *MockStore_CreateSlotSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSlotSwap_Call) RunAndReturn(run func(context.Context, sqlc.CreateSlotSwapParams) (sqlc.SlotSwap, error)) *MockStore_CreateSlotSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *MockStore) CreateUser(ctx context.Context, arg sqlc.CreateUserParams) (sqlc.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateUserParams) (sqlc.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateUserParams) sqlc.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockStore_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.CreateUserParams
+func (_e *MockStore_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockStore_CreateUser_Call {
+	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *MockStore_CreateUser_Call) Run(run func(ctx context.Context, arg sqlc.CreateUserParams)) *MockStore_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(context.Context, sqlc.CreateUserParams) (sqlc.User, error)) *MockStore_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChainBlocksAfter provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteChainBlocksAfter(ctx context.Context, arg sqlc.DeleteChainBlocksAfterParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChainBlocksAfter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteChainBlocksAfterParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteChainBlocksAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChainBlocksAfter'
+type MockStore_DeleteChainBlocksAfter_Call struct {
+	*mock.Call
+}
+
+// DeleteChainBlocksAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.DeleteChainBlocksAfterParams
+func (_e *MockStore_Expecter) DeleteChainBlocksAfter(ctx interface{}, arg interface{}) *MockStore_DeleteChainBlocksAfter_Call {
+	return &MockStore_DeleteChainBlocksAfter_Call{Call: _e.mock.On("DeleteChainBlocksAfter", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteChainBlocksAfter_Call) Run(run func(ctx context.Context, arg sqlc.DeleteChainBlocksAfterParams)) *MockStore_DeleteChainBlocksAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.DeleteChainBlocksAfterParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteChainBlocksAfter_Call) Return(_a0 error) *MockStore_DeleteChainBlocksAfter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteChainBlocksAfter_Call) RunAndReturn(run func(context.Context, sqlc.DeleteChainBlocksAfterParams) error) *MockStore_DeleteChainBlocksAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChainBlocksBefore provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteChainBlocksBefore(ctx context.Context, arg sqlc.DeleteChainBlocksBeforeParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChainBlocksBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteChainBlocksBeforeParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteChainBlocksBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChainBlocksBefore'
+type MockStore_DeleteChainBlocksBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteChainBlocksBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.DeleteChainBlocksBeforeParams
+func (_e *MockStore_Expecter) DeleteChainBlocksBefore(ctx interface{}, arg interface{}) *MockStore_DeleteChainBlocksBefore_Call {
+	return &MockStore_DeleteChainBlocksBefore_Call{Call: _e.mock.On("DeleteChainBlocksBefore", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteChainBlocksBefore_Call) Run(run func(ctx context.Context, arg sqlc.DeleteChainBlocksBeforeParams)) *MockStore_DeleteChainBlocksBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.DeleteChainBlocksBeforeParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteChainBlocksBefore_Call) Return(_a0 error) *MockStore_DeleteChainBlocksBefore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteChainBlocksBefore_Call) RunAndReturn(run func(context.Context, sqlc.DeleteChainBlocksBeforeParams) error) *MockStore_DeleteChainBlocksBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChainRoundEventsAfter provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteChainRoundEventsAfter(ctx context.Context, arg sqlc.DeleteChainRoundEventsAfterParams) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChainRoundEventsAfter")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteChainRoundEventsAfterParams) ([]uuid.UUID, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteChainRoundEventsAfterParams) []uuid.UUID); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.DeleteChainRoundEventsAfterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_DeleteChainRoundEventsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChainRoundEventsAfter'
+type MockStore_DeleteChainRoundEventsAfter_Call struct {
+	*mock.Call
+}
+
+// DeleteChainRoundEventsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.DeleteChainRoundEventsAfterParams
+func (_e *MockStore_Expecter) DeleteChainRoundEventsAfter(ctx interface{}, arg interface{}) *MockStore_DeleteChainRoundEventsAfter_Call {
+	return &MockStore_DeleteChainRoundEventsAfter_Call{Call: _e.mock.On("DeleteChainRoundEventsAfter", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteChainRoundEventsAfter_Call) Run(run func(ctx context.Context, arg sqlc.DeleteChainRoundEventsAfterParams)) *MockStore_DeleteChainRoundEventsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.DeleteChainRoundEventsAfterParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteChainRoundEventsAfter_Call) Return(_a0 []uuid.UUID, _a1 error) *MockStore_DeleteChainRoundEventsAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_DeleteChainRoundEventsAfter_Call) RunAndReturn(run func(context.Context, sqlc.DeleteChainRoundEventsAfterParams) ([]uuid.UUID, error)) *MockStore_DeleteChainRoundEventsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroup provides a mock function with given fields: ctx, id
+func (_m *MockStore) DeleteGroup(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroup'
+type MockStore_DeleteGroup_Call struct {
+	*mock.Call
+}
+
+// DeleteGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) DeleteGroup(ctx interface{}, id interface{}) *MockStore_DeleteGroup_Call {
+	return &MockStore_DeleteGroup_Call{Call: _e.mock.On("DeleteGroup", ctx, id)}
+}
+
+func (_c *MockStore_DeleteGroup_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_DeleteGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteGroup_Call) Return(_a0 error) *MockStore_DeleteGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroupAllowlists provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) DeleteGroupAllowlists(ctx context.Context, groupID uuid.UUID) error {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroupAllowlists")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteGroupAllowlists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroupAllowlists'
+type MockStore_DeleteGroupAllowlists_Call struct {
+	*mock.Call
+}
+
+// DeleteGroupAllowlists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) DeleteGroupAllowlists(ctx interface{}, groupID interface{}) *MockStore_DeleteGroupAllowlists_Call {
+	return &MockStore_DeleteGroupAllowlists_Call{Call: _e.mock.On("DeleteGroupAllowlists", ctx, groupID)}
+}
+
+func (_c *MockStore_DeleteGroupAllowlists_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_DeleteGroupAllowlists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupAllowlists_Call) Return(_a0 error) *MockStore_DeleteGroupAllowlists_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupAllowlists_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteGroupAllowlists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroupInvites provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) DeleteGroupInvites(ctx context.Context, groupID uuid.UUID) error {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroupInvites")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteGroupInvites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroupInvites'
+type MockStore_DeleteGroupInvites_Call struct {
+	*mock.Call
+}
+
+// DeleteGroupInvites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) DeleteGroupInvites(ctx interface{}, groupID interface{}) *MockStore_DeleteGroupInvites_Call {
+	return &MockStore_DeleteGroupInvites_Call{Call: _e.mock.On("DeleteGroupInvites", ctx, groupID)}
+}
+
+func (_c *MockStore_DeleteGroupInvites_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_DeleteGroupInvites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupInvites_Call) Return(_a0 error) *MockStore_DeleteGroupInvites_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupInvites_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteGroupInvites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroupMemberRemovals provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) DeleteGroupMemberRemovals(ctx context.Context, groupID uuid.UUID) error {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroupMemberRemovals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteGroupMemberRemovals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroupMemberRemovals'
+type MockStore_DeleteGroupMemberRemovals_Call struct {
+	*mock.Call
+}
+
+// DeleteGroupMemberRemovals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) DeleteGroupMemberRemovals(ctx interface{}, groupID interface{}) *MockStore_DeleteGroupMemberRemovals_Call {
+	return &MockStore_DeleteGroupMemberRemovals_Call{Call: _e.mock.On("DeleteGroupMemberRemovals", ctx, groupID)}
+}
+
+func (_c *MockStore_DeleteGroupMemberRemovals_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_DeleteGroupMemberRemovals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupMemberRemovals_Call) Return(_a0 error) *MockStore_DeleteGroupMemberRemovals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupMemberRemovals_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteGroupMemberRemovals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroupMembers provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) DeleteGroupMembers(ctx context.Context, groupID uuid.UUID) error {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroupMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroupMembers'
+type MockStore_DeleteGroupMembers_Call struct {
+	*mock.Call
+}
+
+// DeleteGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) DeleteGroupMembers(ctx interface{}, groupID interface{}) *MockStore_DeleteGroupMembers_Call {
+	return &MockStore_DeleteGroupMembers_Call{Call: _e.mock.On("DeleteGroupMembers", ctx, groupID)}
+}
+
+func (_c *MockStore_DeleteGroupMembers_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_DeleteGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupMembers_Call) Return(_a0 error) *MockStore_DeleteGroupMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteGroupMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteGroupMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteIndexerCursor provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteIndexerCursor(ctx context.Context, arg sqlc.DeleteIndexerCursorParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIndexerCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteIndexerCursorParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteIndexerCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIndexerCursor'
+type MockStore_DeleteIndexerCursor_Call struct {
+	*mock.Call
+}
+
+// DeleteIndexerCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.DeleteIndexerCursorParams
+func (_e *MockStore_Expecter) DeleteIndexerCursor(ctx interface{}, arg interface{}) *MockStore_DeleteIndexerCursor_Call {
+	return &MockStore_DeleteIndexerCursor_Call{Call: _e.mock.On("DeleteIndexerCursor", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteIndexerCursor_Call) Run(run func(ctx context.Context, arg sqlc.DeleteIndexerCursorParams)) *MockStore_DeleteIndexerCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.DeleteIndexerCursorParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteIndexerCursor_Call) Return(_a0 error) *MockStore_DeleteIndexerCursor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteIndexerCursor_Call) RunAndReturn(run func(context.Context, sqlc.DeleteIndexerCursorParams) error) *MockStore_DeleteIndexerCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoundEventsByID provides a mock function with given fields: ctx, ids
+func (_m *MockStore) DeleteRoundEventsByID(ctx context.Context, ids []uuid.UUID) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoundEventsByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRoundEventsByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoundEventsByID'
+type MockStore_DeleteRoundEventsByID_Call struct {
+	*mock.Call
+}
+
+// DeleteRoundEventsByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *MockStore_Expecter) DeleteRoundEventsByID(ctx interface{}, ids interface{}) *MockStore_DeleteRoundEventsByID_Call {
+	return &MockStore_DeleteRoundEventsByID_Call{Call: _e.mock.On("DeleteRoundEventsByID", ctx, ids)}
+}
+
+func (_c *MockStore_DeleteRoundEventsByID_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockStore_DeleteRoundEventsByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRoundEventsByID_Call) Return(_a0 error) *MockStore_DeleteRoundEventsByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRoundEventsByID_Call) RunAndReturn(run func(context.Context, []uuid.UUID) error) *MockStore_DeleteRoundEventsByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoundEventsFrom provides a mock function with given fields: ctx, arg
+func (_m *MockStore) DeleteRoundEventsFrom(ctx context.Context, arg sqlc.DeleteRoundEventsFromParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoundEventsFrom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteRoundEventsFromParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRoundEventsFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoundEventsFrom'
+type MockStore_DeleteRoundEventsFrom_Call struct {
+	*mock.Call
+}
+
+// DeleteRoundEventsFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.DeleteRoundEventsFromParams
+func (_e *MockStore_Expecter) DeleteRoundEventsFrom(ctx interface{}, arg interface{}) *MockStore_DeleteRoundEventsFrom_Call {
+	return &MockStore_DeleteRoundEventsFrom_Call{Call: _e.mock.On("DeleteRoundEventsFrom", ctx, arg)}
+}
+
+func (_c *MockStore_DeleteRoundEventsFrom_Call) Run(run func(ctx context.Context, arg sqlc.DeleteRoundEventsFromParams)) *MockStore_DeleteRoundEventsFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.DeleteRoundEventsFromParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRoundEventsFrom_Call) Return(_a0 error) *MockStore_DeleteRoundEventsFrom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRoundEventsFrom_Call) RunAndReturn(run func(context.Context, sqlc.DeleteRoundEventsFromParams) error) *MockStore_DeleteRoundEventsFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoundPeriodTotals provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) DeleteRoundPeriodTotals(ctx context.Context, roundID uuid.UUID) error {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoundPeriodTotals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRoundPeriodTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoundPeriodTotals'
+type MockStore_DeleteRoundPeriodTotals_Call struct {
+	*mock.Call
+}
+
+// DeleteRoundPeriodTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) DeleteRoundPeriodTotals(ctx interface{}, roundID interface{}) *MockStore_DeleteRoundPeriodTotals_Call {
+	return &MockStore_DeleteRoundPeriodTotals_Call{Call: _e.mock.On("DeleteRoundPeriodTotals", ctx, roundID)}
+}
+
+func (_c *MockStore_DeleteRoundPeriodTotals_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_DeleteRoundPeriodTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRoundPeriodTotals_Call) Return(_a0 error) *MockStore_DeleteRoundPeriodTotals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRoundPeriodTotals_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteRoundPeriodTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportRoundActivity provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ExportRoundActivity(ctx context.Context, arg sqlc.ExportRoundActivityParams) ([]sqlc.ExportRoundActivityRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRoundActivity")
+	}
+
+	var r0 []sqlc.ExportRoundActivityRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ExportRoundActivityParams) ([]sqlc.ExportRoundActivityRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ExportRoundActivityParams) []sqlc.ExportRoundActivityRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ExportRoundActivityRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ExportRoundActivityParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ExportRoundActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportRoundActivity'
+type MockStore_ExportRoundActivity_Call struct {
+	*mock.Call
+}
+
+// ExportRoundActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ExportRoundActivityParams
+func (_e *MockStore_Expecter) ExportRoundActivity(ctx interface{}, arg interface{}) *MockStore_ExportRoundActivity_Call {
+	return &MockStore_ExportRoundActivity_Call{Call: _e.mock.On("ExportRoundActivity", ctx, arg)}
+}
+
+func (_c *MockStore_ExportRoundActivity_Call) Run(run func(ctx context.Context, arg sqlc.ExportRoundActivityParams)) *MockStore_ExportRoundActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ExportRoundActivityParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ExportRoundActivity_Call) Return(_a0 []sqlc.ExportRoundActivityRow, _a1 error) *MockStore_ExportRoundActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ExportRoundActivity_Call) RunAndReturn(run func(context.Context, sqlc.ExportRoundActivityParams) ([]sqlc.ExportRoundActivityRow, error)) *MockStore_ExportRoundActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailIndexerBackfill provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FailIndexerBackfill(ctx context.Context, arg sqlc.FailIndexerBackfillParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailIndexerBackfill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.FailIndexerBackfillParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_FailIndexerBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailIndexerBackfill'
+type MockStore_FailIndexerBackfill_Call struct {
+	*mock.Call
+}
+
+// FailIndexerBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.FailIndexerBackfillParams
+func (_e *MockStore_Expecter) FailIndexerBackfill(ctx interface{}, arg interface{}) *MockStore_FailIndexerBackfill_Call {
+	return &MockStore_FailIndexerBackfill_Call{Call: _e.mock.On("FailIndexerBackfill", ctx, arg)}
+}
+
+func (_c *MockStore_FailIndexerBackfill_Call) Run(run func(ctx context.Context, arg sqlc.FailIndexerBackfillParams)) *MockStore_FailIndexerBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.FailIndexerBackfillParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FailIndexerBackfill_Call) Return(_a0 error) *MockStore_FailIndexerBackfill_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_FailIndexerBackfill_Call) RunAndReturn(run func(context.Context, sqlc.FailIndexerBackfillParams) error) *MockStore_FailIndexerBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinalizePayoutOrder provides a mock function with given fields: ctx, arg
+func (_m *MockStore) FinalizePayoutOrder(ctx context.Context, arg sqlc.FinalizePayoutOrderParams) (sqlc.PayoutOrder, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizePayoutOrder")
+	}
+
+	var r0 sqlc.PayoutOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.FinalizePayoutOrderParams) (sqlc.PayoutOrder, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.FinalizePayoutOrderParams) sqlc.PayoutOrder); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.PayoutOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.FinalizePayoutOrderParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_FinalizePayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizePayoutOrder'
+type MockStore_FinalizePayoutOrder_Call struct {
+	*mock.Call
+}
+
+// FinalizePayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.FinalizePayoutOrderParams
+func (_e *MockStore_Expecter) FinalizePayoutOrder(ctx interface{}, arg interface{}) *MockStore_FinalizePayoutOrder_Call {
+	return &MockStore_FinalizePayoutOrder_Call{Call: _e.mock.On("FinalizePayoutOrder", ctx, arg)}
+}
+
+func (_c *MockStore_FinalizePayoutOrder_Call) Run(run func(ctx context.Context, arg sqlc.FinalizePayoutOrderParams)) *MockStore_FinalizePayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.FinalizePayoutOrderParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_FinalizePayoutOrder_Call) Return(_a0 sqlc.PayoutOrder, _a1 error) *MockStore_FinalizePayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_FinalizePayoutOrder_Call) RunAndReturn(run func(context.Context, sqlc.FinalizePayoutOrderParams) (sqlc.PayoutOrder, error)) *MockStore_FinalizePayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveGroupMemberByAddress provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetActiveGroupMemberByAddress(ctx context.Context, arg sqlc.GetActiveGroupMemberByAddressParams) (sqlc.GroupMember, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveGroupMemberByAddress")
+	}
+
+	var r0 sqlc.GroupMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetActiveGroupMemberByAddressParams) (sqlc.GroupMember, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetActiveGroupMemberByAddressParams) sqlc.GroupMember); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.GroupMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetActiveGroupMemberByAddressParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetActiveGroupMemberByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveGroupMemberByAddress'
+type MockStore_GetActiveGroupMemberByAddress_Call struct {
+	*mock.Call
+}
+
+// GetActiveGroupMemberByAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetActiveGroupMemberByAddressParams
+func (_e *MockStore_Expecter) GetActiveGroupMemberByAddress(ctx interface{}, arg interface{}) *MockStore_GetActiveGroupMemberByAddress_Call {
+	return &MockStore_GetActiveGroupMemberByAddress_Call{Call: _e.mock.On("GetActiveGroupMemberByAddress", ctx, arg)}
+}
+
+func (_c *MockStore_GetActiveGroupMemberByAddress_Call) Run(run func(ctx context.Context, arg sqlc.GetActiveGroupMemberByAddressParams)) *MockStore_GetActiveGroupMemberByAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetActiveGroupMemberByAddressParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetActiveGroupMemberByAddress_Call) Return(_a0 sqlc.GroupMember, _a1 error) *MockStore_GetActiveGroupMemberByAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetActiveGroupMemberByAddress_Call) RunAndReturn(run func(context.Context, sqlc.GetActiveGroupMemberByAddressParams) (sqlc.GroupMember, error)) *MockStore_GetActiveGroupMemberByAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveInvite provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetActiveInvite(ctx context.Context, arg sqlc.GetActiveInviteParams) (sqlc.Invite, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveInvite")
+	}
+
+	var r0 sqlc.Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetActiveInviteParams) (sqlc.Invite, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetActiveInviteParams) sqlc.Invite); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Invite)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetActiveInviteParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetActiveInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveInvite'
+type MockStore_GetActiveInvite_Call struct {
+	*mock.Call
+}
+
+// GetActiveInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetActiveInviteParams
+func (_e *MockStore_Expecter) GetActiveInvite(ctx interface{}, arg interface{}) *MockStore_GetActiveInvite_Call {
+	return &MockStore_GetActiveInvite_Call{Call: _e.mock.On("GetActiveInvite", ctx, arg)}
+}
+
+func (_c *MockStore_GetActiveInvite_Call) Run(run func(ctx context.Context, arg sqlc.GetActiveInviteParams)) *MockStore_GetActiveInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetActiveInviteParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetActiveInvite_Call) Return(_a0 sqlc.Invite, _a1 error) *MockStore_GetActiveInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetActiveInvite_Call) RunAndReturn(run func(context.Context, sqlc.GetActiveInviteParams) (sqlc.Invite, error)) *MockStore_GetActiveInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChainBlock provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetChainBlock(ctx context.Context, arg sqlc.GetChainBlockParams) (sqlc.ChainBlock, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChainBlock")
+	}
+
+	var r0 sqlc.ChainBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetChainBlockParams) (sqlc.ChainBlock, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetChainBlockParams) sqlc.ChainBlock); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.ChainBlock)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetChainBlockParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetChainBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChainBlock'
+type MockStore_GetChainBlock_Call struct {
+	*mock.Call
+}
+
+// GetChainBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetChainBlockParams
+func (_e *MockStore_Expecter) GetChainBlock(ctx interface{}, arg interface{}) *MockStore_GetChainBlock_Call {
+	return &MockStore_GetChainBlock_Call{Call: _e.mock.On("GetChainBlock", ctx, arg)}
+}
+
+func (_c *MockStore_GetChainBlock_Call) Run(run func(ctx context.Context, arg sqlc.GetChainBlockParams)) *MockStore_GetChainBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetChainBlockParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetChainBlock_Call) Return(_a0 sqlc.ChainBlock, _a1 error) *MockStore_GetChainBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetChainBlock_Call) RunAndReturn(run func(context.Context, sqlc.GetChainBlockParams) (sqlc.ChainBlock, error)) *MockStore_GetChainBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupAllowlist provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetGroupAllowlist(ctx context.Context, arg sqlc.GetGroupAllowlistParams) (sqlc.GroupAllowlist, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupAllowlist")
+	}
+
+	var r0 sqlc.GroupAllowlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetGroupAllowlistParams) (sqlc.GroupAllowlist, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetGroupAllowlistParams) sqlc.GroupAllowlist); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.GroupAllowlist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetGroupAllowlistParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetGroupAllowlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupAllowlist'
+type MockStore_GetGroupAllowlist_Call struct {
+	*mock.Call
+}
+
+// GetGroupAllowlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetGroupAllowlistParams
+func (_e *MockStore_Expecter) GetGroupAllowlist(ctx interface{}, arg interface{}) *MockStore_GetGroupAllowlist_Call {
+	return &MockStore_GetGroupAllowlist_Call{Call: _e.mock.On("GetGroupAllowlist", ctx, arg)}
+}
+
+func (_c *MockStore_GetGroupAllowlist_Call) Run(run func(ctx context.Context, arg sqlc.GetGroupAllowlistParams)) *MockStore_GetGroupAllowlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetGroupAllowlistParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetGroupAllowlist_Call) Return(_a0 sqlc.GroupAllowlist, _a1 error) *MockStore_GetGroupAllowlist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetGroupAllowlist_Call) RunAndReturn(run func(context.Context, sqlc.GetGroupAllowlistParams) (sqlc.GroupAllowlist, error)) *MockStore_GetGroupAllowlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetGroupByID(ctx context.Context, id uuid.UUID) (sqlc.Group, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByID")
+	}
+
+	var r0 sqlc.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Group, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Group); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetGroupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByID'
+type MockStore_GetGroupByID_Call struct {
+	*mock.Call
+}
+
+// GetGroupByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetGroupByID(ctx interface{}, id interface{}) *MockStore_GetGroupByID_Call {
+	return &MockStore_GetGroupByID_Call{Call: _e.mock.On("GetGroupByID", ctx, id)}
+}
+
+func (_c *MockStore_GetGroupByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetGroupByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetGroupByID_Call) Return(_a0 sqlc.Group, _a1 error) *MockStore_GetGroupByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetGroupByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.Group, error)) *MockStore_GetGroupByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupMemberDetails provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetGroupMemberDetails(ctx context.Context, arg sqlc.GetGroupMemberDetailsParams) (sqlc.GetGroupMemberDetailsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupMemberDetails")
+	}
+
+	var r0 sqlc.GetGroupMemberDetailsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetGroupMemberDetailsParams) (sqlc.GetGroupMemberDetailsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetGroupMemberDetailsParams) sqlc.GetGroupMemberDetailsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.GetGroupMemberDetailsRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetGroupMemberDetailsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetGroupMemberDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupMemberDetails'
+type MockStore_GetGroupMemberDetails_Call struct {
+	*mock.Call
+}
+
+// GetGroupMemberDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetGroupMemberDetailsParams
+func (_e *MockStore_Expecter) GetGroupMemberDetails(ctx interface{}, arg interface{}) *MockStore_GetGroupMemberDetails_Call {
+	return &MockStore_GetGroupMemberDetails_Call{Call: _e.mock.On("GetGroupMemberDetails", ctx, arg)}
+}
+
+func (_c *MockStore_GetGroupMemberDetails_Call) Run(run func(ctx context.Context, arg sqlc.GetGroupMemberDetailsParams)) *MockStore_GetGroupMemberDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetGroupMemberDetailsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetGroupMemberDetails_Call) Return(_a0 sqlc.GetGroupMemberDetailsRow, _a1 error) *MockStore_GetGroupMemberDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetGroupMemberDetails_Call) RunAndReturn(run func(context.Context, sqlc.GetGroupMemberDetailsParams) (sqlc.GetGroupMemberDetailsRow, error)) *MockStore_GetGroupMemberDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexerBackfill provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetIndexerBackfill(ctx context.Context, id uuid.UUID) (sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexerBackfill")
+	}
+
+	var r0 sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.IndexerBackfill)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIndexerBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexerBackfill'
+type MockStore_GetIndexerBackfill_Call struct {
+	*mock.Call
+}
+
+// GetIndexerBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetIndexerBackfill(ctx interface{}, id interface{}) *MockStore_GetIndexerBackfill_Call {
+	return &MockStore_GetIndexerBackfill_Call{Call: _e.mock.On("GetIndexerBackfill", ctx, id)}
+}
+
+func (_c *MockStore_GetIndexerBackfill_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetIndexerBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIndexerBackfill_Call) Return(_a0 sqlc.IndexerBackfill, _a1 error) *MockStore_GetIndexerBackfill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIndexerBackfill_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.IndexerBackfill, error)) *MockStore_GetIndexerBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexerCursor provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetIndexerCursor(ctx context.Context, arg sqlc.GetIndexerCursorParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexerCursor")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetIndexerCursorParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetIndexerCursorParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetIndexerCursorParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetIndexerCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexerCursor'
+type MockStore_GetIndexerCursor_Call struct {
+	*mock.Call
+}
+
+// GetIndexerCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetIndexerCursorParams
+func (_e *MockStore_Expecter) GetIndexerCursor(ctx interface{}, arg interface{}) *MockStore_GetIndexerCursor_Call {
+	return &MockStore_GetIndexerCursor_Call{Call: _e.mock.On("GetIndexerCursor", ctx, arg)}
+}
+
+func (_c *MockStore_GetIndexerCursor_Call) Run(run func(ctx context.Context, arg sqlc.GetIndexerCursorParams)) *MockStore_GetIndexerCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetIndexerCursorParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetIndexerCursor_Call) Return(_a0 int64, _a1 error) *MockStore_GetIndexerCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetIndexerCursor_Call) RunAndReturn(run func(context.Context, sqlc.GetIndexerCursorParams) (int64, error)) *MockStore_GetIndexerCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetJobByID(ctx context.Context, id uuid.UUID) (sqlc.Job, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobByID")
+	}
+
+	var r0 sqlc.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Job, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Job); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetJobByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobByID'
+type MockStore_GetJobByID_Call struct {
+	*mock.Call
+}
+
+// GetJobByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetJobByID(ctx interface{}, id interface{}) *MockStore_GetJobByID_Call {
+	return &MockStore_GetJobByID_Call{Call: _e.mock.On("GetJobByID", ctx, id)}
+}
+
+func (_c *MockStore_GetJobByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetJobByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetJobByID_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_GetJobByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetJobByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.Job, error)) *MockStore_GetJobByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestChainBlock provides a mock function with given fields: ctx, chainID
+func (_m *MockStore) GetLatestChainBlock(ctx context.Context, chainID int64) (sqlc.ChainBlock, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestChainBlock")
+	}
+
+	var r0 sqlc.ChainBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (sqlc.ChainBlock, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) sqlc.ChainBlock); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(sqlc.ChainBlock)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestChainBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestChainBlock'
+type MockStore_GetLatestChainBlock_Call struct {
+	*mock.Call
+}
+
+// GetLatestChainBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID int64
+func (_e *MockStore_Expecter) GetLatestChainBlock(ctx interface{}, chainID interface{}) *MockStore_GetLatestChainBlock_Call {
+	return &MockStore_GetLatestChainBlock_Call{Call: _e.mock.On("GetLatestChainBlock", ctx, chainID)}
+}
+
+func (_c *MockStore_GetLatestChainBlock_Call) Run(run func(ctx context.Context, chainID int64)) *MockStore_GetLatestChainBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestChainBlock_Call) Return(_a0 sqlc.ChainBlock, _a1 error) *MockStore_GetLatestChainBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestChainBlock_Call) RunAndReturn(run func(context.Context, int64) (sqlc.ChainBlock, error)) *MockStore_GetLatestChainBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestFinalizedPayoutOrder provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) GetLatestFinalizedPayoutOrder(ctx context.Context, groupID uuid.UUID) (sqlc.PayoutOrder, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestFinalizedPayoutOrder")
+	}
+
+	var r0 sqlc.PayoutOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.PayoutOrder, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.PayoutOrder); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		r0 = ret.Get(0).(sqlc.PayoutOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestFinalizedPayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestFinalizedPayoutOrder'
+type MockStore_GetLatestFinalizedPayoutOrder_Call struct {
+	*mock.Call
+}
+
+// GetLatestFinalizedPayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) GetLatestFinalizedPayoutOrder(ctx interface{}, groupID interface{}) *MockStore_GetLatestFinalizedPayoutOrder_Call {
+	return &MockStore_GetLatestFinalizedPayoutOrder_Call{Call: _e.mock.On("GetLatestFinalizedPayoutOrder", ctx, groupID)}
+}
+
+func (_c *MockStore_GetLatestFinalizedPayoutOrder_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_GetLatestFinalizedPayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestFinalizedPayoutOrder_Call) Return(_a0 sqlc.PayoutOrder, _a1 error) *MockStore_GetLatestFinalizedPayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestFinalizedPayoutOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.PayoutOrder, error)) *MockStore_GetLatestFinalizedPayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestGroupAllowlist provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) GetLatestGroupAllowlist(ctx context.Context, groupID uuid.UUID) (sqlc.GroupAllowlist, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestGroupAllowlist")
+	}
+
+	var r0 sqlc.GroupAllowlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.GroupAllowlist, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.GroupAllowlist); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		r0 = ret.Get(0).(sqlc.GroupAllowlist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestGroupAllowlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestGroupAllowlist'
+type MockStore_GetLatestGroupAllowlist_Call struct {
+	*mock.Call
+}
+
+// GetLatestGroupAllowlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) GetLatestGroupAllowlist(ctx interface{}, groupID interface{}) *MockStore_GetLatestGroupAllowlist_Call {
+	return &MockStore_GetLatestGroupAllowlist_Call{Call: _e.mock.On("GetLatestGroupAllowlist", ctx, groupID)}
+}
+
+func (_c *MockStore_GetLatestGroupAllowlist_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_GetLatestGroupAllowlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestGroupAllowlist_Call) Return(_a0 sqlc.GroupAllowlist, _a1 error) *MockStore_GetLatestGroupAllowlist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestGroupAllowlist_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.GroupAllowlist, error)) *MockStore_GetLatestGroupAllowlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMagicLinkByPendingSignupID provides a mock function with given fields: ctx, pendingSignupID
+func (_m *MockStore) GetMagicLinkByPendingSignupID(ctx context.Context, pendingSignupID uuid.UUID) (sqlc.MagicLink, error) {
+	ret := _m.Called(ctx, pendingSignupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMagicLinkByPendingSignupID")
+	}
+
+	var r0 sqlc.MagicLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.MagicLink, error)); ok {
+		return rf(ctx, pendingSignupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.MagicLink); ok {
+		r0 = rf(ctx, pendingSignupID)
+	} else {
+		r0 = ret.Get(0).(sqlc.MagicLink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, pendingSignupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMagicLinkByPendingSignupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMagicLinkByPendingSignupID'
+type MockStore_GetMagicLinkByPendingSignupID_Call struct {
+	*mock.Call
+}
+
+// GetMagicLinkByPendingSignupID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pendingSignupID uuid.UUID
+func (_e *MockStore_Expecter) GetMagicLinkByPendingSignupID(ctx interface{}, pendingSignupID interface{}) *MockStore_GetMagicLinkByPendingSignupID_Call {
+	return &MockStore_GetMagicLinkByPendingSignupID_Call{Call: _e.mock.On("GetMagicLinkByPendingSignupID", ctx, pendingSignupID)}
+}
+
+func (_c *MockStore_GetMagicLinkByPendingSignupID_Call) Run(run func(ctx context.Context, pendingSignupID uuid.UUID)) *MockStore_GetMagicLinkByPendingSignupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMagicLinkByPendingSignupID_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_GetMagicLinkByPendingSignupID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMagicLinkByPendingSignupID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.MagicLink, error)) *MockStore_GetMagicLinkByPendingSignupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMagicLinkByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *MockStore) GetMagicLinkByTokenHash(ctx context.Context, tokenHash string) (sqlc.MagicLink, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMagicLinkByTokenHash")
+	}
+
+	var r0 sqlc.MagicLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.MagicLink, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.MagicLink); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(sqlc.MagicLink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetMagicLinkByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMagicLinkByTokenHash'
+type MockStore_GetMagicLinkByTokenHash_Call struct {
+	*mock.Call
+}
+
+// GetMagicLinkByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockStore_Expecter) GetMagicLinkByTokenHash(ctx interface{}, tokenHash interface{}) *MockStore_GetMagicLinkByTokenHash_Call {
+	return &MockStore_GetMagicLinkByTokenHash_Call{Call: _e.mock.On("GetMagicLinkByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *MockStore_GetMagicLinkByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockStore_GetMagicLinkByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetMagicLinkByTokenHash_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_GetMagicLinkByTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetMagicLinkByTokenHash_Call) RunAndReturn(run func(context.Context, string) (sqlc.MagicLink, error)) *MockStore_GetMagicLinkByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextPayoutAddress provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) GetNextPayoutAddress(ctx context.Context, roundID uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextPayoutAddress")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetNextPayoutAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextPayoutAddress'
+type MockStore_GetNextPayoutAddress_Call struct {
+	*mock.Call
+}
+
+// GetNextPayoutAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) GetNextPayoutAddress(ctx interface{}, roundID interface{}) *MockStore_GetNextPayoutAddress_Call {
+	return &MockStore_GetNextPayoutAddress_Call{Call: _e.mock.On("GetNextPayoutAddress", ctx, roundID)}
+}
+
+func (_c *MockStore_GetNextPayoutAddress_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_GetNextPayoutAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetNextPayoutAddress_Call) Return(_a0 string, _a1 error) *MockStore_GetNextPayoutAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetNextPayoutAddress_Call) RunAndReturn(run func(context.Context, uuid.UUID) (string, error)) *MockStore_GetNextPayoutAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextPendingJob provides a mock function with given fields: ctx
+func (_m *MockStore) GetNextPendingJob(ctx context.Context) (sqlc.Job, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextPendingJob")
+	}
+
+	var r0 sqlc.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (sqlc.Job, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) sqlc.Job); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(sqlc.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetNextPendingJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextPendingJob'
+type MockStore_GetNextPendingJob_Call struct {
+	*mock.Call
+}
+
+// GetNextPendingJob is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetNextPendingJob(ctx interface{}) *MockStore_GetNextPendingJob_Call {
+	return &MockStore_GetNextPendingJob_Call{Call: _e.mock.On("GetNextPendingJob", ctx)}
+}
+
+func (_c *MockStore_GetNextPendingJob_Call) Run(run func(ctx context.Context)) *MockStore_GetNextPendingJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetNextPendingJob_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_GetNextPendingJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetNextPendingJob_Call) RunAndReturn(run func(context.Context) (sqlc.Job, error)) *MockStore_GetNextPendingJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockStore) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (sqlc.NotificationPreference, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferences")
+	}
+
+	var r0 sqlc.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.NotificationPreference, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.NotificationPreference); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(sqlc.NotificationPreference)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferences'
+type MockStore_GetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockStore_Expecter) GetNotificationPreferences(ctx interface{}, userID interface{}) *MockStore_GetNotificationPreferences_Call {
+	return &MockStore_GetNotificationPreferences_Call{Call: _e.mock.On("GetNotificationPreferences", ctx, userID)}
+}
+
+func (_c *MockStore_GetNotificationPreferences_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockStore_GetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetNotificationPreferences_Call) Return(_a0 sqlc.NotificationPreference, _a1 error) *MockStore_GetNotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetNotificationPreferences_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.NotificationPreference, error)) *MockStore_GetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPayoutOrder provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetPayoutOrder(ctx context.Context, arg sqlc.GetPayoutOrderParams) (sqlc.PayoutOrder, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayoutOrder")
+	}
+
+	var r0 sqlc.PayoutOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetPayoutOrderParams) (sqlc.PayoutOrder, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetPayoutOrderParams) sqlc.PayoutOrder); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.PayoutOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetPayoutOrderParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayoutOrder'
+type MockStore_GetPayoutOrder_Call struct {
+	*mock.Call
+}
+
+// GetPayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetPayoutOrderParams
+func (_e *MockStore_Expecter) GetPayoutOrder(ctx interface{}, arg interface{}) *MockStore_GetPayoutOrder_Call {
+	return &MockStore_GetPayoutOrder_Call{Call: _e.mock.On("GetPayoutOrder", ctx, arg)}
+}
+
+func (_c *MockStore_GetPayoutOrder_Call) Run(run func(ctx context.Context, arg sqlc.GetPayoutOrderParams)) *MockStore_GetPayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetPayoutOrderParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPayoutOrder_Call) Return(_a0 sqlc.PayoutOrder, _a1 error) *MockStore_GetPayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPayoutOrder_Call) RunAndReturn(run func(context.Context, sqlc.GetPayoutOrderParams) (sqlc.PayoutOrder, error)) *MockStore_GetPayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPayoutOrderBid provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetPayoutOrderBid(ctx context.Context, arg sqlc.GetPayoutOrderBidParams) (sqlc.PayoutOrderBid, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayoutOrderBid")
+	}
+
+	var r0 sqlc.PayoutOrderBid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetPayoutOrderBidParams) (sqlc.PayoutOrderBid, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetPayoutOrderBidParams) sqlc.PayoutOrderBid); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.PayoutOrderBid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetPayoutOrderBidParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPayoutOrderBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayoutOrderBid'
+type MockStore_GetPayoutOrderBid_Call struct {
+	*mock.Call
+}
+
+// GetPayoutOrderBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetPayoutOrderBidParams
+func (_e *MockStore_Expecter) GetPayoutOrderBid(ctx interface{}, arg interface{}) *MockStore_GetPayoutOrderBid_Call {
+	return &MockStore_GetPayoutOrderBid_Call{Call: _e.mock.On("GetPayoutOrderBid", ctx, arg)}
+}
+
+func (_c *MockStore_GetPayoutOrderBid_Call) Run(run func(ctx context.Context, arg sqlc.GetPayoutOrderBidParams)) *MockStore_GetPayoutOrderBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetPayoutOrderBidParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPayoutOrderBid_Call) Return(_a0 sqlc.PayoutOrderBid, _a1 error) *MockStore_GetPayoutOrderBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPayoutOrderBid_Call) RunAndReturn(run func(context.Context, sqlc.GetPayoutOrderBidParams) (sqlc.PayoutOrderBid, error)) *MockStore_GetPayoutOrderBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingJobByType provides a mock function with given fields: ctx, type_
+func (_m *MockStore) GetPendingJobByType(ctx context.Context, type_ string) (sqlc.Job, error) {
+	ret := _m.Called(ctx, type_)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingJobByType")
+	}
+
+	var r0 sqlc.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.Job, error)); ok {
+		return rf(ctx, type_)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.Job); ok {
+		r0 = rf(ctx, type_)
+	} else {
+		r0 = ret.Get(0).(sqlc.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, type_)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPendingJobByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingJobByType'
+type MockStore_GetPendingJobByType_Call struct {
+	*mock.Call
+}
+
+// GetPendingJobByType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - type_ string
+func (_e *MockStore_Expecter) GetPendingJobByType(ctx interface{}, type_ interface{}) *MockStore_GetPendingJobByType_Call {
+	return &MockStore_GetPendingJobByType_Call{Call: _e.mock.On("GetPendingJobByType", ctx, type_)}
+}
+
+func (_c *MockStore_GetPendingJobByType_Call) Run(run func(ctx context.Context, type_ string)) *MockStore_GetPendingJobByType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPendingJobByType_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_GetPendingJobByType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPendingJobByType_Call) RunAndReturn(run func(context.Context, string) (sqlc.Job, error)) *MockStore_GetPendingJobByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingSignupByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) GetPendingSignupByEmail(ctx context.Context, email pgtype.Text) (sqlc.PendingSignup, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingSignupByEmail")
+	}
+
+	var r0 sqlc.PendingSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (sqlc.PendingSignup, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) sqlc.PendingSignup); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(sqlc.PendingSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPendingSignupByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingSignupByEmail'
+type MockStore_GetPendingSignupByEmail_Call struct {
+	*mock.Call
+}
+
+// GetPendingSignupByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email pgtype.Text
+func (_e *MockStore_Expecter) GetPendingSignupByEmail(ctx interface{}, email interface{}) *MockStore_GetPendingSignupByEmail_Call {
+	return &MockStore_GetPendingSignupByEmail_Call{Call: _e.mock.On("GetPendingSignupByEmail", ctx, email)}
+}
+
+func (_c *MockStore_GetPendingSignupByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_GetPendingSignupByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPendingSignupByEmail_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_GetPendingSignupByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPendingSignupByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) (sqlc.PendingSignup, error)) *MockStore_GetPendingSignupByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingSignupByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetPendingSignupByID(ctx context.Context, id uuid.UUID) (sqlc.PendingSignup, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingSignupByID")
+	}
+
+	var r0 sqlc.PendingSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.PendingSignup, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.PendingSignup); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.PendingSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPendingSignupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingSignupByID'
+type MockStore_GetPendingSignupByID_Call struct {
+	*mock.Call
+}
+
+// GetPendingSignupByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetPendingSignupByID(ctx interface{}, id interface{}) *MockStore_GetPendingSignupByID_Call {
+	return &MockStore_GetPendingSignupByID_Call{Call: _e.mock.On("GetPendingSignupByID", ctx, id)}
+}
+
+func (_c *MockStore_GetPendingSignupByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetPendingSignupByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPendingSignupByID_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_GetPendingSignupByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPendingSignupByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.PendingSignup, error)) *MockStore_GetPendingSignupByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoundByContract provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetRoundByContract(ctx context.Context, arg sqlc.GetRoundByContractParams) (sqlc.Round, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoundByContract")
+	}
+
+	var r0 sqlc.Round
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetRoundByContractParams) (sqlc.Round, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetRoundByContractParams) sqlc.Round); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Round)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetRoundByContractParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRoundByContract_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoundByContract'
+type MockStore_GetRoundByContract_Call struct {
+	*mock.Call
+}
+
+// GetRoundByContract is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetRoundByContractParams
+func (_e *MockStore_Expecter) GetRoundByContract(ctx interface{}, arg interface{}) *MockStore_GetRoundByContract_Call {
+	return &MockStore_GetRoundByContract_Call{Call: _e.mock.On("GetRoundByContract", ctx, arg)}
+}
+
+func (_c *MockStore_GetRoundByContract_Call) Run(run func(ctx context.Context, arg sqlc.GetRoundByContractParams)) *MockStore_GetRoundByContract_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetRoundByContractParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRoundByContract_Call) Return(_a0 sqlc.Round, _a1 error) *MockStore_GetRoundByContract_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRoundByContract_Call) RunAndReturn(run func(context.Context, sqlc.GetRoundByContractParams) (sqlc.Round, error)) *MockStore_GetRoundByContract_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoundByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetRoundByID(ctx context.Context, id uuid.UUID) (sqlc.Round, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoundByID")
+	}
+
+	var r0 sqlc.Round
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Round, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Round); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.Round)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRoundByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoundByID'
+type MockStore_GetRoundByID_Call struct {
+	*mock.Call
+}
+
+// GetRoundByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetRoundByID(ctx interface{}, id interface{}) *MockStore_GetRoundByID_Call {
+	return &MockStore_GetRoundByID_Call{Call: _e.mock.On("GetRoundByID", ctx, id)}
+}
+
+func (_c *MockStore_GetRoundByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetRoundByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRoundByID_Call) Return(_a0 sqlc.Round, _a1 error) *MockStore_GetRoundByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRoundByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.Round, error)) *MockStore_GetRoundByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunningIndexerBackfill provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) GetRunningIndexerBackfill(ctx context.Context, roundID uuid.UUID) (sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunningIndexerBackfill")
+	}
+
+	var r0 sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Get(0).(sqlc.IndexerBackfill)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetRunningIndexerBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunningIndexerBackfill'
+type MockStore_GetRunningIndexerBackfill_Call struct {
+	*mock.Call
+}
+
+// GetRunningIndexerBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) GetRunningIndexerBackfill(ctx interface{}, roundID interface{}) *MockStore_GetRunningIndexerBackfill_Call {
+	return &MockStore_GetRunningIndexerBackfill_Call{Call: _e.mock.On("GetRunningIndexerBackfill", ctx, roundID)}
+}
+
+func (_c *MockStore_GetRunningIndexerBackfill_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_GetRunningIndexerBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRunningIndexerBackfill_Call) Return(_a0 sqlc.IndexerBackfill, _a1 error) *MockStore_GetRunningIndexerBackfill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetRunningIndexerBackfill_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.IndexerBackfill, error)) *MockStore_GetRunningIndexerBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSlotSwap provides a mock function with given fields: ctx, arg
+func (_m *MockStore) GetSlotSwap(ctx context.Context, arg sqlc.GetSlotSwapParams) (sqlc.SlotSwap, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlotSwap")
+	}
+
+	var r0 sqlc.SlotSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetSlotSwapParams) (sqlc.SlotSwap, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetSlotSwapParams) sqlc.SlotSwap); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.SlotSwap)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetSlotSwapParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetSlotSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlotSwap'
+type MockStore_GetSlotSwap_Call struct {
+	*mock.Call
+}
+
+// GetSlotSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.GetSlotSwapParams
+func (_e *MockStore_Expecter) GetSlotSwap(ctx interface{}, arg interface{}) *MockStore_GetSlotSwap_Call {
+	return &MockStore_GetSlotSwap_Call{Call: _e.mock.On("GetSlotSwap", ctx, arg)}
+}
+
+func (_c *MockStore_GetSlotSwap_Call) Run(run func(ctx context.Context, arg sqlc.GetSlotSwapParams)) *MockStore_GetSlotSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.GetSlotSwapParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetSlotSwap_Call) Return(_a0 sqlc.SlotSwap, _a1 error) *MockStore_GetSlotSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetSlotSwap_Call) RunAndReturn(run func(context.Context, sqlc.GetSlotSwapParams) (sqlc.SlotSwap, error)) *MockStore_GetSlotSwap_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -601,22 +3466,3950 @@ func (_m *MockStore) GetUserByAddress(ctx context.Context, address string) (sqlc
 	ret := _m.Called(ctx, address)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByAddress")
+		panic("no return value specified for GetUserByAddress")
+	}
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.User, error)); ok {
+		return rf(ctx, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.User); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByAddress'
+type MockStore_GetUserByAddress_Call struct {
+	*mock.Call
+}
+
+// GetUserByAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address string
+func (_e *MockStore_Expecter) GetUserByAddress(ctx interface{}, address interface{}) *MockStore_GetUserByAddress_Call {
+	return &MockStore_GetUserByAddress_Call{Call: _e.mock.On("GetUserByAddress", ctx, address)}
+}
+
+func (_c *MockStore_GetUserByAddress_Call) Run(run func(ctx context.Context, address string)) *MockStore_GetUserByAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByAddress_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_GetUserByAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByAddress_Call) RunAndReturn(run func(context.Context, string) (sqlc.User, error)) *MockStore_GetUserByAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) GetUserByEmail(ctx context.Context, email pgtype.Text) (sqlc.User, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (sqlc.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) sqlc.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockStore_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email pgtype.Text
+func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
+	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) (sqlc.User, error)) *MockStore_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetUserByID(ctx context.Context, id uuid.UUID) (sqlc.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type MockStore_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockStore_GetUserByID_Call {
+	return &MockStore_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+}
+
+func (_c *MockStore_GetUserByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByID_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetUserByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.User, error)) *MockStore_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerifiedPendingSignupByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetVerifiedPendingSignupByID(ctx context.Context, id uuid.UUID) (sqlc.PendingSignup, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerifiedPendingSignupByID")
+	}
+
+	var r0 sqlc.PendingSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.PendingSignup, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.PendingSignup); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.PendingSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetVerifiedPendingSignupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerifiedPendingSignupByID'
+type MockStore_GetVerifiedPendingSignupByID_Call struct {
+	*mock.Call
+}
+
+// GetVerifiedPendingSignupByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetVerifiedPendingSignupByID(ctx interface{}, id interface{}) *MockStore_GetVerifiedPendingSignupByID_Call {
+	return &MockStore_GetVerifiedPendingSignupByID_Call{Call: _e.mock.On("GetVerifiedPendingSignupByID", ctx, id)}
+}
+
+func (_c *MockStore_GetVerifiedPendingSignupByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetVerifiedPendingSignupByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetVerifiedPendingSignupByID_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_GetVerifiedPendingSignupByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetVerifiedPendingSignupByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.PendingSignup, error)) *MockStore_GetVerifiedPendingSignupByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementJobRetry provides a mock function with given fields: ctx, arg
+func (_m *MockStore) IncrementJobRetry(ctx context.Context, arg sqlc.IncrementJobRetryParams) (sqlc.Job, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementJobRetry")
+	}
+
+	var r0 sqlc.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.IncrementJobRetryParams) (sqlc.Job, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.IncrementJobRetryParams) sqlc.Job); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.IncrementJobRetryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_IncrementJobRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementJobRetry'
+type MockStore_IncrementJobRetry_Call struct {
+	*mock.Call
+}
+
+// IncrementJobRetry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.IncrementJobRetryParams
+func (_e *MockStore_Expecter) IncrementJobRetry(ctx interface{}, arg interface{}) *MockStore_IncrementJobRetry_Call {
+	return &MockStore_IncrementJobRetry_Call{Call: _e.mock.On("IncrementJobRetry", ctx, arg)}
+}
+
+func (_c *MockStore_IncrementJobRetry_Call) Run(run func(ctx context.Context, arg sqlc.IncrementJobRetryParams)) *MockStore_IncrementJobRetry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.IncrementJobRetryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_IncrementJobRetry_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_IncrementJobRetry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_IncrementJobRetry_Call) RunAndReturn(run func(context.Context, sqlc.IncrementJobRetryParams) (sqlc.Job, error)) *MockStore_IncrementJobRetry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertContributionReminder provides a mock function with given fields: ctx, arg
+func (_m *MockStore) InsertContributionReminder(ctx context.Context, arg sqlc.InsertContributionReminderParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertContributionReminder")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertContributionReminderParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertContributionReminderParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.InsertContributionReminderParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_InsertContributionReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertContributionReminder'
+type MockStore_InsertContributionReminder_Call struct {
+	*mock.Call
+}
+
+// InsertContributionReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.InsertContributionReminderParams
+func (_e *MockStore_Expecter) InsertContributionReminder(ctx interface{}, arg interface{}) *MockStore_InsertContributionReminder_Call {
+	return &MockStore_InsertContributionReminder_Call{Call: _e.mock.On("InsertContributionReminder", ctx, arg)}
+}
+
+func (_c *MockStore_InsertContributionReminder_Call) Run(run func(ctx context.Context, arg sqlc.InsertContributionReminderParams)) *MockStore_InsertContributionReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.InsertContributionReminderParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertContributionReminder_Call) Return(_a0 int64, _a1 error) *MockStore_InsertContributionReminder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_InsertContributionReminder_Call) RunAndReturn(run func(context.Context, sqlc.InsertContributionReminderParams) (int64, error)) *MockStore_InsertContributionReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertLedgerEntry provides a mock function with given fields: ctx, arg
+func (_m *MockStore) InsertLedgerEntry(ctx context.Context, arg sqlc.InsertLedgerEntryParams) (sqlc.LedgerEntry, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertLedgerEntry")
+	}
+
+	var r0 sqlc.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertLedgerEntryParams) (sqlc.LedgerEntry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertLedgerEntryParams) sqlc.LedgerEntry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.LedgerEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.InsertLedgerEntryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_InsertLedgerEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertLedgerEntry'
+type MockStore_InsertLedgerEntry_Call struct {
+	*mock.Call
+}
+
+// InsertLedgerEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.InsertLedgerEntryParams
+func (_e *MockStore_Expecter) InsertLedgerEntry(ctx interface{}, arg interface{}) *MockStore_InsertLedgerEntry_Call {
+	return &MockStore_InsertLedgerEntry_Call{Call: _e.mock.On("InsertLedgerEntry", ctx, arg)}
+}
+
+func (_c *MockStore_InsertLedgerEntry_Call) Run(run func(ctx context.Context, arg sqlc.InsertLedgerEntryParams)) *MockStore_InsertLedgerEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.InsertLedgerEntryParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertLedgerEntry_Call) Return(_a0 sqlc.LedgerEntry, _a1 error) *MockStore_InsertLedgerEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_InsertLedgerEntry_Call) RunAndReturn(run func(context.Context, sqlc.InsertLedgerEntryParams) (sqlc.LedgerEntry, error)) *MockStore_InsertLedgerEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertLedgerPosting provides a mock function with given fields: ctx, arg
+func (_m *MockStore) InsertLedgerPosting(ctx context.Context, arg sqlc.InsertLedgerPostingParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertLedgerPosting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertLedgerPostingParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_InsertLedgerPosting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertLedgerPosting'
+type MockStore_InsertLedgerPosting_Call struct {
+	*mock.Call
+}
+
+// InsertLedgerPosting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.InsertLedgerPostingParams
+func (_e *MockStore_Expecter) InsertLedgerPosting(ctx interface{}, arg interface{}) *MockStore_InsertLedgerPosting_Call {
+	return &MockStore_InsertLedgerPosting_Call{Call: _e.mock.On("InsertLedgerPosting", ctx, arg)}
+}
+
+func (_c *MockStore_InsertLedgerPosting_Call) Run(run func(ctx context.Context, arg sqlc.InsertLedgerPostingParams)) *MockStore_InsertLedgerPosting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.InsertLedgerPostingParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertLedgerPosting_Call) Return(_a0 error) *MockStore_InsertLedgerPosting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_InsertLedgerPosting_Call) RunAndReturn(run func(context.Context, sqlc.InsertLedgerPostingParams) error) *MockStore_InsertLedgerPosting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertRoundDelinquency provides a mock function with given fields: ctx, arg
+func (_m *MockStore) InsertRoundDelinquency(ctx context.Context, arg sqlc.InsertRoundDelinquencyParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertRoundDelinquency")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertRoundDelinquencyParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertRoundDelinquencyParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.InsertRoundDelinquencyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_InsertRoundDelinquency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertRoundDelinquency'
+type MockStore_InsertRoundDelinquency_Call struct {
+	*mock.Call
+}
+
+// InsertRoundDelinquency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.InsertRoundDelinquencyParams
+func (_e *MockStore_Expecter) InsertRoundDelinquency(ctx interface{}, arg interface{}) *MockStore_InsertRoundDelinquency_Call {
+	return &MockStore_InsertRoundDelinquency_Call{Call: _e.mock.On("InsertRoundDelinquency", ctx, arg)}
+}
+
+func (_c *MockStore_InsertRoundDelinquency_Call) Run(run func(ctx context.Context, arg sqlc.InsertRoundDelinquencyParams)) *MockStore_InsertRoundDelinquency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.InsertRoundDelinquencyParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertRoundDelinquency_Call) Return(_a0 int64, _a1 error) *MockStore_InsertRoundDelinquency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_InsertRoundDelinquency_Call) RunAndReturn(run func(context.Context, sqlc.InsertRoundDelinquencyParams) (int64, error)) *MockStore_InsertRoundDelinquency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertRoundEvent provides a mock function with given fields: ctx, arg
+func (_m *MockStore) InsertRoundEvent(ctx context.Context, arg sqlc.InsertRoundEventParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertRoundEvent")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertRoundEventParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.InsertRoundEventParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.InsertRoundEventParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_InsertRoundEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertRoundEvent'
+type MockStore_InsertRoundEvent_Call struct {
+	*mock.Call
+}
+
+// InsertRoundEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.InsertRoundEventParams
+func (_e *MockStore_Expecter) InsertRoundEvent(ctx interface{}, arg interface{}) *MockStore_InsertRoundEvent_Call {
+	return &MockStore_InsertRoundEvent_Call{Call: _e.mock.On("InsertRoundEvent", ctx, arg)}
+}
+
+func (_c *MockStore_InsertRoundEvent_Call) Run(run func(ctx context.Context, arg sqlc.InsertRoundEventParams)) *MockStore_InsertRoundEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.InsertRoundEventParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertRoundEvent_Call) Return(_a0 int64, _a1 error) *MockStore_InsertRoundEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_InsertRoundEvent_Call) RunAndReturn(run func(context.Context, sqlc.InsertRoundEventParams) (int64, error)) *MockStore_InsertRoundEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertRoundPeriodTotals provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) InsertRoundPeriodTotals(ctx context.Context, roundID uuid.UUID) error {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertRoundPeriodTotals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_InsertRoundPeriodTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertRoundPeriodTotals'
+type MockStore_InsertRoundPeriodTotals_Call struct {
+	*mock.Call
+}
+
+// InsertRoundPeriodTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) InsertRoundPeriodTotals(ctx interface{}, roundID interface{}) *MockStore_InsertRoundPeriodTotals_Call {
+	return &MockStore_InsertRoundPeriodTotals_Call{Call: _e.mock.On("InsertRoundPeriodTotals", ctx, roundID)}
+}
+
+func (_c *MockStore_InsertRoundPeriodTotals_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_InsertRoundPeriodTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_InsertRoundPeriodTotals_Call) Return(_a0 error) *MockStore_InsertRoundPeriodTotals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_InsertRoundPeriodTotals_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_InsertRoundPeriodTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateMagicLinksByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) InvalidateMagicLinksByEmail(ctx context.Context, email pgtype.Text) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateMagicLinksByEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_InvalidateMagicLinksByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateMagicLinksByEmail'
+type MockStore_InvalidateMagicLinksByEmail_Call struct {
+	*mock.Call
+}
+
+// InvalidateMagicLinksByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email pgtype.Text
+func (_e *MockStore_Expecter) InvalidateMagicLinksByEmail(ctx interface{}, email interface{}) *MockStore_InvalidateMagicLinksByEmail_Call {
+	return &MockStore_InvalidateMagicLinksByEmail_Call{Call: _e.mock.On("InvalidateMagicLinksByEmail", ctx, email)}
+}
+
+func (_c *MockStore_InvalidateMagicLinksByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_InvalidateMagicLinksByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_InvalidateMagicLinksByEmail_Call) Return(_a0 error) *MockStore_InvalidateMagicLinksByEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_InvalidateMagicLinksByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) error) *MockStore_InvalidateMagicLinksByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidatePendingSignupsByEmail provides a mock function with given fields: ctx, email
+func (_m *MockStore) InvalidatePendingSignupsByEmail(ctx context.Context, email pgtype.Text) error {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidatePendingSignupsByEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_InvalidatePendingSignupsByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidatePendingSignupsByEmail'
+type MockStore_InvalidatePendingSignupsByEmail_Call struct {
+	*mock.Call
+}
+
+// InvalidatePendingSignupsByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email pgtype.Text
+func (_e *MockStore_Expecter) InvalidatePendingSignupsByEmail(ctx interface{}, email interface{}) *MockStore_InvalidatePendingSignupsByEmail_Call {
+	return &MockStore_InvalidatePendingSignupsByEmail_Call{Call: _e.mock.On("InvalidatePendingSignupsByEmail", ctx, email)}
+}
+
+func (_c *MockStore_InvalidatePendingSignupsByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_InvalidatePendingSignupsByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Text))
+	})
+	return _c
+}
+
+func (_c *MockStore_InvalidatePendingSignupsByEmail_Call) Return(_a0 error) *MockStore_InvalidatePendingSignupsByEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_InvalidatePendingSignupsByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) error) *MockStore_InvalidatePendingSignupsByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAcceptedGroupMemberAddresses provides a mock function with given fields: ctx, groupID
+func (_m *MockStore) ListAcceptedGroupMemberAddresses(ctx context.Context, groupID uuid.UUID) ([]string, error) {
+	ret := _m.Called(ctx, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAcceptedGroupMemberAddresses")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]string, error)); ok {
+		return rf(ctx, groupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListAcceptedGroupMemberAddresses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAcceptedGroupMemberAddresses'
+type MockStore_ListAcceptedGroupMemberAddresses_Call struct {
+	*mock.Call
+}
+
+// ListAcceptedGroupMemberAddresses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+func (_e *MockStore_Expecter) ListAcceptedGroupMemberAddresses(ctx interface{}, groupID interface{}) *MockStore_ListAcceptedGroupMemberAddresses_Call {
+	return &MockStore_ListAcceptedGroupMemberAddresses_Call{Call: _e.mock.On("ListAcceptedGroupMemberAddresses", ctx, groupID)}
+}
+
+func (_c *MockStore_ListAcceptedGroupMemberAddresses_Call) Run(run func(ctx context.Context, groupID uuid.UUID)) *MockStore_ListAcceptedGroupMemberAddresses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListAcceptedGroupMemberAddresses_Call) Return(_a0 []string, _a1 error) *MockStore_ListAcceptedGroupMemberAddresses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListAcceptedGroupMemberAddresses_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]string, error)) *MockStore_ListAcceptedGroupMemberAddresses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveGroupIDs provides a mock function with given fields: ctx
+func (_m *MockStore) ListActiveGroupIDs(ctx context.Context) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveGroupIDs")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]uuid.UUID, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []uuid.UUID); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListActiveGroupIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveGroupIDs'
+type MockStore_ListActiveGroupIDs_Call struct {
+	*mock.Call
+}
+
+// ListActiveGroupIDs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListActiveGroupIDs(ctx interface{}) *MockStore_ListActiveGroupIDs_Call {
+	return &MockStore_ListActiveGroupIDs_Call{Call: _e.mock.On("ListActiveGroupIDs", ctx)}
+}
+
+func (_c *MockStore_ListActiveGroupIDs_Call) Run(run func(ctx context.Context)) *MockStore_ListActiveGroupIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListActiveGroupIDs_Call) Return(_a0 []uuid.UUID, _a1 error) *MockStore_ListActiveGroupIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListActiveGroupIDs_Call) RunAndReturn(run func(context.Context) ([]uuid.UUID, error)) *MockStore_ListActiveGroupIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActiveRoundObligations provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListActiveRoundObligations(ctx context.Context, arg sqlc.ListActiveRoundObligationsParams) ([]sqlc.ListActiveRoundObligationsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveRoundObligations")
+	}
+
+	var r0 []sqlc.ListActiveRoundObligationsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListActiveRoundObligationsParams) ([]sqlc.ListActiveRoundObligationsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListActiveRoundObligationsParams) []sqlc.ListActiveRoundObligationsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListActiveRoundObligationsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListActiveRoundObligationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListActiveRoundObligations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveRoundObligations'
+type MockStore_ListActiveRoundObligations_Call struct {
+	*mock.Call
+}
+
+// ListActiveRoundObligations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListActiveRoundObligationsParams
+func (_e *MockStore_Expecter) ListActiveRoundObligations(ctx interface{}, arg interface{}) *MockStore_ListActiveRoundObligations_Call {
+	return &MockStore_ListActiveRoundObligations_Call{Call: _e.mock.On("ListActiveRoundObligations", ctx, arg)}
+}
+
+func (_c *MockStore_ListActiveRoundObligations_Call) Run(run func(ctx context.Context, arg sqlc.ListActiveRoundObligationsParams)) *MockStore_ListActiveRoundObligations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListActiveRoundObligationsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListActiveRoundObligations_Call) Return(_a0 []sqlc.ListActiveRoundObligationsRow, _a1 error) *MockStore_ListActiveRoundObligations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListActiveRoundObligations_Call) RunAndReturn(run func(context.Context, sqlc.ListActiveRoundObligationsParams) ([]sqlc.ListActiveRoundObligationsRow, error)) *MockStore_ListActiveRoundObligations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDelinquencyCheckRounds provides a mock function with given fields: ctx
+func (_m *MockStore) ListDelinquencyCheckRounds(ctx context.Context) ([]sqlc.ListDelinquencyCheckRoundsRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDelinquencyCheckRounds")
+	}
+
+	var r0 []sqlc.ListDelinquencyCheckRoundsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sqlc.ListDelinquencyCheckRoundsRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []sqlc.ListDelinquencyCheckRoundsRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListDelinquencyCheckRoundsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListDelinquencyCheckRounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDelinquencyCheckRounds'
+type MockStore_ListDelinquencyCheckRounds_Call struct {
+	*mock.Call
+}
+
+// ListDelinquencyCheckRounds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListDelinquencyCheckRounds(ctx interface{}) *MockStore_ListDelinquencyCheckRounds_Call {
+	return &MockStore_ListDelinquencyCheckRounds_Call{Call: _e.mock.On("ListDelinquencyCheckRounds", ctx)}
+}
+
+func (_c *MockStore_ListDelinquencyCheckRounds_Call) Run(run func(ctx context.Context)) *MockStore_ListDelinquencyCheckRounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListDelinquencyCheckRounds_Call) Return(_a0 []sqlc.ListDelinquencyCheckRoundsRow, _a1 error) *MockStore_ListDelinquencyCheckRounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListDelinquencyCheckRounds_Call) RunAndReturn(run func(context.Context) ([]sqlc.ListDelinquencyCheckRoundsRow, error)) *MockStore_ListDelinquencyCheckRounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGroupMemberDelinquencies provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListGroupMemberDelinquencies(ctx context.Context, arg sqlc.ListGroupMemberDelinquenciesParams) ([]sqlc.ListGroupMemberDelinquenciesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGroupMemberDelinquencies")
+	}
+
+	var r0 []sqlc.ListGroupMemberDelinquenciesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListGroupMemberDelinquenciesParams) ([]sqlc.ListGroupMemberDelinquenciesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListGroupMemberDelinquenciesParams) []sqlc.ListGroupMemberDelinquenciesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListGroupMemberDelinquenciesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListGroupMemberDelinquenciesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListGroupMemberDelinquencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGroupMemberDelinquencies'
+type MockStore_ListGroupMemberDelinquencies_Call struct {
+	*mock.Call
+}
+
+// ListGroupMemberDelinquencies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListGroupMemberDelinquenciesParams
+func (_e *MockStore_Expecter) ListGroupMemberDelinquencies(ctx interface{}, arg interface{}) *MockStore_ListGroupMemberDelinquencies_Call {
+	return &MockStore_ListGroupMemberDelinquencies_Call{Call: _e.mock.On("ListGroupMemberDelinquencies", ctx, arg)}
+}
+
+func (_c *MockStore_ListGroupMemberDelinquencies_Call) Run(run func(ctx context.Context, arg sqlc.ListGroupMemberDelinquenciesParams)) *MockStore_ListGroupMemberDelinquencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListGroupMemberDelinquenciesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListGroupMemberDelinquencies_Call) Return(_a0 []sqlc.ListGroupMemberDelinquenciesRow, _a1 error) *MockStore_ListGroupMemberDelinquencies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListGroupMemberDelinquencies_Call) RunAndReturn(run func(context.Context, sqlc.ListGroupMemberDelinquenciesParams) ([]sqlc.ListGroupMemberDelinquenciesRow, error)) *MockStore_ListGroupMemberDelinquencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIndexableRounds provides a mock function with given fields: ctx
+func (_m *MockStore) ListIndexableRounds(ctx context.Context) ([]sqlc.ListIndexableRoundsRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIndexableRounds")
+	}
+
+	var r0 []sqlc.ListIndexableRoundsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sqlc.ListIndexableRoundsRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []sqlc.ListIndexableRoundsRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListIndexableRoundsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListIndexableRounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexableRounds'
+type MockStore_ListIndexableRounds_Call struct {
+	*mock.Call
+}
+
+// ListIndexableRounds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListIndexableRounds(ctx interface{}) *MockStore_ListIndexableRounds_Call {
+	return &MockStore_ListIndexableRounds_Call{Call: _e.mock.On("ListIndexableRounds", ctx)}
+}
+
+func (_c *MockStore_ListIndexableRounds_Call) Run(run func(ctx context.Context)) *MockStore_ListIndexableRounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListIndexableRounds_Call) Return(_a0 []sqlc.ListIndexableRoundsRow, _a1 error) *MockStore_ListIndexableRounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListIndexableRounds_Call) RunAndReturn(run func(context.Context) ([]sqlc.ListIndexableRoundsRow, error)) *MockStore_ListIndexableRounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLedgerEntryPostings provides a mock function with given fields: ctx, entryID
+func (_m *MockStore) ListLedgerEntryPostings(ctx context.Context, entryID uuid.UUID) ([]sqlc.LedgerPosting, error) {
+	ret := _m.Called(ctx, entryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLedgerEntryPostings")
+	}
+
+	var r0 []sqlc.LedgerPosting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.LedgerPosting, error)); ok {
+		return rf(ctx, entryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.LedgerPosting); ok {
+		r0 = rf(ctx, entryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.LedgerPosting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, entryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListLedgerEntryPostings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLedgerEntryPostings'
+type MockStore_ListLedgerEntryPostings_Call struct {
+	*mock.Call
+}
+
+// ListLedgerEntryPostings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entryID uuid.UUID
+func (_e *MockStore_Expecter) ListLedgerEntryPostings(ctx interface{}, entryID interface{}) *MockStore_ListLedgerEntryPostings_Call {
+	return &MockStore_ListLedgerEntryPostings_Call{Call: _e.mock.On("ListLedgerEntryPostings", ctx, entryID)}
+}
+
+func (_c *MockStore_ListLedgerEntryPostings_Call) Run(run func(ctx context.Context, entryID uuid.UUID)) *MockStore_ListLedgerEntryPostings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListLedgerEntryPostings_Call) Return(_a0 []sqlc.LedgerPosting, _a1 error) *MockStore_ListLedgerEntryPostings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListLedgerEntryPostings_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.LedgerPosting, error)) *MockStore_ListLedgerEntryPostings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOpenRoundDiscrepancies provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListOpenRoundDiscrepancies(ctx context.Context, roundID pgtype.UUID) ([]sqlc.RoundDiscrepancy, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOpenRoundDiscrepancies")
+	}
+
+	var r0 []sqlc.RoundDiscrepancy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]sqlc.RoundDiscrepancy, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []sqlc.RoundDiscrepancy); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.RoundDiscrepancy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListOpenRoundDiscrepancies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOpenRoundDiscrepancies'
+type MockStore_ListOpenRoundDiscrepancies_Call struct {
+	*mock.Call
+}
+
+// ListOpenRoundDiscrepancies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID pgtype.UUID
+func (_e *MockStore_Expecter) ListOpenRoundDiscrepancies(ctx interface{}, roundID interface{}) *MockStore_ListOpenRoundDiscrepancies_Call {
+	return &MockStore_ListOpenRoundDiscrepancies_Call{Call: _e.mock.On("ListOpenRoundDiscrepancies", ctx, roundID)}
+}
+
+func (_c *MockStore_ListOpenRoundDiscrepancies_Call) Run(run func(ctx context.Context, roundID pgtype.UUID)) *MockStore_ListOpenRoundDiscrepancies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListOpenRoundDiscrepancies_Call) Return(_a0 []sqlc.RoundDiscrepancy, _a1 error) *MockStore_ListOpenRoundDiscrepancies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListOpenRoundDiscrepancies_Call) RunAndReturn(run func(context.Context, pgtype.UUID) ([]sqlc.RoundDiscrepancy, error)) *MockStore_ListOpenRoundDiscrepancies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrphanedLedgerEntries provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListOrphanedLedgerEntries(ctx context.Context, roundID uuid.UUID) ([]sqlc.LedgerEntry, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrphanedLedgerEntries")
+	}
+
+	var r0 []sqlc.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.LedgerEntry, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.LedgerEntry); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.LedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListOrphanedLedgerEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrphanedLedgerEntries'
+type MockStore_ListOrphanedLedgerEntries_Call struct {
+	*mock.Call
+}
+
+// ListOrphanedLedgerEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListOrphanedLedgerEntries(ctx interface{}, roundID interface{}) *MockStore_ListOrphanedLedgerEntries_Call {
+	return &MockStore_ListOrphanedLedgerEntries_Call{Call: _e.mock.On("ListOrphanedLedgerEntries", ctx, roundID)}
+}
+
+func (_c *MockStore_ListOrphanedLedgerEntries_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListOrphanedLedgerEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListOrphanedLedgerEntries_Call) Return(_a0 []sqlc.LedgerEntry, _a1 error) *MockStore_ListOrphanedLedgerEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListOrphanedLedgerEntries_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.LedgerEntry, error)) *MockStore_ListOrphanedLedgerEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPayoutOrderApprovals provides a mock function with given fields: ctx, payoutOrderID
+func (_m *MockStore) ListPayoutOrderApprovals(ctx context.Context, payoutOrderID uuid.UUID) ([]sqlc.PayoutOrderApproval, error) {
+	ret := _m.Called(ctx, payoutOrderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPayoutOrderApprovals")
+	}
+
+	var r0 []sqlc.PayoutOrderApproval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.PayoutOrderApproval, error)); ok {
+		return rf(ctx, payoutOrderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.PayoutOrderApproval); ok {
+		r0 = rf(ctx, payoutOrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.PayoutOrderApproval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, payoutOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListPayoutOrderApprovals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPayoutOrderApprovals'
+type MockStore_ListPayoutOrderApprovals_Call struct {
+	*mock.Call
+}
+
+// ListPayoutOrderApprovals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payoutOrderID uuid.UUID
+func (_e *MockStore_Expecter) ListPayoutOrderApprovals(ctx interface{}, payoutOrderID interface{}) *MockStore_ListPayoutOrderApprovals_Call {
+	return &MockStore_ListPayoutOrderApprovals_Call{Call: _e.mock.On("ListPayoutOrderApprovals", ctx, payoutOrderID)}
+}
+
+func (_c *MockStore_ListPayoutOrderApprovals_Call) Run(run func(ctx context.Context, payoutOrderID uuid.UUID)) *MockStore_ListPayoutOrderApprovals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPayoutOrderApprovals_Call) Return(_a0 []sqlc.PayoutOrderApproval, _a1 error) *MockStore_ListPayoutOrderApprovals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListPayoutOrderApprovals_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.PayoutOrderApproval, error)) *MockStore_ListPayoutOrderApprovals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPayoutOrderBids provides a mock function with given fields: ctx, payoutOrderID
+func (_m *MockStore) ListPayoutOrderBids(ctx context.Context, payoutOrderID uuid.UUID) ([]sqlc.PayoutOrderBid, error) {
+	ret := _m.Called(ctx, payoutOrderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPayoutOrderBids")
+	}
+
+	var r0 []sqlc.PayoutOrderBid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.PayoutOrderBid, error)); ok {
+		return rf(ctx, payoutOrderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.PayoutOrderBid); ok {
+		r0 = rf(ctx, payoutOrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.PayoutOrderBid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, payoutOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListPayoutOrderBids_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPayoutOrderBids'
+type MockStore_ListPayoutOrderBids_Call struct {
+	*mock.Call
+}
+
+// ListPayoutOrderBids is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payoutOrderID uuid.UUID
+func (_e *MockStore_Expecter) ListPayoutOrderBids(ctx interface{}, payoutOrderID interface{}) *MockStore_ListPayoutOrderBids_Call {
+	return &MockStore_ListPayoutOrderBids_Call{Call: _e.mock.On("ListPayoutOrderBids", ctx, payoutOrderID)}
+}
+
+func (_c *MockStore_ListPayoutOrderBids_Call) Run(run func(ctx context.Context, payoutOrderID uuid.UUID)) *MockStore_ListPayoutOrderBids_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPayoutOrderBids_Call) Return(_a0 []sqlc.PayoutOrderBid, _a1 error) *MockStore_ListPayoutOrderBids_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListPayoutOrderBids_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.PayoutOrderBid, error)) *MockStore_ListPayoutOrderBids_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPurgeableGroups provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListPurgeableGroups(ctx context.Context, arg sqlc.ListPurgeableGroupsParams) ([]sqlc.ListPurgeableGroupsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPurgeableGroups")
+	}
+
+	var r0 []sqlc.ListPurgeableGroupsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListPurgeableGroupsParams) ([]sqlc.ListPurgeableGroupsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListPurgeableGroupsParams) []sqlc.ListPurgeableGroupsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListPurgeableGroupsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListPurgeableGroupsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListPurgeableGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPurgeableGroups'
+type MockStore_ListPurgeableGroups_Call struct {
+	*mock.Call
+}
+
+// ListPurgeableGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListPurgeableGroupsParams
+func (_e *MockStore_Expecter) ListPurgeableGroups(ctx interface{}, arg interface{}) *MockStore_ListPurgeableGroups_Call {
+	return &MockStore_ListPurgeableGroups_Call{Call: _e.mock.On("ListPurgeableGroups", ctx, arg)}
+}
+
+func (_c *MockStore_ListPurgeableGroups_Call) Run(run func(ctx context.Context, arg sqlc.ListPurgeableGroupsParams)) *MockStore_ListPurgeableGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListPurgeableGroupsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListPurgeableGroups_Call) Return(_a0 []sqlc.ListPurgeableGroupsRow, _a1 error) *MockStore_ListPurgeableGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListPurgeableGroups_Call) RunAndReturn(run func(context.Context, sqlc.ListPurgeableGroupsParams) ([]sqlc.ListPurgeableGroupsRow, error)) *MockStore_ListPurgeableGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReconcileRounds provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListReconcileRounds(ctx context.Context, roundID pgtype.UUID) ([]sqlc.ListReconcileRoundsRow, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReconcileRounds")
+	}
+
+	var r0 []sqlc.ListReconcileRoundsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.UUID) ([]sqlc.ListReconcileRoundsRow, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.UUID) []sqlc.ListReconcileRoundsRow); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListReconcileRoundsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListReconcileRounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReconcileRounds'
+type MockStore_ListReconcileRounds_Call struct {
+	*mock.Call
+}
+
+// ListReconcileRounds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID pgtype.UUID
+func (_e *MockStore_Expecter) ListReconcileRounds(ctx interface{}, roundID interface{}) *MockStore_ListReconcileRounds_Call {
+	return &MockStore_ListReconcileRounds_Call{Call: _e.mock.On("ListReconcileRounds", ctx, roundID)}
+}
+
+func (_c *MockStore_ListReconcileRounds_Call) Run(run func(ctx context.Context, roundID pgtype.UUID)) *MockStore_ListReconcileRounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListReconcileRounds_Call) Return(_a0 []sqlc.ListReconcileRoundsRow, _a1 error) *MockStore_ListReconcileRounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListReconcileRounds_Call) RunAndReturn(run func(context.Context, pgtype.UUID) ([]sqlc.ListReconcileRoundsRow, error)) *MockStore_ListReconcileRounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReminderRecipients provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListReminderRecipients(ctx context.Context, roundID uuid.UUID) ([]sqlc.ListReminderRecipientsRow, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReminderRecipients")
+	}
+
+	var r0 []sqlc.ListReminderRecipientsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.ListReminderRecipientsRow, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.ListReminderRecipientsRow); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListReminderRecipientsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListReminderRecipients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReminderRecipients'
+type MockStore_ListReminderRecipients_Call struct {
+	*mock.Call
+}
+
+// ListReminderRecipients is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListReminderRecipients(ctx interface{}, roundID interface{}) *MockStore_ListReminderRecipients_Call {
+	return &MockStore_ListReminderRecipients_Call{Call: _e.mock.On("ListReminderRecipients", ctx, roundID)}
+}
+
+func (_c *MockStore_ListReminderRecipients_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListReminderRecipients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListReminderRecipients_Call) Return(_a0 []sqlc.ListReminderRecipientsRow, _a1 error) *MockStore_ListReminderRecipients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListReminderRecipients_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.ListReminderRecipientsRow, error)) *MockStore_ListReminderRecipients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReminderRounds provides a mock function with given fields: ctx
+func (_m *MockStore) ListReminderRounds(ctx context.Context) ([]sqlc.ListReminderRoundsRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReminderRounds")
+	}
+
+	var r0 []sqlc.ListReminderRoundsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sqlc.ListReminderRoundsRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []sqlc.ListReminderRoundsRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListReminderRoundsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListReminderRounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReminderRounds'
+type MockStore_ListReminderRounds_Call struct {
+	*mock.Call
+}
+
+// ListReminderRounds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) ListReminderRounds(ctx interface{}) *MockStore_ListReminderRounds_Call {
+	return &MockStore_ListReminderRounds_Call{Call: _e.mock.On("ListReminderRounds", ctx)}
+}
+
+func (_c *MockStore_ListReminderRounds_Call) Run(run func(ctx context.Context)) *MockStore_ListReminderRounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListReminderRounds_Call) Return(_a0 []sqlc.ListReminderRoundsRow, _a1 error) *MockStore_ListReminderRounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListReminderRounds_Call) RunAndReturn(run func(context.Context) ([]sqlc.ListReminderRoundsRow, error)) *MockStore_ListReminderRounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundActivity provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListRoundActivity(ctx context.Context, arg sqlc.ListRoundActivityParams) ([]sqlc.ListRoundActivityRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundActivity")
+	}
+
+	var r0 []sqlc.ListRoundActivityRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListRoundActivityParams) ([]sqlc.ListRoundActivityRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListRoundActivityParams) []sqlc.ListRoundActivityRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListRoundActivityRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListRoundActivityParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundActivity'
+type MockStore_ListRoundActivity_Call struct {
+	*mock.Call
+}
+
+// ListRoundActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListRoundActivityParams
+func (_e *MockStore_Expecter) ListRoundActivity(ctx interface{}, arg interface{}) *MockStore_ListRoundActivity_Call {
+	return &MockStore_ListRoundActivity_Call{Call: _e.mock.On("ListRoundActivity", ctx, arg)}
+}
+
+func (_c *MockStore_ListRoundActivity_Call) Run(run func(ctx context.Context, arg sqlc.ListRoundActivityParams)) *MockStore_ListRoundActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListRoundActivityParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundActivity_Call) Return(_a0 []sqlc.ListRoundActivityRow, _a1 error) *MockStore_ListRoundActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundActivity_Call) RunAndReturn(run func(context.Context, sqlc.ListRoundActivityParams) ([]sqlc.ListRoundActivityRow, error)) *MockStore_ListRoundActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundContributionPeriods provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundContributionPeriods(ctx context.Context, roundID uuid.UUID) ([]sqlc.ListRoundContributionPeriodsRow, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundContributionPeriods")
+	}
+
+	var r0 []sqlc.ListRoundContributionPeriodsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.ListRoundContributionPeriodsRow, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.ListRoundContributionPeriodsRow); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListRoundContributionPeriodsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundContributionPeriods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundContributionPeriods'
+type MockStore_ListRoundContributionPeriods_Call struct {
+	*mock.Call
+}
+
+// ListRoundContributionPeriods is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundContributionPeriods(ctx interface{}, roundID interface{}) *MockStore_ListRoundContributionPeriods_Call {
+	return &MockStore_ListRoundContributionPeriods_Call{Call: _e.mock.On("ListRoundContributionPeriods", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundContributionPeriods_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundContributionPeriods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundContributionPeriods_Call) Return(_a0 []sqlc.ListRoundContributionPeriodsRow, _a1 error) *MockStore_ListRoundContributionPeriods_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundContributionPeriods_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.ListRoundContributionPeriodsRow, error)) *MockStore_ListRoundContributionPeriods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundDelinquencies provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundDelinquencies(ctx context.Context, roundID uuid.UUID) ([]sqlc.RoundDelinquency, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundDelinquencies")
+	}
+
+	var r0 []sqlc.RoundDelinquency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.RoundDelinquency, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.RoundDelinquency); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.RoundDelinquency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundDelinquencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundDelinquencies'
+type MockStore_ListRoundDelinquencies_Call struct {
+	*mock.Call
+}
+
+// ListRoundDelinquencies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundDelinquencies(ctx interface{}, roundID interface{}) *MockStore_ListRoundDelinquencies_Call {
+	return &MockStore_ListRoundDelinquencies_Call{Call: _e.mock.On("ListRoundDelinquencies", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundDelinquencies_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundDelinquencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundDelinquencies_Call) Return(_a0 []sqlc.RoundDelinquency, _a1 error) *MockStore_ListRoundDelinquencies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundDelinquencies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.RoundDelinquency, error)) *MockStore_ListRoundDelinquencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundEvents provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundEvents(ctx context.Context, roundID uuid.UUID) ([]sqlc.RoundEvent, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundEvents")
+	}
+
+	var r0 []sqlc.RoundEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.RoundEvent, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.RoundEvent); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.RoundEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundEvents'
+type MockStore_ListRoundEvents_Call struct {
+	*mock.Call
+}
+
+// ListRoundEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundEvents(ctx interface{}, roundID interface{}) *MockStore_ListRoundEvents_Call {
+	return &MockStore_ListRoundEvents_Call{Call: _e.mock.On("ListRoundEvents", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundEvents_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundEvents_Call) Return(_a0 []sqlc.RoundEvent, _a1 error) *MockStore_ListRoundEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.RoundEvent, error)) *MockStore_ListRoundEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundEventsBetween provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListRoundEventsBetween(ctx context.Context, arg sqlc.ListRoundEventsBetweenParams) ([]sqlc.RoundEvent, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundEventsBetween")
+	}
+
+	var r0 []sqlc.RoundEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListRoundEventsBetweenParams) ([]sqlc.RoundEvent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListRoundEventsBetweenParams) []sqlc.RoundEvent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.RoundEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListRoundEventsBetweenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundEventsBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundEventsBetween'
+type MockStore_ListRoundEventsBetween_Call struct {
+	*mock.Call
+}
+
+// ListRoundEventsBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListRoundEventsBetweenParams
+func (_e *MockStore_Expecter) ListRoundEventsBetween(ctx interface{}, arg interface{}) *MockStore_ListRoundEventsBetween_Call {
+	return &MockStore_ListRoundEventsBetween_Call{Call: _e.mock.On("ListRoundEventsBetween", ctx, arg)}
+}
+
+func (_c *MockStore_ListRoundEventsBetween_Call) Run(run func(ctx context.Context, arg sqlc.ListRoundEventsBetweenParams)) *MockStore_ListRoundEventsBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListRoundEventsBetweenParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundEventsBetween_Call) Return(_a0 []sqlc.RoundEvent, _a1 error) *MockStore_ListRoundEventsBetween_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundEventsBetween_Call) RunAndReturn(run func(context.Context, sqlc.ListRoundEventsBetweenParams) ([]sqlc.RoundEvent, error)) *MockStore_ListRoundEventsBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundHourlyContributions provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundHourlyContributions(ctx context.Context, roundID uuid.UUID) ([]sqlc.LedgerHourlyContribution, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundHourlyContributions")
+	}
+
+	var r0 []sqlc.LedgerHourlyContribution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.LedgerHourlyContribution, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.LedgerHourlyContribution); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.LedgerHourlyContribution)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundHourlyContributions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundHourlyContributions'
+type MockStore_ListRoundHourlyContributions_Call struct {
+	*mock.Call
+}
+
+// ListRoundHourlyContributions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundHourlyContributions(ctx interface{}, roundID interface{}) *MockStore_ListRoundHourlyContributions_Call {
+	return &MockStore_ListRoundHourlyContributions_Call{Call: _e.mock.On("ListRoundHourlyContributions", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundHourlyContributions_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundHourlyContributions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundHourlyContributions_Call) Return(_a0 []sqlc.LedgerHourlyContribution, _a1 error) *MockStore_ListRoundHourlyContributions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundHourlyContributions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.LedgerHourlyContribution, error)) *MockStore_ListRoundHourlyContributions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundIndexerBackfills provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundIndexerBackfills(ctx context.Context, roundID uuid.UUID) ([]sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundIndexerBackfills")
+	}
+
+	var r0 []sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.IndexerBackfill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundIndexerBackfills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundIndexerBackfills'
+type MockStore_ListRoundIndexerBackfills_Call struct {
+	*mock.Call
+}
+
+// ListRoundIndexerBackfills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundIndexerBackfills(ctx interface{}, roundID interface{}) *MockStore_ListRoundIndexerBackfills_Call {
+	return &MockStore_ListRoundIndexerBackfills_Call{Call: _e.mock.On("ListRoundIndexerBackfills", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundIndexerBackfills_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundIndexerBackfills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundIndexerBackfills_Call) Return(_a0 []sqlc.IndexerBackfill, _a1 error) *MockStore_ListRoundIndexerBackfills_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundIndexerBackfills_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.IndexerBackfill, error)) *MockStore_ListRoundIndexerBackfills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundLedgerAccounts provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundLedgerAccounts(ctx context.Context, roundID uuid.UUID) ([]sqlc.LedgerAccount, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundLedgerAccounts")
+	}
+
+	var r0 []sqlc.LedgerAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.LedgerAccount, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.LedgerAccount); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.LedgerAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundLedgerAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundLedgerAccounts'
+type MockStore_ListRoundLedgerAccounts_Call struct {
+	*mock.Call
+}
+
+// ListRoundLedgerAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundLedgerAccounts(ctx interface{}, roundID interface{}) *MockStore_ListRoundLedgerAccounts_Call {
+	return &MockStore_ListRoundLedgerAccounts_Call{Call: _e.mock.On("ListRoundLedgerAccounts", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundLedgerAccounts_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundLedgerAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundLedgerAccounts_Call) Return(_a0 []sqlc.LedgerAccount, _a1 error) *MockStore_ListRoundLedgerAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundLedgerAccounts_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.LedgerAccount, error)) *MockStore_ListRoundLedgerAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundLedgerPostings provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundLedgerPostings(ctx context.Context, roundID uuid.UUID) ([]sqlc.ListRoundLedgerPostingsRow, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundLedgerPostings")
+	}
+
+	var r0 []sqlc.ListRoundLedgerPostingsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.ListRoundLedgerPostingsRow, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.ListRoundLedgerPostingsRow); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListRoundLedgerPostingsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundLedgerPostings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundLedgerPostings'
+type MockStore_ListRoundLedgerPostings_Call struct {
+	*mock.Call
+}
+
+// ListRoundLedgerPostings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundLedgerPostings(ctx interface{}, roundID interface{}) *MockStore_ListRoundLedgerPostings_Call {
+	return &MockStore_ListRoundLedgerPostings_Call{Call: _e.mock.On("ListRoundLedgerPostings", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundLedgerPostings_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundLedgerPostings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundLedgerPostings_Call) Return(_a0 []sqlc.ListRoundLedgerPostingsRow, _a1 error) *MockStore_ListRoundLedgerPostings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundLedgerPostings_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.ListRoundLedgerPostingsRow, error)) *MockStore_ListRoundLedgerPostings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundMembers provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundMembers(ctx context.Context, roundID uuid.UUID) ([]sqlc.RoundMember, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundMembers")
+	}
+
+	var r0 []sqlc.RoundMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.RoundMember, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.RoundMember); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.RoundMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundMembers'
+type MockStore_ListRoundMembers_Call struct {
+	*mock.Call
+}
+
+// ListRoundMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundMembers(ctx interface{}, roundID interface{}) *MockStore_ListRoundMembers_Call {
+	return &MockStore_ListRoundMembers_Call{Call: _e.mock.On("ListRoundMembers", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundMembers_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundMembers_Call) Return(_a0 []sqlc.RoundMember, _a1 error) *MockStore_ListRoundMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundMembers_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.RoundMember, error)) *MockStore_ListRoundMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundPeriodTotals provides a mock function with given fields: ctx, roundIds
+func (_m *MockStore) ListRoundPeriodTotals(ctx context.Context, roundIds []uuid.UUID) ([]sqlc.RoundPeriodTotal, error) {
+	ret := _m.Called(ctx, roundIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundPeriodTotals")
+	}
+
+	var r0 []sqlc.RoundPeriodTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]sqlc.RoundPeriodTotal, error)); ok {
+		return rf(ctx, roundIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []sqlc.RoundPeriodTotal); ok {
+		r0 = rf(ctx, roundIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.RoundPeriodTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, roundIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundPeriodTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundPeriodTotals'
+type MockStore_ListRoundPeriodTotals_Call struct {
+	*mock.Call
+}
+
+// ListRoundPeriodTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundIds []uuid.UUID
+func (_e *MockStore_Expecter) ListRoundPeriodTotals(ctx interface{}, roundIds interface{}) *MockStore_ListRoundPeriodTotals_Call {
+	return &MockStore_ListRoundPeriodTotals_Call{Call: _e.mock.On("ListRoundPeriodTotals", ctx, roundIds)}
+}
+
+func (_c *MockStore_ListRoundPeriodTotals_Call) Run(run func(ctx context.Context, roundIds []uuid.UUID)) *MockStore_ListRoundPeriodTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundPeriodTotals_Call) Return(_a0 []sqlc.RoundPeriodTotal, _a1 error) *MockStore_ListRoundPeriodTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundPeriodTotals_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]sqlc.RoundPeriodTotal, error)) *MockStore_ListRoundPeriodTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundSlotSwaps provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListRoundSlotSwaps(ctx context.Context, arg sqlc.ListRoundSlotSwapsParams) ([]sqlc.SlotSwap, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundSlotSwaps")
+	}
+
+	var r0 []sqlc.SlotSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListRoundSlotSwapsParams) ([]sqlc.SlotSwap, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListRoundSlotSwapsParams) []sqlc.SlotSwap); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.SlotSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListRoundSlotSwapsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundSlotSwaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundSlotSwaps'
+type MockStore_ListRoundSlotSwaps_Call struct {
+	*mock.Call
+}
+
+// ListRoundSlotSwaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListRoundSlotSwapsParams
+func (_e *MockStore_Expecter) ListRoundSlotSwaps(ctx interface{}, arg interface{}) *MockStore_ListRoundSlotSwaps_Call {
+	return &MockStore_ListRoundSlotSwaps_Call{Call: _e.mock.On("ListRoundSlotSwaps", ctx, arg)}
+}
+
+func (_c *MockStore_ListRoundSlotSwaps_Call) Run(run func(ctx context.Context, arg sqlc.ListRoundSlotSwapsParams)) *MockStore_ListRoundSlotSwaps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListRoundSlotSwapsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundSlotSwaps_Call) Return(_a0 []sqlc.SlotSwap, _a1 error) *MockStore_ListRoundSlotSwaps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundSlotSwaps_Call) RunAndReturn(run func(context.Context, sqlc.ListRoundSlotSwapsParams) ([]sqlc.SlotSwap, error)) *MockStore_ListRoundSlotSwaps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoundSwapEvents provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListRoundSwapEvents(ctx context.Context, roundID uuid.UUID) ([]sqlc.ListRoundSwapEventsRow, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoundSwapEvents")
+	}
+
+	var r0 []sqlc.ListRoundSwapEventsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.ListRoundSwapEventsRow, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.ListRoundSwapEventsRow); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListRoundSwapEventsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListRoundSwapEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoundSwapEvents'
+type MockStore_ListRoundSwapEvents_Call struct {
+	*mock.Call
+}
+
+// ListRoundSwapEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListRoundSwapEvents(ctx interface{}, roundID interface{}) *MockStore_ListRoundSwapEvents_Call {
+	return &MockStore_ListRoundSwapEvents_Call{Call: _e.mock.On("ListRoundSwapEvents", ctx, roundID)}
+}
+
+func (_c *MockStore_ListRoundSwapEvents_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListRoundSwapEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListRoundSwapEvents_Call) Return(_a0 []sqlc.ListRoundSwapEventsRow, _a1 error) *MockStore_ListRoundSwapEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListRoundSwapEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.ListRoundSwapEventsRow, error)) *MockStore_ListRoundSwapEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnjournaledRoundEvents provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ListUnjournaledRoundEvents(ctx context.Context, roundID uuid.UUID) ([]sqlc.RoundEvent, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnjournaledRoundEvents")
+	}
+
+	var r0 []sqlc.RoundEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.RoundEvent, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.RoundEvent); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.RoundEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListUnjournaledRoundEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnjournaledRoundEvents'
+type MockStore_ListUnjournaledRoundEvents_Call struct {
+	*mock.Call
+}
+
+// ListUnjournaledRoundEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ListUnjournaledRoundEvents(ctx interface{}, roundID interface{}) *MockStore_ListUnjournaledRoundEvents_Call {
+	return &MockStore_ListUnjournaledRoundEvents_Call{Call: _e.mock.On("ListUnjournaledRoundEvents", ctx, roundID)}
+}
+
+func (_c *MockStore_ListUnjournaledRoundEvents_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ListUnjournaledRoundEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListUnjournaledRoundEvents_Call) Return(_a0 []sqlc.RoundEvent, _a1 error) *MockStore_ListUnjournaledRoundEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListUnjournaledRoundEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.RoundEvent, error)) *MockStore_ListUnjournaledRoundEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserGroups provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUserGroups(ctx context.Context, arg sqlc.ListUserGroupsParams) ([]sqlc.ListUserGroupsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserGroups")
+	}
+
+	var r0 []sqlc.ListUserGroupsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserGroupsParams) ([]sqlc.ListUserGroupsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserGroupsParams) []sqlc.ListUserGroupsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListUserGroupsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListUserGroupsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserGroups'
+type MockStore_ListUserGroups_Call struct {
+	*mock.Call
+}
+
+// ListUserGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListUserGroupsParams
+func (_e *MockStore_Expecter) ListUserGroups(ctx interface{}, arg interface{}) *MockStore_ListUserGroups_Call {
+	return &MockStore_ListUserGroups_Call{Call: _e.mock.On("ListUserGroups", ctx, arg)}
+}
+
+func (_c *MockStore_ListUserGroups_Call) Run(run func(ctx context.Context, arg sqlc.ListUserGroupsParams)) *MockStore_ListUserGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListUserGroupsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListUserGroups_Call) Return(_a0 []sqlc.ListUserGroupsRow, _a1 error) *MockStore_ListUserGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListUserGroups_Call) RunAndReturn(run func(context.Context, sqlc.ListUserGroupsParams) ([]sqlc.ListUserGroupsRow, error)) *MockStore_ListUserGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserPendingInvites provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUserPendingInvites(ctx context.Context, arg sqlc.ListUserPendingInvitesParams) ([]sqlc.ListUserPendingInvitesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserPendingInvites")
+	}
+
+	var r0 []sqlc.ListUserPendingInvitesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserPendingInvitesParams) ([]sqlc.ListUserPendingInvitesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserPendingInvitesParams) []sqlc.ListUserPendingInvitesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListUserPendingInvitesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListUserPendingInvitesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListUserPendingInvites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserPendingInvites'
+type MockStore_ListUserPendingInvites_Call struct {
+	*mock.Call
+}
+
+// ListUserPendingInvites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListUserPendingInvitesParams
+func (_e *MockStore_Expecter) ListUserPendingInvites(ctx interface{}, arg interface{}) *MockStore_ListUserPendingInvites_Call {
+	return &MockStore_ListUserPendingInvites_Call{Call: _e.mock.On("ListUserPendingInvites", ctx, arg)}
+}
+
+func (_c *MockStore_ListUserPendingInvites_Call) Run(run func(ctx context.Context, arg sqlc.ListUserPendingInvitesParams)) *MockStore_ListUserPendingInvites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListUserPendingInvitesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListUserPendingInvites_Call) Return(_a0 []sqlc.ListUserPendingInvitesRow, _a1 error) *MockStore_ListUserPendingInvites_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListUserPendingInvites_Call) RunAndReturn(run func(context.Context, sqlc.ListUserPendingInvitesParams) ([]sqlc.ListUserPendingInvitesRow, error)) *MockStore_ListUserPendingInvites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserRecentActivity provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUserRecentActivity(ctx context.Context, arg sqlc.ListUserRecentActivityParams) ([]sqlc.ListUserRecentActivityRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserRecentActivity")
+	}
+
+	var r0 []sqlc.ListUserRecentActivityRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserRecentActivityParams) ([]sqlc.ListUserRecentActivityRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserRecentActivityParams) []sqlc.ListUserRecentActivityRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListUserRecentActivityRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListUserRecentActivityParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListUserRecentActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRecentActivity'
+type MockStore_ListUserRecentActivity_Call struct {
+	*mock.Call
+}
+
+// ListUserRecentActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListUserRecentActivityParams
+func (_e *MockStore_Expecter) ListUserRecentActivity(ctx interface{}, arg interface{}) *MockStore_ListUserRecentActivity_Call {
+	return &MockStore_ListUserRecentActivity_Call{Call: _e.mock.On("ListUserRecentActivity", ctx, arg)}
+}
+
+func (_c *MockStore_ListUserRecentActivity_Call) Run(run func(ctx context.Context, arg sqlc.ListUserRecentActivityParams)) *MockStore_ListUserRecentActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListUserRecentActivityParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListUserRecentActivity_Call) Return(_a0 []sqlc.ListUserRecentActivityRow, _a1 error) *MockStore_ListUserRecentActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListUserRecentActivity_Call) RunAndReturn(run func(context.Context, sqlc.ListUserRecentActivityParams) ([]sqlc.ListUserRecentActivityRow, error)) *MockStore_ListUserRecentActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserRoundMemberships provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUserRoundMemberships(ctx context.Context, arg sqlc.ListUserRoundMembershipsParams) ([]sqlc.ListUserRoundMembershipsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserRoundMemberships")
+	}
+
+	var r0 []sqlc.ListUserRoundMembershipsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserRoundMembershipsParams) ([]sqlc.ListUserRoundMembershipsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserRoundMembershipsParams) []sqlc.ListUserRoundMembershipsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListUserRoundMembershipsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListUserRoundMembershipsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListUserRoundMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRoundMemberships'
+type MockStore_ListUserRoundMemberships_Call struct {
+	*mock.Call
+}
+
+// ListUserRoundMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListUserRoundMembershipsParams
+func (_e *MockStore_Expecter) ListUserRoundMemberships(ctx interface{}, arg interface{}) *MockStore_ListUserRoundMemberships_Call {
+	return &MockStore_ListUserRoundMemberships_Call{Call: _e.mock.On("ListUserRoundMemberships", ctx, arg)}
+}
+
+func (_c *MockStore_ListUserRoundMemberships_Call) Run(run func(ctx context.Context, arg sqlc.ListUserRoundMembershipsParams)) *MockStore_ListUserRoundMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListUserRoundMembershipsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListUserRoundMemberships_Call) Return(_a0 []sqlc.ListUserRoundMembershipsRow, _a1 error) *MockStore_ListUserRoundMemberships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListUserRoundMemberships_Call) RunAndReturn(run func(context.Context, sqlc.ListUserRoundMembershipsParams) ([]sqlc.ListUserRoundMembershipsRow, error)) *MockStore_ListUserRoundMemberships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserRounds provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ListUserRounds(ctx context.Context, arg sqlc.ListUserRoundsParams) ([]sqlc.ListUserRoundsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserRounds")
+	}
+
+	var r0 []sqlc.ListUserRoundsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserRoundsParams) ([]sqlc.ListUserRoundsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListUserRoundsParams) []sqlc.ListUserRoundsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListUserRoundsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListUserRoundsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ListUserRounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRounds'
+type MockStore_ListUserRounds_Call struct {
+	*mock.Call
+}
+
+// ListUserRounds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ListUserRoundsParams
+func (_e *MockStore_Expecter) ListUserRounds(ctx interface{}, arg interface{}) *MockStore_ListUserRounds_Call {
+	return &MockStore_ListUserRounds_Call{Call: _e.mock.On("ListUserRounds", ctx, arg)}
+}
+
+func (_c *MockStore_ListUserRounds_Call) Run(run func(ctx context.Context, arg sqlc.ListUserRoundsParams)) *MockStore_ListUserRounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ListUserRoundsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ListUserRounds_Call) Return(_a0 []sqlc.ListUserRoundsRow, _a1 error) *MockStore_ListUserRounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ListUserRounds_Call) RunAndReturn(run func(context.Context, sqlc.ListUserRoundsParams) ([]sqlc.ListUserRoundsRow, error)) *MockStore_ListUserRounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockGroup provides a mock function with given fields: ctx, id
+func (_m *MockStore) LockGroup(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_LockGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockGroup'
+type MockStore_LockGroup_Call struct {
+	*mock.Call
+}
+
+// LockGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) LockGroup(ctx interface{}, id interface{}) *MockStore_LockGroup_Call {
+	return &MockStore_LockGroup_Call{Call: _e.mock.On("LockGroup", ctx, id)}
+}
+
+func (_c *MockStore_LockGroup_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_LockGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_LockGroup_Call) Return(_a0 error) *MockStore_LockGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_LockGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_LockGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockGroupMember provides a mock function with given fields: ctx, id
+func (_m *MockStore) LockGroupMember(ctx context.Context, id uuid.UUID) (sqlc.GroupMember, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockGroupMember")
+	}
+
+	var r0 sqlc.GroupMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.GroupMember, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.GroupMember); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.GroupMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_LockGroupMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockGroupMember'
+type MockStore_LockGroupMember_Call struct {
+	*mock.Call
+}
+
+// LockGroupMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) LockGroupMember(ctx interface{}, id interface{}) *MockStore_LockGroupMember_Call {
+	return &MockStore_LockGroupMember_Call{Call: _e.mock.On("LockGroupMember", ctx, id)}
+}
+
+func (_c *MockStore_LockGroupMember_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_LockGroupMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_LockGroupMember_Call) Return(_a0 sqlc.GroupMember, _a1 error) *MockStore_LockGroupMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_LockGroupMember_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.GroupMember, error)) *MockStore_LockGroupMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockRoundEvents provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) LockRoundEvents(ctx context.Context, roundID uuid.UUID) error {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockRoundEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_LockRoundEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockRoundEvents'
+type MockStore_LockRoundEvents_Call struct {
+	*mock.Call
+}
+
+// LockRoundEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) LockRoundEvents(ctx interface{}, roundID interface{}) *MockStore_LockRoundEvents_Call {
+	return &MockStore_LockRoundEvents_Call{Call: _e.mock.On("LockRoundEvents", ctx, roundID)}
+}
+
+func (_c *MockStore_LockRoundEvents_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_LockRoundEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_LockRoundEvents_Call) Return(_a0 error) *MockStore_LockRoundEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_LockRoundEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_LockRoundEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkGroupMemberRemoved provides a mock function with given fields: ctx, id
+func (_m *MockStore) MarkGroupMemberRemoved(ctx context.Context, id uuid.UUID) (sqlc.GroupMember, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkGroupMemberRemoved")
+	}
+
+	var r0 sqlc.GroupMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.GroupMember, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.GroupMember); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.GroupMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_MarkGroupMemberRemoved_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkGroupMemberRemoved'
+type MockStore_MarkGroupMemberRemoved_Call struct {
+	*mock.Call
+}
+
+// MarkGroupMemberRemoved is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) MarkGroupMemberRemoved(ctx interface{}, id interface{}) *MockStore_MarkGroupMemberRemoved_Call {
+	return &MockStore_MarkGroupMemberRemoved_Call{Call: _e.mock.On("MarkGroupMemberRemoved", ctx, id)}
+}
+
+func (_c *MockStore_MarkGroupMemberRemoved_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_MarkGroupMemberRemoved_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_MarkGroupMemberRemoved_Call) Return(_a0 sqlc.GroupMember, _a1 error) *MockStore_MarkGroupMemberRemoved_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_MarkGroupMemberRemoved_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.GroupMember, error)) *MockStore_MarkGroupMemberRemoved_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkMagicLinkAsUsed provides a mock function with given fields: ctx, id
+func (_m *MockStore) MarkMagicLinkAsUsed(ctx context.Context, id uuid.UUID) (sqlc.MagicLink, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkMagicLinkAsUsed")
+	}
+
+	var r0 sqlc.MagicLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.MagicLink, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.MagicLink); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.MagicLink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_MarkMagicLinkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkMagicLinkAsUsed'
+type MockStore_MarkMagicLinkAsUsed_Call struct {
+	*mock.Call
+}
+
+// MarkMagicLinkAsUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) MarkMagicLinkAsUsed(ctx interface{}, id interface{}) *MockStore_MarkMagicLinkAsUsed_Call {
+	return &MockStore_MarkMagicLinkAsUsed_Call{Call: _e.mock.On("MarkMagicLinkAsUsed", ctx, id)}
+}
+
+func (_c *MockStore_MarkMagicLinkAsUsed_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_MarkMagicLinkAsUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_MarkMagicLinkAsUsed_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_MarkMagicLinkAsUsed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_MarkMagicLinkAsUsed_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.MagicLink, error)) *MockStore_MarkMagicLinkAsUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSlotSwapsApplied provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) MarkSlotSwapsApplied(ctx context.Context, roundID uuid.UUID) error {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSlotSwapsApplied")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MarkSlotSwapsApplied_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSlotSwapsApplied'
+type MockStore_MarkSlotSwapsApplied_Call struct {
+	*mock.Call
+}
+
+// MarkSlotSwapsApplied is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) MarkSlotSwapsApplied(ctx interface{}, roundID interface{}) *MockStore_MarkSlotSwapsApplied_Call {
+	return &MockStore_MarkSlotSwapsApplied_Call{Call: _e.mock.On("MarkSlotSwapsApplied", ctx, roundID)}
+}
+
+func (_c *MockStore_MarkSlotSwapsApplied_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_MarkSlotSwapsApplied_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_MarkSlotSwapsApplied_Call) Return(_a0 error) *MockStore_MarkSlotSwapsApplied_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MarkSlotSwapsApplied_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_MarkSlotSwapsApplied_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenSlotSwapExists provides a mock function with given fields: ctx, arg
+func (_m *MockStore) OpenSlotSwapExists(ctx context.Context, arg sqlc.OpenSlotSwapExistsParams) (bool, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenSlotSwapExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.OpenSlotSwapExistsParams) (bool, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.OpenSlotSwapExistsParams) bool); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.OpenSlotSwapExistsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_OpenSlotSwapExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenSlotSwapExists'
+type MockStore_OpenSlotSwapExists_Call struct {
+	*mock.Call
+}
+
+// OpenSlotSwapExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.OpenSlotSwapExistsParams
+func (_e *MockStore_Expecter) OpenSlotSwapExists(ctx interface{}, arg interface{}) *MockStore_OpenSlotSwapExists_Call {
+	return &MockStore_OpenSlotSwapExists_Call{Call: _e.mock.On("OpenSlotSwapExists", ctx, arg)}
+}
+
+func (_c *MockStore_OpenSlotSwapExists_Call) Run(run func(ctx context.Context, arg sqlc.OpenSlotSwapExistsParams)) *MockStore_OpenSlotSwapExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.OpenSlotSwapExistsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_OpenSlotSwapExists_Call) Return(_a0 bool, _a1 error) *MockStore_OpenSlotSwapExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_OpenSlotSwapExists_Call) RunAndReturn(run func(context.Context, sqlc.OpenSlotSwapExistsParams) (bool, error)) *MockStore_OpenSlotSwapExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetRoundMemberPositions provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) ResetRoundMemberPositions(ctx context.Context, roundID uuid.UUID) error {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetRoundMemberPositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_ResetRoundMemberPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetRoundMemberPositions'
+type MockStore_ResetRoundMemberPositions_Call struct {
+	*mock.Call
+}
+
+// ResetRoundMemberPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) ResetRoundMemberPositions(ctx interface{}, roundID interface{}) *MockStore_ResetRoundMemberPositions_Call {
+	return &MockStore_ResetRoundMemberPositions_Call{Call: _e.mock.On("ResetRoundMemberPositions", ctx, roundID)}
+}
+
+func (_c *MockStore_ResetRoundMemberPositions_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_ResetRoundMemberPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ResetRoundMemberPositions_Call) Return(_a0 error) *MockStore_ResetRoundMemberPositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_ResetRoundMemberPositions_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_ResetRoundMemberPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveRoundDiscrepancies provides a mock function with given fields: ctx, arg
+func (_m *MockStore) ResolveRoundDiscrepancies(ctx context.Context, arg sqlc.ResolveRoundDiscrepanciesParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveRoundDiscrepancies")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ResolveRoundDiscrepanciesParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ResolveRoundDiscrepanciesParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ResolveRoundDiscrepanciesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ResolveRoundDiscrepancies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveRoundDiscrepancies'
+type MockStore_ResolveRoundDiscrepancies_Call struct {
+	*mock.Call
+}
+
+// ResolveRoundDiscrepancies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.ResolveRoundDiscrepanciesParams
+func (_e *MockStore_Expecter) ResolveRoundDiscrepancies(ctx interface{}, arg interface{}) *MockStore_ResolveRoundDiscrepancies_Call {
+	return &MockStore_ResolveRoundDiscrepancies_Call{Call: _e.mock.On("ResolveRoundDiscrepancies", ctx, arg)}
+}
+
+func (_c *MockStore_ResolveRoundDiscrepancies_Call) Run(run func(ctx context.Context, arg sqlc.ResolveRoundDiscrepanciesParams)) *MockStore_ResolveRoundDiscrepancies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.ResolveRoundDiscrepanciesParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_ResolveRoundDiscrepancies_Call) Return(_a0 int64, _a1 error) *MockStore_ResolveRoundDiscrepancies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ResolveRoundDiscrepancies_Call) RunAndReturn(run func(context.Context, sqlc.ResolveRoundDiscrepanciesParams) (int64, error)) *MockStore_ResolveRoundDiscrepancies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeIndexerBackfill provides a mock function with given fields: ctx, id
+func (_m *MockStore) ResumeIndexerBackfill(ctx context.Context, id uuid.UUID) (sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeIndexerBackfill")
+	}
+
+	var r0 sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.IndexerBackfill)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_ResumeIndexerBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeIndexerBackfill'
+type MockStore_ResumeIndexerBackfill_Call struct {
+	*mock.Call
+}
+
+// ResumeIndexerBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) ResumeIndexerBackfill(ctx interface{}, id interface{}) *MockStore_ResumeIndexerBackfill_Call {
+	return &MockStore_ResumeIndexerBackfill_Call{Call: _e.mock.On("ResumeIndexerBackfill", ctx, id)}
+}
+
+func (_c *MockStore_ResumeIndexerBackfill_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_ResumeIndexerBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_ResumeIndexerBackfill_Call) Return(_a0 sqlc.IndexerBackfill, _a1 error) *MockStore_ResumeIndexerBackfill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_ResumeIndexerBackfill_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.IndexerBackfill, error)) *MockStore_ResumeIndexerBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevealPayoutOrderBid provides a mock function with given fields: ctx, arg
+func (_m *MockStore) RevealPayoutOrderBid(ctx context.Context, arg sqlc.RevealPayoutOrderBidParams) (sqlc.PayoutOrderBid, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevealPayoutOrderBid")
+	}
+
+	var r0 sqlc.PayoutOrderBid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RevealPayoutOrderBidParams) (sqlc.PayoutOrderBid, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RevealPayoutOrderBidParams) sqlc.PayoutOrderBid); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.PayoutOrderBid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.RevealPayoutOrderBidParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_RevealPayoutOrderBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevealPayoutOrderBid'
+type MockStore_RevealPayoutOrderBid_Call struct {
+	*mock.Call
+}
+
+// RevealPayoutOrderBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.RevealPayoutOrderBidParams
+func (_e *MockStore_Expecter) RevealPayoutOrderBid(ctx interface{}, arg interface{}) *MockStore_RevealPayoutOrderBid_Call {
+	return &MockStore_RevealPayoutOrderBid_Call{Call: _e.mock.On("RevealPayoutOrderBid", ctx, arg)}
+}
+
+func (_c *MockStore_RevealPayoutOrderBid_Call) Run(run func(ctx context.Context, arg sqlc.RevealPayoutOrderBidParams)) *MockStore_RevealPayoutOrderBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.RevealPayoutOrderBidParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_RevealPayoutOrderBid_Call) Return(_a0 sqlc.PayoutOrderBid, _a1 error) *MockStore_RevealPayoutOrderBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_RevealPayoutOrderBid_Call) RunAndReturn(run func(context.Context, sqlc.RevealPayoutOrderBidParams) (sqlc.PayoutOrderBid, error)) *MockStore_RevealPayoutOrderBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RewindIndexerCursor provides a mock function with given fields: ctx, arg
+func (_m *MockStore) RewindIndexerCursor(ctx context.Context, arg sqlc.RewindIndexerCursorParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RewindIndexerCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RewindIndexerCursorParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_RewindIndexerCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RewindIndexerCursor'
+type MockStore_RewindIndexerCursor_Call struct {
+	*mock.Call
+}
+
+// RewindIndexerCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.RewindIndexerCursorParams
+func (_e *MockStore_Expecter) RewindIndexerCursor(ctx interface{}, arg interface{}) *MockStore_RewindIndexerCursor_Call {
+	return &MockStore_RewindIndexerCursor_Call{Call: _e.mock.On("RewindIndexerCursor", ctx, arg)}
+}
+
+func (_c *MockStore_RewindIndexerCursor_Call) Run(run func(ctx context.Context, arg sqlc.RewindIndexerCursorParams)) *MockStore_RewindIndexerCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.RewindIndexerCursorParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_RewindIndexerCursor_Call) Return(_a0 error) *MockStore_RewindIndexerCursor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_RewindIndexerCursor_Call) RunAndReturn(run func(context.Context, sqlc.RewindIndexerCursorParams) error) *MockStore_RewindIndexerCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RewindIndexerCursors provides a mock function with given fields: ctx, arg
+func (_m *MockStore) RewindIndexerCursors(ctx context.Context, arg sqlc.RewindIndexerCursorsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RewindIndexerCursors")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RewindIndexerCursorsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_RewindIndexerCursors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RewindIndexerCursors'
+type MockStore_RewindIndexerCursors_Call struct {
+	*mock.Call
+}
+
+// RewindIndexerCursors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.RewindIndexerCursorsParams
+func (_e *MockStore_Expecter) RewindIndexerCursors(ctx interface{}, arg interface{}) *MockStore_RewindIndexerCursors_Call {
+	return &MockStore_RewindIndexerCursors_Call{Call: _e.mock.On("RewindIndexerCursors", ctx, arg)}
+}
+
+func (_c *MockStore_RewindIndexerCursors_Call) Run(run func(ctx context.Context, arg sqlc.RewindIndexerCursorsParams)) *MockStore_RewindIndexerCursors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.RewindIndexerCursorsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_RewindIndexerCursors_Call) Return(_a0 error) *MockStore_RewindIndexerCursors_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_RewindIndexerCursors_Call) RunAndReturn(run func(context.Context, sqlc.RewindIndexerCursorsParams) error) *MockStore_RewindIndexerCursors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RoundContributionExists provides a mock function with given fields: ctx, arg
+func (_m *MockStore) RoundContributionExists(ctx context.Context, arg sqlc.RoundContributionExistsParams) (bool, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoundContributionExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RoundContributionExistsParams) (bool, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RoundContributionExistsParams) bool); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.RoundContributionExistsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_RoundContributionExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoundContributionExists'
+type MockStore_RoundContributionExists_Call struct {
+	*mock.Call
+}
+
+// RoundContributionExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.RoundContributionExistsParams
+func (_e *MockStore_Expecter) RoundContributionExists(ctx interface{}, arg interface{}) *MockStore_RoundContributionExists_Call {
+	return &MockStore_RoundContributionExists_Call{Call: _e.mock.On("RoundContributionExists", ctx, arg)}
+}
+
+func (_c *MockStore_RoundContributionExists_Call) Run(run func(ctx context.Context, arg sqlc.RoundContributionExistsParams)) *MockStore_RoundContributionExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.RoundContributionExistsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_RoundContributionExists_Call) Return(_a0 bool, _a1 error) *MockStore_RoundContributionExists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_RoundContributionExists_Call) RunAndReturn(run func(context.Context, sqlc.RoundContributionExistsParams) (bool, error)) *MockStore_RoundContributionExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchGroupMembers provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SearchGroupMembers(ctx context.Context, arg sqlc.SearchGroupMembersParams) ([]sqlc.SearchGroupMembersRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchGroupMembers")
+	}
+
+	var r0 []sqlc.SearchGroupMembersRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SearchGroupMembersParams) ([]sqlc.SearchGroupMembersRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SearchGroupMembersParams) []sqlc.SearchGroupMembersRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.SearchGroupMembersRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.SearchGroupMembersParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_SearchGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchGroupMembers'
+type MockStore_SearchGroupMembers_Call struct {
+	*mock.Call
+}
+
+// SearchGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.SearchGroupMembersParams
+func (_e *MockStore_Expecter) SearchGroupMembers(ctx interface{}, arg interface{}) *MockStore_SearchGroupMembers_Call {
+	return &MockStore_SearchGroupMembers_Call{Call: _e.mock.On("SearchGroupMembers", ctx, arg)}
+}
+
+func (_c *MockStore_SearchGroupMembers_Call) Run(run func(ctx context.Context, arg sqlc.SearchGroupMembersParams)) *MockStore_SearchGroupMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.SearchGroupMembersParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SearchGroupMembers_Call) Return(_a0 []sqlc.SearchGroupMembersRow, _a1 error) *MockStore_SearchGroupMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_SearchGroupMembers_Call) RunAndReturn(run func(context.Context, sqlc.SearchGroupMembersParams) ([]sqlc.SearchGroupMembersRow, error)) *MockStore_SearchGroupMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUserGroups provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SearchUserGroups(ctx context.Context, arg sqlc.SearchUserGroupsParams) ([]sqlc.SearchUserGroupsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUserGroups")
+	}
+
+	var r0 []sqlc.SearchUserGroupsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SearchUserGroupsParams) ([]sqlc.SearchUserGroupsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SearchUserGroupsParams) []sqlc.SearchUserGroupsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.SearchUserGroupsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.SearchUserGroupsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_SearchUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUserGroups'
+type MockStore_SearchUserGroups_Call struct {
+	*mock.Call
+}
+
+// SearchUserGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.SearchUserGroupsParams
+func (_e *MockStore_Expecter) SearchUserGroups(ctx interface{}, arg interface{}) *MockStore_SearchUserGroups_Call {
+	return &MockStore_SearchUserGroups_Call{Call: _e.mock.On("SearchUserGroups", ctx, arg)}
+}
+
+func (_c *MockStore_SearchUserGroups_Call) Run(run func(ctx context.Context, arg sqlc.SearchUserGroupsParams)) *MockStore_SearchUserGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.SearchUserGroupsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SearchUserGroups_Call) Return(_a0 []sqlc.SearchUserGroupsRow, _a1 error) *MockStore_SearchUserGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_SearchUserGroups_Call) RunAndReturn(run func(context.Context, sqlc.SearchUserGroupsParams) ([]sqlc.SearchUserGroupsRow, error)) *MockStore_SearchUserGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwapRoundMemberPositions provides a mock function with given fields: ctx, arg
+func (_m *MockStore) SwapRoundMemberPositions(ctx context.Context, arg sqlc.SwapRoundMemberPositionsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SwapRoundMemberPositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SwapRoundMemberPositionsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_SwapRoundMemberPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwapRoundMemberPositions'
+type MockStore_SwapRoundMemberPositions_Call struct {
+	*mock.Call
+}
+
+// SwapRoundMemberPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.SwapRoundMemberPositionsParams
+func (_e *MockStore_Expecter) SwapRoundMemberPositions(ctx interface{}, arg interface{}) *MockStore_SwapRoundMemberPositions_Call {
+	return &MockStore_SwapRoundMemberPositions_Call{Call: _e.mock.On("SwapRoundMemberPositions", ctx, arg)}
+}
+
+func (_c *MockStore_SwapRoundMemberPositions_Call) Run(run func(ctx context.Context, arg sqlc.SwapRoundMemberPositionsParams)) *MockStore_SwapRoundMemberPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.SwapRoundMemberPositionsParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_SwapRoundMemberPositions_Call) Return(_a0 error) *MockStore_SwapRoundMemberPositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_SwapRoundMemberPositions_Call) RunAndReturn(run func(context.Context, sqlc.SwapRoundMemberPositionsParams) error) *MockStore_SwapRoundMemberPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncRoundMemberTotals provides a mock function with given fields: ctx, roundID
+func (_m *MockStore) SyncRoundMemberTotals(ctx context.Context, roundID uuid.UUID) error {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncRoundMemberTotals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_SyncRoundMemberTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncRoundMemberTotals'
+type MockStore_SyncRoundMemberTotals_Call struct {
+	*mock.Call
+}
+
+// SyncRoundMemberTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockStore_Expecter) SyncRoundMemberTotals(ctx interface{}, roundID interface{}) *MockStore_SyncRoundMemberTotals_Call {
+	return &MockStore_SyncRoundMemberTotals_Call{Call: _e.mock.On("SyncRoundMemberTotals", ctx, roundID)}
+}
+
+func (_c *MockStore_SyncRoundMemberTotals_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockStore_SyncRoundMemberTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_SyncRoundMemberTotals_Call) Return(_a0 error) *MockStore_SyncRoundMemberTotals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_SyncRoundMemberTotals_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_SyncRoundMemberTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncRoundTotals provides a mock function with given fields: ctx, id
+func (_m *MockStore) SyncRoundTotals(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncRoundTotals")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_SyncRoundTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncRoundTotals'
+type MockStore_SyncRoundTotals_Call struct {
+	*mock.Call
+}
+
+// SyncRoundTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) SyncRoundTotals(ctx interface{}, id interface{}) *MockStore_SyncRoundTotals_Call {
+	return &MockStore_SyncRoundTotals_Call{Call: _e.mock.On("SyncRoundTotals", ctx, id)}
+}
+
+func (_c *MockStore_SyncRoundTotals_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_SyncRoundTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_SyncRoundTotals_Call) Return(_a0 error) *MockStore_SyncRoundTotals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_SyncRoundTotals_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_SyncRoundTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnarchiveGroup provides a mock function with given fields: ctx, id
+func (_m *MockStore) UnarchiveGroup(ctx context.Context, id uuid.UUID) (sqlc.Group, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveGroup")
+	}
+
+	var r0 sqlc.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Group, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Group); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UnarchiveGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnarchiveGroup'
+type MockStore_UnarchiveGroup_Call struct {
+	*mock.Call
+}
+
+// UnarchiveGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) UnarchiveGroup(ctx interface{}, id interface{}) *MockStore_UnarchiveGroup_Call {
+	return &MockStore_UnarchiveGroup_Call{Call: _e.mock.On("UnarchiveGroup", ctx, id)}
+}
+
+func (_c *MockStore_UnarchiveGroup_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_UnarchiveGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_UnarchiveGroup_Call) Return(_a0 sqlc.Group, _a1 error) *MockStore_UnarchiveGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UnarchiveGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.Group, error)) *MockStore_UnarchiveGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateJobStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateJobStatus(ctx context.Context, arg sqlc.UpdateJobStatusParams) (sqlc.Job, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobStatus")
+	}
+
+	var r0 sqlc.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateJobStatusParams) (sqlc.Job, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateJobStatusParams) sqlc.Job); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateJobStatusParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateJobStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobStatus'
+type MockStore_UpdateJobStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateJobStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.UpdateJobStatusParams
+func (_e *MockStore_Expecter) UpdateJobStatus(ctx interface{}, arg interface{}) *MockStore_UpdateJobStatus_Call {
+	return &MockStore_UpdateJobStatus_Call{Call: _e.mock.On("UpdateJobStatus", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateJobStatus_Call) Run(run func(ctx context.Context, arg sqlc.UpdateJobStatusParams)) *MockStore_UpdateJobStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.UpdateJobStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateJobStatus_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_UpdateJobStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateJobStatus_Call) RunAndReturn(run func(context.Context, sqlc.UpdateJobStatusParams) (sqlc.Job, error)) *MockStore_UpdateJobStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMagicLink provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateMagicLink(ctx context.Context, arg sqlc.UpdateMagicLinkParams) (sqlc.MagicLink, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMagicLink")
+	}
+
+	var r0 sqlc.MagicLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateMagicLinkParams) (sqlc.MagicLink, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateMagicLinkParams) sqlc.MagicLink); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.MagicLink)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateMagicLinkParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdateMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMagicLink'
+type MockStore_UpdateMagicLink_Call struct {
+	*mock.Call
+}
+
+// UpdateMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.UpdateMagicLinkParams
+func (_e *MockStore_Expecter) UpdateMagicLink(ctx interface{}, arg interface{}) *MockStore_UpdateMagicLink_Call {
+	return &MockStore_UpdateMagicLink_Call{Call: _e.mock.On("UpdateMagicLink", ctx, arg)}
+}
+
+func (_c *MockStore_UpdateMagicLink_Call) Run(run func(ctx context.Context, arg sqlc.UpdateMagicLinkParams)) *MockStore_UpdateMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.UpdateMagicLinkParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdateMagicLink_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_UpdateMagicLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdateMagicLink_Call) RunAndReturn(run func(context.Context, sqlc.UpdateMagicLinkParams) (sqlc.MagicLink, error)) *MockStore_UpdateMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePayoutOrderProposal provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdatePayoutOrderProposal(ctx context.Context, arg sqlc.UpdatePayoutOrderProposalParams) (sqlc.PayoutOrder, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePayoutOrderProposal")
+	}
+
+	var r0 sqlc.PayoutOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdatePayoutOrderProposalParams) (sqlc.PayoutOrder, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdatePayoutOrderProposalParams) sqlc.PayoutOrder); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.PayoutOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdatePayoutOrderProposalParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_UpdatePayoutOrderProposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePayoutOrderProposal'
+type MockStore_UpdatePayoutOrderProposal_Call struct {
+	*mock.Call
+}
+
+// UpdatePayoutOrderProposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg sqlc.UpdatePayoutOrderProposalParams
+func (_e *MockStore_Expecter) UpdatePayoutOrderProposal(ctx interface{}, arg interface{}) *MockStore_UpdatePayoutOrderProposal_Call {
+	return &MockStore_UpdatePayoutOrderProposal_Call{Call: _e.mock.On("UpdatePayoutOrderProposal", ctx, arg)}
+}
+
+func (_c *MockStore_UpdatePayoutOrderProposal_Call) Run(run func(ctx context.Context, arg sqlc.UpdatePayoutOrderProposalParams)) *MockStore_UpdatePayoutOrderProposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.UpdatePayoutOrderProposalParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_UpdatePayoutOrderProposal_Call) Return(_a0 sqlc.PayoutOrder, _a1 error) *MockStore_UpdatePayoutOrderProposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_UpdatePayoutOrderProposal_Call) RunAndReturn(run func(context.Context, sqlc.UpdatePayoutOrderProposalParams) (sqlc.PayoutOrder, error)) *MockStore_UpdatePayoutOrderProposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePendingSignup provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdatePendingSignup(ctx context.Context, arg sqlc.UpdatePendingSignupParams) (sqlc.PendingSignup, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePendingSignup")
 	}
 
-	var r0 sqlc.User
+	var r0 sqlc.PendingSignup
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.User, error)); ok {
-		return rf(ctx, address)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdatePendingSignupParams) (sqlc.PendingSignup, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.User); ok {
-		r0 = rf(ctx, address)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdatePendingSignupParams) sqlc.PendingSignup); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.User)
+		r0 = ret.Get(0).(sqlc.PendingSignup)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, address)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdatePendingSignupParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -624,56 +7417,56 @@ func (_m *MockStore) GetUserByAddress(ctx context.Context, address string) (sqlc
 	return r0, r1
 }
 
-// MockStore_GetUserByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByAddress'
-type MockStore_GetUserByAddress_Call struct {
+// MockStore_UpdatePendingSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePendingSignup'
+type MockStore_UpdatePendingSignup_Call struct {
 	*mock.Call
 }
 
-// GetUserByAddress is a helper method to define mock.On call
+// UpdatePendingSignup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - address string
-func (_e *MockStore_Expecter) GetUserByAddress(ctx interface{}, address interface{}) *MockStore_GetUserByAddress_Call {
-	return &MockStore_GetUserByAddress_Call{Call: _e.mock.On("GetUserByAddress", ctx, address)}
+//   - arg sqlc.UpdatePendingSignupParams
+func (_e *MockStore_Expecter) UpdatePendingSignup(ctx interface{}, arg interface{}) *MockStore_UpdatePendingSignup_Call {
+	return &MockStore_UpdatePendingSignup_Call{Call: _e.mock.On("UpdatePendingSignup", ctx, arg)}
 }
 
-func (_c *MockStore_GetUserByAddress_Call) Run(run func(ctx context.Context, address string)) *MockStore_GetUserByAddress_Call {
+func (_c *MockStore_UpdatePendingSignup_Call) Run(run func(ctx context.Context, arg sqlc.UpdatePendingSignupParams)) *MockStore_UpdatePendingSignup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(sqlc.UpdatePendingSignupParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByAddress_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_GetUserByAddress_Call {
+func (_c *MockStore_UpdatePendingSignup_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_UpdatePendingSignup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByAddress_Call) RunAndReturn(run func(context.Context, string) (sqlc.User, error)) *MockStore_GetUserByAddress_Call {
+func (_c *MockStore_UpdatePendingSignup_Call) RunAndReturn(run func(context.Context, sqlc.UpdatePendingSignupParams) (sqlc.PendingSignup, error)) *MockStore_UpdatePendingSignup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) GetUserByEmail(ctx context.Context, email pgtype.Text) (sqlc.User, error) {
-	ret := _m.Called(ctx, email)
+// UpdateRoundStatus provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateRoundStatus(ctx context.Context, arg sqlc.UpdateRoundStatusParams) (sqlc.Round, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByEmail")
+		panic("no return value specified for UpdateRoundStatus")
 	}
 
-	var r0 sqlc.User
+	var r0 sqlc.Round
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) (sqlc.User, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateRoundStatusParams) (sqlc.Round, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) sqlc.User); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateRoundStatusParams) sqlc.Round); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.User)
+		r0 = ret.Get(0).(sqlc.Round)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Text) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateRoundStatusParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -681,55 +7474,55 @@ func (_m *MockStore) GetUserByEmail(ctx context.Context, email pgtype.Text) (sql
 	return r0, r1
 }
 
-// MockStore_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type MockStore_GetUserByEmail_Call struct {
+// MockStore_UpdateRoundStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoundStatus'
+type MockStore_UpdateRoundStatus_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// UpdateRoundStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email pgtype.Text
-func (_e *MockStore_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockStore_GetUserByEmail_Call {
-	return &MockStore_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+//   - arg sqlc.UpdateRoundStatusParams
+func (_e *MockStore_Expecter) UpdateRoundStatus(ctx interface{}, arg interface{}) *MockStore_UpdateRoundStatus_Call {
+	return &MockStore_UpdateRoundStatus_Call{Call: _e.mock.On("UpdateRoundStatus", ctx, arg)}
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_UpdateRoundStatus_Call) Run(run func(ctx context.Context, arg sqlc.UpdateRoundStatusParams)) *MockStore_UpdateRoundStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(sqlc.UpdateRoundStatusParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_UpdateRoundStatus_Call) Return(_a0 sqlc.Round, _a1 error) *MockStore_UpdateRoundStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) (sqlc.User, error)) *MockStore_GetUserByEmail_Call {
+func (_c *MockStore_UpdateRoundStatus_Call) RunAndReturn(run func(context.Context, sqlc.UpdateRoundStatusParams) (sqlc.Round, error)) *MockStore_UpdateRoundStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IncrementJobRetry provides a mock function with given fields: ctx, arg
-func (_m *MockStore) IncrementJobRetry(ctx context.Context, arg sqlc.IncrementJobRetryParams) (sqlc.Job, error) {
+// UpdateUserProfile provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpdateUserProfile(ctx context.Context, arg sqlc.UpdateUserProfileParams) (sqlc.User, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IncrementJobRetry")
+		panic("no return value specified for UpdateUserProfile")
 	}
 
-	var r0 sqlc.Job
+	var r0 sqlc.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.IncrementJobRetryParams) (sqlc.Job, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateUserProfileParams) (sqlc.User, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.IncrementJobRetryParams) sqlc.Job); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateUserProfileParams) sqlc.User); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.Job)
+		r0 = ret.Get(0).(sqlc.User)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.IncrementJobRetryParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateUserProfileParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -738,46 +7531,46 @@ func (_m *MockStore) IncrementJobRetry(ctx context.Context, arg sqlc.IncrementJo
 	return r0, r1
 }
 
-// MockStore_IncrementJobRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementJobRetry'
-type MockStore_IncrementJobRetry_Call struct {
+// MockStore_UpdateUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserProfile'
+type MockStore_UpdateUserProfile_Call struct {
 	*mock.Call
 }
 
-// IncrementJobRetry is a helper method to define mock.On call
+// UpdateUserProfile is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.IncrementJobRetryParams
-func (_e *MockStore_Expecter) IncrementJobRetry(ctx interface{}, arg interface{}) *MockStore_IncrementJobRetry_Call {
-	return &MockStore_IncrementJobRetry_Call{Call: _e.mock.On("IncrementJobRetry", ctx, arg)}
+//   - arg sqlc.UpdateUserProfileParams
+func (_e *MockStore_Expecter) UpdateUserProfile(ctx interface{}, arg interface{}) *MockStore_UpdateUserProfile_Call {
+	return &MockStore_UpdateUserProfile_Call{Call: _e.mock.On("UpdateUserProfile", ctx, arg)}
 }
 
-func (_c *MockStore_IncrementJobRetry_Call) Run(run func(ctx context.Context, arg sqlc.IncrementJobRetryParams)) *MockStore_IncrementJobRetry_Call {
+func (_c *MockStore_UpdateUserProfile_Call) Run(run func(ctx context.Context, arg sqlc.UpdateUserProfileParams)) *MockStore_UpdateUserProfile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.IncrementJobRetryParams))
+		run(args[0].(context.Context), args[1].(sqlc.UpdateUserProfileParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_IncrementJobRetry_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_IncrementJobRetry_Call {
+func (_c *MockStore_UpdateUserProfile_Call) Return(_a0 sqlc.User, _a1 error) *MockStore_UpdateUserProfile_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_IncrementJobRetry_Call) RunAndReturn(run func(context.Context, sqlc.IncrementJobRetryParams) (sqlc.Job, error)) *MockStore_IncrementJobRetry_Call {
+func (_c *MockStore_UpdateUserProfile_Call) RunAndReturn(run func(context.Context, sqlc.UpdateUserProfileParams) (sqlc.User, error)) *MockStore_UpdateUserProfile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InvalidateMagicLinksByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) InvalidateMagicLinksByEmail(ctx context.Context, email pgtype.Text) error {
-	ret := _m.Called(ctx, email)
+// UpsertChainBlock provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertChainBlock(ctx context.Context, arg sqlc.UpsertChainBlockParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InvalidateMagicLinksByEmail")
+		panic("no return value specified for UpsertChainBlock")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) error); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertChainBlockParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -785,46 +7578,46 @@ func (_m *MockStore) InvalidateMagicLinksByEmail(ctx context.Context, email pgty
 	return r0
 }
 
-// MockStore_InvalidateMagicLinksByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateMagicLinksByEmail'
-type MockStore_InvalidateMagicLinksByEmail_Call struct {
+// MockStore_UpsertChainBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertChainBlock'
+type MockStore_UpsertChainBlock_Call struct {
 	*mock.Call
 }
 
-// InvalidateMagicLinksByEmail is a helper method to define mock.On call
+// UpsertChainBlock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email pgtype.Text
-func (_e *MockStore_Expecter) InvalidateMagicLinksByEmail(ctx interface{}, email interface{}) *MockStore_InvalidateMagicLinksByEmail_Call {
-	return &MockStore_InvalidateMagicLinksByEmail_Call{Call: _e.mock.On("InvalidateMagicLinksByEmail", ctx, email)}
+//   - arg sqlc.UpsertChainBlockParams
+func (_e *MockStore_Expecter) UpsertChainBlock(ctx interface{}, arg interface{}) *MockStore_UpsertChainBlock_Call {
+	return &MockStore_UpsertChainBlock_Call{Call: _e.mock.On("UpsertChainBlock", ctx, arg)}
 }
 
-func (_c *MockStore_InvalidateMagicLinksByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_InvalidateMagicLinksByEmail_Call {
+func (_c *MockStore_UpsertChainBlock_Call) Run(run func(ctx context.Context, arg sqlc.UpsertChainBlockParams)) *MockStore_UpsertChainBlock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(sqlc.UpsertChainBlockParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_InvalidateMagicLinksByEmail_Call) Return(_a0 error) *MockStore_InvalidateMagicLinksByEmail_Call {
+func (_c *MockStore_UpsertChainBlock_Call) Return(_a0 error) *MockStore_UpsertChainBlock_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_InvalidateMagicLinksByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) error) *MockStore_InvalidateMagicLinksByEmail_Call {
+func (_c *MockStore_UpsertChainBlock_Call) RunAndReturn(run func(context.Context, sqlc.UpsertChainBlockParams) error) *MockStore_UpsertChainBlock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InvalidatePendingSignupsByEmail provides a mock function with given fields: ctx, email
-func (_m *MockStore) InvalidatePendingSignupsByEmail(ctx context.Context, email pgtype.Text) error {
-	ret := _m.Called(ctx, email)
+// UpsertIndexerCursor provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertIndexerCursor(ctx context.Context, arg sqlc.UpsertIndexerCursorParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InvalidatePendingSignupsByEmail")
+		panic("no return value specified for UpsertIndexerCursor")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Text) error); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertIndexerCursorParams) error); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -832,56 +7625,56 @@ func (_m *MockStore) InvalidatePendingSignupsByEmail(ctx context.Context, email
 	return r0
 }
 
-// MockStore_InvalidatePendingSignupsByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidatePendingSignupsByEmail'
-type MockStore_InvalidatePendingSignupsByEmail_Call struct {
+// MockStore_UpsertIndexerCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertIndexerCursor'
+type MockStore_UpsertIndexerCursor_Call struct {
 	*mock.Call
 }
 
-// InvalidatePendingSignupsByEmail is a helper method to define mock.On call
+// UpsertIndexerCursor is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email pgtype.Text
-func (_e *MockStore_Expecter) InvalidatePendingSignupsByEmail(ctx interface{}, email interface{}) *MockStore_InvalidatePendingSignupsByEmail_Call {
-	return &MockStore_InvalidatePendingSignupsByEmail_Call{Call: _e.mock.On("InvalidatePendingSignupsByEmail", ctx, email)}
+//   - arg sqlc.UpsertIndexerCursorParams
+func (_e *MockStore_Expecter) UpsertIndexerCursor(ctx interface{}, arg interface{}) *MockStore_UpsertIndexerCursor_Call {
+	return &MockStore_UpsertIndexerCursor_Call{Call: _e.mock.On("UpsertIndexerCursor", ctx, arg)}
 }
 
-func (_c *MockStore_InvalidatePendingSignupsByEmail_Call) Run(run func(ctx context.Context, email pgtype.Text)) *MockStore_InvalidatePendingSignupsByEmail_Call {
+func (_c *MockStore_UpsertIndexerCursor_Call) Run(run func(ctx context.Context, arg sqlc.UpsertIndexerCursorParams)) *MockStore_UpsertIndexerCursor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(pgtype.Text))
+		run(args[0].(context.Context), args[1].(sqlc.UpsertIndexerCursorParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_InvalidatePendingSignupsByEmail_Call) Return(_a0 error) *MockStore_InvalidatePendingSignupsByEmail_Call {
+func (_c *MockStore_UpsertIndexerCursor_Call) Return(_a0 error) *MockStore_UpsertIndexerCursor_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_InvalidatePendingSignupsByEmail_Call) RunAndReturn(run func(context.Context, pgtype.Text) error) *MockStore_InvalidatePendingSignupsByEmail_Call {
+func (_c *MockStore_UpsertIndexerCursor_Call) RunAndReturn(run func(context.Context, sqlc.UpsertIndexerCursorParams) error) *MockStore_UpsertIndexerCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkMagicLinkAsUsed provides a mock function with given fields: ctx, id
-func (_m *MockStore) MarkMagicLinkAsUsed(ctx context.Context, id uuid.UUID) (sqlc.MagicLink, error) {
-	ret := _m.Called(ctx, id)
+// UpsertNotificationPreferences provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertNotificationPreferences(ctx context.Context, arg sqlc.UpsertNotificationPreferencesParams) (sqlc.NotificationPreference, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkMagicLinkAsUsed")
+		panic("no return value specified for UpsertNotificationPreferences")
 	}
 
-	var r0 sqlc.MagicLink
+	var r0 sqlc.NotificationPreference
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.MagicLink, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertNotificationPreferencesParams) (sqlc.NotificationPreference, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.MagicLink); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertNotificationPreferencesParams) sqlc.NotificationPreference); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.MagicLink)
+		r0 = ret.Get(0).(sqlc.NotificationPreference)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpsertNotificationPreferencesParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -889,169 +7682,149 @@ func (_m *MockStore) MarkMagicLinkAsUsed(ctx context.Context, id uuid.UUID) (sql
 	return r0, r1
 }
 
-// MockStore_MarkMagicLinkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkMagicLinkAsUsed'
-type MockStore_MarkMagicLinkAsUsed_Call struct {
+// MockStore_UpsertNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertNotificationPreferences'
+type MockStore_UpsertNotificationPreferences_Call struct {
 	*mock.Call
 }
 
-// MarkMagicLinkAsUsed is a helper method to define mock.On call
+// UpsertNotificationPreferences is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) MarkMagicLinkAsUsed(ctx interface{}, id interface{}) *MockStore_MarkMagicLinkAsUsed_Call {
-	return &MockStore_MarkMagicLinkAsUsed_Call{Call: _e.mock.On("MarkMagicLinkAsUsed", ctx, id)}
+//   - arg sqlc.UpsertNotificationPreferencesParams
+func (_e *MockStore_Expecter) UpsertNotificationPreferences(ctx interface{}, arg interface{}) *MockStore_UpsertNotificationPreferences_Call {
+	return &MockStore_UpsertNotificationPreferences_Call{Call: _e.mock.On("UpsertNotificationPreferences", ctx, arg)}
 }
 
-func (_c *MockStore_MarkMagicLinkAsUsed_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_MarkMagicLinkAsUsed_Call {
+func (_c *MockStore_UpsertNotificationPreferences_Call) Run(run func(ctx context.Context, arg sqlc.UpsertNotificationPreferencesParams)) *MockStore_UpsertNotificationPreferences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(sqlc.UpsertNotificationPreferencesParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_MarkMagicLinkAsUsed_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_MarkMagicLinkAsUsed_Call {
+func (_c *MockStore_UpsertNotificationPreferences_Call) Return(_a0 sqlc.NotificationPreference, _a1 error) *MockStore_UpsertNotificationPreferences_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_MarkMagicLinkAsUsed_Call) RunAndReturn(run func(context.Context, uuid.UUID) (sqlc.MagicLink, error)) *MockStore_MarkMagicLinkAsUsed_Call {
+func (_c *MockStore_UpsertNotificationPreferences_Call) RunAndReturn(run func(context.Context, sqlc.UpsertNotificationPreferencesParams) (sqlc.NotificationPreference, error)) *MockStore_UpsertNotificationPreferences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateJobStatus provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateJobStatus(ctx context.Context, arg sqlc.UpdateJobStatusParams) (sqlc.Job, error) {
+// UpsertPayoutOrderApproval provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertPayoutOrderApproval(ctx context.Context, arg sqlc.UpsertPayoutOrderApprovalParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateJobStatus")
+		panic("no return value specified for UpsertPayoutOrderApproval")
 	}
 
-	var r0 sqlc.Job
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateJobStatusParams) (sqlc.Job, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateJobStatusParams) sqlc.Job); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertPayoutOrderApprovalParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.Job)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateJobStatusParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateJobStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobStatus'
-type MockStore_UpdateJobStatus_Call struct {
+// MockStore_UpsertPayoutOrderApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPayoutOrderApproval'
+type MockStore_UpsertPayoutOrderApproval_Call struct {
 	*mock.Call
 }
 
-// UpdateJobStatus is a helper method to define mock.On call
+// UpsertPayoutOrderApproval is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.UpdateJobStatusParams
-func (_e *MockStore_Expecter) UpdateJobStatus(ctx interface{}, arg interface{}) *MockStore_UpdateJobStatus_Call {
-	return &MockStore_UpdateJobStatus_Call{Call: _e.mock.On("UpdateJobStatus", ctx, arg)}
+//   - arg sqlc.UpsertPayoutOrderApprovalParams
+func (_e *MockStore_Expecter) UpsertPayoutOrderApproval(ctx interface{}, arg interface{}) *MockStore_UpsertPayoutOrderApproval_Call {
+	return &MockStore_UpsertPayoutOrderApproval_Call{Call: _e.mock.On("UpsertPayoutOrderApproval", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateJobStatus_Call) Run(run func(ctx context.Context, arg sqlc.UpdateJobStatusParams)) *MockStore_UpdateJobStatus_Call {
+func (_c *MockStore_UpsertPayoutOrderApproval_Call) Run(run func(ctx context.Context, arg sqlc.UpsertPayoutOrderApprovalParams)) *MockStore_UpsertPayoutOrderApproval_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.UpdateJobStatusParams))
+		run(args[0].(context.Context), args[1].(sqlc.UpsertPayoutOrderApprovalParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateJobStatus_Call) Return(_a0 sqlc.Job, _a1 error) *MockStore_UpdateJobStatus_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpsertPayoutOrderApproval_Call) Return(_a0 error) *MockStore_UpsertPayoutOrderApproval_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateJobStatus_Call) RunAndReturn(run func(context.Context, sqlc.UpdateJobStatusParams) (sqlc.Job, error)) *MockStore_UpdateJobStatus_Call {
+func (_c *MockStore_UpsertPayoutOrderApproval_Call) RunAndReturn(run func(context.Context, sqlc.UpsertPayoutOrderApprovalParams) error) *MockStore_UpsertPayoutOrderApproval_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateMagicLink provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdateMagicLink(ctx context.Context, arg sqlc.UpdateMagicLinkParams) (sqlc.MagicLink, error) {
+// UpsertPayoutOrderBid provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertPayoutOrderBid(ctx context.Context, arg sqlc.UpsertPayoutOrderBidParams) error {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateMagicLink")
+		panic("no return value specified for UpsertPayoutOrderBid")
 	}
 
-	var r0 sqlc.MagicLink
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateMagicLinkParams) (sqlc.MagicLink, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateMagicLinkParams) sqlc.MagicLink); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertPayoutOrderBidParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.MagicLink)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateMagicLinkParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMagicLink'
-type MockStore_UpdateMagicLink_Call struct {
+// MockStore_UpsertPayoutOrderBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertPayoutOrderBid'
+type MockStore_UpsertPayoutOrderBid_Call struct {
 	*mock.Call
 }
 
-// UpdateMagicLink is a helper method to define mock.On call
+// UpsertPayoutOrderBid is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.UpdateMagicLinkParams
-func (_e *MockStore_Expecter) UpdateMagicLink(ctx interface{}, arg interface{}) *MockStore_UpdateMagicLink_Call {
-	return &MockStore_UpdateMagicLink_Call{Call: _e.mock.On("UpdateMagicLink", ctx, arg)}
+//   - arg sqlc.UpsertPayoutOrderBidParams
+func (_e *MockStore_Expecter) UpsertPayoutOrderBid(ctx interface{}, arg interface{}) *MockStore_UpsertPayoutOrderBid_Call {
+	return &MockStore_UpsertPayoutOrderBid_Call{Call: _e.mock.On("UpsertPayoutOrderBid", ctx, arg)}
 }
 
-func (_c *MockStore_UpdateMagicLink_Call) Run(run func(ctx context.Context, arg sqlc.UpdateMagicLinkParams)) *MockStore_UpdateMagicLink_Call {
+func (_c *MockStore_UpsertPayoutOrderBid_Call) Run(run func(ctx context.Context, arg sqlc.UpsertPayoutOrderBidParams)) *MockStore_UpsertPayoutOrderBid_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.UpdateMagicLinkParams))
+		run(args[0].(context.Context), args[1].(sqlc.UpsertPayoutOrderBidParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateMagicLink_Call) Return(_a0 sqlc.MagicLink, _a1 error) *MockStore_UpdateMagicLink_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpsertPayoutOrderBid_Call) Return(_a0 error) *MockStore_UpsertPayoutOrderBid_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateMagicLink_Call) RunAndReturn(run func(context.Context, sqlc.UpdateMagicLinkParams) (sqlc.MagicLink, error)) *MockStore_UpdateMagicLink_Call {
+func (_c *MockStore_UpsertPayoutOrderBid_Call) RunAndReturn(run func(context.Context, sqlc.UpsertPayoutOrderBidParams) error) *MockStore_UpsertPayoutOrderBid_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePendingSignup provides a mock function with given fields: ctx, arg
-func (_m *MockStore) UpdatePendingSignup(ctx context.Context, arg sqlc.UpdatePendingSignupParams) (sqlc.PendingSignup, error) {
+// UpsertRoundDiscrepancy provides a mock function with given fields: ctx, arg
+func (_m *MockStore) UpsertRoundDiscrepancy(ctx context.Context, arg sqlc.UpsertRoundDiscrepancyParams) (bool, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePendingSignup")
+		panic("no return value specified for UpsertRoundDiscrepancy")
 	}
 
-	var r0 sqlc.PendingSignup
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdatePendingSignupParams) (sqlc.PendingSignup, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertRoundDiscrepancyParams) (bool, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdatePendingSignupParams) sqlc.PendingSignup); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertRoundDiscrepancyParams) bool); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(sqlc.PendingSignup)
+		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdatePendingSignupParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpsertRoundDiscrepancyParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -1060,31 +7833,31 @@ func (_m *MockStore) UpdatePendingSignup(ctx context.Context, arg sqlc.UpdatePen
 	return r0, r1
 }
 
-// MockStore_UpdatePendingSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePendingSignup'
-type MockStore_UpdatePendingSignup_Call struct {
+// MockStore_UpsertRoundDiscrepancy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertRoundDiscrepancy'
+type MockStore_UpsertRoundDiscrepancy_Call struct {
 	*mock.Call
 }
 
-// UpdatePendingSignup is a helper method to define mock.On call
+// UpsertRoundDiscrepancy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg sqlc.UpdatePendingSignupParams
-func (_e *MockStore_Expecter) UpdatePendingSignup(ctx interface{}, arg interface{}) *MockStore_UpdatePendingSignup_Call {
-	return &MockStore_UpdatePendingSignup_Call{Call: _e.mock.On("UpdatePendingSignup", ctx, arg)}
+//   - arg sqlc.UpsertRoundDiscrepancyParams
+func (_e *MockStore_Expecter) UpsertRoundDiscrepancy(ctx interface{}, arg interface{}) *MockStore_UpsertRoundDiscrepancy_Call {
+	return &MockStore_UpsertRoundDiscrepancy_Call{Call: _e.mock.On("UpsertRoundDiscrepancy", ctx, arg)}
 }
 
-func (_c *MockStore_UpdatePendingSignup_Call) Run(run func(ctx context.Context, arg sqlc.UpdatePendingSignupParams)) *MockStore_UpdatePendingSignup_Call {
+func (_c *MockStore_UpsertRoundDiscrepancy_Call) Run(run func(ctx context.Context, arg sqlc.UpsertRoundDiscrepancyParams)) *MockStore_UpsertRoundDiscrepancy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(sqlc.UpdatePendingSignupParams))
+		run(args[0].(context.Context), args[1].(sqlc.UpsertRoundDiscrepancyParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdatePendingSignup_Call) Return(_a0 sqlc.PendingSignup, _a1 error) *MockStore_UpdatePendingSignup_Call {
+func (_c *MockStore_UpsertRoundDiscrepancy_Call) Return(_a0 bool, _a1 error) *MockStore_UpsertRoundDiscrepancy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdatePendingSignup_Call) RunAndReturn(run func(context.Context, sqlc.UpdatePendingSignupParams) (sqlc.PendingSignup, error)) *MockStore_UpdatePendingSignup_Call {
+func (_c *MockStore_UpsertRoundDiscrepancy_Call) RunAndReturn(run func(context.Context, sqlc.UpsertRoundDiscrepancyParams) (bool, error)) *MockStore_UpsertRoundDiscrepancy_Call {
 	_c.Call.Return(run)
 	return _c
 }
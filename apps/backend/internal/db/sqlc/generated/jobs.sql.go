@@ -104,6 +104,35 @@ func (q *Queries) GetNextPendingJob(ctx context.Context) (Job, error) {
 	return i, err
 }
 
+const getPendingJobByType = `-- name: GetPendingJobByType :one
+SELECT id, type, payload, status, retry_count, max_retries, error_message, scheduled_at, processed_at, created_at, updated_at, deleted_at FROM jobs
+WHERE type = $1
+  AND status = 'pending'
+  AND deleted_at IS NULL
+ORDER BY scheduled_at ASC
+LIMIT 1
+`
+
+func (q *Queries) GetPendingJobByType(ctx context.Context, type_ string) (Job, error) {
+	row := q.db.QueryRow(ctx, getPendingJobByType, type_)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Payload,
+		&i.Status,
+		&i.RetryCount,
+		&i.MaxRetries,
+		&i.ErrorMessage,
+		&i.ScheduledAt,
+		&i.ProcessedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const incrementJobRetry = `-- name: IncrementJobRetry :one
 UPDATE jobs 
 SET retry_count = retry_count + 1,
@@ -9,6 +9,106 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ChainBlock struct {
+	ChainID     int64            `json:"chain_id"`
+	BlockNumber int64            `json:"block_number"`
+	BlockHash   string           `json:"block_hash"`
+	ParentHash  string           `json:"parent_hash"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+}
+
+type ContributionReminder struct {
+	ID              uuid.UUID        `json:"id"`
+	RoundID         uuid.UUID        `json:"round_id"`
+	Address         string           `json:"address"`
+	Period          int64            `json:"period"`
+	LeadTimeSeconds int64            `json:"lead_time_seconds"`
+	Channel         string           `json:"channel"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+}
+
+type Group struct {
+	ID                 uuid.UUID        `json:"id"`
+	Name               string           `json:"name"`
+	Description        *string          `json:"description"`
+	AvatarUrl          *string          `json:"avatar_url"`
+	OwnerID            uuid.UUID        `json:"owner_id"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+	UpdatedAt          pgtype.Timestamp `json:"updated_at"`
+	DeletedAt          pgtype.Timestamp `json:"deleted_at"`
+	ArchivedAt         pgtype.Timestamp `json:"archived_at"`
+	GracePeriodSeconds int64            `json:"grace_period_seconds"`
+	AnonymizedAt       pgtype.Timestamp `json:"anonymized_at"`
+}
+
+type GroupAllowlist struct {
+	ID            uuid.UUID        `json:"id"`
+	GroupID       uuid.UUID        `json:"group_id"`
+	Version       int32            `json:"version"`
+	Root          string           `json:"root"`
+	Members       []string         `json:"members"`
+	PayoutOrderID pgtype.UUID      `json:"payout_order_id"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+type GroupMember struct {
+	ID        uuid.UUID        `json:"id"`
+	GroupID   uuid.UUID        `json:"group_id"`
+	UserID    uuid.UUID        `json:"user_id"`
+	Role      string           `json:"role"`
+	Status    string           `json:"status"`
+	JoinedAt  pgtype.Timestamp `json:"joined_at"`
+	RemovedAt pgtype.Timestamp `json:"removed_at"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
+type GroupMemberRemoval struct {
+	ID          uuid.UUID        `json:"id"`
+	GroupID     uuid.UUID        `json:"group_id"`
+	UserID      uuid.UUID        `json:"user_id"`
+	RemovedBy   uuid.UUID        `json:"removed_by"`
+	Kind        string           `json:"kind"`
+	Reason      *string          `json:"reason"`
+	Outstanding []byte           `json:"outstanding"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+}
+
+type IndexerBackfill struct {
+	ID            uuid.UUID        `json:"id"`
+	RoundID       uuid.UUID        `json:"round_id"`
+	FromBlock     int64            `json:"from_block"`
+	ToBlock       int64            `json:"to_block"`
+	NextBlock     int64            `json:"next_block"`
+	Status        string           `json:"status"`
+	EventsWritten int64            `json:"events_written"`
+	ErrorMessage  *string          `json:"error_message"`
+	RequestedBy   pgtype.UUID      `json:"requested_by"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	CompletedAt   pgtype.Timestamp `json:"completed_at"`
+}
+
+type IndexerCursor struct {
+	ChainID         int64            `json:"chain_id"`
+	ContractAddress string           `json:"contract_address"`
+	LastBlock       int64            `json:"last_block"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+}
+
+type Invite struct {
+	ID        uuid.UUID        `json:"id"`
+	GroupID   uuid.UUID        `json:"group_id"`
+	CodeHash  string           `json:"code_hash"`
+	CreatedBy uuid.UUID        `json:"created_by"`
+	MaxUses   int32            `json:"max_uses"`
+	Uses      int32            `json:"uses"`
+	ExpiresAt pgtype.Timestamp `json:"expires_at"`
+	RevokedAt pgtype.Timestamp `json:"revoked_at"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
 type Job struct {
 	ID           uuid.UUID          `json:"id"`
 	Type         string             `json:"type"`
@@ -24,6 +124,40 @@ type Job struct {
 	DeletedAt    pgtype.Timestamp   `json:"deleted_at"`
 }
 
+type LedgerAccount struct {
+	RoundID   uuid.UUID        `json:"round_id"`
+	Kind      string           `json:"kind"`
+	Member    string           `json:"member"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	Balance   string           `json:"balance"`
+}
+
+type LedgerEntry struct {
+	ID         uuid.UUID          `json:"id"`
+	RoundID    uuid.UUID          `json:"round_id"`
+	Kind       string             `json:"kind"`
+	Period     int64              `json:"period"`
+	TxHash     string             `json:"tx_hash"`
+	LogIndex   int32              `json:"log_index"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+	Reverses   pgtype.UUID        `json:"reverses"`
+	CreatedAt  pgtype.Timestamp   `json:"created_at"`
+}
+
+type LedgerHourlyContribution struct {
+	RoundID uuid.UUID          `json:"round_id"`
+	Hour    pgtype.Timestamptz `json:"hour"`
+	Amount  string             `json:"amount"`
+}
+
+type LedgerPosting struct {
+	EntryID     uuid.UUID `json:"entry_id"`
+	RoundID     uuid.UUID `json:"round_id"`
+	AccountKind string    `json:"account_kind"`
+	Member      string    `json:"member"`
+	Amount      string    `json:"amount"`
+}
+
 type MagicLink struct {
 	ID              uuid.UUID        `json:"id"`
 	PendingSignupID uuid.UUID        `json:"pending_signup_id"`
@@ -34,6 +168,51 @@ type MagicLink struct {
 	DeletedAt       pgtype.Timestamp `json:"deleted_at"`
 }
 
+type NotificationPreference struct {
+	UserID                   uuid.UUID        `json:"user_id"`
+	ReminderChannels         []string         `json:"reminder_channels"`
+	ReminderLeadTimesSeconds []int64          `json:"reminder_lead_times_seconds"`
+	CreatedAt                pgtype.Timestamp `json:"created_at"`
+	UpdatedAt                pgtype.Timestamp `json:"updated_at"`
+}
+
+type PayoutOrder struct {
+	ID              uuid.UUID        `json:"id"`
+	GroupID         uuid.UUID        `json:"group_id"`
+	Strategy        string           `json:"strategy"`
+	Status          string           `json:"status"`
+	Participants    []string         `json:"participants"`
+	ProposedOrder   []string         `json:"proposed_order"`
+	Revision        int32            `json:"revision"`
+	ChainID         pgtype.Int8      `json:"chain_id"`
+	SeedBlockNumber pgtype.Int8      `json:"seed_block_number"`
+	SeedBlockHash   *string          `json:"seed_block_hash"`
+	BiddingEndsAt   pgtype.Timestamp `json:"bidding_ends_at"`
+	RevealEndsAt    pgtype.Timestamp `json:"reveal_ends_at"`
+	FinalOrder      []string         `json:"final_order"`
+	FinalDiscounts  []string         `json:"final_discounts"`
+	CreatedBy       uuid.UUID        `json:"created_by"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+	FinalizedAt     pgtype.Timestamp `json:"finalized_at"`
+}
+
+type PayoutOrderApproval struct {
+	PayoutOrderID uuid.UUID        `json:"payout_order_id"`
+	Address       string           `json:"address"`
+	Revision      int32            `json:"revision"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+type PayoutOrderBid struct {
+	PayoutOrderID uuid.UUID        `json:"payout_order_id"`
+	Address       string           `json:"address"`
+	Commitment    string           `json:"commitment"`
+	Discount      *string          `json:"discount"`
+	RevealedAt    pgtype.Timestamp `json:"revealed_at"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
 type PendingSignup struct {
 	ID              uuid.UUID        `json:"id"`
 	FullName        pgtype.Text      `json:"full_name"`
@@ -47,14 +226,119 @@ type PendingSignup struct {
 	DeletedAt       pgtype.Timestamp `json:"deleted_at"`
 }
 
-type User struct {
+type Round struct {
+	ID                    uuid.UUID        `json:"id"`
+	GroupID               uuid.UUID        `json:"group_id"`
+	ChainID               int64            `json:"chain_id"`
+	ContractAddress       string           `json:"contract_address"`
+	ContributionAmount    string           `json:"contribution_amount"`
+	CurrencySymbol        *string          `json:"currency_symbol"`
+	PeriodDurationSeconds int64            `json:"period_duration_seconds"`
+	Status                string           `json:"status"`
+	StartedAt             pgtype.Timestamp `json:"started_at"`
+	CreatedAt             pgtype.Timestamp `json:"created_at"`
+	UpdatedAt             pgtype.Timestamp `json:"updated_at"`
+	MemberCount           int32            `json:"member_count"`
+	ContributionCount     int64            `json:"contribution_count"`
+	TokenAddress          *string          `json:"token_address"`
+	StartBlock            int64            `json:"start_block"`
+}
+
+type RoundDelinquency struct {
+	ID        uuid.UUID        `json:"id"`
+	RoundID   uuid.UUID        `json:"round_id"`
+	Address   string           `json:"address"`
+	Period    int64            `json:"period"`
+	Status    string           `json:"status"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type RoundDiscrepancy struct {
 	ID          uuid.UUID        `json:"id"`
-	FullName    pgtype.Text      `json:"full_name"`
-	Email       pgtype.Text      `json:"email"`
-	Address     string           `json:"address"`
-	DisplayName *string          `json:"display_name"`
-	AvatarUrl   *string          `json:"avatar_url"`
-	CreatedAt   pgtype.Timestamp `json:"created_at"`
-	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
-	DeletedAt   pgtype.Timestamp `json:"deleted_at"`
+	RoundID     uuid.UUID        `json:"round_id"`
+	Kind        string           `json:"kind"`
+	Key         string           `json:"key"`
+	Period      pgtype.Int8      `json:"period"`
+	Address     *string          `json:"address"`
+	OnChain     string           `json:"on_chain"`
+	OffChain    string           `json:"off_chain"`
+	BlockNumber int64            `json:"block_number"`
+	DetectedAt  pgtype.Timestamp `json:"detected_at"`
+	LastSeenAt  pgtype.Timestamp `json:"last_seen_at"`
+	ResolvedAt  pgtype.Timestamp `json:"resolved_at"`
+}
+
+type RoundEvent struct {
+	ID           uuid.UUID          `json:"id"`
+	RoundID      uuid.UUID          `json:"round_id"`
+	EventType    string             `json:"event_type"`
+	Address      string             `json:"address"`
+	Period       int64              `json:"period"`
+	Amount       string             `json:"amount"`
+	BlockNumber  int64              `json:"block_number"`
+	BlockHash    string             `json:"block_hash"`
+	TxHash       string             `json:"tx_hash"`
+	LogIndex     int32              `json:"log_index"`
+	BlockTime    pgtype.Timestamptz `json:"block_time"`
+	CreatedAt    pgtype.Timestamp   `json:"created_at"`
+	Confirmed    bool               `json:"confirmed"`
+	Counterparty *string            `json:"counterparty"`
+}
+
+type RoundMember struct {
+	RoundID           uuid.UUID        `json:"round_id"`
+	Address           string           `json:"address"`
+	PayoutPosition    int32            `json:"payout_position"`
+	ContributionsPaid int32            `json:"contributions_paid"`
+	PayoutReceivedAt  pgtype.Timestamp `json:"payout_received_at"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+	InitialPosition   int32            `json:"initial_position"`
+}
+
+type RoundPeriodTotal struct {
+	RoundID   uuid.UUID `json:"round_id"`
+	Period    int64     `json:"period"`
+	PaidCount int32     `json:"paid_count"`
+}
+
+type RoundStatusHistory struct {
+	ID         uuid.UUID        `json:"id"`
+	RoundID    uuid.UUID        `json:"round_id"`
+	FromStatus string           `json:"from_status"`
+	ToStatus   string           `json:"to_status"`
+	Cause      string           `json:"cause"`
+	ActorID    pgtype.UUID      `json:"actor_id"`
+	Reason     *string          `json:"reason"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type SlotSwap struct {
+	ID                  uuid.UUID          `json:"id"`
+	RoundID             uuid.UUID          `json:"round_id"`
+	ProposerAddress     string             `json:"proposer_address"`
+	CounterpartyAddress string             `json:"counterparty_address"`
+	ProposerSlot        int32              `json:"proposer_slot"`
+	CounterpartySlot    int32              `json:"counterparty_slot"`
+	Nonce               string             `json:"nonce"`
+	Deadline            pgtype.Timestamptz `json:"deadline"`
+	Status              string             `json:"status"`
+	Signature           *string            `json:"signature"`
+	CreatedAt           pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt           pgtype.Timestamp   `json:"updated_at"`
+	AcceptedAt          pgtype.Timestamp   `json:"accepted_at"`
+	AppliedAt           pgtype.Timestamp   `json:"applied_at"`
+}
+
+type User struct {
+	ID                uuid.UUID        `json:"id"`
+	FullName          pgtype.Text      `json:"full_name"`
+	Email             pgtype.Text      `json:"email"`
+	Address           string           `json:"address"`
+	DisplayName       *string          `json:"display_name"`
+	AvatarUrl         *string          `json:"avatar_url"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+	DeletedAt         pgtype.Timestamp `json:"deleted_at"`
+	PreferredCurrency *string          `json:"preferred_currency"`
 }
@@ -12,27 +12,202 @@ import (
 )
 
 type Querier interface {
+	AcceptSlotSwap(ctx context.Context, arg AcceptSlotSwapParams) (SlotSwap, error)
+	// Opens the account if needed and adds a posting's amount to its balance.
+	AddLedgerAccountBalance(ctx context.Context, arg AddLedgerAccountBalanceParams) error
+	AddLedgerHourlyContribution(ctx context.Context, arg AddLedgerHourlyContributionParams) error
+	// Moves a running backfill past a chunk it has written. It only applies
+	// when the backfill is still at the chunk's first block, so a chunk that
+	// is processed twice is counted once.
+	AdvanceIndexerBackfill(ctx context.Context, arg AdvanceIndexerBackfillParams) (IndexerBackfill, error)
+	AnonymizeGroup(ctx context.Context, id uuid.UUID) error
+	ArchiveGroup(ctx context.Context, id uuid.UUID) (Group, error)
+	// Declines, cancels or expires a swap that has not been applied.
+	CloseSlotSwap(ctx context.Context, arg CloseSlotSwapParams) (SlotSwap, error)
+	ConfirmChainRoundEvents(ctx context.Context, arg ConfirmChainRoundEventsParams) (int64, error)
+	CountUnfinishedGroupRounds(ctx context.Context, groupID uuid.UUID) (int64, error)
+	// Stores the next version of a group's allowlist. It returns no rows when
+	// another version was stored concurrently.
+	CreateGroupAllowlist(ctx context.Context, arg CreateGroupAllowlistParams) (GroupAllowlist, error)
+	CreateGroupMemberRemoval(ctx context.Context, arg CreateGroupMemberRemovalParams) (GroupMemberRemoval, error)
+	CreateIndexerBackfill(ctx context.Context, arg CreateIndexerBackfillParams) (IndexerBackfill, error)
+	CreateInvite(ctx context.Context, arg CreateInviteParams) (Invite, error)
 	CreateJob(ctx context.Context, arg CreateJobParams) (Job, error)
 	CreateMagicLink(ctx context.Context, arg CreateMagicLinkParams) (MagicLink, error)
+	CreatePayoutOrder(ctx context.Context, arg CreatePayoutOrderParams) (PayoutOrder, error)
 	CreatePendingSignup(ctx context.Context, arg CreatePendingSignupParams) (PendingSignup, error)
+	CreateRound(ctx context.Context, arg CreateRoundParams) (Round, error)
+	CreateRoundMember(ctx context.Context, arg CreateRoundMemberParams) error
+	CreateRoundStatusHistory(ctx context.Context, arg CreateRoundStatusHistoryParams) (RoundStatusHistory, error)
+	CreateSlotSwap(ctx context.Context, arg CreateSlotSwapParams) (SlotSwap, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeleteChainBlocksAfter(ctx context.Context, arg DeleteChainBlocksAfterParams) error
+	DeleteChainBlocksBefore(ctx context.Context, arg DeleteChainBlocksBeforeParams) error
+	// Removes events in blocks that were reorganized out of the chain.
+	DeleteChainRoundEventsAfter(ctx context.Context, arg DeleteChainRoundEventsAfterParams) ([]uuid.UUID, error)
+	DeleteGroup(ctx context.Context, id uuid.UUID) error
+	DeleteGroupAllowlists(ctx context.Context, groupID uuid.UUID) error
+	DeleteGroupInvites(ctx context.Context, groupID uuid.UUID) error
+	DeleteGroupMemberRemovals(ctx context.Context, groupID uuid.UUID) error
+	DeleteGroupMembers(ctx context.Context, groupID uuid.UUID) error
+	DeleteIndexerCursor(ctx context.Context, arg DeleteIndexerCursorParams) error
+	DeleteRoundEventsByID(ctx context.Context, ids []uuid.UUID) error
+	// Removes a round's events from a block on, so they can be indexed again.
+	DeleteRoundEventsFrom(ctx context.Context, arg DeleteRoundEventsFromParams) error
+	DeleteRoundPeriodTotals(ctx context.Context, roundID uuid.UUID) error
+	ExportRoundActivity(ctx context.Context, arg ExportRoundActivityParams) ([]ExportRoundActivityRow, error)
+	FailIndexerBackfill(ctx context.Context, arg FailIndexerBackfillParams) error
+	FinalizePayoutOrder(ctx context.Context, arg FinalizePayoutOrderParams) (PayoutOrder, error)
+	GetActiveGroupMemberByAddress(ctx context.Context, arg GetActiveGroupMemberByAddressParams) (GroupMember, error)
+	GetActiveInvite(ctx context.Context, arg GetActiveInviteParams) (Invite, error)
+	GetChainBlock(ctx context.Context, arg GetChainBlockParams) (ChainBlock, error)
+	GetGroupAllowlist(ctx context.Context, arg GetGroupAllowlistParams) (GroupAllowlist, error)
+	GetGroupByID(ctx context.Context, id uuid.UUID) (Group, error)
+	GetGroupMemberDetails(ctx context.Context, arg GetGroupMemberDetailsParams) (GetGroupMemberDetailsRow, error)
+	GetIndexerBackfill(ctx context.Context, id uuid.UUID) (IndexerBackfill, error)
+	GetIndexerCursor(ctx context.Context, arg GetIndexerCursorParams) (int64, error)
 	GetJobByID(ctx context.Context, id uuid.UUID) (Job, error)
+	GetLatestChainBlock(ctx context.Context, chainID int64) (ChainBlock, error)
+	GetLatestFinalizedPayoutOrder(ctx context.Context, groupID uuid.UUID) (PayoutOrder, error)
+	GetLatestGroupAllowlist(ctx context.Context, groupID uuid.UUID) (GroupAllowlist, error)
 	GetMagicLinkByPendingSignupID(ctx context.Context, pendingSignupID uuid.UUID) (MagicLink, error)
 	GetMagicLinkByTokenHash(ctx context.Context, tokenHash string) (MagicLink, error)
+	// The first member in payout order that has not been paid out yet.
+	GetNextPayoutAddress(ctx context.Context, roundID uuid.UUID) (string, error)
 	GetNextPendingJob(ctx context.Context) (Job, error)
+	GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (NotificationPreference, error)
+	GetPayoutOrder(ctx context.Context, arg GetPayoutOrderParams) (PayoutOrder, error)
+	GetPayoutOrderBid(ctx context.Context, arg GetPayoutOrderBidParams) (PayoutOrderBid, error)
+	GetPendingJobByType(ctx context.Context, type_ string) (Job, error)
 	GetPendingSignupByEmail(ctx context.Context, email pgtype.Text) (PendingSignup, error)
 	GetPendingSignupByID(ctx context.Context, id uuid.UUID) (PendingSignup, error)
+	GetRoundByContract(ctx context.Context, arg GetRoundByContractParams) (Round, error)
+	GetRoundByID(ctx context.Context, id uuid.UUID) (Round, error)
+	GetRunningIndexerBackfill(ctx context.Context, roundID uuid.UUID) (IndexerBackfill, error)
+	GetSlotSwap(ctx context.Context, arg GetSlotSwapParams) (SlotSwap, error)
 	GetUserByAddress(ctx context.Context, address string) (User, error)
 	GetUserByEmail(ctx context.Context, email pgtype.Text) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
 	GetVerifiedPendingSignupByID(ctx context.Context, id uuid.UUID) (PendingSignup, error)
 	IncrementJobRetry(ctx context.Context, arg IncrementJobRetryParams) (Job, error)
+	// Returns 0 when the reminder was already sent.
+	InsertContributionReminder(ctx context.Context, arg InsertContributionReminderParams) (int64, error)
+	InsertLedgerEntry(ctx context.Context, arg InsertLedgerEntryParams) (LedgerEntry, error)
+	InsertLedgerPosting(ctx context.Context, arg InsertLedgerPostingParams) error
+	// Returns 0 when the contribution was already flagged with this status.
+	InsertRoundDelinquency(ctx context.Context, arg InsertRoundDelinquencyParams) (int64, error)
+	// Returns 0 when the log was already indexed.
+	InsertRoundEvent(ctx context.Context, arg InsertRoundEventParams) (int64, error)
+	InsertRoundPeriodTotals(ctx context.Context, roundID uuid.UUID) error
 	InvalidateMagicLinksByEmail(ctx context.Context, email pgtype.Text) error
 	InvalidatePendingSignupsByEmail(ctx context.Context, email pgtype.Text) error
+	ListAcceptedGroupMemberAddresses(ctx context.Context, groupID uuid.UUID) ([]string, error)
+	ListActiveGroupIDs(ctx context.Context) ([]uuid.UUID, error)
+	ListActiveRoundObligations(ctx context.Context, arg ListActiveRoundObligationsParams) ([]ListActiveRoundObligationsRow, error)
+	// Active rounds whose clock has started, with what the delinquency job
+	// needs from their group.
+	ListDelinquencyCheckRounds(ctx context.Context) ([]ListDelinquencyCheckRoundsRow, error)
+	// A member's flags across the group's rounds, with whether the flagged
+	// contribution has been paid since.
+	ListGroupMemberDelinquencies(ctx context.Context, arg ListGroupMemberDelinquenciesParams) ([]ListGroupMemberDelinquenciesRow, error)
+	ListIndexableRounds(ctx context.Context) ([]ListIndexableRoundsRow, error)
+	ListLedgerEntryPostings(ctx context.Context, entryID uuid.UUID) ([]LedgerPosting, error)
+	ListOpenRoundDiscrepancies(ctx context.Context, roundID pgtype.UUID) ([]RoundDiscrepancy, error)
+	// Standing entries whose event is no longer indexed, which must be reversed.
+	ListOrphanedLedgerEntries(ctx context.Context, roundID uuid.UUID) ([]LedgerEntry, error)
+	ListPayoutOrderApprovals(ctx context.Context, payoutOrderID uuid.UUID) ([]PayoutOrderApproval, error)
+	ListPayoutOrderBids(ctx context.Context, payoutOrderID uuid.UUID) ([]PayoutOrderBid, error)
+	// Groups that still have rounds cannot be deleted: rounds map to on-chain
+	// contracts and stay around as history. They are anonymized instead, once.
+	ListPurgeableGroups(ctx context.Context, arg ListPurgeableGroupsParams) ([]ListPurgeableGroupsRow, error)
+	// Rounds whose contract can still change, or the given round whatever its
+	// status, with what the reconciliation job needs from their group.
+	ListReconcileRounds(ctx context.Context, roundID pgtype.UUID) ([]ListReconcileRoundsRow, error)
+	// Members of a round with their contact details and notification
+	// preferences. The preference columns are NULL for users who never set them.
+	ListReminderRecipients(ctx context.Context, roundID uuid.UUID) ([]ListReminderRecipientsRow, error)
+	// Active rounds whose clock has started, with what contribution reminders
+	// need from their group.
+	ListReminderRounds(ctx context.Context) ([]ListReminderRoundsRow, error)
+	// Newest first, keyed on chain position so pages stay stable while new
+	// blocks are indexed.
+	ListRoundActivity(ctx context.Context, arg ListRoundActivityParams) ([]ListRoundActivityRow, error)
+	ListRoundContributionPeriods(ctx context.Context, roundID uuid.UUID) ([]ListRoundContributionPeriodsRow, error)
+	ListRoundDelinquencies(ctx context.Context, roundID uuid.UUID) ([]RoundDelinquency, error)
+	ListRoundEvents(ctx context.Context, roundID uuid.UUID) ([]RoundEvent, error)
+	ListRoundEventsBetween(ctx context.Context, arg ListRoundEventsBetweenParams) ([]RoundEvent, error)
+	// Hours in which contributions to a round were made, with what they added up
+	// to. Hours whose contributions were all reversed are left out.
+	ListRoundHourlyContributions(ctx context.Context, roundID uuid.UUID) ([]LedgerHourlyContribution, error)
+	ListRoundIndexerBackfills(ctx context.Context, roundID uuid.UUID) ([]IndexerBackfill, error)
+	ListRoundLedgerAccounts(ctx context.Context, roundID uuid.UUID) ([]LedgerAccount, error)
+	// Every posting of a round, grouped by entry in the order entries were made.
+	ListRoundLedgerPostings(ctx context.Context, roundID uuid.UUID) ([]ListRoundLedgerPostingsRow, error)
+	ListRoundMembers(ctx context.Context, roundID uuid.UUID) ([]RoundMember, error)
+	ListRoundPeriodTotals(ctx context.Context, roundIds []uuid.UUID) ([]RoundPeriodTotal, error)
+	ListRoundSlotSwaps(ctx context.Context, arg ListRoundSlotSwapsParams) ([]SlotSwap, error)
+	ListRoundSwapEvents(ctx context.Context, roundID uuid.UUID) ([]ListRoundSwapEventsRow, error)
+	// Contribution and payout events of a round without a standing journal
+	// entry: new events, and events indexed again after a reorg reversed their
+	// entry.
+	ListUnjournaledRoundEvents(ctx context.Context, roundID uuid.UUID) ([]RoundEvent, error)
+	ListUserGroups(ctx context.Context, arg ListUserGroupsParams) ([]ListUserGroupsRow, error)
+	ListUserPendingInvites(ctx context.Context, arg ListUserPendingInvitesParams) ([]ListUserPendingInvitesRow, error)
+	// Latest events across every round of the user's groups.
+	ListUserRecentActivity(ctx context.Context, arg ListUserRecentActivityParams) ([]ListUserRecentActivityRow, error)
+	// Unfinished rounds the address takes part in, with its own progress.
+	ListUserRoundMemberships(ctx context.Context, arg ListUserRoundMembershipsParams) ([]ListUserRoundMembershipsRow, error)
+	ListUserRounds(ctx context.Context, arg ListUserRoundsParams) ([]ListUserRoundsRow, error)
+	// Holds the group row until the transaction ends, so that writes derived
+	// from its current members, like allowlist versions, happen one at a time.
+	LockGroup(ctx context.Context, id uuid.UUID) error
+	// Locks a member who has not been removed until the transaction ends, so
+	// that checks made before removing them cannot race another removal.
+	LockGroupMember(ctx context.Context, id uuid.UUID) (GroupMember, error)
+	// Serialises transactions that change a round's events and recompute what
+	// is derived from them, such as the live indexer and a backfill.
+	LockRoundEvents(ctx context.Context, roundID uuid.UUID) error
+	MarkGroupMemberRemoved(ctx context.Context, id uuid.UUID) (GroupMember, error)
 	MarkMagicLinkAsUsed(ctx context.Context, id uuid.UUID) (MagicLink, error)
+	// An accepted swap is applied once its pair's swap event is indexed.
+	MarkSlotSwapsApplied(ctx context.Context, roundID uuid.UUID) error
+	OpenSlotSwapExists(ctx context.Context, arg OpenSlotSwapExistsParams) (bool, error)
+	ResetRoundMemberPositions(ctx context.Context, roundID uuid.UUID) error
+	// Resolves the open discrepancies of a round that were not seen again.
+	ResolveRoundDiscrepancies(ctx context.Context, arg ResolveRoundDiscrepanciesParams) (int64, error)
+	ResumeIndexerBackfill(ctx context.Context, id uuid.UUID) (IndexerBackfill, error)
+	RevealPayoutOrderBid(ctx context.Context, arg RevealPayoutOrderBidParams) (PayoutOrderBid, error)
+	RewindIndexerCursor(ctx context.Context, arg RewindIndexerCursorParams) error
+	RewindIndexerCursors(ctx context.Context, arg RewindIndexerCursorsParams) error
+	RoundContributionExists(ctx context.Context, arg RoundContributionExistsParams) (bool, error)
+	SearchGroupMembers(ctx context.Context, arg SearchGroupMembersParams) ([]SearchGroupMembersRow, error)
+	// Full-text match on name and description, falling back to trigram word
+	// similarity on the name so partial and misspelled names still match.
+	SearchUserGroups(ctx context.Context, arg SearchUserGroupsParams) ([]SearchUserGroupsRow, error)
+	SwapRoundMemberPositions(ctx context.Context, arg SwapRoundMemberPositionsParams) error
+	// Recomputes the per-member counters from the indexed events, so the read
+	// model stays correct no matter how often a block range is replayed.
+	SyncRoundMemberTotals(ctx context.Context, roundID uuid.UUID) error
+	// The round clock starts at the first indexed contribution.
+	SyncRoundTotals(ctx context.Context, id uuid.UUID) error
+	UnarchiveGroup(ctx context.Context, id uuid.UUID) (Group, error)
 	UpdateJobStatus(ctx context.Context, arg UpdateJobStatusParams) (Job, error)
 	UpdateMagicLink(ctx context.Context, arg UpdateMagicLinkParams) (MagicLink, error)
+	UpdatePayoutOrderProposal(ctx context.Context, arg UpdatePayoutOrderProposalParams) (PayoutOrder, error)
 	UpdatePendingSignup(ctx context.Context, arg UpdatePendingSignupParams) (PendingSignup, error)
+	// Only succeeds while the round is still in from_status, so concurrent
+	// transitions cannot both apply.
+	UpdateRoundStatus(ctx context.Context, arg UpdateRoundStatusParams) (Round, error)
+	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (User, error)
+	UpsertChainBlock(ctx context.Context, arg UpsertChainBlockParams) error
+	UpsertIndexerCursor(ctx context.Context, arg UpsertIndexerCursorParams) error
+	UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) (NotificationPreference, error)
+	UpsertPayoutOrderApproval(ctx context.Context, arg UpsertPayoutOrderApprovalParams) error
+	// A new commitment replaces the previous one and clears any reveal.
+	UpsertPayoutOrderBid(ctx context.Context, arg UpsertPayoutOrderBidParams) error
+	// Opens a discrepancy, or refreshes the open one with the same key. Returns
+	// true when it was opened.
+	UpsertRoundDiscrepancy(ctx context.Context, arg UpsertRoundDiscrepancyParams) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -15,7 +15,7 @@ import (
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (full_name, email, address, display_name)
 VALUES ($1, $2, $3, $4)
-RETURNING id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at
+RETURNING id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at, preferred_currency
 `
 
 type CreateUserParams struct {
@@ -43,12 +43,13 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
 
 const getUserByAddress = `-- name: GetUserByAddress :one
-SELECT id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at FROM users WHERE address = $1 AND deleted_at IS NULL
+SELECT id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at, preferred_currency FROM users WHERE address = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByAddress(ctx context.Context, address string) (User, error) {
@@ -64,12 +65,13 @@ func (q *Queries) GetUserByAddress(ctx context.Context, address string) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at FROM users WHERE email = $1 AND deleted_at IS NULL
+SELECT id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at, preferred_currency FROM users WHERE email = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (User, error) {
@@ -85,12 +87,13 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email pgtype.Text) (User,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at FROM users WHERE id = $1 AND deleted_at IS NULL
+SELECT id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at, preferred_currency FROM users WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
@@ -106,6 +109,47 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PreferredCurrency,
+	)
+	return i, err
+}
+
+const updateUserProfile = `-- name: UpdateUserProfile :one
+UPDATE users
+SET display_name = COALESCE($2, display_name),
+    avatar_url = COALESCE($3, avatar_url),
+    preferred_currency = COALESCE($4, preferred_currency),
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, full_name, email, address, display_name, avatar_url, created_at, updated_at, deleted_at, preferred_currency
+`
+
+type UpdateUserProfileParams struct {
+	ID                uuid.UUID `json:"id"`
+	DisplayName       *string   `json:"display_name"`
+	AvatarUrl         *string   `json:"avatar_url"`
+	PreferredCurrency *string   `json:"preferred_currency"`
+}
+
+func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserProfile,
+		arg.ID,
+		arg.DisplayName,
+		arg.AvatarUrl,
+		arg.PreferredCurrency,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FullName,
+		&i.Email,
+		&i.Address,
+		&i.DisplayName,
+		&i.AvatarUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
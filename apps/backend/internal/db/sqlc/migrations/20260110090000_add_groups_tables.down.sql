@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_invites_group;
+DROP TABLE IF EXISTS invites;
+DROP TABLE IF EXISTS group_member_removals;
+DROP INDEX IF EXISTS idx_group_members_user;
+DROP TABLE IF EXISTS group_members;
+DROP TABLE IF EXISTS groups;
@@ -0,0 +1,57 @@
+CREATE TABLE
+    groups (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "name" TEXT NOT NULL,
+        "description" TEXT,
+        "avatar_url" TEXT,
+        "owner_id" UUID NOT NULL REFERENCES users (id),
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "deleted_at" TIMESTAMP
+    );
+
+CREATE TABLE
+    group_members (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "group_id" UUID NOT NULL REFERENCES groups (id),
+        "user_id" UUID NOT NULL REFERENCES users (id),
+        "role" TEXT NOT NULL DEFAULT 'member',
+        "status" TEXT NOT NULL DEFAULT 'invited',
+        "joined_at" TIMESTAMPTZ,
+        "removed_at" TIMESTAMPTZ,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE (group_id, user_id)
+    );
+
+CREATE INDEX idx_group_members_user ON group_members (user_id) WHERE status <> 'removed';
+
+-- Audit trail of members leaving or being removed. Force removals keep a
+-- snapshot of what the member still owed at the time.
+CREATE TABLE
+    group_member_removals (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "group_id" UUID NOT NULL REFERENCES groups (id),
+        "user_id" UUID NOT NULL REFERENCES users (id),
+        "removed_by" UUID NOT NULL REFERENCES users (id),
+        "kind" TEXT NOT NULL,
+        "reason" TEXT,
+        "outstanding" JSONB NOT NULL DEFAULT '[]',
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE TABLE
+    invites (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "group_id" UUID NOT NULL REFERENCES groups (id),
+        "code_hash" TEXT UNIQUE NOT NULL,
+        "created_by" UUID NOT NULL REFERENCES users (id),
+        "max_uses" INTEGER NOT NULL DEFAULT 1,
+        "uses" INTEGER NOT NULL DEFAULT 0,
+        "expires_at" TIMESTAMPTZ,
+        "revoked_at" TIMESTAMPTZ,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX idx_invites_group ON invites (group_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_round_members_address;
+DROP TABLE IF EXISTS round_members;
+DROP INDEX IF EXISTS idx_rounds_group_status;
+DROP TABLE IF EXISTS rounds;
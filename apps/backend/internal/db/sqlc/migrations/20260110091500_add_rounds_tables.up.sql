@@ -0,0 +1,33 @@
+CREATE TABLE
+    rounds (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "group_id" UUID NOT NULL REFERENCES groups (id),
+        "chain_id" BIGINT NOT NULL,
+        "contract_address" TEXT NOT NULL,
+        "contribution_amount" TEXT NOT NULL,
+        "currency_symbol" TEXT,
+        "period_duration_seconds" BIGINT NOT NULL,
+        "status" TEXT NOT NULL DEFAULT 'pending',
+        "started_at" TIMESTAMPTZ,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE (chain_id, contract_address)
+    );
+
+CREATE INDEX idx_rounds_group_status ON rounds (group_id, status);
+
+-- One row per participant in a round. The rotation has one period per
+-- participant, so the row count is also the number of periods.
+CREATE TABLE
+    round_members (
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "address" TEXT NOT NULL,
+        "payout_position" INTEGER NOT NULL,
+        "contributions_paid" INTEGER NOT NULL DEFAULT 0,
+        "payout_received_at" TIMESTAMPTZ,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        PRIMARY KEY (round_id, address)
+    );
+
+CREATE INDEX idx_round_members_address ON round_members (address);
@@ -0,0 +1,3 @@
+ALTER TABLE groups ADD COLUMN "archived_at" TIMESTAMPTZ;
+
+CREATE INDEX idx_groups_deleted_at ON groups (deleted_at) WHERE deleted_at IS NOT NULL;
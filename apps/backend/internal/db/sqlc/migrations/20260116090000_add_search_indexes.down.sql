@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_address_prefix;
+DROP INDEX IF EXISTS idx_users_display_name_trgm;
+DROP INDEX IF EXISTS idx_groups_name_trgm;
+DROP INDEX IF EXISTS idx_groups_search;
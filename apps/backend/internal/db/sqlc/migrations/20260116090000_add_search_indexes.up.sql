@@ -0,0 +1,10 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Group search matches this exact expression, so keep the two in sync.
+CREATE INDEX idx_groups_search ON groups USING GIN (to_tsvector('simple', name || ' ' || COALESCE(description, '')));
+
+CREATE INDEX idx_groups_name_trgm ON groups USING GIN (name gin_trgm_ops);
+
+CREATE INDEX idx_users_display_name_trgm ON users USING GIN (display_name gin_trgm_ops);
+
+CREATE INDEX idx_users_address_prefix ON users (address varchar_pattern_ops);
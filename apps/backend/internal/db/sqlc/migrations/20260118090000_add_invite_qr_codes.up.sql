@@ -0,0 +1,10 @@
+-- Invite codes are only stored hashed, so the QR code of the deep link has to
+-- be rendered while the plaintext code still exists, when the invite is
+-- created.
+CREATE TABLE
+    invite_qr_codes (
+        "invite_id" UUID PRIMARY KEY REFERENCES invites (id) ON DELETE CASCADE,
+        "png" BYTEA NOT NULL,
+        "svg" TEXT NOT NULL,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+    );
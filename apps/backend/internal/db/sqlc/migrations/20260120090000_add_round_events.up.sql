@@ -0,0 +1,30 @@
+-- Decoded Round contract logs. A log is identified by its transaction hash
+-- and log index, so re-indexing a block range never duplicates rows.
+CREATE TABLE
+    round_events (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "event_type" TEXT NOT NULL,
+        "address" TEXT NOT NULL,
+        "period" BIGINT NOT NULL,
+        "amount" TEXT NOT NULL,
+        "block_number" BIGINT NOT NULL,
+        "block_hash" TEXT NOT NULL,
+        "tx_hash" TEXT NOT NULL,
+        "log_index" INTEGER NOT NULL,
+        "block_time" TIMESTAMPTZ NOT NULL,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE (tx_hash, log_index)
+    );
+
+CREATE INDEX idx_round_events_round_block ON round_events (round_id, block_number);
+
+-- Last block fully indexed for each contract.
+CREATE TABLE
+    indexer_cursors (
+        "chain_id" BIGINT NOT NULL,
+        "contract_address" TEXT NOT NULL,
+        "last_block" BIGINT NOT NULL,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        PRIMARY KEY (chain_id, contract_address)
+    );
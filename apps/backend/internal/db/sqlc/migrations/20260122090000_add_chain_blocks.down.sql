@@ -0,0 +1,4 @@
+ALTER TABLE round_events
+DROP COLUMN IF EXISTS "confirmed";
+
+DROP TABLE IF EXISTS chain_blocks;
@@ -0,0 +1,16 @@
+-- Recent block headers per chain. A reorg is detected when a new block's
+-- parent hash differs from the hash stored for its predecessor.
+CREATE TABLE
+    chain_blocks (
+        "chain_id" BIGINT NOT NULL,
+        "block_number" BIGINT NOT NULL,
+        "block_hash" TEXT NOT NULL,
+        "parent_hash" TEXT NOT NULL,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        PRIMARY KEY (chain_id, block_number)
+    );
+
+-- Events stay pending until they are buried under the chain's confirmation
+-- depth.
+ALTER TABLE round_events
+ADD COLUMN "confirmed" BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_round_events_round_position;
+
+CREATE INDEX idx_round_events_round_block ON round_events (round_id, block_number);
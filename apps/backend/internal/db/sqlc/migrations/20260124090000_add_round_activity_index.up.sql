@@ -0,0 +1,4 @@
+-- Serves the activity feed's keyset order.
+DROP INDEX IF EXISTS idx_round_events_round_block;
+
+CREATE INDEX idx_round_events_round_position ON round_events (round_id, block_number, log_index, id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_rounds_created;
+
+DROP TABLE IF EXISTS round_period_totals;
+
+ALTER TABLE rounds
+DROP COLUMN IF EXISTS "contribution_count",
+DROP COLUMN IF EXISTS "member_count";
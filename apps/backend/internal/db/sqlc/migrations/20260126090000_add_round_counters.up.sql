@@ -0,0 +1,31 @@
+-- Counters maintained by the indexer so round reads never scan events.
+-- member_count is fixed when the round is registered.
+ALTER TABLE rounds
+ADD COLUMN "member_count" INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN "contribution_count" BIGINT NOT NULL DEFAULT 0;
+
+-- Distinct members that have contributed to each period.
+CREATE TABLE
+    round_period_totals (
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "period" BIGINT NOT NULL,
+        "paid_count" INTEGER NOT NULL,
+        PRIMARY KEY (round_id, period)
+    );
+
+UPDATE rounds r
+SET member_count = (
+        SELECT COUNT(*) FROM round_members rm WHERE rm.round_id = r.id
+    ),
+    contribution_count = (
+        SELECT COUNT(*) FROM round_events e
+        WHERE e.round_id = r.id AND e.event_type = 'contribution'
+    );
+
+INSERT INTO round_period_totals (round_id, period, paid_count)
+SELECT round_id, period, COUNT(DISTINCT address)
+FROM round_events
+WHERE event_type = 'contribution'
+GROUP BY round_id, period;
+
+CREATE INDEX idx_rounds_created ON rounds (created_at DESC, id DESC);
@@ -0,0 +1,15 @@
+-- Every round status change, with what caused it. actor_id is set for owner
+-- actions and NULL for transitions driven by indexed contract events.
+CREATE TABLE
+    round_status_history (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "from_status" TEXT NOT NULL,
+        "to_status" TEXT NOT NULL,
+        "cause" TEXT NOT NULL,
+        "actor_id" UUID REFERENCES users (id),
+        "reason" TEXT,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX idx_round_status_history_round ON round_status_history (round_id, created_at);
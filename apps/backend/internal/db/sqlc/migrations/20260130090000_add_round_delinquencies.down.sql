@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS round_delinquencies;
+
+ALTER TABLE groups
+DROP COLUMN IF EXISTS "grace_period_seconds";
@@ -0,0 +1,21 @@
+-- How long after a period ends a member may still pay before the
+-- contribution counts as missed.
+ALTER TABLE groups
+ADD COLUMN "grace_period_seconds" BIGINT NOT NULL DEFAULT 86400;
+
+-- Contributions flagged by the delinquency job. A member who has not paid
+-- when a period ends is flagged late, and missed once the group's grace
+-- period has run out too. Rows are never updated, so a late payment keeps
+-- its flag.
+CREATE TABLE
+    round_delinquencies (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "address" TEXT NOT NULL,
+        "period" BIGINT NOT NULL,
+        "status" TEXT NOT NULL,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE (round_id, address, period, status)
+    );
+
+CREATE INDEX idx_round_delinquencies_address ON round_delinquencies (address, created_at DESC);
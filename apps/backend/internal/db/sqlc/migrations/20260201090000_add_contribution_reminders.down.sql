@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS contribution_reminders;
+
+DROP TABLE IF EXISTS notification_preferences;
@@ -0,0 +1,25 @@
+-- Per-user notification settings. Users without a row get the defaults:
+-- email reminders 72h, 24h and 2h before each contribution deadline. An
+-- empty channel list turns reminders off.
+CREATE TABLE
+    notification_preferences (
+        "user_id" UUID PRIMARY KEY REFERENCES users (id),
+        "reminder_channels" TEXT[] NOT NULL DEFAULT '{email}',
+        "reminder_lead_times_seconds" BIGINT[] NOT NULL DEFAULT '{259200,86400,7200}',
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+    );
+
+-- Contribution reminders already sent, so each lead time is sent at most
+-- once per member, period and channel.
+CREATE TABLE
+    contribution_reminders (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "address" TEXT NOT NULL,
+        "period" BIGINT NOT NULL,
+        "lead_time_seconds" BIGINT NOT NULL,
+        "channel" TEXT NOT NULL,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE (round_id, address, period, lead_time_seconds, channel)
+    );
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS payout_order_bids;
+
+DROP TABLE IF EXISTS payout_order_approvals;
+
+DROP TABLE IF EXISTS payout_orders;
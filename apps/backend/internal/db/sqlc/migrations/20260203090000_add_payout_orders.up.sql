@@ -0,0 +1,53 @@
+-- Payout orders decide the rotation of a round before its contract is
+-- deployed. participants is the set being ordered; proposed_order is the
+-- order under discussion for the fixed and consensus strategies, and
+-- revision counts its changes so approvals of an older proposal do not
+-- count. final_order and final_discounts are set once, when the order is
+-- finalized, and are what the contract is deployed with.
+CREATE TABLE
+    payout_orders (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "group_id" UUID NOT NULL REFERENCES groups (id),
+        "strategy" TEXT NOT NULL,
+        "status" TEXT NOT NULL DEFAULT 'open',
+        "participants" TEXT[] NOT NULL,
+        "proposed_order" TEXT[] NOT NULL DEFAULT '{}',
+        "revision" INTEGER NOT NULL DEFAULT 1,
+        "chain_id" BIGINT,
+        "seed_block_number" BIGINT,
+        "seed_block_hash" TEXT,
+        "bidding_ends_at" TIMESTAMPTZ,
+        "reveal_ends_at" TIMESTAMPTZ,
+        "final_order" TEXT[],
+        "final_discounts" TEXT[],
+        "created_by" UUID NOT NULL REFERENCES users (id),
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "finalized_at" TIMESTAMPTZ
+    );
+
+CREATE INDEX idx_payout_orders_group ON payout_orders (group_id, created_at DESC);
+
+-- The latest proposal revision each participant approved (consensus).
+CREATE TABLE
+    payout_order_approvals (
+        "payout_order_id" UUID NOT NULL REFERENCES payout_orders (id),
+        "address" TEXT NOT NULL,
+        "revision" INTEGER NOT NULL,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        PRIMARY KEY (payout_order_id, address)
+    );
+
+-- Sealed bids (auction). commitment is keccak256 of the bidder address, the
+-- discount as uint256 and a 32-byte salt; discount is set once the bid is
+-- revealed and matches its commitment.
+CREATE TABLE
+    payout_order_bids (
+        "payout_order_id" UUID NOT NULL REFERENCES payout_orders (id),
+        "address" TEXT NOT NULL,
+        "commitment" TEXT NOT NULL,
+        "discount" TEXT,
+        "revealed_at" TIMESTAMPTZ,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        PRIMARY KEY (payout_order_id, address)
+    );
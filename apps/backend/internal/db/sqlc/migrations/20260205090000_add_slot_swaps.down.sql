@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS slot_swaps;
+
+ALTER TABLE round_members DROP COLUMN IF EXISTS initial_position;
+
+ALTER TABLE round_events DROP COLUMN IF EXISTS counterparty;
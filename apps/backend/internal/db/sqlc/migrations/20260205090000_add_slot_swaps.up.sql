@@ -0,0 +1,38 @@
+-- SlotsSwapped events name the member whose payout slot was taken.
+ALTER TABLE round_events ADD COLUMN "counterparty" TEXT;
+
+-- Payout positions follow the swaps applied on chain. The registered order is
+-- kept so positions can be replayed from the indexed events after a reorg.
+ALTER TABLE round_members ADD COLUMN "initial_position" INTEGER;
+
+UPDATE round_members SET initial_position = payout_position;
+
+ALTER TABLE round_members ALTER COLUMN "initial_position" SET NOT NULL;
+
+-- Proposed swaps of payout slots between two round members. The counterparty
+-- accepts by signing the swap's EIP-712 typed data; the proposer submits it
+-- to the round contract.
+CREATE TABLE
+    slot_swaps (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "proposer_address" TEXT NOT NULL,
+        "counterparty_address" TEXT NOT NULL,
+        "proposer_slot" INTEGER NOT NULL,
+        "counterparty_slot" INTEGER NOT NULL,
+        "nonce" TEXT NOT NULL,
+        "deadline" TIMESTAMPTZ NOT NULL,
+        "status" TEXT NOT NULL DEFAULT 'proposed',
+        "signature" TEXT,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "accepted_at" TIMESTAMPTZ,
+        "applied_at" TIMESTAMPTZ
+    );
+
+CREATE INDEX idx_slot_swaps_round ON slot_swaps (round_id, created_at DESC);
+
+-- A member has at most one open swap with the same counterparty.
+CREATE UNIQUE INDEX idx_slot_swaps_open_pair ON slot_swaps (round_id, proposer_address, counterparty_address)
+WHERE
+    status IN ('proposed', 'accepted');
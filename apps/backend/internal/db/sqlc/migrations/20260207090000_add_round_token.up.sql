@@ -0,0 +1,3 @@
+-- The ERC-20 token a round collects contributions in, read from the
+-- contract at registration. NULL for contracts that do not expose it.
+ALTER TABLE rounds ADD COLUMN "token_address" TEXT;
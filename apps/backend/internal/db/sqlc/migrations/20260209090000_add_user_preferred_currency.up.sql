@@ -0,0 +1,3 @@
+-- ISO 4217 code amounts are shown in alongside token amounts. NULL uses the
+-- server default.
+ALTER TABLE users ADD COLUMN "preferred_currency" TEXT;
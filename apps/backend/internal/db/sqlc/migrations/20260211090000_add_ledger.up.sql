@@ -0,0 +1,72 @@
+-- Double-entry journal of the funds moving through each round, posted from
+-- indexed round events. Rows are never updated: when a reorg drops an event,
+-- its entry is cancelled by a reversing entry.
+CREATE TABLE
+    ledger_accounts (
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "kind" TEXT NOT NULL,
+        -- Lowercase member address, empty for the round's pot.
+        "member" TEXT NOT NULL DEFAULT '',
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        PRIMARY KEY (round_id, kind, member)
+    );
+
+CREATE TABLE
+    ledger_entries (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "kind" TEXT NOT NULL,
+        "period" BIGINT NOT NULL,
+        "tx_hash" TEXT NOT NULL,
+        "log_index" INTEGER NOT NULL,
+        "occurred_at" TIMESTAMPTZ NOT NULL,
+        "reverses" UUID UNIQUE REFERENCES ledger_entries (id),
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX idx_ledger_entries_round ON ledger_entries (round_id, created_at);
+
+CREATE INDEX idx_ledger_entries_source ON ledger_entries (round_id, tx_hash, log_index);
+
+-- Signed amounts in token base units: debits positive, credits negative.
+-- The postings of an entry sum to zero.
+CREATE TABLE
+    ledger_postings (
+        "entry_id" UUID NOT NULL REFERENCES ledger_entries (id),
+        "round_id" UUID NOT NULL,
+        "account_kind" TEXT NOT NULL,
+        "member" TEXT NOT NULL,
+        "amount" TEXT NOT NULL,
+        PRIMARY KEY (entry_id, account_kind, member),
+        FOREIGN KEY (round_id, account_kind, member) REFERENCES ledger_accounts (round_id, kind, member)
+    );
+
+-- Journal the events indexed so far.
+INSERT INTO ledger_entries (round_id, kind, period, tx_hash, log_index, occurred_at)
+SELECT round_id, event_type, period, tx_hash, log_index, block_time
+FROM round_events
+WHERE event_type IN ('contribution', 'payout')
+ORDER BY block_number, log_index;
+
+INSERT INTO ledger_accounts (round_id, kind, member)
+SELECT DISTINCT round_id, 'pot', '' FROM round_events
+WHERE event_type IN ('contribution', 'payout')
+UNION
+SELECT DISTINCT round_id,
+    CASE event_type WHEN 'contribution' THEN 'contributed' ELSE 'received' END,
+    address
+FROM round_events
+WHERE event_type IN ('contribution', 'payout');
+
+INSERT INTO ledger_postings (entry_id, round_id, account_kind, member, amount)
+SELECT le.id, le.round_id, 'pot', '',
+    CASE le.kind WHEN 'contribution' THEN e.amount ELSE '-' || e.amount END
+FROM ledger_entries le
+JOIN round_events e ON e.tx_hash = le.tx_hash AND e.log_index = le.log_index
+UNION ALL
+SELECT le.id, le.round_id,
+    CASE le.kind WHEN 'contribution' THEN 'contributed' ELSE 'received' END,
+    e.address,
+    CASE le.kind WHEN 'contribution' THEN '-' || e.amount ELSE e.amount END
+FROM ledger_entries le
+JOIN round_events e ON e.tx_hash = le.tx_hash AND e.log_index = le.log_index;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS round_discrepancies;
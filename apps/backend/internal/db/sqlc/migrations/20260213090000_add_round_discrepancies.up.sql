@@ -0,0 +1,22 @@
+-- Differences between a round contract's state and the read model found by
+-- the reconciliation job. key identifies what differs, so a discrepancy seen
+-- on every run stays one open row until it is resolved.
+CREATE TABLE
+    round_discrepancies (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "kind" TEXT NOT NULL,
+        "key" TEXT NOT NULL,
+        "period" BIGINT,
+        "address" TEXT,
+        "on_chain" TEXT NOT NULL,
+        "off_chain" TEXT NOT NULL,
+        "block_number" BIGINT NOT NULL,
+        "detected_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "last_seen_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "resolved_at" TIMESTAMPTZ
+    );
+
+CREATE UNIQUE INDEX idx_round_discrepancies_open ON round_discrepancies (round_id, key)
+WHERE
+    resolved_at IS NULL;
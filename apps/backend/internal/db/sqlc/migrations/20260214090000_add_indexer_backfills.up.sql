@@ -0,0 +1,22 @@
+-- Backfills re-read a round's history from the chain in chunks of blocks,
+-- alongside the live indexer. next_block is the first block still to read,
+-- so a backfill resumes where it stopped.
+CREATE TABLE
+    indexer_backfills (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "from_block" BIGINT NOT NULL,
+        "to_block" BIGINT NOT NULL,
+        "next_block" BIGINT NOT NULL,
+        "status" TEXT NOT NULL DEFAULT 'running',
+        "events_written" BIGINT NOT NULL DEFAULT 0,
+        "error_message" TEXT,
+        "requested_by" UUID REFERENCES users (id),
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "updated_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        "completed_at" TIMESTAMPTZ
+    );
+
+CREATE UNIQUE INDEX idx_indexer_backfills_running ON indexer_backfills (round_id)
+WHERE
+    status = 'running';
@@ -0,0 +1,16 @@
+-- Allowlists are the Merkle roots a Round contract is deployed with to
+-- check membership without the member list being published. A new version
+-- is stored whenever a group's accepted members, or the payout order they
+-- are listed in, change. members is the list in payout order, so proofs
+-- can still be served for the root an earlier contract was deployed with.
+CREATE TABLE
+    group_allowlists (
+        "id" UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        "group_id" UUID NOT NULL REFERENCES groups (id),
+        "version" INTEGER NOT NULL,
+        "root" TEXT NOT NULL,
+        "members" TEXT[] NOT NULL,
+        "payout_order_id" UUID REFERENCES payout_orders (id),
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        UNIQUE (group_id, version)
+    );
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ledger_hourly_contributions;
+
+ALTER TABLE ledger_accounts DROP COLUMN IF EXISTS balance;
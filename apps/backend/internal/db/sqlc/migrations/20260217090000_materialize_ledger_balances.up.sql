@@ -0,0 +1,36 @@
+-- Account balances are kept on the accounts and updated in the transaction
+-- that posts to them, so reads do not replay the journal. Amounts are signed
+-- token base units, like postings.
+ALTER TABLE ledger_accounts ADD COLUMN "balance" TEXT NOT NULL DEFAULT '0';
+
+UPDATE ledger_accounts a
+SET balance = p.total::TEXT
+FROM (
+    SELECT round_id, account_kind, member, SUM(amount::NUMERIC) AS total
+    FROM ledger_postings
+    GROUP BY round_id, account_kind, member
+) p
+WHERE a.round_id = p.round_id
+  AND a.kind = p.account_kind
+  AND a.member = p.member;
+
+-- Contributions paid into each round per hour, the resolution of historical
+-- prices, so fiat totals are priced without reading every entry. A reversal
+-- is subtracted from the hour of the contribution it cancels.
+CREATE TABLE
+    ledger_hourly_contributions (
+        "round_id" UUID NOT NULL REFERENCES rounds (id),
+        "hour" TIMESTAMPTZ NOT NULL,
+        "amount" TEXT NOT NULL DEFAULT '0',
+        PRIMARY KEY (round_id, hour)
+    );
+
+INSERT INTO ledger_hourly_contributions (round_id, hour, amount)
+SELECT le.round_id,
+    date_trunc('hour', COALESCE(original.occurred_at, le.occurred_at)),
+    SUM(p.amount::NUMERIC)::TEXT
+FROM ledger_entries le
+JOIN ledger_postings p ON p.entry_id = le.id AND p.account_kind = 'pot'
+LEFT JOIN ledger_entries original ON original.id = le.reverses
+WHERE le.kind = 'contribution'
+GROUP BY 1, 2;
@@ -0,0 +1,7 @@
+CREATE TABLE
+    invite_qr_codes (
+        "invite_id" UUID PRIMARY KEY REFERENCES invites (id) ON DELETE CASCADE,
+        "png" BYTEA NOT NULL,
+        "svg" TEXT NOT NULL,
+        "created_at" TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+    );
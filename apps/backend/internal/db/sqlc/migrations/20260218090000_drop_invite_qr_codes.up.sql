@@ -0,0 +1,3 @@
+-- A stored QR code of the deep link is the plaintext invite code under
+-- another encoding, so it is only rendered into the create invite response.
+DROP TABLE IF EXISTS invite_qr_codes;
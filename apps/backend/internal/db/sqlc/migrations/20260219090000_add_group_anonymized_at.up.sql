@@ -0,0 +1,3 @@
+-- Set when the purge job anonymizes a deleted group it cannot delete because
+-- rounds still belong to it.
+ALTER TABLE groups ADD COLUMN "anonymized_at" TIMESTAMPTZ;
@@ -0,0 +1 @@
+ALTER TABLE rounds DROP COLUMN IF EXISTS start_block;
@@ -0,0 +1,4 @@
+-- The block a round is indexed from when it has no cursor yet: its
+-- deployment block when known, otherwise the confirmed head when it was
+-- registered. Existing rounds keep indexing from their cursors.
+ALTER TABLE rounds ADD COLUMN "start_block" BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,23 @@
+-- name: GetLatestChainBlock :one
+SELECT * FROM chain_blocks
+WHERE chain_id = $1
+ORDER BY block_number DESC
+LIMIT 1;
+
+-- name: GetChainBlock :one
+SELECT * FROM chain_blocks
+WHERE chain_id = $1 AND block_number = $2;
+
+-- name: UpsertChainBlock :exec
+INSERT INTO chain_blocks (chain_id, block_number, block_hash, parent_hash)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (chain_id, block_number)
+DO UPDATE SET block_hash = EXCLUDED.block_hash, parent_hash = EXCLUDED.parent_hash;
+
+-- name: DeleteChainBlocksAfter :exec
+DELETE FROM chain_blocks
+WHERE chain_id = $1 AND block_number > $2;
+
+-- name: DeleteChainBlocksBefore :exec
+DELETE FROM chain_blocks
+WHERE chain_id = $1 AND block_number < $2;
@@ -0,0 +1,20 @@
+-- name: InsertContributionReminder :execrows
+-- Returns 0 when the reminder was already sent.
+INSERT INTO contribution_reminders (round_id, address, period, lead_time_seconds, channel)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (round_id, address, period, lead_time_seconds, channel) DO NOTHING;
+
+-- name: ListReminderRecipients :many
+-- Members of a round with their contact details and notification
+-- preferences. The preference columns are NULL for users who never set them.
+SELECT rm.address,
+       u.email,
+       u.display_name,
+       u.preferred_currency,
+       p.reminder_channels,
+       p.reminder_lead_times_seconds
+FROM round_members rm
+JOIN users u ON u.address = rm.address AND u.deleted_at IS NULL
+LEFT JOIN notification_preferences p ON p.user_id = u.id
+WHERE rm.round_id = $1
+ORDER BY rm.payout_position ASC;
@@ -0,0 +1,22 @@
+-- name: CreateGroupAllowlist :one
+-- Stores the next version of a group's allowlist. It returns no rows when
+-- another version was stored concurrently.
+INSERT INTO group_allowlists (group_id, version, root, members, payout_order_id)
+SELECT sqlc.arg(group_id), COALESCE(MAX(version), 0) + 1, sqlc.arg(root), sqlc.arg(members)::text[], sqlc.narg(payout_order_id)
+FROM group_allowlists
+WHERE group_id = sqlc.arg(group_id)
+ON CONFLICT (group_id, version) DO NOTHING
+RETURNING *;
+
+-- name: GetLatestGroupAllowlist :one
+SELECT * FROM group_allowlists
+WHERE group_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: GetGroupAllowlist :one
+SELECT * FROM group_allowlists
+WHERE group_id = $1 AND version = $2;
+
+-- name: DeleteGroupAllowlists :exec
+DELETE FROM group_allowlists WHERE group_id = $1;
@@ -0,0 +1,80 @@
+-- name: GetActiveGroupMemberByAddress :one
+SELECT gm.*
+FROM group_members gm
+JOIN users u ON u.id = gm.user_id
+WHERE gm.group_id = $1
+  AND u.address = $2
+  AND u.deleted_at IS NULL
+  AND gm.status <> 'removed';
+
+-- name: LockGroupMember :one
+-- Locks a member who has not been removed until the transaction ends, so
+-- that checks made before removing them cannot race another removal.
+SELECT * FROM group_members
+WHERE id = $1 AND status <> 'removed'
+FOR UPDATE;
+
+-- name: MarkGroupMemberRemoved :one
+UPDATE group_members
+SET status = 'removed',
+    removed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status <> 'removed'
+RETURNING *;
+
+-- name: CreateGroupMemberRemoval :one
+INSERT INTO group_member_removals (group_id, user_id, removed_by, kind, reason, outstanding)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: SearchGroupMembers :many
+SELECT gm.id, u.address, u.display_name, gm.role, gm.status, gm.joined_at
+FROM group_members gm
+JOIN users u ON u.id = gm.user_id
+WHERE gm.group_id = sqlc.arg(group_id)
+  AND gm.status <> 'removed'
+  AND u.deleted_at IS NULL
+  AND (
+    u.address LIKE sqlc.arg(address_prefix)::text
+    OR u.display_name ILIKE sqlc.arg(name_pattern)::text
+    OR sqlc.arg(query)::text <% u.display_name
+  )
+  AND (
+    sqlc.narg(cursor_address)::text IS NULL
+    OR (u.address, gm.id) > (sqlc.narg(cursor_address)::text, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY u.address ASC, gm.id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListAcceptedGroupMemberAddresses :many
+SELECT u.address
+FROM group_members gm
+JOIN users u ON u.id = gm.user_id
+WHERE gm.group_id = $1
+  AND gm.status = 'accepted'
+  AND u.deleted_at IS NULL
+ORDER BY u.address;
+
+-- name: ListUserPendingInvites :many
+SELECT gm.id,
+       g.id AS group_id,
+       g.name AS group_name,
+       g.avatar_url AS group_avatar_url,
+       gm.created_at
+FROM group_members gm
+JOIN groups g ON g.id = gm.group_id
+WHERE gm.user_id = $1
+  AND gm.status = 'invited'
+  AND g.deleted_at IS NULL
+  AND g.archived_at IS NULL
+ORDER BY gm.created_at DESC
+LIMIT $2;
+
+-- name: GetGroupMemberDetails :one
+SELECT gm.id, u.address, u.display_name, gm.role, gm.status, gm.joined_at
+FROM group_members gm
+JOIN users u ON u.id = gm.user_id
+WHERE gm.group_id = $1
+  AND u.address = $2
+  AND u.deleted_at IS NULL
+  AND gm.status <> 'removed';
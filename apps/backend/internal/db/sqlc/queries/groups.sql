@@ -0,0 +1,123 @@
+-- name: GetGroupByID :one
+SELECT * FROM groups WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListUserGroups :many
+SELECT g.id,
+       g.name,
+       g.description,
+       g.avatar_url,
+       g.owner_id,
+       g.archived_at,
+       g.created_at,
+       g.updated_at,
+       COUNT(m.id) AS member_count
+FROM groups g
+JOIN group_members me ON me.group_id = g.id
+JOIN group_members m ON m.group_id = g.id AND m.status = 'accepted'
+WHERE me.user_id = sqlc.arg(user_id)
+  AND me.status = 'accepted'
+  AND g.deleted_at IS NULL
+  AND (g.archived_at IS NOT NULL) = sqlc.arg(archived)::boolean
+  AND (
+    sqlc.narg(cursor_created_at)::timestamptz IS NULL
+    OR (g.created_at, g.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid)
+  )
+GROUP BY g.id
+ORDER BY g.created_at DESC, g.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: SearchUserGroups :many
+-- Full-text match on name and description, falling back to trigram word
+-- similarity on the name so partial and misspelled names still match.
+SELECT id, name, description, avatar_url, owner_id, archived_at, created_at, updated_at, member_count, rank
+FROM (
+    SELECT g.id,
+           g.name,
+           g.description,
+           g.avatar_url,
+           g.owner_id,
+           g.archived_at,
+           g.created_at,
+           g.updated_at,
+           COUNT(m.id) AS member_count,
+           (
+             ts_rank(to_tsvector('simple', g.name || ' ' || COALESCE(g.description, '')), websearch_to_tsquery('simple', sqlc.arg(query)::text))
+             + word_similarity(sqlc.arg(query)::text, g.name)
+           )::float8 AS rank
+    FROM groups g
+    JOIN group_members me ON me.group_id = g.id
+    JOIN group_members m ON m.group_id = g.id AND m.status = 'accepted'
+    WHERE me.user_id = sqlc.arg(user_id)
+      AND me.status = 'accepted'
+      AND g.deleted_at IS NULL
+      AND (g.archived_at IS NOT NULL) = sqlc.arg(archived)::boolean
+      AND (
+        to_tsvector('simple', g.name || ' ' || COALESCE(g.description, '')) @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+        OR sqlc.arg(query)::text <% g.name
+      )
+    GROUP BY g.id
+) AS matches
+WHERE sqlc.narg(cursor_rank)::float8 IS NULL
+   OR (rank, id) < (sqlc.narg(cursor_rank)::float8, sqlc.narg(cursor_id)::uuid)
+ORDER BY rank DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountUnfinishedGroupRounds :one
+SELECT COUNT(*) FROM rounds
+WHERE group_id = $1 AND status IN ('pending', 'active', 'paused');
+
+-- name: ArchiveGroup :one
+UPDATE groups
+SET archived_at = COALESCE(archived_at, NOW()),
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UnarchiveGroup :one
+UPDATE groups
+SET archived_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListPurgeableGroups :many
+-- Groups that still have rounds cannot be deleted: rounds map to on-chain
+-- contracts and stay around as history. They are anonymized instead, once.
+SELECT id, EXISTS (SELECT 1 FROM rounds WHERE rounds.group_id = groups.id) AS has_rounds
+FROM groups
+WHERE deleted_at IS NOT NULL
+  AND deleted_at < $1
+  AND anonymized_at IS NULL
+ORDER BY deleted_at ASC
+LIMIT $2;
+
+-- name: AnonymizeGroup :exec
+UPDATE groups
+SET name = 'Deleted group',
+    description = NULL,
+    avatar_url = NULL,
+    anonymized_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: DeleteGroupInvites :exec
+DELETE FROM invites WHERE group_id = $1;
+
+-- name: DeleteGroupMemberRemovals :exec
+DELETE FROM group_member_removals WHERE group_id = $1;
+
+-- name: DeleteGroupMembers :exec
+DELETE FROM group_members WHERE group_id = $1;
+
+-- name: DeleteGroup :exec
+DELETE FROM groups WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: LockGroup :exec
+-- Holds the group row until the transaction ends, so that writes derived
+-- from its current members, like allowlist versions, happen one at a time.
+SELECT id FROM groups WHERE id = $1 FOR NO KEY UPDATE;
+
+-- name: ListActiveGroupIDs :many
+SELECT id FROM groups
+WHERE deleted_at IS NULL
+ORDER BY created_at ASC;
@@ -0,0 +1,44 @@
+-- name: CreateIndexerBackfill :one
+INSERT INTO indexer_backfills (round_id, from_block, to_block, next_block, requested_by)
+VALUES (sqlc.arg(round_id), sqlc.arg(from_block), sqlc.arg(to_block), sqlc.arg(from_block), sqlc.narg(requested_by))
+RETURNING *;
+
+-- name: GetIndexerBackfill :one
+SELECT * FROM indexer_backfills
+WHERE id = $1;
+
+-- name: GetRunningIndexerBackfill :one
+SELECT * FROM indexer_backfills
+WHERE round_id = $1 AND status = 'running';
+
+-- name: ListRoundIndexerBackfills :many
+SELECT * FROM indexer_backfills
+WHERE round_id = $1
+ORDER BY created_at DESC;
+
+-- name: AdvanceIndexerBackfill :one
+-- Moves a running backfill past a chunk it has written. It only applies
+-- when the backfill is still at the chunk's first block, so a chunk that
+-- is processed twice is counted once.
+UPDATE indexer_backfills
+SET next_block = sqlc.arg(next_block),
+    events_written = events_written + sqlc.arg(events_written),
+    status = CASE WHEN sqlc.arg(next_block) > to_block THEN 'completed' ELSE status END,
+    completed_at = CASE WHEN sqlc.arg(next_block) > to_block THEN CURRENT_TIMESTAMP ELSE completed_at END,
+    error_message = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id)
+  AND status = 'running'
+  AND next_block = sqlc.arg(chunk_start)
+RETURNING *;
+
+-- name: FailIndexerBackfill :exec
+UPDATE indexer_backfills
+SET status = 'failed', error_message = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'running';
+
+-- name: ResumeIndexerBackfill :one
+UPDATE indexer_backfills
+SET status = 'running', error_message = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'failed'
+RETURNING *;
@@ -0,0 +1,10 @@
+-- name: CreateInvite :one
+INSERT INTO invites (group_id, code_hash, created_by, max_uses, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetActiveInvite :one
+SELECT * FROM invites
+WHERE id = $1
+  AND group_id = $2
+  AND revoked_at IS NULL;
@@ -43,3 +43,11 @@ RETURNING *;
 -- name: GetJobByID :one
 SELECT * FROM jobs WHERE id = $1 AND deleted_at IS NULL;
 
+
+-- name: GetPendingJobByType :one
+SELECT * FROM jobs
+WHERE type = $1
+  AND status = 'pending'
+  AND deleted_at IS NULL
+ORDER BY scheduled_at ASC
+LIMIT 1;
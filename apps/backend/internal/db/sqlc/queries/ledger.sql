@@ -0,0 +1,88 @@
+-- name: ListUnjournaledRoundEvents :many
+-- Contribution and payout events of a round without a standing journal
+-- entry: new events, and events indexed again after a reorg reversed their
+-- entry.
+SELECT e.* FROM round_events e
+WHERE e.round_id = $1
+  AND e.event_type IN ('contribution', 'payout')
+  AND NOT EXISTS (
+    SELECT 1 FROM ledger_entries le
+    WHERE le.round_id = e.round_id
+      AND le.tx_hash = e.tx_hash
+      AND le.log_index = e.log_index
+      AND le.reverses IS NULL
+      AND NOT EXISTS (SELECT 1 FROM ledger_entries r WHERE r.reverses = le.id)
+  )
+ORDER BY e.block_number ASC, e.log_index ASC;
+
+-- name: ListOrphanedLedgerEntries :many
+-- Standing entries whose event is no longer indexed, which must be reversed.
+SELECT le.* FROM ledger_entries le
+WHERE le.round_id = $1
+  AND le.reverses IS NULL
+  AND NOT EXISTS (SELECT 1 FROM ledger_entries r WHERE r.reverses = le.id)
+  AND NOT EXISTS (
+    SELECT 1 FROM round_events e
+    WHERE e.round_id = le.round_id
+      AND e.tx_hash = le.tx_hash
+      AND e.log_index = le.log_index
+  )
+ORDER BY le.created_at ASC, le.id ASC;
+
+-- name: InsertLedgerEntry :one
+INSERT INTO ledger_entries (round_id, kind, period, tx_hash, log_index, occurred_at, reverses)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: AddLedgerAccountBalance :exec
+-- Opens the account if needed and adds a posting's amount to its balance.
+INSERT INTO ledger_accounts (round_id, kind, member, balance)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (round_id, kind, member)
+DO UPDATE SET balance = (ledger_accounts.balance::NUMERIC + EXCLUDED.balance::NUMERIC)::TEXT;
+
+-- name: AddLedgerHourlyContribution :exec
+INSERT INTO ledger_hourly_contributions (round_id, hour, amount)
+VALUES ($1, $2, $3)
+ON CONFLICT (round_id, hour)
+DO UPDATE SET amount = (ledger_hourly_contributions.amount::NUMERIC + EXCLUDED.amount::NUMERIC)::TEXT;
+
+-- name: InsertLedgerPosting :exec
+INSERT INTO ledger_postings (entry_id, round_id, account_kind, member, amount)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListLedgerEntryPostings :many
+SELECT * FROM ledger_postings
+WHERE entry_id = $1
+ORDER BY account_kind ASC, member ASC;
+
+-- name: ListRoundLedgerPostings :many
+-- Every posting of a round, grouped by entry in the order entries were made.
+SELECT
+    le.id AS entry_id,
+    le.kind,
+    le.period,
+    le.tx_hash,
+    le.log_index,
+    le.occurred_at,
+    le.reverses,
+    p.account_kind,
+    p.member,
+    p.amount
+FROM ledger_entries le
+JOIN ledger_postings p ON p.entry_id = le.id
+WHERE le.round_id = $1
+ORDER BY le.created_at ASC, le.id ASC, p.account_kind ASC, p.member ASC;
+
+-- name: ListRoundLedgerAccounts :many
+SELECT * FROM ledger_accounts
+WHERE round_id = $1
+ORDER BY kind ASC, member ASC;
+
+-- name: ListRoundHourlyContributions :many
+-- Hours in which contributions to a round were made, with what they added up
+-- to. Hours whose contributions were all reversed are left out.
+SELECT * FROM ledger_hourly_contributions
+WHERE round_id = $1
+  AND amount::NUMERIC <> 0
+ORDER BY hour ASC;
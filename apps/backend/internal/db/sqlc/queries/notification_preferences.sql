@@ -0,0 +1,11 @@
+-- name: GetNotificationPreferences :one
+SELECT * FROM notification_preferences WHERE user_id = $1;
+
+-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (user_id, reminder_channels, reminder_lead_times_seconds)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET reminder_channels = EXCLUDED.reminder_channels,
+    reminder_lead_times_seconds = EXCLUDED.reminder_lead_times_seconds,
+    updated_at = NOW()
+RETURNING *;
@@ -0,0 +1,79 @@
+-- name: CreatePayoutOrder :one
+INSERT INTO payout_orders (
+    group_id,
+    strategy,
+    participants,
+    proposed_order,
+    chain_id,
+    seed_block_number,
+    bidding_ends_at,
+    reveal_ends_at,
+    created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetPayoutOrder :one
+SELECT * FROM payout_orders WHERE id = $1 AND group_id = $2;
+
+-- name: UpdatePayoutOrderProposal :one
+UPDATE payout_orders
+SET proposed_order = $2,
+    revision = revision + 1,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'open'
+RETURNING *;
+
+-- name: FinalizePayoutOrder :one
+UPDATE payout_orders
+SET status = 'finalized',
+    final_order = $2,
+    final_discounts = $3,
+    seed_block_hash = $4,
+    finalized_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'open'
+RETURNING *;
+
+-- name: UpsertPayoutOrderApproval :exec
+INSERT INTO payout_order_approvals (payout_order_id, address, revision)
+VALUES ($1, $2, $3)
+ON CONFLICT (payout_order_id, address) DO UPDATE
+SET revision = EXCLUDED.revision,
+    created_at = NOW();
+
+-- name: ListPayoutOrderApprovals :many
+SELECT * FROM payout_order_approvals
+WHERE payout_order_id = $1
+ORDER BY address ASC;
+
+-- name: UpsertPayoutOrderBid :exec
+-- A new commitment replaces the previous one and clears any reveal.
+INSERT INTO payout_order_bids (payout_order_id, address, commitment)
+VALUES ($1, $2, $3)
+ON CONFLICT (payout_order_id, address) DO UPDATE
+SET commitment = EXCLUDED.commitment,
+    discount = NULL,
+    revealed_at = NULL,
+    created_at = NOW();
+
+-- name: GetPayoutOrderBid :one
+SELECT * FROM payout_order_bids WHERE payout_order_id = $1 AND address = $2;
+
+-- name: RevealPayoutOrderBid :one
+UPDATE payout_order_bids
+SET discount = $3,
+    revealed_at = NOW()
+WHERE payout_order_id = $1 AND address = $2
+RETURNING *;
+
+-- name: ListPayoutOrderBids :many
+SELECT * FROM payout_order_bids
+WHERE payout_order_id = $1
+ORDER BY address ASC;
+
+-- name: GetLatestFinalizedPayoutOrder :one
+SELECT * FROM payout_orders
+WHERE group_id = $1 AND status = 'finalized'
+ORDER BY finalized_at DESC
+LIMIT 1;
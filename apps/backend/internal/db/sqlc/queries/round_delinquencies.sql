@@ -0,0 +1,32 @@
+-- name: InsertRoundDelinquency :execrows
+-- Returns 0 when the contribution was already flagged with this status.
+INSERT INTO round_delinquencies (round_id, address, period, status)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (round_id, address, period, status) DO NOTHING;
+
+-- name: ListRoundDelinquencies :many
+SELECT * FROM round_delinquencies
+WHERE round_id = $1
+ORDER BY period ASC, address ASC, created_at ASC;
+
+-- name: ListGroupMemberDelinquencies :many
+-- A member's flags across the group's rounds, with whether the flagged
+-- contribution has been paid since.
+SELECT d.id,
+       d.round_id,
+       d.period,
+       d.status,
+       d.created_at,
+       EXISTS (
+           SELECT 1 FROM round_events e
+           WHERE e.round_id = d.round_id
+             AND e.address = d.address
+             AND e.period = d.period
+             AND e.event_type = 'contribution'
+       ) AS paid
+FROM round_delinquencies d
+JOIN rounds r ON r.id = d.round_id
+WHERE r.group_id = $1
+  AND d.address = $2
+ORDER BY d.created_at DESC, d.id DESC
+LIMIT $3;
@@ -0,0 +1,43 @@
+-- name: ListReconcileRounds :many
+-- Rounds whose contract can still change, or the given round whatever its
+-- status, with what the reconciliation job needs from their group.
+SELECT r.id,
+       g.name AS group_name,
+       g.owner_id,
+       r.chain_id,
+       r.contract_address,
+       r.token_address,
+       r.period_duration_seconds,
+       r.started_at,
+       r.member_count
+FROM rounds r
+JOIN groups g ON g.id = r.group_id
+WHERE (sqlc.narg(round_id)::uuid IS NULL AND r.status IN ('pending', 'active', 'paused') AND g.deleted_at IS NULL)
+   OR r.id = sqlc.narg(round_id)::uuid
+ORDER BY r.created_at ASC;
+
+-- name: UpsertRoundDiscrepancy :one
+-- Opens a discrepancy, or refreshes the open one with the same key. Returns
+-- true when it was opened.
+INSERT INTO round_discrepancies (round_id, kind, key, period, address, on_chain, off_chain, block_number)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (round_id, key) WHERE resolved_at IS NULL
+DO UPDATE SET on_chain = EXCLUDED.on_chain,
+    off_chain = EXCLUDED.off_chain,
+    block_number = EXCLUDED.block_number,
+    last_seen_at = CURRENT_TIMESTAMP
+RETURNING (xmax = 0)::boolean AS opened;
+
+-- name: ResolveRoundDiscrepancies :execrows
+-- Resolves the open discrepancies of a round that were not seen again.
+UPDATE round_discrepancies
+SET resolved_at = CURRENT_TIMESTAMP
+WHERE round_id = $1
+  AND resolved_at IS NULL
+  AND NOT (key = ANY(sqlc.arg(seen_keys)::text[]));
+
+-- name: ListOpenRoundDiscrepancies :many
+SELECT * FROM round_discrepancies
+WHERE resolved_at IS NULL
+  AND (sqlc.narg(round_id)::uuid IS NULL OR round_id = sqlc.narg(round_id)::uuid)
+ORDER BY detected_at ASC, key ASC;
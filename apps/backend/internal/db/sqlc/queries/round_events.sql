@@ -0,0 +1,208 @@
+-- name: ListIndexableRounds :many
+SELECT id, chain_id, contract_address, start_block FROM rounds
+ORDER BY created_at ASC;
+
+-- name: GetIndexerCursor :one
+SELECT last_block FROM indexer_cursors
+WHERE chain_id = $1 AND contract_address = $2;
+
+-- name: UpsertIndexerCursor :exec
+INSERT INTO indexer_cursors (chain_id, contract_address, last_block)
+VALUES ($1, $2, $3)
+ON CONFLICT (chain_id, contract_address)
+DO UPDATE SET last_block = EXCLUDED.last_block, updated_at = CURRENT_TIMESTAMP;
+
+-- name: InsertRoundEvent :execrows
+-- Returns 0 when the log was already indexed.
+INSERT INTO round_events (
+    round_id, event_type, address, period, amount,
+    block_number, block_hash, tx_hash, log_index, block_time, counterparty
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (tx_hash, log_index) DO NOTHING;
+
+-- name: SyncRoundMemberTotals :exec
+-- Recomputes the per-member counters from the indexed events, so the read
+-- model stays correct no matter how often a block range is replayed.
+UPDATE round_members rm
+SET contributions_paid = (
+        SELECT COUNT(*) FROM round_events e
+        WHERE e.round_id = rm.round_id
+          AND e.address = rm.address
+          AND e.event_type = 'contribution'
+    ),
+    payout_received_at = (
+        SELECT MIN(e.block_time) FROM round_events e
+        WHERE e.round_id = rm.round_id
+          AND e.address = rm.address
+          AND e.event_type = 'payout'
+    ),
+    updated_at = CURRENT_TIMESTAMP
+WHERE rm.round_id = $1;
+
+-- name: ResetRoundMemberPositions :exec
+UPDATE round_members
+SET payout_position = initial_position,
+    updated_at = CURRENT_TIMESTAMP
+WHERE round_id = $1
+  AND payout_position <> initial_position;
+
+-- name: ListRoundSwapEvents :many
+SELECT address, counterparty FROM round_events
+WHERE round_id = $1
+  AND event_type = 'swap'
+ORDER BY block_number ASC, log_index ASC;
+
+-- name: SwapRoundMemberPositions :exec
+UPDATE round_members rm
+SET payout_position = other.payout_position,
+    updated_at = CURRENT_TIMESTAMP
+FROM round_members other
+WHERE rm.round_id = sqlc.arg(round_id)
+  AND other.round_id = sqlc.arg(round_id)
+  AND (
+    (rm.address = sqlc.arg(member) AND other.address = sqlc.arg(counterparty))
+    OR (rm.address = sqlc.arg(counterparty) AND other.address = sqlc.arg(member))
+  );
+
+-- name: SyncRoundTotals :exec
+-- The round clock starts at the first indexed contribution.
+UPDATE rounds
+SET started_at = (
+        SELECT MIN(e.block_time) FROM round_events e
+        WHERE e.round_id = rounds.id
+          AND e.event_type = 'contribution'
+    ),
+    contribution_count = (
+        SELECT COUNT(*) FROM round_events e
+        WHERE e.round_id = rounds.id
+          AND e.event_type = 'contribution'
+    ),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: DeleteRoundPeriodTotals :exec
+DELETE FROM round_period_totals
+WHERE round_id = $1;
+
+-- name: InsertRoundPeriodTotals :exec
+INSERT INTO round_period_totals (round_id, period, paid_count)
+SELECT round_id, period, COUNT(DISTINCT address)
+FROM round_events
+WHERE round_id = $1
+  AND event_type = 'contribution'
+GROUP BY round_id, period;
+
+-- name: ListRoundEvents :many
+SELECT * FROM round_events
+WHERE round_id = $1
+ORDER BY block_number ASC, log_index ASC;
+
+-- name: ListRoundActivity :many
+-- Newest first, keyed on chain position so pages stay stable while new
+-- blocks are indexed.
+SELECT e.id, e.event_type, e.address, u.display_name, e.period, e.amount,
+       e.block_number, e.log_index, e.tx_hash, e.block_time, e.confirmed,
+       e.counterparty
+FROM round_events e
+LEFT JOIN users u ON u.address = e.address AND u.deleted_at IS NULL
+WHERE e.round_id = sqlc.arg(round_id)
+  AND (sqlc.narg(event_type)::text IS NULL OR e.event_type = sqlc.narg(event_type)::text)
+  AND (
+    sqlc.narg(cursor_block_number)::bigint IS NULL
+    OR (e.block_number, e.log_index, e.id) < (sqlc.narg(cursor_block_number)::bigint, sqlc.narg(cursor_log_index)::integer, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY e.block_number DESC, e.log_index DESC, e.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ExportRoundActivity :many
+SELECT e.id, e.event_type, e.address, u.display_name, e.period, e.amount,
+       e.block_number, e.log_index, e.tx_hash, e.block_time, e.confirmed,
+       e.counterparty
+FROM round_events e
+LEFT JOIN users u ON u.address = e.address AND u.deleted_at IS NULL
+WHERE e.round_id = sqlc.arg(round_id)
+  AND (sqlc.narg(event_type)::text IS NULL OR e.event_type = sqlc.narg(event_type)::text)
+ORDER BY e.block_number ASC, e.log_index ASC;
+
+-- name: DeleteChainRoundEventsAfter :many
+-- Removes events in blocks that were reorganized out of the chain.
+DELETE FROM round_events e
+USING rounds r
+WHERE e.round_id = r.id
+  AND r.chain_id = $1
+  AND e.block_number > $2
+RETURNING e.round_id;
+
+-- name: RewindIndexerCursors :exec
+UPDATE indexer_cursors
+SET last_block = $2, updated_at = CURRENT_TIMESTAMP
+WHERE chain_id = $1 AND last_block > $2;
+
+-- name: DeleteRoundEventsFrom :exec
+-- Removes a round's events from a block on, so they can be indexed again.
+DELETE FROM round_events
+WHERE round_id = $1 AND block_number >= $2;
+
+-- name: DeleteIndexerCursor :exec
+DELETE FROM indexer_cursors
+WHERE chain_id = $1 AND contract_address = $2;
+
+-- name: RewindIndexerCursor :exec
+UPDATE indexer_cursors
+SET last_block = $3, updated_at = CURRENT_TIMESTAMP
+WHERE chain_id = $1 AND contract_address = $2 AND last_block > $3;
+
+-- name: ConfirmChainRoundEvents :execrows
+UPDATE round_events e
+SET confirmed = true
+FROM rounds r
+WHERE e.round_id = r.id
+  AND r.chain_id = $1
+  AND NOT e.confirmed
+  AND e.block_number <= $2;
+
+-- name: ListUserRecentActivity :many
+-- Latest events across every round of the user's groups.
+SELECT e.id, e.event_type, e.address, u.display_name, e.period, e.amount,
+       e.block_number, e.log_index, e.tx_hash, e.block_time, e.confirmed,
+       e.counterparty, r.chain_id, r.token_address, r.currency_symbol
+FROM round_events e
+JOIN rounds r ON r.id = e.round_id
+JOIN groups g ON g.id = r.group_id
+JOIN group_members me ON me.group_id = r.group_id
+LEFT JOIN users u ON u.address = e.address AND u.deleted_at IS NULL
+WHERE me.user_id = $1
+  AND me.status = 'accepted'
+  AND g.deleted_at IS NULL
+ORDER BY e.block_time DESC, e.id DESC
+LIMIT $2;
+
+-- name: ListRoundContributionPeriods :many
+SELECT DISTINCT address, period
+FROM round_events
+WHERE round_id = $1
+  AND event_type = 'contribution';
+
+-- name: RoundContributionExists :one
+SELECT EXISTS (
+    SELECT 1 FROM round_events
+    WHERE round_id = $1
+      AND address = $2
+      AND period = $3
+      AND event_type = 'contribution'
+)::boolean;
+
+-- name: ListRoundEventsBetween :many
+SELECT * FROM round_events
+WHERE round_id = sqlc.arg(round_id) AND block_number BETWEEN sqlc.arg(from_block) AND sqlc.arg(to_block)
+ORDER BY block_number ASC, log_index ASC;
+
+-- name: DeleteRoundEventsByID :exec
+DELETE FROM round_events
+WHERE id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- name: LockRoundEvents :exec
+-- Serialises transactions that change a round's events and recompute what
+-- is derived from them, such as the live indexer and a backfill.
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(round_id)::uuid::text, 0));
@@ -0,0 +1,4 @@
+-- name: CreateRoundStatusHistory :one
+INSERT INTO round_status_history (round_id, from_status, to_status, cause, actor_id, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
@@ -0,0 +1,158 @@
+-- name: ListActiveRoundObligations :many
+SELECT r.id AS round_id,
+       rm.contributions_paid,
+       rm.payout_received_at,
+       COUNT(peer.address) AS total_periods
+FROM rounds r
+JOIN round_members rm ON rm.round_id = r.id
+JOIN round_members peer ON peer.round_id = r.id
+WHERE r.group_id = $1
+  AND rm.address = $2
+  AND r.status IN ('active', 'paused')
+GROUP BY r.id, rm.contributions_paid, rm.payout_received_at
+ORDER BY r.created_at ASC;
+
+-- name: GetRoundByContract :one
+SELECT * FROM rounds
+WHERE chain_id = $1 AND contract_address = $2;
+
+-- name: CreateRound :one
+INSERT INTO rounds (
+    group_id, chain_id, contract_address, contribution_amount,
+    currency_symbol, period_duration_seconds, member_count, token_address,
+    start_block
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: CreateRoundMember :exec
+INSERT INTO round_members (round_id, address, payout_position, initial_position)
+VALUES ($1, $2, $3, $3);
+
+-- name: GetRoundByID :one
+SELECT * FROM rounds
+WHERE id = $1;
+
+-- name: ListRoundMembers :many
+SELECT * FROM round_members
+WHERE round_id = $1
+ORDER BY payout_position ASC;
+
+-- name: ListUserRounds :many
+SELECT r.id,
+       r.group_id,
+       g.name AS group_name,
+       r.chain_id,
+       r.contract_address,
+       r.contribution_amount,
+       r.currency_symbol,
+       r.token_address,
+       r.period_duration_seconds,
+       r.status,
+       r.started_at,
+       r.member_count,
+       r.created_at
+FROM rounds r
+JOIN groups g ON g.id = r.group_id
+JOIN group_members me ON me.group_id = r.group_id
+WHERE me.user_id = sqlc.arg(user_id)
+  AND me.status = 'accepted'
+  AND g.deleted_at IS NULL
+  AND (sqlc.narg(status)::text IS NULL OR r.status = sqlc.narg(status)::text)
+  AND (sqlc.narg(group_id)::uuid IS NULL OR r.group_id = sqlc.narg(group_id)::uuid)
+  AND (
+    sqlc.narg(cursor_created_at)::timestamptz IS NULL
+    OR (r.created_at, r.id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid)
+  )
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListRoundPeriodTotals :many
+SELECT * FROM round_period_totals
+WHERE round_id = ANY(sqlc.arg(round_ids)::uuid[]);
+
+-- name: GetNextPayoutAddress :one
+-- The first member in payout order that has not been paid out yet.
+SELECT address FROM round_members
+WHERE round_id = $1
+  AND payout_received_at IS NULL
+ORDER BY payout_position ASC
+LIMIT 1;
+
+-- name: ListUserRoundMemberships :many
+-- Unfinished rounds the address takes part in, with its own progress.
+SELECT r.id,
+       r.group_id,
+       g.name AS group_name,
+       r.chain_id,
+       r.contract_address,
+       r.contribution_amount,
+       r.currency_symbol,
+       r.token_address,
+       r.period_duration_seconds,
+       r.status,
+       r.started_at,
+       r.member_count,
+       r.created_at,
+       rm.payout_position,
+       rm.contributions_paid,
+       rm.payout_received_at
+FROM round_members rm
+JOIN rounds r ON r.id = rm.round_id
+JOIN groups g ON g.id = r.group_id
+WHERE rm.address = $1
+  AND r.status IN ('pending', 'active', 'paused')
+  AND g.deleted_at IS NULL
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT $2;
+
+-- name: UpdateRoundStatus :one
+-- Only succeeds while the round is still in from_status, so concurrent
+-- transitions cannot both apply.
+UPDATE rounds
+SET status = sqlc.arg(to_status),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id)
+  AND status = sqlc.arg(from_status)
+RETURNING *;
+
+-- name: ListDelinquencyCheckRounds :many
+-- Active rounds whose clock has started, with what the delinquency job
+-- needs from their group.
+SELECT r.id,
+       r.group_id,
+       g.name AS group_name,
+       g.owner_id,
+       g.grace_period_seconds,
+       r.chain_id,
+       r.contribution_amount,
+       r.currency_symbol,
+       r.token_address,
+       r.period_duration_seconds,
+       r.started_at,
+       r.member_count
+FROM rounds r
+JOIN groups g ON g.id = r.group_id
+WHERE r.status = 'active'
+  AND r.started_at IS NOT NULL
+  AND g.deleted_at IS NULL
+ORDER BY r.created_at ASC;
+
+-- name: ListReminderRounds :many
+-- Active rounds whose clock has started, with what contribution reminders
+-- need from their group.
+SELECT r.id,
+       g.name AS group_name,
+       r.chain_id,
+       r.contribution_amount,
+       r.currency_symbol,
+       r.token_address,
+       r.period_duration_seconds,
+       r.started_at,
+       r.member_count
+FROM rounds r
+JOIN groups g ON g.id = r.group_id
+WHERE r.status = 'active'
+  AND r.started_at IS NOT NULL
+  AND g.deleted_at IS NULL
+ORDER BY r.created_at ASC;
@@ -0,0 +1,62 @@
+-- name: CreateSlotSwap :one
+INSERT INTO slot_swaps (
+    round_id,
+    proposer_address,
+    counterparty_address,
+    proposer_slot,
+    counterparty_slot,
+    nonce,
+    deadline
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetSlotSwap :one
+SELECT * FROM slot_swaps WHERE id = $1 AND round_id = $2;
+
+-- name: ListRoundSlotSwaps :many
+SELECT * FROM slot_swaps
+WHERE round_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: AcceptSlotSwap :one
+UPDATE slot_swaps
+SET status = 'accepted',
+    signature = $2,
+    accepted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+RETURNING *;
+
+-- name: CloseSlotSwap :one
+-- Declines, cancels or expires a swap that has not been applied.
+UPDATE slot_swaps
+SET status = $2,
+    updated_at = NOW()
+WHERE id = $1 AND status IN ('proposed', 'accepted')
+RETURNING *;
+
+-- name: MarkSlotSwapsApplied :exec
+-- An accepted swap is applied once its pair's swap event is indexed.
+UPDATE slot_swaps s
+SET status = 'applied',
+    applied_at = e.block_time,
+    updated_at = NOW()
+FROM round_events e
+WHERE s.round_id = $1
+  AND s.status = 'accepted'
+  AND e.round_id = s.round_id
+  AND e.event_type = 'swap'
+  AND e.address = s.proposer_address
+  AND e.counterparty = s.counterparty_address
+  AND e.block_time >= s.created_at;
+
+-- name: OpenSlotSwapExists :one
+SELECT EXISTS (
+    SELECT 1 FROM slot_swaps
+    WHERE round_id = $1
+      AND proposer_address = $2
+      AND counterparty_address = $3
+      AND status IN ('proposed', 'accepted')
+);
@@ -12,3 +12,11 @@ INSERT INTO users (full_name, email, address, display_name)
 VALUES ($1, $2, $3, $4)
 RETURNING *;
 
+-- name: UpdateUserProfile :one
+UPDATE users
+SET display_name = COALESCE(sqlc.narg(display_name), display_name),
+    avatar_url = COALESCE(sqlc.narg(avatar_url), avatar_url),
+    preferred_currency = COALESCE(sqlc.narg(preferred_currency), preferred_currency),
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
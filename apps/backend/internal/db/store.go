@@ -2,10 +2,16 @@ package db
 
 import (
 	sqlc "circa/internal/db/sqlc/generated"
+	"errors"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// uniqueViolation is the Postgres error code of a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
 type Store interface {
 	sqlc.Querier
 }
@@ -26,3 +32,10 @@ func NewStore(db *pgxpool.Pool) Store {
 func (s *PGXStore) GetDB() *pgxpool.Pool {
 	return s.db
 }
+
+// IsUniqueViolation reports whether err is a unique constraint violation,
+// as when a concurrent request inserted the same row first.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
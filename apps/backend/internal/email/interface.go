@@ -1,7 +1,67 @@
 package email
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+// DelinquencyNotice is an email about a contribution that was not paid by
+// the end of its period. Members get a reminder while the contribution is
+// late and a notice once it is missed; the group owner gets an escalation
+// for missed contributions. FiatAmount is the contribution in the
+// recipient's currency, if it could be priced.
+type DelinquencyNotice struct {
+	ToEmail    string    `json:"to_email"`
+	ToName     string    `json:"to_name"`
+	GroupName  string    `json:"group_name"`
+	MemberName string    `json:"member_name"`
+	Period     int       `json:"period"`
+	Amount     string    `json:"amount"`
+	FiatAmount string    `json:"fiat_amount,omitempty"`
+	Missed     bool      `json:"missed"`
+	Escalation bool      `json:"escalation"`
+	GraceEnds  time.Time `json:"grace_ends"`
+}
+
+// ContributionReminder is an email reminding a member that a contribution is
+// due soon.
+type ContributionReminder struct {
+	ToEmail    string    `json:"to_email"`
+	ToName     string    `json:"to_name"`
+	GroupName  string    `json:"group_name"`
+	Period     int       `json:"period"`
+	Amount     string    `json:"amount"`
+	FiatAmount string    `json:"fiat_amount,omitempty"`
+	Deadline   time.Time `json:"deadline"`
+}
+
+// DiscrepancyAlert is an email to a group owner or the operator listing the
+// differences the reconciliation job found between a round contract and the
+// database. Discrepancies are human-readable descriptions.
+type DiscrepancyAlert struct {
+	ToEmail         string   `json:"to_email"`
+	ToName          string   `json:"to_name"`
+	GroupName       string   `json:"group_name"`
+	RoundID         string   `json:"round_id"`
+	ChainID         int64    `json:"chain_id"`
+	ContractAddress string   `json:"contract_address"`
+	Discrepancies   []string `json:"discrepancies"`
+}
+
+// RoundReady is an email telling a group member that a round was deployed
+// for their group and can be joined. Amount is the contribution per period.
+type RoundReady struct {
+	ToEmail   string `json:"to_email"`
+	ToName    string `json:"to_name"`
+	GroupName string `json:"group_name"`
+	Amount    string `json:"amount"`
+	RoundURL  string `json:"round_url"`
+}
 
 type EmailService interface {
 	SendMagicLink(ctx context.Context, toEmail, toName, magicLinkURL string, isLogin bool) error
+	SendDelinquencyNotice(ctx context.Context, notice DelinquencyNotice) error
+	SendContributionReminder(ctx context.Context, reminder ContributionReminder) error
+	SendDiscrepancyAlert(ctx context.Context, alert DiscrepancyAlert) error
+	SendRoundReady(ctx context.Context, notice RoundReady) error
 }
@@ -3,6 +3,8 @@ package email
 import (
 	"context"
 	"fmt"
+	"html"
+	"strings"
 	"time"
 
 	"github.com/resend/resend-go/v2"
@@ -146,3 +148,272 @@ If you didn't create an account, you can safely ignore this email.
 
 	return nil
 }
+
+func (s *Service) SendDelinquencyNotice(ctx context.Context, notice DelinquencyNotice) error {
+	// Periods are 0-indexed in the API but numbered from 1 for people.
+	period := notice.Period + 1
+
+	amount := displayAmount(notice.Amount, notice.FiatAmount)
+
+	var subject, headerText, bodyText string
+	switch {
+	case notice.Escalation:
+		subject = fmt.Sprintf("%s missed a contribution to %s", notice.MemberName, notice.GroupName)
+		headerText = "Missed contribution"
+		bodyText = fmt.Sprintf("%s did not pay their contribution of %s for period %d of %s within the grace period.",
+			notice.MemberName, amount, period, notice.GroupName)
+	case notice.Missed:
+		subject = fmt.Sprintf("You missed a contribution to %s", notice.GroupName)
+		headerText = "Missed contribution"
+		bodyText = fmt.Sprintf("Your contribution of %s for period %d of %s was not paid within the grace period. The group owner has been notified.",
+			amount, period, notice.GroupName)
+	default:
+		subject = fmt.Sprintf("Your contribution to %s is late", notice.GroupName)
+		headerText = "Contribution reminder"
+		bodyText = fmt.Sprintf("Your contribution of %s for period %d of %s was due at the end of the period. Pay before %s to keep it from being marked as missed.",
+			amount, period, notice.GroupName, notice.GraceEnds.UTC().Format("Jan 2, 2006 15:04 MST"))
+	}
+
+	htmlBody := fmt.Sprintf(`
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="utf-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+		</head>
+		<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
+			<div style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); padding: 30px; text-align: center; border-radius: 8px 8px 0 0;">
+				<h1 style="color: white; margin: 0; font-size: 28px;">%s</h1>
+			</div>
+			<div style="background: #ffffff; padding: 40px; border-radius: 0 0 8px 8px; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+				<p style="font-size: 16px; margin-bottom: 20px;">Hi %s,</p>
+				<p style="font-size: 16px; margin-bottom: 20px;">%s</p>
+			</div>
+			<div style="text-align: center; margin-top: 30px; padding-top: 20px; border-top: 1px solid #eee;">
+				<p style="font-size: 12px; color: #999;">© %d Circa. All rights reserved.</p>
+			</div>
+		</body>
+		</html>
+	`, html.EscapeString(headerText), html.EscapeString(notice.ToName), html.EscapeString(bodyText), time.Now().Year())
+
+	textBody := fmt.Sprintf(`
+Hi %s,
+
+%s
+		`, notice.ToName, bodyText)
+
+	params := &resend.SendEmailRequest{
+		From:    "Circa <onboarding@resend.dev>",
+		To:      []string{notice.ToEmail},
+		Subject: subject,
+		Html:    htmlBody,
+		Text:    textBody,
+	}
+
+	sent, err := s.client.Emails().SendWithContext(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Str("email", notice.ToEmail).Msg("Failed to send delinquency email")
+		return err
+	}
+
+	log.Info().
+		Str("email", notice.ToEmail).
+		Str("resend_id", sent.Id).
+		Msg("Delinquency email sent successfully")
+
+	return nil
+}
+
+func (s *Service) SendContributionReminder(ctx context.Context, reminder ContributionReminder) error {
+	// Periods are 0-indexed in the API but numbered from 1 for people.
+	period := reminder.Period + 1
+	deadline := reminder.Deadline.UTC().Format("Jan 2, 2006 15:04 MST")
+
+	subject := fmt.Sprintf("Your contribution to %s is due soon", reminder.GroupName)
+	bodyText := fmt.Sprintf("Your contribution of %s for period %d of %s is due by %s.",
+		displayAmount(reminder.Amount, reminder.FiatAmount), period, reminder.GroupName, deadline)
+
+	htmlBody := fmt.Sprintf(`
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="utf-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+		</head>
+		<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
+			<div style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); padding: 30px; text-align: center; border-radius: 8px 8px 0 0;">
+				<h1 style="color: white; margin: 0; font-size: 28px;">Contribution due soon</h1>
+			</div>
+			<div style="background: #ffffff; padding: 40px; border-radius: 0 0 8px 8px; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+				<p style="font-size: 16px; margin-bottom: 20px;">Hi %s,</p>
+				<p style="font-size: 16px; margin-bottom: 20px;">%s</p>
+				<p style="font-size: 14px; color: #666;">You can change when and how you are reminded in your notification preferences.</p>
+			</div>
+			<div style="text-align: center; margin-top: 30px; padding-top: 20px; border-top: 1px solid #eee;">
+				<p style="font-size: 12px; color: #999;">© %d Circa. All rights reserved.</p>
+			</div>
+		</body>
+		</html>
+	`, html.EscapeString(reminder.ToName), html.EscapeString(bodyText), time.Now().Year())
+
+	textBody := fmt.Sprintf(`
+Hi %s,
+
+%s
+
+You can change when and how you are reminded in your notification preferences.
+		`, reminder.ToName, bodyText)
+
+	params := &resend.SendEmailRequest{
+		From:    "Circa <onboarding@resend.dev>",
+		To:      []string{reminder.ToEmail},
+		Subject: subject,
+		Html:    htmlBody,
+		Text:    textBody,
+	}
+
+	sent, err := s.client.Emails().SendWithContext(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Str("email", reminder.ToEmail).Msg("Failed to send contribution reminder")
+		return err
+	}
+
+	log.Info().
+		Str("email", reminder.ToEmail).
+		Str("resend_id", sent.Id).
+		Msg("Contribution reminder sent successfully")
+
+	return nil
+}
+
+func (s *Service) SendDiscrepancyAlert(ctx context.Context, alert DiscrepancyAlert) error {
+	subject := fmt.Sprintf("%s is out of sync with its contract", alert.GroupName)
+	bodyText := fmt.Sprintf("The round %s of %s no longer matches contract %s on chain %d. Balances and payment status shown in Circa may be wrong until it is re-synced.",
+		alert.RoundID, alert.GroupName, alert.ContractAddress, alert.ChainID)
+
+	var items, lines strings.Builder
+	for _, d := range alert.Discrepancies {
+		fmt.Fprintf(&items, "<li>%s</li>", html.EscapeString(d))
+		fmt.Fprintf(&lines, "- %s\n", d)
+	}
+
+	htmlBody := fmt.Sprintf(`
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="utf-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+		</head>
+		<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
+			<div style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); padding: 30px; text-align: center; border-radius: 8px 8px 0 0;">
+				<h1 style="color: white; margin: 0; font-size: 28px;">Round out of sync</h1>
+			</div>
+			<div style="background: #ffffff; padding: 40px; border-radius: 0 0 8px 8px; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+				<p style="font-size: 16px; margin-bottom: 20px;">Hi %s,</p>
+				<p style="font-size: 16px; margin-bottom: 20px;">%s</p>
+				<ul style="font-size: 14px; margin-bottom: 20px;">%s</ul>
+			</div>
+			<div style="text-align: center; margin-top: 30px; padding-top: 20px; border-top: 1px solid #eee;">
+				<p style="font-size: 12px; color: #999;">© %d Circa. All rights reserved.</p>
+			</div>
+		</body>
+		</html>
+	`, html.EscapeString(alert.ToName), html.EscapeString(bodyText), items.String(), time.Now().Year())
+
+	textBody := fmt.Sprintf(`
+Hi %s,
+
+%s
+
+%s
+		`, alert.ToName, bodyText, lines.String())
+
+	params := &resend.SendEmailRequest{
+		From:    "Circa <onboarding@resend.dev>",
+		To:      []string{alert.ToEmail},
+		Subject: subject,
+		Html:    htmlBody,
+		Text:    textBody,
+	}
+
+	sent, err := s.client.Emails().SendWithContext(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Str("email", alert.ToEmail).Msg("Failed to send discrepancy alert")
+		return err
+	}
+
+	log.Info().
+		Str("email", alert.ToEmail).
+		Str("resend_id", sent.Id).
+		Msg("Discrepancy alert sent successfully")
+
+	return nil
+}
+
+func (s *Service) SendRoundReady(ctx context.Context, notice RoundReady) error {
+	subject := fmt.Sprintf("A new round of %s is ready", notice.GroupName)
+	bodyText := fmt.Sprintf("A round was deployed for %s with a contribution of %s per period. Review the payout order and make your first contribution to join it.",
+		notice.GroupName, notice.Amount)
+
+	htmlBody := fmt.Sprintf(`
+		<!DOCTYPE html>
+		<html>
+		<head>
+			<meta charset="utf-8">
+			<meta name="viewport" content="width=device-width, initial-scale=1.0">
+		</head>
+		<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
+			<div style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); padding: 30px; text-align: center; border-radius: 8px 8px 0 0;">
+				<h1 style="color: white; margin: 0; font-size: 28px;">New round</h1>
+			</div>
+			<div style="background: #ffffff; padding: 40px; border-radius: 0 0 8px 8px; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+				<p style="font-size: 16px; margin-bottom: 20px;">Hi %s,</p>
+				<p style="font-size: 16px; margin-bottom: 20px;">%s</p>
+				<div style="text-align: center; margin: 30px 0;">
+					<a href="%s" style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); color: white; padding: 14px 28px; text-decoration: none; border-radius: 6px; display: inline-block; font-weight: 600; font-size: 16px;">View round</a>
+				</div>
+			</div>
+			<div style="text-align: center; margin-top: 30px; padding-top: 20px; border-top: 1px solid #eee;">
+				<p style="font-size: 12px; color: #999;">© %d Circa. All rights reserved.</p>
+			</div>
+		</body>
+		</html>
+	`, html.EscapeString(notice.ToName), html.EscapeString(bodyText), html.EscapeString(notice.RoundURL), time.Now().Year())
+
+	textBody := fmt.Sprintf(`
+Hi %s,
+
+%s
+
+%s
+		`, notice.ToName, bodyText, notice.RoundURL)
+
+	params := &resend.SendEmailRequest{
+		From:    "Circa <onboarding@resend.dev>",
+		To:      []string{notice.ToEmail},
+		Subject: subject,
+		Html:    htmlBody,
+		Text:    textBody,
+	}
+
+	sent, err := s.client.Emails().SendWithContext(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Str("email", notice.ToEmail).Msg("Failed to send round ready email")
+		return err
+	}
+
+	log.Info().
+		Str("email", notice.ToEmail).
+		Str("resend_id", sent.Id).
+		Msg("Round ready email sent successfully")
+
+	return nil
+}
+
+// displayAmount follows a token amount with its fiat value, when known.
+func displayAmount(amount, fiat string) string {
+	if fiat == "" {
+		return amount
+	}
+	return fmt.Sprintf("%s (about %s)", amount, fiat)
+}
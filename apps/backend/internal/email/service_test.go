@@ -81,7 +81,7 @@ func TestService_SendMagicLink(t *testing.T) {
 			},
 			expectedError: nil,
 			validateEmail: func(t *testing.T, params *resend.SendEmailRequest) {
-				assert.Equal(t, "Circa <noreply@circa.com>", params.From)
+				assert.Equal(t, "Circa <onboarding@resend.dev>", params.From)
 				assert.Equal(t, []string{"user@example.com"}, params.To)
 				assert.Equal(t, "Verify your email for Circa", params.Subject)
 				assert.Contains(t, params.Html, "John Doe")
@@ -238,3 +238,181 @@ func TestService_SendMagicLink_EmailContent(t *testing.T) {
 
 	service.SetClient(originalClient)
 }
+
+func TestService_SendDelinquencyNotice(t *testing.T) {
+	graceEnds := time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		notice          email.DelinquencyNotice
+		expectedSubject string
+		expectedBody    []string
+	}{
+		{
+			name: "late reminder to the member",
+			notice: email.DelinquencyNotice{
+				ToEmail: "ada@example.com", ToName: "Ada", GroupName: "Friday Ajo", MemberName: "Ada",
+				Period: 1, Amount: "100 USDC", GraceEnds: graceEnds,
+			},
+			expectedSubject: "Your contribution to Friday Ajo is late",
+			expectedBody:    []string{"Hi Ada", "100 USDC", "period 2", "Mar 8, 2026 12:00 UTC"},
+		},
+		{
+			name: "missed notice to the member",
+			notice: email.DelinquencyNotice{
+				ToEmail: "ada@example.com", ToName: "Ada", GroupName: "Friday Ajo", MemberName: "Ada",
+				Period: 0, Amount: "100 USDC", Missed: true, GraceEnds: graceEnds,
+			},
+			expectedSubject: "You missed a contribution to Friday Ajo",
+			expectedBody:    []string{"period 1", "group owner has been notified"},
+		},
+		{
+			name: "escalation to the owner",
+			notice: email.DelinquencyNotice{
+				ToEmail: "owner@example.com", ToName: "Owner", GroupName: "Friday Ajo", MemberName: "Ada",
+				Period: 0, Amount: "100 USDC", Missed: true, Escalation: true, GraceEnds: graceEnds,
+			},
+			expectedSubject: "Ada missed a contribution to Friday Ajo",
+			expectedBody:    []string{"Hi Owner", "Ada did not pay"},
+		},
+		{
+			name: "fiat value follows the amount",
+			notice: email.DelinquencyNotice{
+				ToEmail: "ada@example.com", ToName: "Ada", GroupName: "Friday Ajo", MemberName: "Ada",
+				Period: 0, Amount: "100 USDC", FiatAmount: "NGN 155000.00", Missed: true, GraceEnds: graceEnds,
+			},
+			expectedSubject: "You missed a contribution to Friday Ajo",
+			expectedBody:    []string{"100 USDC (about NGN 155000.00) for period 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := email.NewService("test-api-key")
+
+			var capturedParams *resend.SendEmailRequest
+			service.SetClient(&mockResendClient{
+				sendFunc: func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+					capturedParams = params
+					return &resend.SendEmailResponse{Id: "test-id"}, nil
+				},
+			})
+
+			err := service.SendDelinquencyNotice(context.Background(), tt.notice)
+			require.NoError(t, err)
+
+			require.NotNil(t, capturedParams)
+			assert.Equal(t, []string{tt.notice.ToEmail}, capturedParams.To)
+			assert.Equal(t, tt.expectedSubject, capturedParams.Subject)
+			for _, s := range tt.expectedBody {
+				assert.Contains(t, capturedParams.Text, s)
+			}
+		})
+	}
+}
+
+func TestService_SendDelinquencyNotice_Error(t *testing.T) {
+	service := email.NewService("test-api-key")
+	service.SetClient(&mockResendClient{
+		sendFunc: func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+			return nil, errors.New("rate limited")
+		},
+	})
+
+	err := service.SendDelinquencyNotice(context.Background(), email.DelinquencyNotice{ToEmail: "ada@example.com"})
+	assert.EqualError(t, err, "rate limited")
+}
+
+func TestService_SendContributionReminder(t *testing.T) {
+	service := email.NewService("test-api-key")
+
+	var capturedParams *resend.SendEmailRequest
+	service.SetClient(&mockResendClient{
+		sendFunc: func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+			capturedParams = params
+			return &resend.SendEmailResponse{Id: "test-id"}, nil
+		},
+	})
+
+	err := service.SendContributionReminder(context.Background(), email.ContributionReminder{
+		ToEmail:    "ada@example.com",
+		ToName:     "Ada",
+		GroupName:  "Friday <Ajo>",
+		Period:     0,
+		Amount:     "100 USDC",
+		FiatAmount: "NGN 155000.00",
+		Deadline:   time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, capturedParams)
+	assert.Equal(t, []string{"ada@example.com"}, capturedParams.To)
+	assert.Equal(t, "Your contribution to Friday <Ajo> is due soon", capturedParams.Subject)
+	assert.Contains(t, capturedParams.Text, "100 USDC (about NGN 155000.00) for period 1")
+	assert.Contains(t, capturedParams.Text, "Mar 8, 2026 12:00 UTC")
+	assert.Contains(t, capturedParams.Html, "Friday &lt;Ajo&gt;")
+	assert.NotContains(t, capturedParams.Html, "<Ajo>")
+}
+
+func TestService_SendDiscrepancyAlert(t *testing.T) {
+	service := email.NewService("test-api-key")
+
+	var capturedParams *resend.SendEmailRequest
+	service.SetClient(&mockResendClient{
+		sendFunc: func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+			capturedParams = params
+			return &resend.SendEmailResponse{Id: "test-id"}, nil
+		},
+	})
+
+	err := service.SendDiscrepancyAlert(context.Background(), email.DiscrepancyAlert{
+		ToEmail:         "owner@example.com",
+		ToName:          "Owner",
+		GroupName:       "Friday <Ajo>",
+		RoundID:         "7f1c7a52-5a8e-4d0a-9a53-0f8f0c6f4c11",
+		ChainID:         84532,
+		ContractAddress: "0x00000000000000000000000000000000000000c1",
+		Discrepancies: []string{
+			"payout index is 2 on chain but 1 in the database",
+			"token balance is 300 on chain but 200 in the ledger",
+		},
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, capturedParams)
+	assert.Equal(t, []string{"owner@example.com"}, capturedParams.To)
+	assert.Equal(t, "Friday <Ajo> is out of sync with its contract", capturedParams.Subject)
+	assert.Contains(t, capturedParams.Text, "contract 0x00000000000000000000000000000000000000c1 on chain 84532")
+	assert.Contains(t, capturedParams.Text, "- payout index is 2 on chain but 1 in the database\n")
+	assert.Contains(t, capturedParams.Html, "<li>token balance is 300 on chain but 200 in the ledger</li>")
+	assert.NotContains(t, capturedParams.Html, "<Ajo>")
+}
+
+func TestService_SendRoundReady(t *testing.T) {
+	service := email.NewService("test-api-key")
+
+	var capturedParams *resend.SendEmailRequest
+	service.SetClient(&mockResendClient{
+		sendFunc: func(ctx context.Context, params *resend.SendEmailRequest) (*resend.SendEmailResponse, error) {
+			capturedParams = params
+			return &resend.SendEmailResponse{Id: "test-id"}, nil
+		},
+	})
+
+	err := service.SendRoundReady(context.Background(), email.RoundReady{
+		ToEmail:   "member@example.com",
+		ToName:    "Member",
+		GroupName: "Friday <Ajo>",
+		Amount:    "50 USDC",
+		RoundURL:  "https://circa.example/rounds/7f1c7a52-5a8e-4d0a-9a53-0f8f0c6f4c11",
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, capturedParams)
+	assert.Equal(t, []string{"member@example.com"}, capturedParams.To)
+	assert.Equal(t, "A new round of Friday <Ajo> is ready", capturedParams.Subject)
+	assert.Contains(t, capturedParams.Text, "a contribution of 50 USDC per period")
+	assert.Contains(t, capturedParams.Text, "https://circa.example/rounds/7f1c7a52-5a8e-4d0a-9a53-0f8f0c6f4c11")
+	assert.Contains(t, capturedParams.Html, `href="https://circa.example/rounds/7f1c7a52-5a8e-4d0a-9a53-0f8f0c6f4c11"`)
+	assert.NotContains(t, capturedParams.Html, "<Ajo>")
+}
@@ -14,3 +14,112 @@ var (
 	ErrInvalidSignature    = errors.New("invalid signature")
 	ErrWalletAlreadyLinked = errors.New("wallet address already linked to another user")
 )
+
+// Group errors
+var (
+	ErrGroupNotFound          = errors.New("group not found")
+	ErrNotGroupMember         = errors.New("not a member of this group")
+	ErrNotGroupOwner          = errors.New("only the group owner can perform this action")
+	ErrMemberNotFound         = errors.New("member not found in this group")
+	ErrOwnerCannotLeave       = errors.New("group owner cannot leave or be removed")
+	ErrOutstandingObligations = errors.New("member has outstanding obligations in an active round")
+	ErrRemovalReasonRequired  = errors.New("a reason is required to force-remove a member with outstanding obligations")
+	ErrGroupArchived          = errors.New("group is archived")
+	ErrGroupHasActiveRounds   = errors.New("group has pending or active rounds")
+	ErrInvalidSearchQuery     = errors.New("search query must not be empty")
+	ErrAllowlistNotFound      = errors.New("allowlist version not found")
+	ErrNotInAllowlist         = errors.New("not a member in this allowlist version")
+)
+
+// Invite errors
+var (
+	ErrInviteNotFound      = errors.New("invite not found")
+	ErrInvalidInviteExpiry = errors.New("invite expiry must be in the future")
+	ErrInvalidMaxUses      = errors.New("invite max uses must be at least 1")
+)
+
+// Round errors
+var (
+	ErrRoundNotFound           = errors.New("round not found")
+	ErrRoundAlreadyExists      = errors.New("round contract is already registered")
+	ErrRoundVerificationFailed = errors.New("round contract verification failed")
+	ErrInvalidActivityType     = errors.New("activity type must be payment or payout")
+	ErrInvalidRoundStatus      = errors.New("invalid round status")
+	ErrInvalidRoundAction      = errors.New("round action must be pause, resume, cancel or default")
+	ErrInvalidRoundTransition  = errors.New("round status transition is not allowed")
+)
+
+// Payout order errors
+var (
+	ErrPayoutOrderNotFound    = errors.New("payout order not found")
+	ErrInvalidPayoutStrategy  = errors.New("payout strategy must be fixed, consensus, random or auction")
+	ErrInvalidPayoutOrder     = errors.New("payout order must list every participant exactly once")
+	ErrInvalidParticipants    = errors.New("participants must be at least two accepted members of the group")
+	ErrInvalidBiddingWindow   = errors.New("bidding must end in the future and before the reveal deadline")
+	ErrUnsupportedChain       = errors.New("chain is not supported")
+	ErrNotPayoutParticipant   = errors.New("not a participant in this payout order")
+	ErrPayoutOrderFinalized   = errors.New("payout order is already finalized")
+	ErrPayoutOrderNotReady    = errors.New("payout order is not ready to be finalized or exported")
+	ErrPayoutActionNotAllowed = errors.New("action is not available for this payout strategy or phase")
+	ErrStaleRevision          = errors.New("payout order has changed since this revision")
+	ErrInvalidBid             = errors.New("bid does not match its commitment")
+)
+
+// Slot swap errors
+var (
+	ErrSlotSwapNotFound        = errors.New("slot swap not found")
+	ErrNotRoundMember          = errors.New("not a member of this round")
+	ErrInvalidSwapCounterparty = errors.New("counterparty must be another member of the round")
+	ErrNotSwapParty            = errors.New("not a party to this slot swap")
+	ErrRoundNotSwappable       = errors.New("slots can only be swapped in pending or active rounds")
+	ErrSlotAlreadyPaid         = errors.New("members who received their payout cannot swap slots")
+	ErrSwapAlreadyOpen         = errors.New("an open slot swap with this member already exists")
+	ErrSwapClosed              = errors.New("slot swap is no longer open")
+	ErrSwapExpired             = errors.New("slot swap deadline has passed")
+	ErrSwapStale               = errors.New("payout slots changed since the swap was proposed")
+	ErrInvalidSwapSignature    = errors.New("signature is not the counterparty's signature of this swap")
+)
+
+// Transaction builder errors
+var (
+	ErrRoundNotActive         = errors.New("round is not active")
+	ErrPayoutAlreadyReceived  = errors.New("payout has already been received")
+	ErrTransactionWouldRevert = errors.New("transaction would revert")
+)
+
+// Token errors
+var (
+	ErrInvalidAmount = errors.New("amount must be a non-negative decimal within the token's precision")
+)
+
+// Price errors
+var (
+	ErrPriceUnavailable = errors.New("no price is available for this token and currency")
+	ErrInvalidCurrency  = errors.New("currency must be a three-letter ISO 4217 code")
+)
+
+// Notification errors
+var (
+	ErrInvalidReminderChannel  = errors.New("reminder channel must be email")
+	ErrInvalidReminderLeadTime = errors.New("reminder lead times must be between 15 minutes and 7 days, at most 5")
+)
+
+// Pagination errors
+var (
+	ErrInvalidCursor = errors.New("invalid pagination cursor")
+	ErrInvalidLimit  = errors.New("limit must be between 1 and 200")
+)
+
+// Ledger errors
+var (
+	ErrUnbalancedEntry = errors.New("journal entry debits and credits do not balance")
+)
+
+// Backfill errors
+var (
+	ErrBackfillNotFound     = errors.New("backfill not found")
+	ErrBackfillInProgress   = errors.New("a backfill of this round is already running")
+	ErrBackfillNotResumable = errors.New("only failed backfills can be resumed")
+	ErrInvalidBackfillRange = errors.New("from block must not be past the last confirmed block")
+	ErrNotAdmin             = errors.New("not an administrator")
+)
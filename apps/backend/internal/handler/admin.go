@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"errors"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListRoundBackfills handles GET /admin/rounds/{roundId}/backfills
+func (h *Handler) ListRoundBackfills(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+	if !h.isAdmin(*user) {
+		return h.backfillError(ctx, circaerrors.ErrNotAdmin, "Failed to list backfills")
+	}
+
+	backfills, err := h.backfillService.List(ctx.Request().Context(), roundId)
+	if err != nil {
+		return h.backfillError(ctx, err, "Failed to list backfills")
+	}
+
+	response := make([]api.IndexerBackfill, 0, len(backfills))
+	for _, b := range backfills {
+		response = append(response, toAPIIndexerBackfill(b))
+	}
+	return ctx.JSON(200, response)
+}
+
+// StartRoundBackfill handles POST /admin/rounds/{roundId}/backfills
+func (h *Handler) StartRoundBackfill(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+	if !h.isAdmin(*user) {
+		return h.backfillError(ctx, circaerrors.ErrNotAdmin, "Failed to start backfill")
+	}
+
+	var req api.StartRoundBackfillJSONRequestBody
+	if err := ctx.Bind(&req); err != nil || req.FromBlock < 0 {
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	backfill, err := h.backfillService.Start(ctx.Request().Context(), roundId, uint64(req.FromBlock), &user.ID)
+	if err != nil {
+		return h.backfillError(ctx, err, "Failed to start backfill")
+	}
+
+	return ctx.JSON(202, toAPIIndexerBackfill(*backfill))
+}
+
+// isAdmin reports whether the user's wallet is one of the configured admin
+// addresses.
+func (h *Handler) isAdmin(user sqlc.User) bool {
+	for _, address := range h.config.AdminAddresses {
+		if strings.EqualFold(address, user.Address) {
+			return true
+		}
+	}
+	return false
+}
+
+func toAPIIndexerBackfill(b sqlc.IndexerBackfill) api.IndexerBackfill {
+	backfill := api.IndexerBackfill{
+		Id:            b.ID,
+		RoundId:       b.RoundID,
+		FromBlock:     b.FromBlock,
+		ToBlock:       b.ToBlock,
+		NextBlock:     b.NextBlock,
+		Status:        api.IndexerBackfillStatus(b.Status),
+		EventsWritten: b.EventsWritten,
+		ErrorMessage:  b.ErrorMessage,
+		CreatedAt:     api.Timestamp(b.CreatedAt.Time),
+	}
+	if b.UpdatedAt.Valid {
+		updatedAt := api.Timestamp(b.UpdatedAt.Time)
+		backfill.UpdatedAt = &updatedAt
+	}
+	if b.CompletedAt.Valid {
+		completedAt := api.Timestamp(b.CompletedAt.Time)
+		backfill.CompletedAt = &completedAt
+	}
+	return backfill
+}
+
+// backfillError maps backfill service errors onto API responses.
+func (h *Handler) backfillError(ctx echo.Context, err error, logMsg string) error {
+	switch {
+	case errors.Is(err, circaerrors.ErrBackfillNotFound):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: "Backfill not found",
+		})
+	case errors.Is(err, circaerrors.ErrNotAdmin):
+		return ctx.JSON(403, api.ErrorForbidden{
+			Code:    403,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrBackfillInProgress),
+		errors.Is(err, circaerrors.ErrBackfillNotResumable):
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:    409,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrInvalidBackfillRange):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
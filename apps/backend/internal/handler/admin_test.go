@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"circa/api"
+	"circa/internal/config"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	backfillmocks "circa/internal/handler/mocks/backfill"
+	"circa/internal/service/auth"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdminHandler(t *testing.T, user sqlc.User) (*Handler, *backfillmocks.MockBackfillService) {
+	mockAuth := authmocks.NewMockAuthService(t)
+	mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+		Return(&auth.GetSessionUserResult{User: user}, nil)
+	mockBackfill := backfillmocks.NewMockBackfillService(t)
+
+	return &Handler{
+		authService:     mockAuth,
+		backfillService: mockBackfill,
+		config: config.Config{
+			AdminAddresses: []string{"0x1111111111111111111111111111111111111111"},
+		},
+	}, mockBackfill
+}
+
+func TestHandler_StartRoundBackfill(t *testing.T) {
+	admin := createTestSessionUser()
+	roundID := uuid.New()
+
+	tests := []struct {
+		name           string
+		user           sqlc.User
+		body           string
+		setupMocks     func(*backfillmocks.MockBackfillService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - backfill started",
+			user: admin,
+			body: `{"fromBlock": 1200}`,
+			setupMocks: func(m *backfillmocks.MockBackfillService) {
+				m.On("Start", mock.Anything, roundID, uint64(1200), &admin.ID).Return(&sqlc.IndexerBackfill{
+					ID:        uuid.New(),
+					RoundID:   roundID,
+					FromBlock: 1200,
+					ToBlock:   5000,
+					NextBlock: 1200,
+					Status:    "running",
+					CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+				}, nil)
+			},
+			expectedStatus: 202,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.IndexerBackfill
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, api.IndexerBackfillStatusRunning, response.Status)
+				assert.Equal(t, int64(5000), response.ToBlock)
+				assert.Nil(t, response.CompletedAt)
+			},
+		},
+		{
+			name:           "error - not an admin",
+			user:           sqlc.User{ID: uuid.New(), Address: "0x2222222222222222222222222222222222222222"},
+			body:           `{"fromBlock": 1200}`,
+			setupMocks:     func(m *backfillmocks.MockBackfillService) {},
+			expectedStatus: 403,
+		},
+		{
+			name:           "error - negative from block",
+			user:           admin,
+			body:           `{"fromBlock": -1}`,
+			setupMocks:     func(m *backfillmocks.MockBackfillService) {},
+			expectedStatus: 400,
+		},
+		{
+			name: "error - already running",
+			user: admin,
+			body: `{"fromBlock": 1200}`,
+			setupMocks: func(m *backfillmocks.MockBackfillService) {
+				m.On("Start", mock.Anything, roundID, uint64(1200), &admin.ID).Return(nil, circaerrors.ErrBackfillInProgress)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "error - past the last confirmed block",
+			user: admin,
+			body: `{"fromBlock": 1200}`,
+			setupMocks: func(m *backfillmocks.MockBackfillService) {
+				m.On("Start", mock.Anything, roundID, uint64(1200), &admin.ID).Return(nil, circaerrors.ErrInvalidBackfillRange)
+			},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/admin/rounds/"+roundID.String()+"/backfills", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler, mockBackfill := newAdminHandler(t, tt.user)
+			tt.setupMocks(mockBackfill)
+
+			require.NoError(t, handler.StartRoundBackfill(c, roundID))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+		})
+	}
+}
+
+func TestHandler_ListRoundBackfills(t *testing.T) {
+	admin := createTestSessionUser()
+	roundID := uuid.New()
+	message := "filter logs 100-199: rate limited"
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/admin/rounds/"+roundID.String()+"/backfills", nil)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler, mockBackfill := newAdminHandler(t, admin)
+	mockBackfill.On("List", mock.Anything, roundID).Return([]sqlc.IndexerBackfill{
+		{
+			ID:           uuid.New(),
+			RoundID:      roundID,
+			FromBlock:    100,
+			ToBlock:      900,
+			NextBlock:    100,
+			Status:       "failed",
+			ErrorMessage: &message,
+			CreatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+			UpdatedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+		},
+	}, nil)
+
+	require.NoError(t, handler.ListRoundBackfills(c, roundID))
+	assert.Equal(t, 200, rec.Code)
+
+	var response []api.IndexerBackfill
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	assert.Equal(t, api.IndexerBackfillStatusFailed, response[0].Status)
+	require.NotNil(t, response[0].ErrorMessage)
+	assert.Equal(t, message, *response[0].ErrorMessage)
+	assert.NotNil(t, response[0].UpdatedAt)
+}
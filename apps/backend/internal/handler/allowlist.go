@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetGroupAllowlist handles GET /groups/{groupId}/allowlist
+func (h *Handler) GetGroupAllowlist(ctx echo.Context, groupId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	allowlist, err := h.groupService.GetAllowlist(ctx.Request().Context(), groupId, *user)
+	if err != nil {
+		return h.groupError(ctx, err, "Failed to get group allowlist")
+	}
+
+	return ctx.JSON(200, toAPIGroupAllowlist(*allowlist))
+}
+
+// GetGroupAllowlistProof handles GET /groups/{groupId}/allowlist/proof
+func (h *Handler) GetGroupAllowlistProof(ctx echo.Context, groupId api.UUID, params api.GetGroupAllowlistProofParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	if params.Version != nil && *params.Version < 1 {
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "version must be at least 1",
+		})
+	}
+
+	result, err := h.groupService.GetAllowlistProof(ctx.Request().Context(), groupId, *user, params.Version)
+	if err != nil {
+		return h.groupError(ctx, err, "Failed to get group allowlist proof")
+	}
+
+	proof := make([]string, 0, len(result.Proof))
+	for _, hash := range result.Proof {
+		proof = append(proof, hash.Hex())
+	}
+	return ctx.JSON(200, api.GroupAllowlistProof{
+		Version:  result.Allowlist.Version,
+		Root:     result.Allowlist.Root,
+		Address:  api.Address(result.Address),
+		Position: result.Position,
+		Leaf:     result.Leaf.Hex(),
+		Proof:    proof,
+	})
+}
+
+func toAPIGroupAllowlist(a sqlc.GroupAllowlist) api.GroupAllowlist {
+	allowlist := api.GroupAllowlist{
+		Version:     a.Version,
+		Root:        a.Root,
+		MemberCount: len(a.Members),
+		CreatedAt:   api.Timestamp(a.CreatedAt.Time),
+	}
+	if a.PayoutOrderID.Valid {
+		orderID := api.UUID(a.PayoutOrderID.Bytes)
+		allowlist.PayoutOrderId = &orderID
+	}
+	return allowlist
+}
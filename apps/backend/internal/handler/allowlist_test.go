@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	groupmocks "circa/internal/handler/mocks/group"
+	"circa/internal/service/auth"
+	"circa/internal/service/group"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testAllowlistRoot = "0x9c22ff5f21f0b81b113e63f7db6da94fedef11b2119b4088b89664fb9a3cb658"
+
+func newAllowlistHandler(t *testing.T, user sqlc.User) (*Handler, *groupmocks.MockGroupService) {
+	mockAuth := authmocks.NewMockAuthService(t)
+	mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+		Return(&auth.GetSessionUserResult{User: user}, nil)
+	mockGroup := groupmocks.NewMockGroupService(t)
+
+	return &Handler{
+		authService:  mockAuth,
+		groupService: mockGroup,
+	}, mockGroup
+}
+
+func TestHandler_GetGroupAllowlist(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	orderID := uuid.New()
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - current version",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetAllowlist", mock.Anything, groupID, user).Return(&sqlc.GroupAllowlist{
+					GroupID:       groupID,
+					Version:       3,
+					Root:          testAllowlistRoot,
+					Members:       []string{testMemberAddress, "0x1111111111111111111111111111111111111111"},
+					PayoutOrderID: pgtype.UUID{Bytes: orderID, Valid: true},
+					CreatedAt:     pgtype.Timestamp{Time: time.Now(), Valid: true},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.GroupAllowlist
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, int32(3), response.Version)
+				assert.Equal(t, testAllowlistRoot, response.Root)
+				assert.Equal(t, 2, response.MemberCount)
+				require.NotNil(t, response.PayoutOrderId)
+				assert.Equal(t, orderID, *response.PayoutOrderId)
+				assert.NotContains(t, rec.Body.String(), testMemberAddress)
+			},
+		},
+		{
+			name: "error - not a member",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetAllowlist", mock.Anything, groupID, user).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/allowlist", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler, mockGroup := newAllowlistHandler(t, user)
+			tt.setupMocks(mockGroup)
+
+			require.NoError(t, handler.GetGroupAllowlist(c, groupID))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+		})
+	}
+}
+
+func TestHandler_GetGroupAllowlistProof(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	version := func(v int32) *int32 { return &v }
+	sibling := common.HexToHash("0x01")
+
+	tests := []struct {
+		name           string
+		params         api.GetGroupAllowlistProofParams
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - proof at a version",
+			params: api.GetGroupAllowlistProofParams{Version: version(2)},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetAllowlistProof", mock.Anything, groupID, user, version(2)).Return(&group.AllowlistProof{
+					Allowlist: sqlc.GroupAllowlist{Version: 2, Root: testAllowlistRoot},
+					Address:   user.Address,
+					Position:  1,
+					Leaf:      common.HexToHash("0x02"),
+					Proof:     []common.Hash{sibling},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.GroupAllowlistProof
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, int32(2), response.Version)
+				assert.Equal(t, testAllowlistRoot, response.Root)
+				assert.Equal(t, 1, response.Position)
+				assert.Equal(t, []string{sibling.Hex()}, response.Proof)
+			},
+		},
+		{
+			name:           "error - version below 1",
+			params:         api.GetGroupAllowlistProofParams{Version: version(0)},
+			setupMocks:     func(m *groupmocks.MockGroupService) {},
+			expectedStatus: 400,
+		},
+		{
+			name:   "error - not in the version",
+			params: api.GetGroupAllowlistProofParams{Version: version(1)},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetAllowlistProof", mock.Anything, groupID, user, version(1)).Return(nil, circaerrors.ErrNotInAllowlist)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name: "error - version not found",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetAllowlistProof", mock.Anything, groupID, user, (*int32)(nil)).Return(nil, circaerrors.ErrAllowlistNotFound)
+			},
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/allowlist/proof", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler, mockGroup := newAllowlistHandler(t, user)
+			tt.setupMocks(mockGroup)
+
+			require.NoError(t, handler.GetGroupAllowlistProof(c, groupID, tt.params))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+		})
+	}
+}
@@ -265,6 +265,82 @@ func TestHandler_AuthSignup(t *testing.T) {
 	}
 }
 
+func TestHandler_UpdateMe(t *testing.T) {
+	user := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*authmocks.MockAuthService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - preferred currency is returned",
+			body: `{"displayName": "ada", "preferredCurrency": "NGN"}`,
+			setupMocks: func(m *authmocks.MockAuthService) {
+				updated := user
+				updated.DisplayName = stringPtr("ada")
+				updated.PreferredCurrency = stringPtr("NGN")
+				m.On("UpdateProfile", mock.Anything, user, auth.UpdateProfileParams{
+					DisplayName:       stringPtr("ada"),
+					PreferredCurrency: stringPtr("NGN"),
+				}).Return(&updated, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.User
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, stringPtr("ada"), response.DisplayName)
+				require.NotNil(t, response.PreferredCurrency)
+				assert.Equal(t, api.Currency("NGN"), *response.PreferredCurrency)
+			},
+		},
+		{
+			name: "error - invalid currency",
+			body: `{"preferredCurrency": "naira"}`,
+			setupMocks: func(m *authmocks.MockAuthService) {
+				m.On("UpdateProfile", mock.Anything, user, mock.Anything).
+					Return(nil, circaerrors.ErrInvalidCurrency)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:           "error - invalid body",
+			body:           `{"displayName": 1}`,
+			setupMocks:     func(m *authmocks.MockAuthService) {},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPatch, "/me", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			tt.setupMocks(mockAuth)
+
+			handler := &Handler{authService: mockAuth}
+
+			err := handler.UpdateMe(c)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+		})
+	}
+}
+
 func createTestPendingSignup() sqlc.PendingSignup {
 	now := time.Now()
 	expiresAt := now.Add(24 * time.Hour)
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"circa/api"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// GetDashboard handles GET /dashboard
+func (h *Handler) GetDashboard(ctx echo.Context) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	d, err := h.dashboardService.GetDashboard(ctx.Request().Context(), *user)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get dashboard")
+		return ctx.JSON(500, api.ErrorInternalServerError{
+			Code:    500,
+			Message: "Internal server error",
+		})
+	}
+
+	groups := make([]api.GroupSummary, 0, len(d.Groups))
+	for _, g := range d.Groups {
+		groups = append(groups, toAPIGroupSummary(g))
+	}
+
+	invites := make([]api.PendingInvite, 0, len(d.Invites))
+	for _, i := range d.Invites {
+		invites = append(invites, api.PendingInvite{
+			Id:             i.ID,
+			GroupId:        i.GroupID,
+			GroupName:      i.GroupName,
+			GroupAvatarUrl: i.GroupAvatarUrl,
+			CreatedAt:      api.Timestamp(i.CreatedAt.Time),
+		})
+	}
+
+	rounds := make([]api.RoundSummary, 0, len(d.Rounds))
+	for _, r := range d.Rounds {
+		rounds = append(rounds, toAPIRoundSummary(r, h.tokens))
+	}
+
+	payouts := make([]api.UpcomingPayout, 0, len(d.UpcomingPayouts))
+	for _, p := range d.UpcomingPayouts {
+		payout := api.UpcomingPayout{
+			RoundId:                 p.RoundID,
+			GroupId:                 p.GroupID,
+			GroupName:               p.GroupName,
+			Period:                  p.Period,
+			ExpectedAmount:          p.ExpectedAmount,
+			FormattedExpectedAmount: p.FormattedExpectedAmount,
+			ExpectedAmountFiat:      toAPIFiatAmount(p.ExpectedAmountFiat),
+		}
+		if p.EstimatedTime != nil {
+			estimated := api.Timestamp(*p.EstimatedTime)
+			payout.EstimatedTime = &estimated
+		}
+		payouts = append(payouts, payout)
+	}
+
+	activity := make([]api.ActivityItem, 0, len(d.RecentActivity))
+	for _, item := range d.RecentActivity {
+		activity = append(activity, toAPIActivityItem(item))
+	}
+
+	return ctx.JSON(200, api.Dashboard{
+		Groups:          groups,
+		Invites:         invites,
+		Rounds:          rounds,
+		UpcomingPayouts: &payouts,
+		RecentActivity:  &activity,
+	})
+}
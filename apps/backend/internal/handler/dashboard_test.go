@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	authmocks "circa/internal/handler/mocks"
+	dashboardmocks "circa/internal/handler/mocks/dashboard"
+	"circa/internal/prices"
+	"circa/internal/service/auth"
+	"circa/internal/service/dashboard"
+	"circa/internal/service/round"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetDashboard(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	roundID := uuid.New()
+	now := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	estimated := now.Add(14 * 24 * time.Hour)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*dashboardmocks.MockDashboardService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - every section is returned",
+			setupMocks: func(m *dashboardmocks.MockDashboardService) {
+				m.On("GetDashboard", mock.Anything, user).Return(&dashboard.Dashboard{
+					Groups: []sqlc.ListUserGroupsRow{{
+						ID:          groupID,
+						Name:        "Family",
+						MemberCount: 3,
+						CreatedAt:   pgtype.Timestamp{Time: now, Valid: true},
+					}},
+					Invites: []sqlc.ListUserPendingInvitesRow{{
+						ID:        uuid.New(),
+						GroupID:   uuid.New(),
+						GroupName: "Work",
+						CreatedAt: pgtype.Timestamp{Time: now, Valid: true},
+					}},
+					Rounds: []round.RoundSummary{{
+						Round: sqlc.Round{
+							ID:                    roundID,
+							GroupID:               groupID,
+							ChainID:               31337,
+							ContractAddress:       "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+							ContributionAmount:    "1000000",
+							PeriodDurationSeconds: 604800,
+							Status:                round.StatusActive,
+							MemberCount:           3,
+							CreatedAt:             pgtype.Timestamp{Time: now, Valid: true},
+						},
+						GroupName:     "Family",
+						CurrentPeriod: intPtr(1),
+						PaidCount:     intPtr(2),
+					}},
+					UpcomingPayouts: []dashboard.UpcomingPayout{{
+						RoundID:        roundID,
+						GroupID:        groupID,
+						GroupName:      "Family",
+						Period:         2,
+						ExpectedAmount: "3000000",
+						EstimatedTime:  &estimated,
+						ExpectedAmountFiat: &prices.Amount{
+							Currency: "NGN",
+							Value:    "4500.00",
+						},
+					}},
+					RecentActivity: []round.ActivityItem{{
+						ID:        uuid.New(),
+						Type:      round.ActivityPayment,
+						Status:    round.ActivityStatusConfirmed,
+						Address:   user.Address,
+						Amount:    "1000000",
+						TxHash:    "0xabc",
+						Timestamp: now,
+					}},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.Dashboard
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				require.Len(t, response.Groups, 1)
+				assert.Equal(t, "Family", response.Groups[0].Name)
+				assert.Equal(t, 3, response.Groups[0].MemberCount)
+				require.Len(t, response.Invites, 1)
+				assert.Equal(t, "Work", response.Invites[0].GroupName)
+				require.Len(t, response.Rounds, 1)
+				assert.Equal(t, roundID, response.Rounds[0].Id)
+				assert.Equal(t, intPtr(1), response.Rounds[0].CurrentPeriod)
+				assert.Equal(t, intPtr(2), response.Rounds[0].PaidCount)
+				require.NotNil(t, response.UpcomingPayouts)
+				require.Len(t, *response.UpcomingPayouts, 1)
+				payout := (*response.UpcomingPayouts)[0]
+				assert.Equal(t, "3000000", payout.ExpectedAmount)
+				assert.Equal(t, &api.FiatAmount{Currency: "NGN", Value: "4500.00"}, payout.ExpectedAmountFiat)
+				require.NotNil(t, payout.EstimatedTime)
+				assert.True(t, estimated.Equal(time.Time(*payout.EstimatedTime)))
+				require.NotNil(t, response.RecentActivity)
+				require.Len(t, *response.RecentActivity, 1)
+				assert.Equal(t, api.ActivityItemType(round.ActivityPayment), (*response.RecentActivity)[0].Type)
+			},
+		},
+		{
+			name: "success - empty dashboard has empty sections",
+			setupMocks: func(m *dashboardmocks.MockDashboardService) {
+				m.On("GetDashboard", mock.Anything, user).Return(&dashboard.Dashboard{}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.JSONEq(t, `{"groups": [], "invites": [], "rounds": [], "upcomingPayouts": [], "recentActivity": []}`, rec.Body.String())
+			},
+		},
+		{
+			name: "error - service failure",
+			setupMocks: func(m *dashboardmocks.MockDashboardService) {
+				m.On("GetDashboard", mock.Anything, user).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/dashboard", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockDashboard := dashboardmocks.NewMockDashboardService(t)
+			tt.setupMocks(mockDashboard)
+
+			handler := &Handler{
+				authService:      mockAuth,
+				dashboardService: mockDashboard,
+			}
+
+			err := handler.GetDashboard(c)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockDashboard.AssertExpectations(t)
+		})
+	}
+}
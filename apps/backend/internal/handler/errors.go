@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+
+	"circa/api"
+	circaerrors "circa/internal/errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// serviceError maps the errors services share, about the groups, rounds
+// and pages they act on, onto API responses. Each handler maps its own
+// service's errors first and falls back to it; anything else is logged and
+// answered with a 500.
+func (h *Handler) serviceError(ctx echo.Context, err error, logMsg string) error {
+	switch {
+	case errors.Is(err, circaerrors.ErrGroupNotFound):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: "Group not found",
+		})
+	case errors.Is(err, circaerrors.ErrRoundNotFound):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: "Round not found",
+		})
+	case errors.Is(err, circaerrors.ErrNotGroupMember),
+		errors.Is(err, circaerrors.ErrNotGroupOwner),
+		errors.Is(err, circaerrors.ErrNotRoundMember):
+		return ctx.JSON(403, api.ErrorForbidden{
+			Code:    403,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrGroupArchived):
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:    409,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrInvalidCursor),
+		errors.Is(err, circaerrors.ErrInvalidLimit),
+		errors.Is(err, circaerrors.ErrUnsupportedChain):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+
+	log.Error().Err(err).Msg(logMsg)
+	return ctx.JSON(500, api.ErrorInternalServerError{
+		Code:    500,
+		Message: "Internal server error",
+	})
+}
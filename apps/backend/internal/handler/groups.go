@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"errors"
+
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/group"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListGroups handles GET /groups
+func (h *Handler) ListGroups(ctx echo.Context, params api.ListGroupsParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	listParams := group.ListGroupsParams{
+		UserID:   user.ID,
+		Archived: params.Archived != nil && *params.Archived,
+		Limit:    params.Limit,
+		Cursor:   params.Cursor,
+	}
+	if params.Q != nil {
+		listParams.Query = *params.Q
+	}
+
+	result, err := h.groupService.ListGroups(ctx.Request().Context(), listParams)
+	if err != nil {
+		return h.groupError(ctx, err, "Failed to list groups")
+	}
+
+	items := make([]api.GroupSummary, 0, len(result.Groups))
+	for _, g := range result.Groups {
+		items = append(items, toAPIGroupSummary(g))
+	}
+
+	return ctx.JSON(200, api.GroupPage{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	})
+}
+
+// SearchGroupMembers handles GET /groups/{groupId}/members/search
+func (h *Handler) SearchGroupMembers(ctx echo.Context, groupId api.UUID, params api.SearchGroupMembersParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	if len(params.Q) > 100 {
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "q must be at most 100 characters",
+		})
+	}
+
+	result, err := h.groupService.SearchMembers(ctx.Request().Context(), group.SearchMembersParams{
+		GroupID: groupId,
+		User:    *user,
+		Query:   params.Q,
+		Limit:   params.Limit,
+		Cursor:  params.Cursor,
+	})
+	if err != nil {
+		return h.groupError(ctx, err, "Failed to search group members")
+	}
+
+	items := make([]api.GroupMember, 0, len(result.Members))
+	for _, m := range result.Members {
+		member := api.GroupMember{
+			Address:     api.Address(m.Address),
+			DisplayName: m.DisplayName,
+			Role:        api.GroupMemberRole(m.Role),
+			Status:      api.GroupMemberStatus(m.Status),
+		}
+		if m.JoinedAt.Valid {
+			joinedAt := api.Timestamp(m.JoinedAt.Time)
+			member.JoinedAt = &joinedAt
+		}
+		items = append(items, member)
+	}
+
+	return ctx.JSON(200, api.GroupMemberPage{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	})
+}
+
+// GetGroupMember handles GET /groups/{groupId}/members/{memberAddress}
+func (h *Handler) GetGroupMember(ctx echo.Context, groupId api.UUID, memberAddress api.Address) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	profile, err := h.groupService.GetMemberProfile(ctx.Request().Context(), groupId, *user, string(memberAddress))
+	if err != nil {
+		return h.groupError(ctx, err, "Failed to get group member")
+	}
+
+	m := profile.Member
+	response := api.GroupMemberProfile{
+		Address:       api.Address(m.Address),
+		DisplayName:   m.DisplayName,
+		Role:          api.GroupMemberProfileRole(m.Role),
+		Status:        api.GroupMemberProfileStatus(m.Status),
+		Delinquencies: make([]api.MemberDelinquency, 0, len(profile.Delinquencies)),
+	}
+	if m.JoinedAt.Valid {
+		joinedAt := api.Timestamp(m.JoinedAt.Time)
+		response.JoinedAt = &joinedAt
+	}
+	for _, d := range profile.Delinquencies {
+		response.Delinquencies = append(response.Delinquencies, api.MemberDelinquency{
+			RoundId:   d.RoundID,
+			Period:    int(d.Period),
+			Status:    api.MemberDelinquencyStatus(d.Status),
+			FlaggedAt: api.Timestamp(d.CreatedAt.Time),
+			Paid:      d.Paid,
+		})
+	}
+
+	return ctx.JSON(200, response)
+}
+
+// ArchiveGroup handles POST /groups/{groupId}/archive
+func (h *Handler) ArchiveGroup(ctx echo.Context, groupId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	if err := h.groupService.ArchiveGroup(ctx.Request().Context(), groupId, *user); err != nil {
+		return h.groupError(ctx, err, "Failed to archive group")
+	}
+
+	return ctx.NoContent(204)
+}
+
+// UnarchiveGroup handles POST /groups/{groupId}/unarchive
+func (h *Handler) UnarchiveGroup(ctx echo.Context, groupId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	if err := h.groupService.UnarchiveGroup(ctx.Request().Context(), groupId, *user); err != nil {
+		return h.groupError(ctx, err, "Failed to unarchive group")
+	}
+
+	return ctx.NoContent(204)
+}
+
+// RemoveGroupMember handles DELETE /groups/{groupId}/members/{memberAddress}
+func (h *Handler) RemoveGroupMember(ctx echo.Context, groupId api.UUID, memberAddress api.Address, params api.RemoveGroupMemberParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	err = h.groupService.RemoveMember(ctx.Request().Context(), group.RemoveMemberParams{
+		GroupID:       groupId,
+		Actor:         *user,
+		MemberAddress: string(memberAddress),
+		Force:         params.Force != nil && *params.Force,
+		Reason:        params.Reason,
+	})
+	if err != nil {
+		return h.groupError(ctx, err, "Failed to remove group member")
+	}
+
+	return ctx.NoContent(204)
+}
+
+// LeaveGroup handles POST /groups/{groupId}/leave
+func (h *Handler) LeaveGroup(ctx echo.Context, groupId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	if err := h.groupService.LeaveGroup(ctx.Request().Context(), groupId, *user); err != nil {
+		return h.groupError(ctx, err, "Failed to leave group")
+	}
+
+	return ctx.NoContent(204)
+}
+
+// groupError maps group service errors onto API responses.
+func (h *Handler) groupError(ctx echo.Context, err error, logMsg string) error {
+	var obligationErr *group.ObligationError
+	if errors.As(err, &obligationErr) {
+		outstanding := make([]api.MemberObligation, 0, len(obligationErr.Obligations))
+		for _, o := range obligationErr.Obligations {
+			outstanding = append(outstanding, api.MemberObligation{
+				RoundId:           o.RoundID,
+				ContributionsOwed: o.ContributionsOwed,
+				AwaitingPayout:    o.AwaitingPayout,
+			})
+		}
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:        409,
+			Message:     obligationErr.Error(),
+			Outstanding: &outstanding,
+		})
+	}
+
+	switch {
+	case errors.Is(err, circaerrors.ErrMemberNotFound):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: "Member not found",
+		})
+	case errors.Is(err, circaerrors.ErrAllowlistNotFound),
+		errors.Is(err, circaerrors.ErrNotInAllowlist):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrOwnerCannotLeave):
+		return ctx.JSON(403, api.ErrorForbidden{
+			Code:    403,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrGroupHasActiveRounds):
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:    409,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrRemovalReasonRequired),
+		errors.Is(err, circaerrors.ErrInvalidSearchQuery):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
+
+func toAPIGroupSummary(g sqlc.ListUserGroupsRow) api.GroupSummary {
+	summary := api.GroupSummary{
+		Id:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		AvatarUrl:   g.AvatarUrl,
+		MemberCount: int(g.MemberCount),
+		CreatedAt:   api.Timestamp(g.CreatedAt.Time),
+	}
+	if g.UpdatedAt.Valid {
+		updatedAt := api.Timestamp(g.UpdatedAt.Time)
+		summary.UpdatedAt = &updatedAt
+	}
+	if g.ArchivedAt.Valid {
+		archivedAt := api.Timestamp(g.ArchivedAt.Time)
+		summary.ArchivedAt = &archivedAt
+	}
+	return summary
+}
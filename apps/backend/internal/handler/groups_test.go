@@ -0,0 +1,595 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	groupmocks "circa/internal/handler/mocks/group"
+	"circa/internal/service/auth"
+	"circa/internal/service/group"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testMemberAddress = "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"
+
+func TestHandler_ListGroups(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name           string
+		params         api.ListGroupsParams
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - archived groups",
+			params: api.ListGroupsParams{Archived: boolPtr(true), Limit: intPtr(10)},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("ListGroups", mock.Anything, group.ListGroupsParams{
+					UserID:   user.ID,
+					Archived: true,
+					Limit:    intPtr(10),
+				}).Return(&group.ListGroupsResult{
+					Groups: []sqlc.ListUserGroupsRow{{
+						ID:          groupID,
+						Name:        "Friday Ajo",
+						MemberCount: 4,
+						CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+						ArchivedAt:  pgtype.Timestamp{Time: archivedAt, Valid: true},
+					}},
+					NextCursor: stringPtr("next"),
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.GroupPage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				require.Len(t, response.Items, 1)
+				assert.Equal(t, groupID, response.Items[0].Id)
+				assert.Equal(t, 4, response.Items[0].MemberCount)
+				require.NotNil(t, response.Items[0].ArchivedAt)
+				require.NotNil(t, response.NextCursor)
+				assert.Equal(t, "next", *response.NextCursor)
+			},
+		},
+		{
+			name:   "success - search query is passed through",
+			params: api.ListGroupsParams{Q: stringPtr("ajo")},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("ListGroups", mock.Anything, group.ListGroupsParams{
+					UserID: user.ID,
+					Query:  "ajo",
+				}).Return(&group.ListGroupsResult{Groups: []sqlc.ListUserGroupsRow{}}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.GroupPage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.NotNil(t, response.Items)
+				assert.Empty(t, response.Items)
+				assert.Nil(t, response.NextCursor)
+			},
+		},
+		{
+			name:   "error - limit out of range",
+			params: api.ListGroupsParams{Limit: intPtr(500)},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("ListGroups", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidLimit)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:   "error - invalid cursor",
+			params: api.ListGroupsParams{Cursor: stringPtr("garbage")},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("ListGroups", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidCursor)
+			},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/groups", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockGroup := groupmocks.NewMockGroupService(t)
+			tt.setupMocks(mockGroup)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				groupService: mockGroup,
+			}
+
+			err := handler.ListGroups(c, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockGroup.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_SearchGroupMembers(t *testing.T) {
+	groupID := uuid.New()
+	user := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		params         api.SearchGroupMembersParams
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - matching members",
+			params: api.SearchGroupMembersParams{Q: "ada", Limit: intPtr(1)},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("SearchMembers", mock.Anything, group.SearchMembersParams{
+					GroupID: groupID,
+					User:    user,
+					Query:   "ada",
+					Limit:   intPtr(1),
+				}).Return(&group.SearchMembersResult{
+					Members: []sqlc.SearchGroupMembersRow{{
+						Address:     testMemberAddress,
+						DisplayName: stringPtr("Ada"),
+						Role:        "member",
+						Status:      "accepted",
+						JoinedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+					}},
+					NextCursor: stringPtr("next"),
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.GroupMemberPage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				require.Len(t, response.Items, 1)
+				assert.Equal(t, api.Address(testMemberAddress), response.Items[0].Address)
+				assert.Equal(t, api.GroupMemberRole("member"), response.Items[0].Role)
+				require.NotNil(t, response.Items[0].JoinedAt)
+				require.NotNil(t, response.NextCursor)
+			},
+		},
+		{
+			name:   "error - not a member",
+			params: api.SearchGroupMembersParams{Q: "ada"},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("SearchMembers", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name:   "error - empty query",
+			params: api.SearchGroupMembersParams{Q: " "},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("SearchMembers", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidSearchQuery)
+			},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/members/search", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockGroup := groupmocks.NewMockGroupService(t)
+			tt.setupMocks(mockGroup)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				groupService: mockGroup,
+			}
+
+			err := handler.SearchGroupMembers(c, groupID, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockGroup.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetGroupMember(t *testing.T) {
+	groupID := uuid.New()
+	roundID := uuid.New()
+	user := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - profile with delinquencies",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetMemberProfile", mock.Anything, groupID, user, testMemberAddress).Return(&group.MemberProfile{
+					Member: sqlc.GetGroupMemberDetailsRow{
+						Address:     testMemberAddress,
+						DisplayName: stringPtr("Ada"),
+						Role:        "member",
+						Status:      "accepted",
+						JoinedAt:    pgtype.Timestamp{Time: time.Now(), Valid: true},
+					},
+					Delinquencies: []sqlc.ListGroupMemberDelinquenciesRow{
+						{RoundID: roundID, Period: 1, Status: "missed", CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true}},
+						{RoundID: roundID, Period: 0, Status: "late", CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true}, Paid: true},
+					},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.GroupMemberProfile
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, api.Address(testMemberAddress), response.Address)
+				require.NotNil(t, response.JoinedAt)
+				require.Len(t, response.Delinquencies, 2)
+				assert.Equal(t, roundID, response.Delinquencies[0].RoundId)
+				assert.Equal(t, api.MemberDelinquencyStatus("missed"), response.Delinquencies[0].Status)
+				assert.False(t, response.Delinquencies[0].Paid)
+				assert.True(t, response.Delinquencies[1].Paid)
+			},
+		},
+		{
+			name: "error - member not found",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetMemberProfile", mock.Anything, groupID, user, testMemberAddress).Return(nil, circaerrors.ErrMemberNotFound)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name: "error - not a member",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("GetMemberProfile", mock.Anything, groupID, user, testMemberAddress).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/members/"+testMemberAddress, nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockGroup := groupmocks.NewMockGroupService(t)
+			tt.setupMocks(mockGroup)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				groupService: mockGroup,
+			}
+
+			err := handler.GetGroupMember(c, groupID, testMemberAddress)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockGroup.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_ArchiveGroup(t *testing.T) {
+	groupID := uuid.New()
+	owner := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+	}{
+		{
+			name: "success - archived",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("ArchiveGroup", mock.Anything, groupID, owner).Return(nil)
+			},
+			expectedStatus: 204,
+		},
+		{
+			name: "error - active rounds",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("ArchiveGroup", mock.Anything, groupID, owner).Return(circaerrors.ErrGroupHasActiveRounds)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "error - not owner",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("ArchiveGroup", mock.Anything, groupID, owner).Return(circaerrors.ErrNotGroupOwner)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/groups/"+groupID.String()+"/archive", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: owner}, nil)
+			mockGroup := groupmocks.NewMockGroupService(t)
+			tt.setupMocks(mockGroup)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				groupService: mockGroup,
+			}
+
+			err := handler.ArchiveGroup(c, groupID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockAuth.AssertExpectations(t)
+			mockGroup.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_RemoveGroupMember(t *testing.T) {
+	groupID := uuid.New()
+	roundID := uuid.New()
+	owner := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		withSession    bool
+		params         api.RemoveGroupMemberParams
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:           "error - no session",
+			withSession:    false,
+			setupMocks:     func(m *groupmocks.MockGroupService) {},
+			expectedStatus: 401,
+		},
+		{
+			name:        "success - member removed",
+			withSession: true,
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("RemoveMember", mock.Anything, group.RemoveMemberParams{
+					GroupID:       groupID,
+					Actor:         owner,
+					MemberAddress: testMemberAddress,
+				}).Return(nil)
+			},
+			expectedStatus: 204,
+		},
+		{
+			name:        "success - force removal passes reason through",
+			withSession: true,
+			params:      api.RemoveGroupMemberParams{Force: boolPtr(true), Reason: stringPtr("Relocated")},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("RemoveMember", mock.Anything, mock.MatchedBy(func(p group.RemoveMemberParams) bool {
+					return p.Force && p.Reason != nil && *p.Reason == "Relocated"
+				})).Return(nil)
+			},
+			expectedStatus: 204,
+		},
+		{
+			name:        "error - outstanding obligations",
+			withSession: true,
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("RemoveMember", mock.Anything, mock.Anything).Return(&group.ObligationError{
+					Address: testMemberAddress,
+					Obligations: []group.Obligation{
+						{RoundID: roundID, ContributionsOwed: 2, AwaitingPayout: true},
+					},
+				})
+			},
+			expectedStatus: 409,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.ErrorConflict
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, 409, response.Code)
+				assert.Contains(t, response.Message, "owes 2 contribution(s)")
+				require.NotNil(t, response.Outstanding)
+				require.Len(t, *response.Outstanding, 1)
+				assert.Equal(t, roundID, (*response.Outstanding)[0].RoundId)
+				assert.Equal(t, 2, (*response.Outstanding)[0].ContributionsOwed)
+				assert.True(t, (*response.Outstanding)[0].AwaitingPayout)
+			},
+		},
+		{
+			name:        "error - force without reason",
+			withSession: true,
+			params:      api.RemoveGroupMemberParams{Force: boolPtr(true)},
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("RemoveMember", mock.Anything, mock.Anything).Return(circaerrors.ErrRemovalReasonRequired)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:        "error - not owner",
+			withSession: true,
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("RemoveMember", mock.Anything, mock.Anything).Return(circaerrors.ErrNotGroupOwner)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name:        "error - group not found",
+			withSession: true,
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("RemoveMember", mock.Anything, mock.Anything).Return(circaerrors.ErrGroupNotFound)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name:        "error - service failure",
+			withSession: true,
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("RemoveMember", mock.Anything, mock.Anything).Return(errors.New("database connection error"))
+			},
+			expectedStatus: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/groups/"+groupID.String()+"/members/"+testMemberAddress, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockGroup := groupmocks.NewMockGroupService(t)
+			if tt.withSession {
+				req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+				mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+					Return(&auth.GetSessionUserResult{User: owner}, nil)
+			}
+			tt.setupMocks(mockGroup)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				groupService: mockGroup,
+			}
+
+			err := handler.RemoveGroupMember(c, groupID, testMemberAddress, tt.params)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockGroup.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_LeaveGroup(t *testing.T) {
+	groupID := uuid.New()
+	member := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*groupmocks.MockGroupService)
+		expectedStatus int
+	}{
+		{
+			name: "success - left group",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("LeaveGroup", mock.Anything, groupID, member).Return(nil)
+			},
+			expectedStatus: 204,
+		},
+		{
+			name: "error - owner cannot leave",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("LeaveGroup", mock.Anything, groupID, member).Return(circaerrors.ErrOwnerCannotLeave)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name: "error - still owes contributions",
+			setupMocks: func(m *groupmocks.MockGroupService) {
+				m.On("LeaveGroup", mock.Anything, groupID, member).Return(&group.ObligationError{
+					Address:     member.Address,
+					Obligations: []group.Obligation{{RoundID: uuid.New(), ContributionsOwed: 1}},
+				})
+			},
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/groups/"+groupID.String()+"/leave", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: member}, nil)
+			mockGroup := groupmocks.NewMockGroupService(t)
+			tt.setupMocks(mockGroup)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				groupService: mockGroup,
+			}
+
+			err := handler.LeaveGroup(c, groupID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			mockAuth.AssertExpectations(t)
+			mockGroup.AssertExpectations(t)
+		})
+	}
+}
+
+func createTestSessionUser() sqlc.User {
+	return sqlc.User{
+		ID:        uuid.New(),
+		Address:   "0x1111111111111111111111111111111111111111",
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func intPtr(i int) *int {
+	return &i
+}
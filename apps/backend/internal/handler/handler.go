@@ -5,22 +5,51 @@ import (
 	"circa/internal/config"
 	circaerrors "circa/internal/errors"
 	"circa/internal/service/auth"
+	"circa/internal/service/backfill"
+	"circa/internal/service/dashboard"
+	"circa/internal/service/group"
+	"circa/internal/service/invite"
+	"circa/internal/service/payoutorder"
+	"circa/internal/service/reminder"
+	"circa/internal/service/round"
+	"circa/internal/service/slotswap"
+	"circa/internal/service/txbuilder"
+	"circa/internal/tokens"
 	"errors"
 
 	"github.com/labstack/echo/v4"
-	"github.com/rs/zerolog/log"
 )
 
 type Handler struct {
-	authService auth.AuthService
-	config      config.Config
+	authService        auth.AuthService
+	groupService       group.GroupService
+	inviteService      invite.InviteService
+	roundService       round.RoundService
+	dashboardService   dashboard.DashboardService
+	reminderService    reminder.ReminderService
+	payoutOrderService payoutorder.PayoutOrderService
+	slotSwapService    slotswap.SlotSwapService
+	txBuilderService   txbuilder.TxBuilderService
+	backfillService    backfill.BackfillService
+	tokens             *tokens.Registry
+	config             config.Config
 }
 
 // NewHandler creates a new handler instance
-func NewHandler(authService auth.AuthService, cfg config.Config) *Handler {
+func NewHandler(authService auth.AuthService, groupService group.GroupService, inviteService invite.InviteService, roundService round.RoundService, dashboardService dashboard.DashboardService, reminderService reminder.ReminderService, payoutOrderService payoutorder.PayoutOrderService, slotSwapService slotswap.SlotSwapService, txBuilderService txbuilder.TxBuilderService, backfillService backfill.BackfillService, registry *tokens.Registry, cfg config.Config) *Handler {
 	return &Handler{
-		authService: authService,
-		config:      cfg,
+		authService:        authService,
+		groupService:       groupService,
+		inviteService:      inviteService,
+		roundService:       roundService,
+		dashboardService:   dashboardService,
+		reminderService:    reminderService,
+		payoutOrderService: payoutOrderService,
+		slotSwapService:    slotSwapService,
+		txBuilderService:   txBuilderService,
+		backfillService:    backfillService,
+		tokens:             registry,
+		config:             cfg,
 	}
 }
 
@@ -32,64 +61,45 @@ func (h *Handler) AuthLogout(ctx echo.Context) error {
 
 // GetMe handles GET /me
 func (h *Handler) GetMe(ctx echo.Context) error {
-	// Check for circa_session cookie
-	cookie, err := ctx.Cookie("circa_session")
-	if err != nil || cookie == nil || cookie.Value == "" {
-		return ctx.JSON(401, api.ErrorUnauthorized{
-			Code:    401,
-			Message: "Unauthorized - no valid session",
-		})
+	sessionUser, err := h.sessionUser(ctx)
+	if sessionUser == nil {
+		return err
 	}
 
-	sessionID := cookie.Value
+	return ctx.JSON(200, toAPIUser(*sessionUser))
+}
 
-	// Get user from session
-	result, err := h.authService.GetSessionUser(ctx.Request().Context(), sessionID)
-	if err != nil {
-		if errors.Is(err, circaerrors.ErrInvalidSession) {
-			return ctx.JSON(401, api.ErrorUnauthorized{
-				Code:    401,
-				Message: "Unauthorized - invalid or expired session",
-			})
-		}
-		log.Error().Err(err).Msg("Failed to get session user")
-		return ctx.JSON(500, api.ErrorInternalServerError{
-			Code:    500,
-			Message: "Internal server error",
-		})
+// UpdateMe handles PATCH /me
+func (h *Handler) UpdateMe(ctx echo.Context) error {
+	sessionUser, err := h.sessionUser(ctx)
+	if sessionUser == nil {
+		return err
 	}
 
-	// Convert user to API response
-	user := api.User{
-		Id:          result.User.ID,
-		Address:     api.Address(result.User.Address),
-		CreatedAt:   api.Timestamp(result.User.CreatedAt.Time),
-		DisplayName: result.User.DisplayName,
-	}
-	if result.User.UpdatedAt.Valid {
-		updatedAt := api.Timestamp(result.User.UpdatedAt.Time)
-		user.UpdatedAt = &updatedAt
+	var req api.UpdateMeJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
 	}
 
-	return ctx.JSON(200, user)
-}
-
-// UpdateMe handles PATCH /me
-func (h *Handler) UpdateMe(ctx echo.Context) error {
-	// TODO: Implement update me
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
+	updated, err := h.authService.UpdateProfile(ctx.Request().Context(), *sessionUser, auth.UpdateProfileParams{
+		DisplayName:       req.DisplayName,
+		AvatarURL:         req.AvatarUrl,
+		PreferredCurrency: (*string)(req.PreferredCurrency),
 	})
-}
+	if err != nil {
+		if errors.Is(err, circaerrors.ErrInvalidCurrency) {
+			return ctx.JSON(400, api.ErrorBadRequest{
+				Code:    400,
+				Message: err.Error(),
+			})
+		}
+		return h.serviceError(ctx, err, "Failed to update profile")
+	}
 
-// ListGroups handles GET /groups
-func (h *Handler) ListGroups(ctx echo.Context, params api.ListGroupsParams) error {
-	// TODO: Implement list groups
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
+	return ctx.JSON(200, toAPIUser(*updated))
 }
 
 // CreateGroup handles POST /groups
@@ -128,24 +138,6 @@ func (h *Handler) ListGroupMembers(ctx echo.Context, groupId api.UUID) error {
 	})
 }
 
-// RemoveGroupMember handles DELETE /groups/{groupId}/members/{memberAddress}
-func (h *Handler) RemoveGroupMember(ctx echo.Context, groupId api.UUID, memberAddress api.Address) error {
-	// TODO: Implement remove group member
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
-
-// LeaveGroup handles POST /groups/{groupId}/leave
-func (h *Handler) LeaveGroup(ctx echo.Context, groupId api.UUID) error {
-	// TODO: Implement leave group
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
-
 // ListInvites handles GET /groups/{groupId}/invites
 func (h *Handler) ListInvites(ctx echo.Context, groupId api.UUID) error {
 	// TODO: Implement list invites
@@ -155,15 +147,6 @@ func (h *Handler) ListInvites(ctx echo.Context, groupId api.UUID) error {
 	})
 }
 
-// CreateInvite handles POST /groups/{groupId}/invites
-func (h *Handler) CreateInvite(ctx echo.Context, groupId api.UUID) error {
-	// TODO: Implement create invite
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
-
 // RevokeInvite handles DELETE /groups/{groupId}/invites/{inviteId}
 func (h *Handler) RevokeInvite(ctx echo.Context, groupId api.UUID, inviteId api.UUID) error {
 	// TODO: Implement revoke invite
@@ -182,15 +165,6 @@ func (h *Handler) ListGroupRounds(ctx echo.Context, groupId api.UUID, params api
 	})
 }
 
-// CreateRound handles POST /groups/{groupId}/rounds
-func (h *Handler) CreateRound(ctx echo.Context, groupId api.UUID) error {
-	// TODO: Implement create round
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
-
 // AcceptInvite handles POST /invites/accept
 func (h *Handler) AcceptInvite(ctx echo.Context) error {
 	// TODO: Implement accept invite
@@ -208,39 +182,3 @@ func (h *Handler) PreviewInvite(ctx echo.Context) error {
 		Message: "Not implemented",
 	})
 }
-
-// ListRounds handles GET /rounds
-func (h *Handler) ListRounds(ctx echo.Context, params api.ListRoundsParams) error {
-	// TODO: Implement list rounds
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
-
-// GetRound handles GET /rounds/{roundId}
-func (h *Handler) GetRound(ctx echo.Context, roundId api.UUID) error {
-	// TODO: Implement get round
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
-
-// GetRoundActivity handles GET /rounds/{roundId}/activity
-func (h *Handler) GetRoundActivity(ctx echo.Context, roundId api.UUID, params api.GetRoundActivityParams) error {
-	// TODO: Implement get round activity
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
-
-// GetRoundPeriods handles GET /rounds/{roundId}/periods
-func (h *Handler) GetRoundPeriods(ctx echo.Context, roundId api.UUID) error {
-	// TODO: Implement get round periods
-	return ctx.JSON(501, api.ErrorBadRequest{
-		Code:    501,
-		Message: "Not implemented",
-	})
-}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"circa/api"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/invite"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// CreateInvite handles POST /groups/{groupId}/invites
+func (h *Handler) CreateInvite(ctx echo.Context, groupId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.CreateInviteJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	params := invite.CreateInviteParams{
+		GroupID: groupId,
+		Actor:   *user,
+		MaxUses: req.MaxUses,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := time.Time(*req.ExpiresAt)
+		params.ExpiresAt = &expiresAt
+	}
+
+	result, err := h.inviteService.CreateInvite(ctx.Request().Context(), params)
+	if err != nil {
+		return h.inviteError(ctx, err, "Failed to create invite")
+	}
+
+	resp := api.Invite{
+		Id:        result.Invite.ID,
+		GroupId:   result.Invite.GroupID,
+		Code:      result.Code,
+		DeepLink:  &result.DeepLink,
+		QrCodePng: &result.QRCodePNG,
+		QrCodeSvg: &result.QRCodeSVG,
+		MaxUses:   int(result.Invite.MaxUses),
+		Uses:      int(result.Invite.Uses),
+		CreatedAt: api.Timestamp(result.Invite.CreatedAt.Time),
+	}
+	if result.Invite.ExpiresAt.Valid {
+		expiresAt := api.Timestamp(result.Invite.ExpiresAt.Time)
+		resp.ExpiresAt = &expiresAt
+	}
+
+	return ctx.JSON(201, resp)
+}
+
+// GetInviteQrCode handles GET /groups/{groupId}/invites/{inviteId}/qr
+func (h *Handler) GetInviteQrCode(ctx echo.Context, groupId api.UUID, inviteId api.UUID, params api.GetInviteQrCodeParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	format := invite.QRFormatPNG
+	if params.Format != nil && *params.Format == api.Svg {
+		format = invite.QRFormatSVG
+	}
+
+	code, err := h.inviteService.GetQRCode(ctx.Request().Context(), groupId, inviteId, *user, format)
+	if err != nil {
+		return h.inviteError(ctx, err, "Failed to get invite QR code")
+	}
+
+	return ctx.Blob(200, code.ContentType, code.Data)
+}
+
+// GetInviteCard handles GET /groups/{groupId}/invites/{inviteId}/card
+func (h *Handler) GetInviteCard(ctx echo.Context, groupId api.UUID, inviteId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	card, err := h.inviteService.RenderCard(ctx.Request().Context(), groupId, inviteId, *user)
+	if err != nil {
+		return h.inviteError(ctx, err, "Failed to render invite card")
+	}
+
+	return ctx.HTMLBlob(200, card)
+}
+
+// inviteError maps invite service errors onto API responses.
+func (h *Handler) inviteError(ctx echo.Context, err error, logMsg string) error {
+	switch {
+	case errors.Is(err, circaerrors.ErrInviteNotFound):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: "Invite not found",
+		})
+	case errors.Is(err, circaerrors.ErrInvalidMaxUses),
+		errors.Is(err, circaerrors.ErrInvalidInviteExpiry):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	invitemocks "circa/internal/handler/mocks/invite"
+	"circa/internal/service/auth"
+	"circa/internal/service/invite"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CreateInvite(t *testing.T) {
+	groupID := uuid.New()
+	owner := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*invitemocks.MockInviteService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - returns code, deep link and QR code",
+			body: `{"maxUses": 3}`,
+			setupMocks: func(m *invitemocks.MockInviteService) {
+				m.On("CreateInvite", mock.Anything, invite.CreateInviteParams{
+					GroupID: groupID,
+					Actor:   owner,
+					MaxUses: intPtr(3),
+				}).Return(&invite.CreateInviteResult{
+					Invite: sqlc.Invite{
+						ID:        uuid.New(),
+						GroupID:   groupID,
+						MaxUses:   3,
+						CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+					},
+					Code:      "ABC234",
+					DeepLink:  "https://app.circa.test/invite?code=ABC234",
+					QRCodePNG: []byte("png"),
+					QRCodeSVG: "<svg/>",
+				}, nil)
+			},
+			expectedStatus: 201,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.Invite
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "ABC234", response.Code)
+				require.NotNil(t, response.DeepLink)
+				assert.Equal(t, "https://app.circa.test/invite?code=ABC234", *response.DeepLink)
+				assert.Equal(t, 3, response.MaxUses)
+				require.NotNil(t, response.QrCodePng)
+				assert.Equal(t, []byte("png"), *response.QrCodePng)
+				require.NotNil(t, response.QrCodeSvg)
+				assert.Equal(t, "<svg/>", *response.QrCodeSvg)
+			},
+		},
+		{
+			name: "error - invalid max uses",
+			body: `{"maxUses": 0}`,
+			setupMocks: func(m *invitemocks.MockInviteService) {
+				m.On("CreateInvite", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidMaxUses)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name: "error - not owner",
+			body: `{}`,
+			setupMocks: func(m *invitemocks.MockInviteService) {
+				m.On("CreateInvite", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrNotGroupOwner)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name:           "error - malformed body",
+			body:           `{"maxUses": "many"}`,
+			setupMocks:     func(m *invitemocks.MockInviteService) {},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/groups/"+groupID.String()+"/invites", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: owner}, nil)
+			mockInvite := invitemocks.NewMockInviteService(t)
+			tt.setupMocks(mockInvite)
+
+			handler := &Handler{
+				authService:   mockAuth,
+				inviteService: mockInvite,
+			}
+
+			err := handler.CreateInvite(c, groupID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockInvite.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetInviteQrCode(t *testing.T) {
+	groupID := uuid.New()
+	inviteID := uuid.New()
+	owner := createTestSessionUser()
+	svg := api.Svg
+
+	tests := []struct {
+		name                string
+		params              api.GetInviteQrCodeParams
+		setupMocks          func(*invitemocks.MockInviteService)
+		expectedStatus      int
+		expectedContentType string
+	}{
+		{
+			name:   "success - png by default",
+			params: api.GetInviteQrCodeParams{},
+			setupMocks: func(m *invitemocks.MockInviteService) {
+				m.On("GetQRCode", mock.Anything, groupID, inviteID, owner, invite.QRFormatPNG).
+					Return(&invite.QRCode{ContentType: "image/png", Data: []byte("png")}, nil)
+			},
+			expectedStatus:      200,
+			expectedContentType: "image/png",
+		},
+		{
+			name:   "success - svg",
+			params: api.GetInviteQrCodeParams{Format: &svg},
+			setupMocks: func(m *invitemocks.MockInviteService) {
+				m.On("GetQRCode", mock.Anything, groupID, inviteID, owner, invite.QRFormatSVG).
+					Return(&invite.QRCode{ContentType: "image/svg+xml", Data: []byte("<svg/>")}, nil)
+			},
+			expectedStatus:      200,
+			expectedContentType: "image/svg+xml",
+		},
+		{
+			name:   "error - revoked invite",
+			params: api.GetInviteQrCodeParams{},
+			setupMocks: func(m *invitemocks.MockInviteService) {
+				m.On("GetQRCode", mock.Anything, groupID, inviteID, owner, invite.QRFormatPNG).
+					Return(nil, circaerrors.ErrInviteNotFound)
+			},
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/invites/"+inviteID.String()+"/qr", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: owner}, nil)
+			mockInvite := invitemocks.NewMockInviteService(t)
+			tt.setupMocks(mockInvite)
+
+			handler := &Handler{
+				authService:   mockAuth,
+				inviteService: mockInvite,
+			}
+
+			err := handler.GetInviteQrCode(c, groupID, inviteID, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedContentType != "" {
+				assert.Equal(t, tt.expectedContentType, rec.Header().Get(echo.HeaderContentType))
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockInvite.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetInviteCard(t *testing.T) {
+	groupID := uuid.New()
+	inviteID := uuid.New()
+	owner := createTestSessionUser()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/invites/"+inviteID.String()+"/card", nil)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockAuth := authmocks.NewMockAuthService(t)
+	mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+		Return(&auth.GetSessionUserResult{User: owner}, nil)
+	mockInvite := invitemocks.NewMockInviteService(t)
+	mockInvite.On("RenderCard", mock.Anything, groupID, inviteID, owner).
+		Return([]byte("<html></html>"), nil)
+
+	handler := &Handler{
+		authService:   mockAuth,
+		inviteService: mockInvite,
+	}
+
+	err := handler.GetInviteCard(c, groupID, inviteID)
+	require.NoError(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/html")
+	assert.Equal(t, "<html></html>", rec.Body.String())
+}
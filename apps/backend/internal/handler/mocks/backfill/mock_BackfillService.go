@@ -0,0 +1,218 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package backfill
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockBackfillService is an autogenerated mock type for the BackfillService type
+type MockBackfillService struct {
+	mock.Mock
+}
+
+type MockBackfillService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBackfillService) EXPECT() *MockBackfillService_Expecter {
+	return &MockBackfillService_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, roundID
+func (_m *MockBackfillService) List(ctx context.Context, roundID uuid.UUID) ([]sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, roundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.IndexerBackfill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBackfillService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockBackfillService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockBackfillService_Expecter) List(ctx interface{}, roundID interface{}) *MockBackfillService_List_Call {
+	return &MockBackfillService_List_Call{Call: _e.mock.On("List", ctx, roundID)}
+}
+
+func (_c *MockBackfillService_List_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockBackfillService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockBackfillService_List_Call) Return(_a0 []sqlc.IndexerBackfill, _a1 error) *MockBackfillService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBackfillService_List_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]sqlc.IndexerBackfill, error)) *MockBackfillService_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields: ctx, backfillID
+func (_m *MockBackfillService) Resume(ctx context.Context, backfillID uuid.UUID) (*sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, backfillID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 *sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, backfillID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, backfillID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.IndexerBackfill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, backfillID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBackfillService_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type MockBackfillService_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - backfillID uuid.UUID
+func (_e *MockBackfillService_Expecter) Resume(ctx interface{}, backfillID interface{}) *MockBackfillService_Resume_Call {
+	return &MockBackfillService_Resume_Call{Call: _e.mock.On("Resume", ctx, backfillID)}
+}
+
+func (_c *MockBackfillService_Resume_Call) Run(run func(ctx context.Context, backfillID uuid.UUID)) *MockBackfillService_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockBackfillService_Resume_Call) Return(_a0 *sqlc.IndexerBackfill, _a1 error) *MockBackfillService_Resume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBackfillService_Resume_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*sqlc.IndexerBackfill, error)) *MockBackfillService_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, roundID, from, requestedBy
+func (_m *MockBackfillService) Start(ctx context.Context, roundID uuid.UUID, from uint64, requestedBy *uuid.UUID) (*sqlc.IndexerBackfill, error) {
+	ret := _m.Called(ctx, roundID, from, requestedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 *sqlc.IndexerBackfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64, *uuid.UUID) (*sqlc.IndexerBackfill, error)); ok {
+		return rf(ctx, roundID, from, requestedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64, *uuid.UUID) *sqlc.IndexerBackfill); ok {
+		r0 = rf(ctx, roundID, from, requestedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.IndexerBackfill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint64, *uuid.UUID) error); ok {
+		r1 = rf(ctx, roundID, from, requestedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBackfillService_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockBackfillService_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - from uint64
+//   - requestedBy *uuid.UUID
+func (_e *MockBackfillService_Expecter) Start(ctx interface{}, roundID interface{}, from interface{}, requestedBy interface{}) *MockBackfillService_Start_Call {
+	return &MockBackfillService_Start_Call{Call: _e.mock.On("Start", ctx, roundID, from, requestedBy)}
+}
+
+func (_c *MockBackfillService_Start_Call) Run(run func(ctx context.Context, roundID uuid.UUID, from uint64, requestedBy *uuid.UUID)) *MockBackfillService_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uint64), args[3].(*uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockBackfillService_Start_Call) Return(_a0 *sqlc.IndexerBackfill, _a1 error) *MockBackfillService_Start_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBackfillService_Start_Call) RunAndReturn(run func(context.Context, uuid.UUID, uint64, *uuid.UUID) (*sqlc.IndexerBackfill, error)) *MockBackfillService_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBackfillService creates a new instance of MockBackfillService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBackfillService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBackfillService {
+	mock := &MockBackfillService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package dashboard
+
+import (
+	dashboard "circa/internal/service/dashboard"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
+)
+
+// MockDashboardService is an autogenerated mock type for the DashboardService type
+type MockDashboardService struct {
+	mock.Mock
+}
+
+type MockDashboardService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDashboardService) EXPECT() *MockDashboardService_Expecter {
+	return &MockDashboardService_Expecter{mock: &_m.Mock}
+}
+
+// GetDashboard provides a mock function with given fields: ctx, user
+func (_m *MockDashboardService) GetDashboard(ctx context.Context, user sqlc.User) (*dashboard.Dashboard, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDashboard")
+	}
+
+	var r0 *dashboard.Dashboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User) (*dashboard.Dashboard, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User) *dashboard.Dashboard); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dashboard.Dashboard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.User) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDashboardService_GetDashboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDashboard'
+type MockDashboardService_GetDashboard_Call struct {
+	*mock.Call
+}
+
+// GetDashboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user sqlc.User
+func (_e *MockDashboardService_Expecter) GetDashboard(ctx interface{}, user interface{}) *MockDashboardService_GetDashboard_Call {
+	return &MockDashboardService_GetDashboard_Call{Call: _e.mock.On("GetDashboard", ctx, user)}
+}
+
+func (_c *MockDashboardService_GetDashboard_Call) Run(run func(ctx context.Context, user sqlc.User)) *MockDashboardService_GetDashboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockDashboardService_GetDashboard_Call) Return(_a0 *dashboard.Dashboard, _a1 error) *MockDashboardService_GetDashboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDashboardService_GetDashboard_Call) RunAndReturn(run func(context.Context, sqlc.User) (*dashboard.Dashboard, error)) *MockDashboardService_GetDashboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDashboardService creates a new instance of MockDashboardService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDashboardService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDashboardService {
+	mock := &MockDashboardService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,532 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package group
+
+import (
+	group "circa/internal/service/group"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockGroupService is an autogenerated mock type for the GroupService type
+type MockGroupService struct {
+	mock.Mock
+}
+
+type MockGroupService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGroupService) EXPECT() *MockGroupService_Expecter {
+	return &MockGroupService_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveGroup provides a mock function with given fields: ctx, groupID, user
+func (_m *MockGroupService) ArchiveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error {
+	ret := _m.Called(ctx, groupID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r0 = rf(ctx, groupID, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGroupService_ArchiveGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveGroup'
+type MockGroupService_ArchiveGroup_Call struct {
+	*mock.Call
+}
+
+// ArchiveGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - user sqlc.User
+func (_e *MockGroupService_Expecter) ArchiveGroup(ctx interface{}, groupID interface{}, user interface{}) *MockGroupService_ArchiveGroup_Call {
+	return &MockGroupService_ArchiveGroup_Call{Call: _e.mock.On("ArchiveGroup", ctx, groupID, user)}
+}
+
+func (_c *MockGroupService_ArchiveGroup_Call) Run(run func(ctx context.Context, groupID uuid.UUID, user sqlc.User)) *MockGroupService_ArchiveGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_ArchiveGroup_Call) Return(_a0 error) *MockGroupService_ArchiveGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGroupService_ArchiveGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) error) *MockGroupService_ArchiveGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllowlist provides a mock function with given fields: ctx, groupID, user
+func (_m *MockGroupService) GetAllowlist(ctx context.Context, groupID uuid.UUID, user sqlc.User) (*sqlc.GroupAllowlist, error) {
+	ret := _m.Called(ctx, groupID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllowlist")
+	}
+
+	var r0 *sqlc.GroupAllowlist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) (*sqlc.GroupAllowlist, error)); ok {
+		return rf(ctx, groupID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) *sqlc.GroupAllowlist); ok {
+		r0 = rf(ctx, groupID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.GroupAllowlist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, groupID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupService_GetAllowlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllowlist'
+type MockGroupService_GetAllowlist_Call struct {
+	*mock.Call
+}
+
+// GetAllowlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - user sqlc.User
+func (_e *MockGroupService_Expecter) GetAllowlist(ctx interface{}, groupID interface{}, user interface{}) *MockGroupService_GetAllowlist_Call {
+	return &MockGroupService_GetAllowlist_Call{Call: _e.mock.On("GetAllowlist", ctx, groupID, user)}
+}
+
+func (_c *MockGroupService_GetAllowlist_Call) Run(run func(ctx context.Context, groupID uuid.UUID, user sqlc.User)) *MockGroupService_GetAllowlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetAllowlist_Call) Return(_a0 *sqlc.GroupAllowlist, _a1 error) *MockGroupService_GetAllowlist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupService_GetAllowlist_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) (*sqlc.GroupAllowlist, error)) *MockGroupService_GetAllowlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllowlistProof provides a mock function with given fields: ctx, groupID, user, version
+func (_m *MockGroupService) GetAllowlistProof(ctx context.Context, groupID uuid.UUID, user sqlc.User, version *int32) (*group.AllowlistProof, error) {
+	ret := _m.Called(ctx, groupID, user, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllowlistProof")
+	}
+
+	var r0 *group.AllowlistProof
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User, *int32) (*group.AllowlistProof, error)); ok {
+		return rf(ctx, groupID, user, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User, *int32) *group.AllowlistProof); ok {
+		r0 = rf(ctx, groupID, user, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.AllowlistProof)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User, *int32) error); ok {
+		r1 = rf(ctx, groupID, user, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupService_GetAllowlistProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllowlistProof'
+type MockGroupService_GetAllowlistProof_Call struct {
+	*mock.Call
+}
+
+// GetAllowlistProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - user sqlc.User
+//   - version *int32
+func (_e *MockGroupService_Expecter) GetAllowlistProof(ctx interface{}, groupID interface{}, user interface{}, version interface{}) *MockGroupService_GetAllowlistProof_Call {
+	return &MockGroupService_GetAllowlistProof_Call{Call: _e.mock.On("GetAllowlistProof", ctx, groupID, user, version)}
+}
+
+func (_c *MockGroupService_GetAllowlistProof_Call) Run(run func(ctx context.Context, groupID uuid.UUID, user sqlc.User, version *int32)) *MockGroupService_GetAllowlistProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User), args[3].(*int32))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetAllowlistProof_Call) Return(_a0 *group.AllowlistProof, _a1 error) *MockGroupService_GetAllowlistProof_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupService_GetAllowlistProof_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User, *int32) (*group.AllowlistProof, error)) *MockGroupService_GetAllowlistProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemberProfile provides a mock function with given fields: ctx, groupID, user, address
+func (_m *MockGroupService) GetMemberProfile(ctx context.Context, groupID uuid.UUID, user sqlc.User, address string) (*group.MemberProfile, error) {
+	ret := _m.Called(ctx, groupID, user, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberProfile")
+	}
+
+	var r0 *group.MemberProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User, string) (*group.MemberProfile, error)); ok {
+		return rf(ctx, groupID, user, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User, string) *group.MemberProfile); ok {
+		r0 = rf(ctx, groupID, user, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.MemberProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User, string) error); ok {
+		r1 = rf(ctx, groupID, user, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupService_GetMemberProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemberProfile'
+type MockGroupService_GetMemberProfile_Call struct {
+	*mock.Call
+}
+
+// GetMemberProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - user sqlc.User
+//   - address string
+func (_e *MockGroupService_Expecter) GetMemberProfile(ctx interface{}, groupID interface{}, user interface{}, address interface{}) *MockGroupService_GetMemberProfile_Call {
+	return &MockGroupService_GetMemberProfile_Call{Call: _e.mock.On("GetMemberProfile", ctx, groupID, user, address)}
+}
+
+func (_c *MockGroupService_GetMemberProfile_Call) Run(run func(ctx context.Context, groupID uuid.UUID, user sqlc.User, address string)) *MockGroupService_GetMemberProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_GetMemberProfile_Call) Return(_a0 *group.MemberProfile, _a1 error) *MockGroupService_GetMemberProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupService_GetMemberProfile_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User, string) (*group.MemberProfile, error)) *MockGroupService_GetMemberProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveGroup provides a mock function with given fields: ctx, groupID, user
+func (_m *MockGroupService) LeaveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error {
+	ret := _m.Called(ctx, groupID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaveGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r0 = rf(ctx, groupID, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGroupService_LeaveGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveGroup'
+type MockGroupService_LeaveGroup_Call struct {
+	*mock.Call
+}
+
+// LeaveGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - user sqlc.User
+func (_e *MockGroupService_Expecter) LeaveGroup(ctx interface{}, groupID interface{}, user interface{}) *MockGroupService_LeaveGroup_Call {
+	return &MockGroupService_LeaveGroup_Call{Call: _e.mock.On("LeaveGroup", ctx, groupID, user)}
+}
+
+func (_c *MockGroupService_LeaveGroup_Call) Run(run func(ctx context.Context, groupID uuid.UUID, user sqlc.User)) *MockGroupService_LeaveGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_LeaveGroup_Call) Return(_a0 error) *MockGroupService_LeaveGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGroupService_LeaveGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) error) *MockGroupService_LeaveGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGroups provides a mock function with given fields: ctx, params
+func (_m *MockGroupService) ListGroups(ctx context.Context, params group.ListGroupsParams) (*group.ListGroupsResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGroups")
+	}
+
+	var r0 *group.ListGroupsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.ListGroupsParams) (*group.ListGroupsResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.ListGroupsParams) *group.ListGroupsResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.ListGroupsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.ListGroupsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupService_ListGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGroups'
+type MockGroupService_ListGroups_Call struct {
+	*mock.Call
+}
+
+// ListGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params group.ListGroupsParams
+func (_e *MockGroupService_Expecter) ListGroups(ctx interface{}, params interface{}) *MockGroupService_ListGroups_Call {
+	return &MockGroupService_ListGroups_Call{Call: _e.mock.On("ListGroups", ctx, params)}
+}
+
+func (_c *MockGroupService_ListGroups_Call) Run(run func(ctx context.Context, params group.ListGroupsParams)) *MockGroupService_ListGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.ListGroupsParams))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_ListGroups_Call) Return(_a0 *group.ListGroupsResult, _a1 error) *MockGroupService_ListGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupService_ListGroups_Call) RunAndReturn(run func(context.Context, group.ListGroupsParams) (*group.ListGroupsResult, error)) *MockGroupService_ListGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, params
+func (_m *MockGroupService) RemoveMember(ctx context.Context, params group.RemoveMemberParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.RemoveMemberParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGroupService_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockGroupService_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params group.RemoveMemberParams
+func (_e *MockGroupService_Expecter) RemoveMember(ctx interface{}, params interface{}) *MockGroupService_RemoveMember_Call {
+	return &MockGroupService_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, params)}
+}
+
+func (_c *MockGroupService_RemoveMember_Call) Run(run func(ctx context.Context, params group.RemoveMemberParams)) *MockGroupService_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.RemoveMemberParams))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_RemoveMember_Call) Return(_a0 error) *MockGroupService_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGroupService_RemoveMember_Call) RunAndReturn(run func(context.Context, group.RemoveMemberParams) error) *MockGroupService_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMembers provides a mock function with given fields: ctx, params
+func (_m *MockGroupService) SearchMembers(ctx context.Context, params group.SearchMembersParams) (*group.SearchMembersResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMembers")
+	}
+
+	var r0 *group.SearchMembersResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, group.SearchMembersParams) (*group.SearchMembersResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, group.SearchMembersParams) *group.SearchMembersResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.SearchMembersResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, group.SearchMembersParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupService_SearchMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMembers'
+type MockGroupService_SearchMembers_Call struct {
+	*mock.Call
+}
+
+// SearchMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params group.SearchMembersParams
+func (_e *MockGroupService_Expecter) SearchMembers(ctx interface{}, params interface{}) *MockGroupService_SearchMembers_Call {
+	return &MockGroupService_SearchMembers_Call{Call: _e.mock.On("SearchMembers", ctx, params)}
+}
+
+func (_c *MockGroupService_SearchMembers_Call) Run(run func(ctx context.Context, params group.SearchMembersParams)) *MockGroupService_SearchMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(group.SearchMembersParams))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_SearchMembers_Call) Return(_a0 *group.SearchMembersResult, _a1 error) *MockGroupService_SearchMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupService_SearchMembers_Call) RunAndReturn(run func(context.Context, group.SearchMembersParams) (*group.SearchMembersResult, error)) *MockGroupService_SearchMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnarchiveGroup provides a mock function with given fields: ctx, groupID, user
+func (_m *MockGroupService) UnarchiveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error {
+	ret := _m.Called(ctx, groupID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r0 = rf(ctx, groupID, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGroupService_UnarchiveGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnarchiveGroup'
+type MockGroupService_UnarchiveGroup_Call struct {
+	*mock.Call
+}
+
+// UnarchiveGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - user sqlc.User
+func (_e *MockGroupService_Expecter) UnarchiveGroup(ctx interface{}, groupID interface{}, user interface{}) *MockGroupService_UnarchiveGroup_Call {
+	return &MockGroupService_UnarchiveGroup_Call{Call: _e.mock.On("UnarchiveGroup", ctx, groupID, user)}
+}
+
+func (_c *MockGroupService_UnarchiveGroup_Call) Run(run func(ctx context.Context, groupID uuid.UUID, user sqlc.User)) *MockGroupService_UnarchiveGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockGroupService_UnarchiveGroup_Call) Return(_a0 error) *MockGroupService_UnarchiveGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGroupService_UnarchiveGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) error) *MockGroupService_UnarchiveGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGroupService creates a new instance of MockGroupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGroupService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGroupService {
+	mock := &MockGroupService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
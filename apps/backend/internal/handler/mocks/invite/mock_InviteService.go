@@ -0,0 +1,223 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package invite
+
+import (
+	invite "circa/internal/service/invite"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockInviteService is an autogenerated mock type for the InviteService type
+type MockInviteService struct {
+	mock.Mock
+}
+
+type MockInviteService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInviteService) EXPECT() *MockInviteService_Expecter {
+	return &MockInviteService_Expecter{mock: &_m.Mock}
+}
+
+// CreateInvite provides a mock function with given fields: ctx, params
+func (_m *MockInviteService) CreateInvite(ctx context.Context, params invite.CreateInviteParams) (*invite.CreateInviteResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvite")
+	}
+
+	var r0 *invite.CreateInviteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, invite.CreateInviteParams) (*invite.CreateInviteResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, invite.CreateInviteParams) *invite.CreateInviteResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invite.CreateInviteResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, invite.CreateInviteParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInviteService_CreateInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvite'
+type MockInviteService_CreateInvite_Call struct {
+	*mock.Call
+}
+
+// CreateInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params invite.CreateInviteParams
+func (_e *MockInviteService_Expecter) CreateInvite(ctx interface{}, params interface{}) *MockInviteService_CreateInvite_Call {
+	return &MockInviteService_CreateInvite_Call{Call: _e.mock.On("CreateInvite", ctx, params)}
+}
+
+func (_c *MockInviteService_CreateInvite_Call) Run(run func(ctx context.Context, params invite.CreateInviteParams)) *MockInviteService_CreateInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(invite.CreateInviteParams))
+	})
+	return _c
+}
+
+func (_c *MockInviteService_CreateInvite_Call) Return(_a0 *invite.CreateInviteResult, _a1 error) *MockInviteService_CreateInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInviteService_CreateInvite_Call) RunAndReturn(run func(context.Context, invite.CreateInviteParams) (*invite.CreateInviteResult, error)) *MockInviteService_CreateInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQRCode provides a mock function with given fields: ctx, groupID, inviteID, user, format
+func (_m *MockInviteService) GetQRCode(ctx context.Context, groupID uuid.UUID, inviteID uuid.UUID, user sqlc.User, format invite.QRFormat) (*invite.QRCode, error) {
+	ret := _m.Called(ctx, groupID, inviteID, user, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQRCode")
+	}
+
+	var r0 *invite.QRCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User, invite.QRFormat) (*invite.QRCode, error)); ok {
+		return rf(ctx, groupID, inviteID, user, format)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User, invite.QRFormat) *invite.QRCode); ok {
+		r0 = rf(ctx, groupID, inviteID, user, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*invite.QRCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User, invite.QRFormat) error); ok {
+		r1 = rf(ctx, groupID, inviteID, user, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInviteService_GetQRCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQRCode'
+type MockInviteService_GetQRCode_Call struct {
+	*mock.Call
+}
+
+// GetQRCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - inviteID uuid.UUID
+//   - user sqlc.User
+//   - format invite.QRFormat
+func (_e *MockInviteService_Expecter) GetQRCode(ctx interface{}, groupID interface{}, inviteID interface{}, user interface{}, format interface{}) *MockInviteService_GetQRCode_Call {
+	return &MockInviteService_GetQRCode_Call{Call: _e.mock.On("GetQRCode", ctx, groupID, inviteID, user, format)}
+}
+
+func (_c *MockInviteService_GetQRCode_Call) Run(run func(ctx context.Context, groupID uuid.UUID, inviteID uuid.UUID, user sqlc.User, format invite.QRFormat)) *MockInviteService_GetQRCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(sqlc.User), args[4].(invite.QRFormat))
+	})
+	return _c
+}
+
+func (_c *MockInviteService_GetQRCode_Call) Return(_a0 *invite.QRCode, _a1 error) *MockInviteService_GetQRCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInviteService_GetQRCode_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, sqlc.User, invite.QRFormat) (*invite.QRCode, error)) *MockInviteService_GetQRCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderCard provides a mock function with given fields: ctx, groupID, inviteID, user
+func (_m *MockInviteService) RenderCard(ctx context.Context, groupID uuid.UUID, inviteID uuid.UUID, user sqlc.User) ([]byte, error) {
+	ret := _m.Called(ctx, groupID, inviteID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderCard")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) ([]byte, error)); ok {
+		return rf(ctx, groupID, inviteID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) []byte); ok {
+		r0 = rf(ctx, groupID, inviteID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, groupID, inviteID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInviteService_RenderCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderCard'
+type MockInviteService_RenderCard_Call struct {
+	*mock.Call
+}
+
+// RenderCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - inviteID uuid.UUID
+//   - user sqlc.User
+func (_e *MockInviteService_Expecter) RenderCard(ctx interface{}, groupID interface{}, inviteID interface{}, user interface{}) *MockInviteService_RenderCard_Call {
+	return &MockInviteService_RenderCard_Call{Call: _e.mock.On("RenderCard", ctx, groupID, inviteID, user)}
+}
+
+func (_c *MockInviteService_RenderCard_Call) Run(run func(ctx context.Context, groupID uuid.UUID, inviteID uuid.UUID, user sqlc.User)) *MockInviteService_RenderCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockInviteService_RenderCard_Call) Return(_a0 []byte, _a1 error) *MockInviteService_RenderCard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInviteService_RenderCard_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) ([]byte, error)) *MockInviteService_RenderCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInviteService creates a new instance of MockInviteService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInviteService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInviteService {
+	mock := &MockInviteService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.5. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package auth
 
@@ -7,6 +7,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
 )
 
 // MockAuthService is an autogenerated mock type for the AuthService type
@@ -265,6 +267,65 @@ func (_c *MockAuthService_GenerateNonce_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetSessionUser provides a mock function with given fields: ctx, sessionID
+func (_m *MockAuthService) GetSessionUser(ctx context.Context, sessionID string) (*auth.GetSessionUserResult, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionUser")
+	}
+
+	var r0 *auth.GetSessionUserResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*auth.GetSessionUserResult, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.GetSessionUserResult); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.GetSessionUserResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthService_GetSessionUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionUser'
+type MockAuthService_GetSessionUser_Call struct {
+	*mock.Call
+}
+
+// GetSessionUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *MockAuthService_Expecter) GetSessionUser(ctx interface{}, sessionID interface{}) *MockAuthService_GetSessionUser_Call {
+	return &MockAuthService_GetSessionUser_Call{Call: _e.mock.On("GetSessionUser", ctx, sessionID)}
+}
+
+func (_c *MockAuthService_GetSessionUser_Call) Run(run func(ctx context.Context, sessionID string)) *MockAuthService_GetSessionUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_GetSessionUser_Call) Return(_a0 *auth.GetSessionUserResult, _a1 error) *MockAuthService_GetSessionUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_GetSessionUser_Call) RunAndReturn(run func(context.Context, string) (*auth.GetSessionUserResult, error)) *MockAuthService_GetSessionUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSignupSession provides a mock function with given fields: ctx, sessionID
 func (_m *MockAuthService) GetSignupSession(ctx context.Context, sessionID string) (map[string]interface{}, error) {
 	ret := _m.Called(ctx, sessionID)
@@ -324,6 +385,66 @@ func (_c *MockAuthService_GetSignupSession_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// UpdateProfile provides a mock function with given fields: ctx, user, params
+func (_m *MockAuthService) UpdateProfile(ctx context.Context, user sqlc.User, params auth.UpdateProfileParams) (*sqlc.User, error) {
+	ret := _m.Called(ctx, user, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User, auth.UpdateProfileParams) (*sqlc.User, error)); ok {
+		return rf(ctx, user, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User, auth.UpdateProfileParams) *sqlc.User); ok {
+		r0 = rf(ctx, user, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.User, auth.UpdateProfileParams) error); ok {
+		r1 = rf(ctx, user, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthService_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockAuthService_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user sqlc.User
+//   - params auth.UpdateProfileParams
+func (_e *MockAuthService_Expecter) UpdateProfile(ctx interface{}, user interface{}, params interface{}) *MockAuthService_UpdateProfile_Call {
+	return &MockAuthService_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, user, params)}
+}
+
+func (_c *MockAuthService_UpdateProfile_Call) Run(run func(ctx context.Context, user sqlc.User, params auth.UpdateProfileParams)) *MockAuthService_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.User), args[2].(auth.UpdateProfileParams))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_UpdateProfile_Call) Return(_a0 *sqlc.User, _a1 error) *MockAuthService_UpdateProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_UpdateProfile_Call) RunAndReturn(run func(context.Context, sqlc.User, auth.UpdateProfileParams) (*sqlc.User, error)) *MockAuthService_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyToken provides a mock function with given fields: ctx, token
 func (_m *MockAuthService) VerifyToken(ctx context.Context, token string) (*auth.VerifyTokenResult, error) {
 	ret := _m.Called(ctx, token)
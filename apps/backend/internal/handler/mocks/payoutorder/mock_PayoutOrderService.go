@@ -0,0 +1,519 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package payoutorder
+
+import (
+	payoutorder "circa/internal/service/payoutorder"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockPayoutOrderService is an autogenerated mock type for the PayoutOrderService type
+type MockPayoutOrderService struct {
+	mock.Mock
+}
+
+type MockPayoutOrderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPayoutOrderService) EXPECT() *MockPayoutOrderService_Expecter {
+	return &MockPayoutOrderService_Expecter{mock: &_m.Mock}
+}
+
+// ApproveOrder provides a mock function with given fields: ctx, params
+func (_m *MockPayoutOrderService) ApproveOrder(ctx context.Context, params payoutorder.ApproveParams) (*payoutorder.Details, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveOrder")
+	}
+
+	var r0 *payoutorder.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.ApproveParams) (*payoutorder.Details, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.ApproveParams) *payoutorder.Details); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, payoutorder.ApproveParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_ApproveOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveOrder'
+type MockPayoutOrderService_ApproveOrder_Call struct {
+	*mock.Call
+}
+
+// ApproveOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params payoutorder.ApproveParams
+func (_e *MockPayoutOrderService_Expecter) ApproveOrder(ctx interface{}, params interface{}) *MockPayoutOrderService_ApproveOrder_Call {
+	return &MockPayoutOrderService_ApproveOrder_Call{Call: _e.mock.On("ApproveOrder", ctx, params)}
+}
+
+func (_c *MockPayoutOrderService_ApproveOrder_Call) Run(run func(ctx context.Context, params payoutorder.ApproveParams)) *MockPayoutOrderService_ApproveOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(payoutorder.ApproveParams))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_ApproveOrder_Call) Return(_a0 *payoutorder.Details, _a1 error) *MockPayoutOrderService_ApproveOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_ApproveOrder_Call) RunAndReturn(run func(context.Context, payoutorder.ApproveParams) (*payoutorder.Details, error)) *MockPayoutOrderService_ApproveOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitBid provides a mock function with given fields: ctx, params
+func (_m *MockPayoutOrderService) CommitBid(ctx context.Context, params payoutorder.CommitBidParams) (*payoutorder.Details, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitBid")
+	}
+
+	var r0 *payoutorder.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.CommitBidParams) (*payoutorder.Details, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.CommitBidParams) *payoutorder.Details); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, payoutorder.CommitBidParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_CommitBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitBid'
+type MockPayoutOrderService_CommitBid_Call struct {
+	*mock.Call
+}
+
+// CommitBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params payoutorder.CommitBidParams
+func (_e *MockPayoutOrderService_Expecter) CommitBid(ctx interface{}, params interface{}) *MockPayoutOrderService_CommitBid_Call {
+	return &MockPayoutOrderService_CommitBid_Call{Call: _e.mock.On("CommitBid", ctx, params)}
+}
+
+func (_c *MockPayoutOrderService_CommitBid_Call) Run(run func(ctx context.Context, params payoutorder.CommitBidParams)) *MockPayoutOrderService_CommitBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(payoutorder.CommitBidParams))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_CommitBid_Call) Return(_a0 *payoutorder.Details, _a1 error) *MockPayoutOrderService_CommitBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_CommitBid_Call) RunAndReturn(run func(context.Context, payoutorder.CommitBidParams) (*payoutorder.Details, error)) *MockPayoutOrderService_CommitBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePayoutOrder provides a mock function with given fields: ctx, params
+func (_m *MockPayoutOrderService) CreatePayoutOrder(ctx context.Context, params payoutorder.CreateParams) (*payoutorder.Details, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePayoutOrder")
+	}
+
+	var r0 *payoutorder.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.CreateParams) (*payoutorder.Details, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.CreateParams) *payoutorder.Details); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, payoutorder.CreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_CreatePayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePayoutOrder'
+type MockPayoutOrderService_CreatePayoutOrder_Call struct {
+	*mock.Call
+}
+
+// CreatePayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params payoutorder.CreateParams
+func (_e *MockPayoutOrderService_Expecter) CreatePayoutOrder(ctx interface{}, params interface{}) *MockPayoutOrderService_CreatePayoutOrder_Call {
+	return &MockPayoutOrderService_CreatePayoutOrder_Call{Call: _e.mock.On("CreatePayoutOrder", ctx, params)}
+}
+
+func (_c *MockPayoutOrderService_CreatePayoutOrder_Call) Run(run func(ctx context.Context, params payoutorder.CreateParams)) *MockPayoutOrderService_CreatePayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(payoutorder.CreateParams))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_CreatePayoutOrder_Call) Return(_a0 *payoutorder.Details, _a1 error) *MockPayoutOrderService_CreatePayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_CreatePayoutOrder_Call) RunAndReturn(run func(context.Context, payoutorder.CreateParams) (*payoutorder.Details, error)) *MockPayoutOrderService_CreatePayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportPayoutOrder provides a mock function with given fields: ctx, groupID, orderID, user
+func (_m *MockPayoutOrderService) ExportPayoutOrder(ctx context.Context, groupID uuid.UUID, orderID uuid.UUID, user sqlc.User) (*payoutorder.Export, error) {
+	ret := _m.Called(ctx, groupID, orderID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPayoutOrder")
+	}
+
+	var r0 *payoutorder.Export
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*payoutorder.Export, error)); ok {
+		return rf(ctx, groupID, orderID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) *payoutorder.Export); ok {
+		r0 = rf(ctx, groupID, orderID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Export)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, groupID, orderID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_ExportPayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPayoutOrder'
+type MockPayoutOrderService_ExportPayoutOrder_Call struct {
+	*mock.Call
+}
+
+// ExportPayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - orderID uuid.UUID
+//   - user sqlc.User
+func (_e *MockPayoutOrderService_Expecter) ExportPayoutOrder(ctx interface{}, groupID interface{}, orderID interface{}, user interface{}) *MockPayoutOrderService_ExportPayoutOrder_Call {
+	return &MockPayoutOrderService_ExportPayoutOrder_Call{Call: _e.mock.On("ExportPayoutOrder", ctx, groupID, orderID, user)}
+}
+
+func (_c *MockPayoutOrderService_ExportPayoutOrder_Call) Run(run func(ctx context.Context, groupID uuid.UUID, orderID uuid.UUID, user sqlc.User)) *MockPayoutOrderService_ExportPayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_ExportPayoutOrder_Call) Return(_a0 *payoutorder.Export, _a1 error) *MockPayoutOrderService_ExportPayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_ExportPayoutOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*payoutorder.Export, error)) *MockPayoutOrderService_ExportPayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinalizePayoutOrder provides a mock function with given fields: ctx, groupID, orderID, user
+func (_m *MockPayoutOrderService) FinalizePayoutOrder(ctx context.Context, groupID uuid.UUID, orderID uuid.UUID, user sqlc.User) (*payoutorder.Details, error) {
+	ret := _m.Called(ctx, groupID, orderID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizePayoutOrder")
+	}
+
+	var r0 *payoutorder.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*payoutorder.Details, error)); ok {
+		return rf(ctx, groupID, orderID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) *payoutorder.Details); ok {
+		r0 = rf(ctx, groupID, orderID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, groupID, orderID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_FinalizePayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalizePayoutOrder'
+type MockPayoutOrderService_FinalizePayoutOrder_Call struct {
+	*mock.Call
+}
+
+// FinalizePayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - orderID uuid.UUID
+//   - user sqlc.User
+func (_e *MockPayoutOrderService_Expecter) FinalizePayoutOrder(ctx interface{}, groupID interface{}, orderID interface{}, user interface{}) *MockPayoutOrderService_FinalizePayoutOrder_Call {
+	return &MockPayoutOrderService_FinalizePayoutOrder_Call{Call: _e.mock.On("FinalizePayoutOrder", ctx, groupID, orderID, user)}
+}
+
+func (_c *MockPayoutOrderService_FinalizePayoutOrder_Call) Run(run func(ctx context.Context, groupID uuid.UUID, orderID uuid.UUID, user sqlc.User)) *MockPayoutOrderService_FinalizePayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_FinalizePayoutOrder_Call) Return(_a0 *payoutorder.Details, _a1 error) *MockPayoutOrderService_FinalizePayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_FinalizePayoutOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*payoutorder.Details, error)) *MockPayoutOrderService_FinalizePayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPayoutOrder provides a mock function with given fields: ctx, groupID, orderID, user
+func (_m *MockPayoutOrderService) GetPayoutOrder(ctx context.Context, groupID uuid.UUID, orderID uuid.UUID, user sqlc.User) (*payoutorder.Details, error) {
+	ret := _m.Called(ctx, groupID, orderID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayoutOrder")
+	}
+
+	var r0 *payoutorder.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*payoutorder.Details, error)); ok {
+		return rf(ctx, groupID, orderID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) *payoutorder.Details); ok {
+		r0 = rf(ctx, groupID, orderID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, groupID, orderID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_GetPayoutOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayoutOrder'
+type MockPayoutOrderService_GetPayoutOrder_Call struct {
+	*mock.Call
+}
+
+// GetPayoutOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - orderID uuid.UUID
+//   - user sqlc.User
+func (_e *MockPayoutOrderService_Expecter) GetPayoutOrder(ctx interface{}, groupID interface{}, orderID interface{}, user interface{}) *MockPayoutOrderService_GetPayoutOrder_Call {
+	return &MockPayoutOrderService_GetPayoutOrder_Call{Call: _e.mock.On("GetPayoutOrder", ctx, groupID, orderID, user)}
+}
+
+func (_c *MockPayoutOrderService_GetPayoutOrder_Call) Run(run func(ctx context.Context, groupID uuid.UUID, orderID uuid.UUID, user sqlc.User)) *MockPayoutOrderService_GetPayoutOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_GetPayoutOrder_Call) Return(_a0 *payoutorder.Details, _a1 error) *MockPayoutOrderService_GetPayoutOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_GetPayoutOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*payoutorder.Details, error)) *MockPayoutOrderService_GetPayoutOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProposeOrder provides a mock function with given fields: ctx, params
+func (_m *MockPayoutOrderService) ProposeOrder(ctx context.Context, params payoutorder.ProposeParams) (*payoutorder.Details, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProposeOrder")
+	}
+
+	var r0 *payoutorder.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.ProposeParams) (*payoutorder.Details, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.ProposeParams) *payoutorder.Details); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, payoutorder.ProposeParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_ProposeOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProposeOrder'
+type MockPayoutOrderService_ProposeOrder_Call struct {
+	*mock.Call
+}
+
+// ProposeOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params payoutorder.ProposeParams
+func (_e *MockPayoutOrderService_Expecter) ProposeOrder(ctx interface{}, params interface{}) *MockPayoutOrderService_ProposeOrder_Call {
+	return &MockPayoutOrderService_ProposeOrder_Call{Call: _e.mock.On("ProposeOrder", ctx, params)}
+}
+
+func (_c *MockPayoutOrderService_ProposeOrder_Call) Run(run func(ctx context.Context, params payoutorder.ProposeParams)) *MockPayoutOrderService_ProposeOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(payoutorder.ProposeParams))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_ProposeOrder_Call) Return(_a0 *payoutorder.Details, _a1 error) *MockPayoutOrderService_ProposeOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_ProposeOrder_Call) RunAndReturn(run func(context.Context, payoutorder.ProposeParams) (*payoutorder.Details, error)) *MockPayoutOrderService_ProposeOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevealBid provides a mock function with given fields: ctx, params
+func (_m *MockPayoutOrderService) RevealBid(ctx context.Context, params payoutorder.RevealBidParams) (*payoutorder.Details, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevealBid")
+	}
+
+	var r0 *payoutorder.Details
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.RevealBidParams) (*payoutorder.Details, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, payoutorder.RevealBidParams) *payoutorder.Details); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*payoutorder.Details)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, payoutorder.RevealBidParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPayoutOrderService_RevealBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevealBid'
+type MockPayoutOrderService_RevealBid_Call struct {
+	*mock.Call
+}
+
+// RevealBid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params payoutorder.RevealBidParams
+func (_e *MockPayoutOrderService_Expecter) RevealBid(ctx interface{}, params interface{}) *MockPayoutOrderService_RevealBid_Call {
+	return &MockPayoutOrderService_RevealBid_Call{Call: _e.mock.On("RevealBid", ctx, params)}
+}
+
+func (_c *MockPayoutOrderService_RevealBid_Call) Run(run func(ctx context.Context, params payoutorder.RevealBidParams)) *MockPayoutOrderService_RevealBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(payoutorder.RevealBidParams))
+	})
+	return _c
+}
+
+func (_c *MockPayoutOrderService_RevealBid_Call) Return(_a0 *payoutorder.Details, _a1 error) *MockPayoutOrderService_RevealBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPayoutOrderService_RevealBid_Call) RunAndReturn(run func(context.Context, payoutorder.RevealBidParams) (*payoutorder.Details, error)) *MockPayoutOrderService_RevealBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPayoutOrderService creates a new instance of MockPayoutOrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPayoutOrderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPayoutOrderService {
+	mock := &MockPayoutOrderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package reminder
+
+import (
+	reminder "circa/internal/service/reminder"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
+)
+
+// MockReminderService is an autogenerated mock type for the ReminderService type
+type MockReminderService struct {
+	mock.Mock
+}
+
+type MockReminderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReminderService) EXPECT() *MockReminderService_Expecter {
+	return &MockReminderService_Expecter{mock: &_m.Mock}
+}
+
+// GetPreferences provides a mock function with given fields: ctx, user
+func (_m *MockReminderService) GetPreferences(ctx context.Context, user sqlc.User) (*reminder.Preferences, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferences")
+	}
+
+	var r0 *reminder.Preferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User) (*reminder.Preferences, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User) *reminder.Preferences); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reminder.Preferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.User) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderService_GetPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreferences'
+type MockReminderService_GetPreferences_Call struct {
+	*mock.Call
+}
+
+// GetPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user sqlc.User
+func (_e *MockReminderService_Expecter) GetPreferences(ctx interface{}, user interface{}) *MockReminderService_GetPreferences_Call {
+	return &MockReminderService_GetPreferences_Call{Call: _e.mock.On("GetPreferences", ctx, user)}
+}
+
+func (_c *MockReminderService_GetPreferences_Call) Run(run func(ctx context.Context, user sqlc.User)) *MockReminderService_GetPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockReminderService_GetPreferences_Call) Return(_a0 *reminder.Preferences, _a1 error) *MockReminderService_GetPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderService_GetPreferences_Call) RunAndReturn(run func(context.Context, sqlc.User) (*reminder.Preferences, error)) *MockReminderService_GetPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function with given fields: ctx, user, prefs
+func (_m *MockReminderService) UpdatePreferences(ctx context.Context, user sqlc.User, prefs reminder.Preferences) (*reminder.Preferences, error) {
+	ret := _m.Called(ctx, user, prefs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 *reminder.Preferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User, reminder.Preferences) (*reminder.Preferences, error)); ok {
+		return rf(ctx, user, prefs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.User, reminder.Preferences) *reminder.Preferences); ok {
+		r0 = rf(ctx, user, prefs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reminder.Preferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.User, reminder.Preferences) error); ok {
+		r1 = rf(ctx, user, prefs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderService_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockReminderService_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user sqlc.User
+//   - prefs reminder.Preferences
+func (_e *MockReminderService_Expecter) UpdatePreferences(ctx interface{}, user interface{}, prefs interface{}) *MockReminderService_UpdatePreferences_Call {
+	return &MockReminderService_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", ctx, user, prefs)}
+}
+
+func (_c *MockReminderService_UpdatePreferences_Call) Run(run func(ctx context.Context, user sqlc.User, prefs reminder.Preferences)) *MockReminderService_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlc.User), args[2].(reminder.Preferences))
+	})
+	return _c
+}
+
+func (_c *MockReminderService_UpdatePreferences_Call) Return(_a0 *reminder.Preferences, _a1 error) *MockReminderService_UpdatePreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderService_UpdatePreferences_Call) RunAndReturn(run func(context.Context, sqlc.User, reminder.Preferences) (*reminder.Preferences, error)) *MockReminderService_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReminderService creates a new instance of MockReminderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReminderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderService {
+	mock := &MockReminderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
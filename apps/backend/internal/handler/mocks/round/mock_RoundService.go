@@ -0,0 +1,516 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package round
+
+import (
+	round "circa/internal/service/round"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRoundService is an autogenerated mock type for the RoundService type
+type MockRoundService struct {
+	mock.Mock
+}
+
+type MockRoundService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRoundService) EXPECT() *MockRoundService_Expecter {
+	return &MockRoundService_Expecter{mock: &_m.Mock}
+}
+
+// CreateRound provides a mock function with given fields: ctx, params
+func (_m *MockRoundService) CreateRound(ctx context.Context, params round.CreateRoundParams) (*sqlc.Round, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRound")
+	}
+
+	var r0 *sqlc.Round
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, round.CreateRoundParams) (*sqlc.Round, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, round.CreateRoundParams) *sqlc.Round); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Round)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, round.CreateRoundParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_CreateRound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRound'
+type MockRoundService_CreateRound_Call struct {
+	*mock.Call
+}
+
+// CreateRound is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params round.CreateRoundParams
+func (_e *MockRoundService_Expecter) CreateRound(ctx interface{}, params interface{}) *MockRoundService_CreateRound_Call {
+	return &MockRoundService_CreateRound_Call{Call: _e.mock.On("CreateRound", ctx, params)}
+}
+
+func (_c *MockRoundService_CreateRound_Call) Run(run func(ctx context.Context, params round.CreateRoundParams)) *MockRoundService_CreateRound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(round.CreateRoundParams))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_CreateRound_Call) Return(_a0 *sqlc.Round, _a1 error) *MockRoundService_CreateRound_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_CreateRound_Call) RunAndReturn(run func(context.Context, round.CreateRoundParams) (*sqlc.Round, error)) *MockRoundService_CreateRound_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportActivity provides a mock function with given fields: ctx, params
+func (_m *MockRoundService) ExportActivity(ctx context.Context, params round.ExportActivityParams) ([]byte, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportActivity")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, round.ExportActivityParams) ([]byte, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, round.ExportActivityParams) []byte); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, round.ExportActivityParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_ExportActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportActivity'
+type MockRoundService_ExportActivity_Call struct {
+	*mock.Call
+}
+
+// ExportActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params round.ExportActivityParams
+func (_e *MockRoundService_Expecter) ExportActivity(ctx interface{}, params interface{}) *MockRoundService_ExportActivity_Call {
+	return &MockRoundService_ExportActivity_Call{Call: _e.mock.On("ExportActivity", ctx, params)}
+}
+
+func (_c *MockRoundService_ExportActivity_Call) Run(run func(ctx context.Context, params round.ExportActivityParams)) *MockRoundService_ExportActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(round.ExportActivityParams))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_ExportActivity_Call) Return(_a0 []byte, _a1 error) *MockRoundService_ExportActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_ExportActivity_Call) RunAndReturn(run func(context.Context, round.ExportActivityParams) ([]byte, error)) *MockRoundService_ExportActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRound provides a mock function with given fields: ctx, roundID, user
+func (_m *MockRoundService) GetRound(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*round.RoundDetail, error) {
+	ret := _m.Called(ctx, roundID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRound")
+	}
+
+	var r0 *round.RoundDetail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) (*round.RoundDetail, error)); ok {
+		return rf(ctx, roundID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) *round.RoundDetail); ok {
+		r0 = rf(ctx, roundID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*round.RoundDetail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, roundID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_GetRound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRound'
+type MockRoundService_GetRound_Call struct {
+	*mock.Call
+}
+
+// GetRound is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - user sqlc.User
+func (_e *MockRoundService_Expecter) GetRound(ctx interface{}, roundID interface{}, user interface{}) *MockRoundService_GetRound_Call {
+	return &MockRoundService_GetRound_Call{Call: _e.mock.On("GetRound", ctx, roundID, user)}
+}
+
+func (_c *MockRoundService_GetRound_Call) Run(run func(ctx context.Context, roundID uuid.UUID, user sqlc.User)) *MockRoundService_GetRound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_GetRound_Call) Return(_a0 *round.RoundDetail, _a1 error) *MockRoundService_GetRound_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_GetRound_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) (*round.RoundDetail, error)) *MockRoundService_GetRound_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoundBalances provides a mock function with given fields: ctx, roundID, user
+func (_m *MockRoundService) GetRoundBalances(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*round.Balances, error) {
+	ret := _m.Called(ctx, roundID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoundBalances")
+	}
+
+	var r0 *round.Balances
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) (*round.Balances, error)); ok {
+		return rf(ctx, roundID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) *round.Balances); ok {
+		r0 = rf(ctx, roundID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*round.Balances)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, roundID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_GetRoundBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoundBalances'
+type MockRoundService_GetRoundBalances_Call struct {
+	*mock.Call
+}
+
+// GetRoundBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - user sqlc.User
+func (_e *MockRoundService_Expecter) GetRoundBalances(ctx interface{}, roundID interface{}, user interface{}) *MockRoundService_GetRoundBalances_Call {
+	return &MockRoundService_GetRoundBalances_Call{Call: _e.mock.On("GetRoundBalances", ctx, roundID, user)}
+}
+
+func (_c *MockRoundService_GetRoundBalances_Call) Run(run func(ctx context.Context, roundID uuid.UUID, user sqlc.User)) *MockRoundService_GetRoundBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_GetRoundBalances_Call) Return(_a0 *round.Balances, _a1 error) *MockRoundService_GetRoundBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_GetRoundBalances_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) (*round.Balances, error)) *MockRoundService_GetRoundBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoundPeriods provides a mock function with given fields: ctx, roundID, user
+func (_m *MockRoundService) GetRoundPeriods(ctx context.Context, roundID uuid.UUID, user sqlc.User) ([]round.Period, error) {
+	ret := _m.Called(ctx, roundID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoundPeriods")
+	}
+
+	var r0 []round.Period
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) ([]round.Period, error)); ok {
+		return rf(ctx, roundID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) []round.Period); ok {
+		r0 = rf(ctx, roundID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]round.Period)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, roundID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_GetRoundPeriods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoundPeriods'
+type MockRoundService_GetRoundPeriods_Call struct {
+	*mock.Call
+}
+
+// GetRoundPeriods is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - user sqlc.User
+func (_e *MockRoundService_Expecter) GetRoundPeriods(ctx interface{}, roundID interface{}, user interface{}) *MockRoundService_GetRoundPeriods_Call {
+	return &MockRoundService_GetRoundPeriods_Call{Call: _e.mock.On("GetRoundPeriods", ctx, roundID, user)}
+}
+
+func (_c *MockRoundService_GetRoundPeriods_Call) Run(run func(ctx context.Context, roundID uuid.UUID, user sqlc.User)) *MockRoundService_GetRoundPeriods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_GetRoundPeriods_Call) Return(_a0 []round.Period, _a1 error) *MockRoundService_GetRoundPeriods_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_GetRoundPeriods_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) ([]round.Period, error)) *MockRoundService_GetRoundPeriods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActivity provides a mock function with given fields: ctx, params
+func (_m *MockRoundService) ListActivity(ctx context.Context, params round.ListActivityParams) (*round.ListActivityResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActivity")
+	}
+
+	var r0 *round.ListActivityResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, round.ListActivityParams) (*round.ListActivityResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, round.ListActivityParams) *round.ListActivityResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*round.ListActivityResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, round.ListActivityParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_ListActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActivity'
+type MockRoundService_ListActivity_Call struct {
+	*mock.Call
+}
+
+// ListActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params round.ListActivityParams
+func (_e *MockRoundService_Expecter) ListActivity(ctx interface{}, params interface{}) *MockRoundService_ListActivity_Call {
+	return &MockRoundService_ListActivity_Call{Call: _e.mock.On("ListActivity", ctx, params)}
+}
+
+func (_c *MockRoundService_ListActivity_Call) Run(run func(ctx context.Context, params round.ListActivityParams)) *MockRoundService_ListActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(round.ListActivityParams))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_ListActivity_Call) Return(_a0 *round.ListActivityResult, _a1 error) *MockRoundService_ListActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_ListActivity_Call) RunAndReturn(run func(context.Context, round.ListActivityParams) (*round.ListActivityResult, error)) *MockRoundService_ListActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRounds provides a mock function with given fields: ctx, params
+func (_m *MockRoundService) ListRounds(ctx context.Context, params round.ListRoundsParams) (*round.ListRoundsResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRounds")
+	}
+
+	var r0 *round.ListRoundsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, round.ListRoundsParams) (*round.ListRoundsResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, round.ListRoundsParams) *round.ListRoundsResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*round.ListRoundsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, round.ListRoundsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_ListRounds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRounds'
+type MockRoundService_ListRounds_Call struct {
+	*mock.Call
+}
+
+// ListRounds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params round.ListRoundsParams
+func (_e *MockRoundService_Expecter) ListRounds(ctx interface{}, params interface{}) *MockRoundService_ListRounds_Call {
+	return &MockRoundService_ListRounds_Call{Call: _e.mock.On("ListRounds", ctx, params)}
+}
+
+func (_c *MockRoundService_ListRounds_Call) Run(run func(ctx context.Context, params round.ListRoundsParams)) *MockRoundService_ListRounds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(round.ListRoundsParams))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_ListRounds_Call) Return(_a0 *round.ListRoundsResult, _a1 error) *MockRoundService_ListRounds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_ListRounds_Call) RunAndReturn(run func(context.Context, round.ListRoundsParams) (*round.ListRoundsResult, error)) *MockRoundService_ListRounds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionRound provides a mock function with given fields: ctx, params
+func (_m *MockRoundService) TransitionRound(ctx context.Context, params round.TransitionRoundParams) (*sqlc.Round, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionRound")
+	}
+
+	var r0 *sqlc.Round
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, round.TransitionRoundParams) (*sqlc.Round, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, round.TransitionRoundParams) *sqlc.Round); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Round)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, round.TransitionRoundParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRoundService_TransitionRound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionRound'
+type MockRoundService_TransitionRound_Call struct {
+	*mock.Call
+}
+
+// TransitionRound is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params round.TransitionRoundParams
+func (_e *MockRoundService_Expecter) TransitionRound(ctx interface{}, params interface{}) *MockRoundService_TransitionRound_Call {
+	return &MockRoundService_TransitionRound_Call{Call: _e.mock.On("TransitionRound", ctx, params)}
+}
+
+func (_c *MockRoundService_TransitionRound_Call) Run(run func(ctx context.Context, params round.TransitionRoundParams)) *MockRoundService_TransitionRound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(round.TransitionRoundParams))
+	})
+	return _c
+}
+
+func (_c *MockRoundService_TransitionRound_Call) Return(_a0 *sqlc.Round, _a1 error) *MockRoundService_TransitionRound_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRoundService_TransitionRound_Call) RunAndReturn(run func(context.Context, round.TransitionRoundParams) (*sqlc.Round, error)) *MockRoundService_TransitionRound_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRoundService creates a new instance of MockRoundService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRoundService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRoundService {
+	mock := &MockRoundService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
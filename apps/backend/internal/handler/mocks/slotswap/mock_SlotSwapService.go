@@ -0,0 +1,280 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package slotswap
+
+import (
+	slotswap "circa/internal/service/slotswap"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	sqlc "circa/internal/db/sqlc/generated"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSlotSwapService is an autogenerated mock type for the SlotSwapService type
+type MockSlotSwapService struct {
+	mock.Mock
+}
+
+type MockSlotSwapService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSlotSwapService) EXPECT() *MockSlotSwapService_Expecter {
+	return &MockSlotSwapService_Expecter{mock: &_m.Mock}
+}
+
+// AcceptSwap provides a mock function with given fields: ctx, params
+func (_m *MockSlotSwapService) AcceptSwap(ctx context.Context, params slotswap.AcceptParams) (*slotswap.Swap, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptSwap")
+	}
+
+	var r0 *slotswap.Swap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, slotswap.AcceptParams) (*slotswap.Swap, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, slotswap.AcceptParams) *slotswap.Swap); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotswap.Swap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, slotswap.AcceptParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSlotSwapService_AcceptSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptSwap'
+type MockSlotSwapService_AcceptSwap_Call struct {
+	*mock.Call
+}
+
+// AcceptSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params slotswap.AcceptParams
+func (_e *MockSlotSwapService_Expecter) AcceptSwap(ctx interface{}, params interface{}) *MockSlotSwapService_AcceptSwap_Call {
+	return &MockSlotSwapService_AcceptSwap_Call{Call: _e.mock.On("AcceptSwap", ctx, params)}
+}
+
+func (_c *MockSlotSwapService_AcceptSwap_Call) Run(run func(ctx context.Context, params slotswap.AcceptParams)) *MockSlotSwapService_AcceptSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(slotswap.AcceptParams))
+	})
+	return _c
+}
+
+func (_c *MockSlotSwapService_AcceptSwap_Call) Return(_a0 *slotswap.Swap, _a1 error) *MockSlotSwapService_AcceptSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSlotSwapService_AcceptSwap_Call) RunAndReturn(run func(context.Context, slotswap.AcceptParams) (*slotswap.Swap, error)) *MockSlotSwapService_AcceptSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelSwap provides a mock function with given fields: ctx, roundID, swapID, user
+func (_m *MockSlotSwapService) CancelSwap(ctx context.Context, roundID uuid.UUID, swapID uuid.UUID, user sqlc.User) (*slotswap.Swap, error) {
+	ret := _m.Called(ctx, roundID, swapID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelSwap")
+	}
+
+	var r0 *slotswap.Swap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*slotswap.Swap, error)); ok {
+		return rf(ctx, roundID, swapID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) *slotswap.Swap); ok {
+		r0 = rf(ctx, roundID, swapID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotswap.Swap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, roundID, swapID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSlotSwapService_CancelSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelSwap'
+type MockSlotSwapService_CancelSwap_Call struct {
+	*mock.Call
+}
+
+// CancelSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - swapID uuid.UUID
+//   - user sqlc.User
+func (_e *MockSlotSwapService_Expecter) CancelSwap(ctx interface{}, roundID interface{}, swapID interface{}, user interface{}) *MockSlotSwapService_CancelSwap_Call {
+	return &MockSlotSwapService_CancelSwap_Call{Call: _e.mock.On("CancelSwap", ctx, roundID, swapID, user)}
+}
+
+func (_c *MockSlotSwapService_CancelSwap_Call) Run(run func(ctx context.Context, roundID uuid.UUID, swapID uuid.UUID, user sqlc.User)) *MockSlotSwapService_CancelSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockSlotSwapService_CancelSwap_Call) Return(_a0 *slotswap.Swap, _a1 error) *MockSlotSwapService_CancelSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSlotSwapService_CancelSwap_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, sqlc.User) (*slotswap.Swap, error)) *MockSlotSwapService_CancelSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSwaps provides a mock function with given fields: ctx, roundID, user
+func (_m *MockSlotSwapService) ListSwaps(ctx context.Context, roundID uuid.UUID, user sqlc.User) ([]slotswap.Swap, error) {
+	ret := _m.Called(ctx, roundID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSwaps")
+	}
+
+	var r0 []slotswap.Swap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) ([]slotswap.Swap, error)); ok {
+		return rf(ctx, roundID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) []slotswap.Swap); ok {
+		r0 = rf(ctx, roundID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]slotswap.Swap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, roundID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSlotSwapService_ListSwaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSwaps'
+type MockSlotSwapService_ListSwaps_Call struct {
+	*mock.Call
+}
+
+// ListSwaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - user sqlc.User
+func (_e *MockSlotSwapService_Expecter) ListSwaps(ctx interface{}, roundID interface{}, user interface{}) *MockSlotSwapService_ListSwaps_Call {
+	return &MockSlotSwapService_ListSwaps_Call{Call: _e.mock.On("ListSwaps", ctx, roundID, user)}
+}
+
+func (_c *MockSlotSwapService_ListSwaps_Call) Run(run func(ctx context.Context, roundID uuid.UUID, user sqlc.User)) *MockSlotSwapService_ListSwaps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockSlotSwapService_ListSwaps_Call) Return(_a0 []slotswap.Swap, _a1 error) *MockSlotSwapService_ListSwaps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSlotSwapService_ListSwaps_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) ([]slotswap.Swap, error)) *MockSlotSwapService_ListSwaps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProposeSwap provides a mock function with given fields: ctx, params
+func (_m *MockSlotSwapService) ProposeSwap(ctx context.Context, params slotswap.ProposeParams) (*slotswap.Swap, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProposeSwap")
+	}
+
+	var r0 *slotswap.Swap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, slotswap.ProposeParams) (*slotswap.Swap, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, slotswap.ProposeParams) *slotswap.Swap); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*slotswap.Swap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, slotswap.ProposeParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSlotSwapService_ProposeSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProposeSwap'
+type MockSlotSwapService_ProposeSwap_Call struct {
+	*mock.Call
+}
+
+// ProposeSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params slotswap.ProposeParams
+func (_e *MockSlotSwapService_Expecter) ProposeSwap(ctx interface{}, params interface{}) *MockSlotSwapService_ProposeSwap_Call {
+	return &MockSlotSwapService_ProposeSwap_Call{Call: _e.mock.On("ProposeSwap", ctx, params)}
+}
+
+func (_c *MockSlotSwapService_ProposeSwap_Call) Run(run func(ctx context.Context, params slotswap.ProposeParams)) *MockSlotSwapService_ProposeSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(slotswap.ProposeParams))
+	})
+	return _c
+}
+
+func (_c *MockSlotSwapService_ProposeSwap_Call) Return(_a0 *slotswap.Swap, _a1 error) *MockSlotSwapService_ProposeSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSlotSwapService_ProposeSwap_Call) RunAndReturn(run func(context.Context, slotswap.ProposeParams) (*slotswap.Swap, error)) *MockSlotSwapService_ProposeSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSlotSwapService creates a new instance of MockSlotSwapService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSlotSwapService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSlotSwapService {
+	mock := &MockSlotSwapService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,161 @@
+// Code generated by mockery v2.53.7. DO NOT EDIT.
+
+package txbuilder
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	txbuilder "circa/internal/service/txbuilder"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTxBuilderService is an autogenerated mock type for the TxBuilderService type
+type MockTxBuilderService struct {
+	mock.Mock
+}
+
+type MockTxBuilderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTxBuilderService) EXPECT() *MockTxBuilderService_Expecter {
+	return &MockTxBuilderService_Expecter{mock: &_m.Mock}
+}
+
+// BuildContribution provides a mock function with given fields: ctx, roundID, user
+func (_m *MockTxBuilderService) BuildContribution(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*txbuilder.ContributionPlan, error) {
+	ret := _m.Called(ctx, roundID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildContribution")
+	}
+
+	var r0 *txbuilder.ContributionPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) (*txbuilder.ContributionPlan, error)); ok {
+		return rf(ctx, roundID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) *txbuilder.ContributionPlan); ok {
+		r0 = rf(ctx, roundID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txbuilder.ContributionPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, roundID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTxBuilderService_BuildContribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildContribution'
+type MockTxBuilderService_BuildContribution_Call struct {
+	*mock.Call
+}
+
+// BuildContribution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - user sqlc.User
+func (_e *MockTxBuilderService_Expecter) BuildContribution(ctx interface{}, roundID interface{}, user interface{}) *MockTxBuilderService_BuildContribution_Call {
+	return &MockTxBuilderService_BuildContribution_Call{Call: _e.mock.On("BuildContribution", ctx, roundID, user)}
+}
+
+func (_c *MockTxBuilderService_BuildContribution_Call) Run(run func(ctx context.Context, roundID uuid.UUID, user sqlc.User)) *MockTxBuilderService_BuildContribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockTxBuilderService_BuildContribution_Call) Return(_a0 *txbuilder.ContributionPlan, _a1 error) *MockTxBuilderService_BuildContribution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTxBuilderService_BuildContribution_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) (*txbuilder.ContributionPlan, error)) *MockTxBuilderService_BuildContribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BuildPayoutClaim provides a mock function with given fields: ctx, roundID, user
+func (_m *MockTxBuilderService) BuildPayoutClaim(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*txbuilder.Transaction, error) {
+	ret := _m.Called(ctx, roundID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildPayoutClaim")
+	}
+
+	var r0 *txbuilder.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) (*txbuilder.Transaction, error)); ok {
+		return rf(ctx, roundID, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, sqlc.User) *txbuilder.Transaction); ok {
+		r0 = rf(ctx, roundID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txbuilder.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, sqlc.User) error); ok {
+		r1 = rf(ctx, roundID, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTxBuilderService_BuildPayoutClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildPayoutClaim'
+type MockTxBuilderService_BuildPayoutClaim_Call struct {
+	*mock.Call
+}
+
+// BuildPayoutClaim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+//   - user sqlc.User
+func (_e *MockTxBuilderService_Expecter) BuildPayoutClaim(ctx interface{}, roundID interface{}, user interface{}) *MockTxBuilderService_BuildPayoutClaim_Call {
+	return &MockTxBuilderService_BuildPayoutClaim_Call{Call: _e.mock.On("BuildPayoutClaim", ctx, roundID, user)}
+}
+
+func (_c *MockTxBuilderService_BuildPayoutClaim_Call) Run(run func(ctx context.Context, roundID uuid.UUID, user sqlc.User)) *MockTxBuilderService_BuildPayoutClaim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(sqlc.User))
+	})
+	return _c
+}
+
+func (_c *MockTxBuilderService_BuildPayoutClaim_Call) Return(_a0 *txbuilder.Transaction, _a1 error) *MockTxBuilderService_BuildPayoutClaim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTxBuilderService_BuildPayoutClaim_Call) RunAndReturn(run func(context.Context, uuid.UUID, sqlc.User) (*txbuilder.Transaction, error)) *MockTxBuilderService_BuildPayoutClaim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTxBuilderService creates a new instance of MockTxBuilderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTxBuilderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTxBuilderService {
+	mock := &MockTxBuilderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"circa/api"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/reminder"
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// GetNotificationPreferences handles GET /me/notification-preferences
+func (h *Handler) GetNotificationPreferences(ctx echo.Context) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	prefs, err := h.reminderService.GetPreferences(ctx.Request().Context(), *user)
+	if err != nil {
+		return h.notificationError(ctx, err, "Failed to get notification preferences")
+	}
+
+	return ctx.JSON(200, toAPINotificationPreferences(*prefs))
+}
+
+// UpdateNotificationPreferences handles PUT /me/notification-preferences
+func (h *Handler) UpdateNotificationPreferences(ctx echo.Context) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.UpdateNotificationPreferencesJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	prefs := reminder.Preferences{
+		Channels:  make([]string, 0, len(req.ReminderChannels)),
+		LeadTimes: make([]time.Duration, 0, len(req.ReminderLeadTimesSeconds)),
+	}
+	for _, channel := range req.ReminderChannels {
+		prefs.Channels = append(prefs.Channels, string(channel))
+	}
+	for _, seconds := range req.ReminderLeadTimesSeconds {
+		prefs.LeadTimes = append(prefs.LeadTimes, time.Duration(seconds)*time.Second)
+	}
+
+	updated, err := h.reminderService.UpdatePreferences(ctx.Request().Context(), *user, prefs)
+	if err != nil {
+		return h.notificationError(ctx, err, "Failed to update notification preferences")
+	}
+
+	return ctx.JSON(200, toAPINotificationPreferences(*updated))
+}
+
+func toAPINotificationPreferences(prefs reminder.Preferences) api.NotificationPreferences {
+	response := api.NotificationPreferences{
+		ReminderChannels:         make([]api.NotificationPreferencesReminderChannels, 0, len(prefs.Channels)),
+		ReminderLeadTimesSeconds: make([]int64, 0, len(prefs.LeadTimes)),
+	}
+	for _, channel := range prefs.Channels {
+		response.ReminderChannels = append(response.ReminderChannels, api.NotificationPreferencesReminderChannels(channel))
+	}
+	for _, lead := range prefs.LeadTimes {
+		response.ReminderLeadTimesSeconds = append(response.ReminderLeadTimesSeconds, int64(lead/time.Second))
+	}
+	return response
+}
+
+// notificationError maps reminder service errors onto API responses.
+func (h *Handler) notificationError(ctx echo.Context, err error, logMsg string) error {
+	switch {
+	case errors.Is(err, circaerrors.ErrInvalidReminderChannel),
+		errors.Is(err, circaerrors.ErrInvalidReminderLeadTime):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"circa/api"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	remindermocks "circa/internal/handler/mocks/reminder"
+	"circa/internal/service/auth"
+	"circa/internal/service/reminder"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetNotificationPreferences(t *testing.T) {
+	user := createTestSessionUser()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/me/notification-preferences", nil)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockAuth := authmocks.NewMockAuthService(t)
+	mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+		Return(&auth.GetSessionUserResult{User: user}, nil)
+	mockReminder := remindermocks.NewMockReminderService(t)
+	prefs := reminder.DefaultPreferences()
+	mockReminder.On("GetPreferences", mock.Anything, user).Return(&prefs, nil)
+
+	handler := &Handler{
+		authService:     mockAuth,
+		reminderService: mockReminder,
+	}
+
+	require.NoError(t, handler.GetNotificationPreferences(c))
+	assert.Equal(t, 200, rec.Code)
+
+	var response api.NotificationPreferences
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []api.NotificationPreferencesReminderChannels{"email"}, response.ReminderChannels)
+	assert.Equal(t, []int64{259200, 86400, 7200}, response.ReminderLeadTimesSeconds)
+}
+
+func TestHandler_UpdateNotificationPreferences(t *testing.T) {
+	user := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*remindermocks.MockReminderService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - preferences replaced",
+			body: `{"reminderChannels": ["email"], "reminderLeadTimesSeconds": [3600, 86400]}`,
+			setupMocks: func(m *remindermocks.MockReminderService) {
+				m.On("UpdatePreferences", mock.Anything, user, reminder.Preferences{
+					Channels:  []string{"email"},
+					LeadTimes: []time.Duration{time.Hour, 24 * time.Hour},
+				}).Return(&reminder.Preferences{
+					Channels:  []string{"email"},
+					LeadTimes: []time.Duration{24 * time.Hour, time.Hour},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.NotificationPreferences
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, []int64{86400, 3600}, response.ReminderLeadTimesSeconds)
+			},
+		},
+		{
+			name: "error - invalid lead time",
+			body: `{"reminderChannels": ["email"], "reminderLeadTimesSeconds": [60]}`,
+			setupMocks: func(m *remindermocks.MockReminderService) {
+				m.On("UpdatePreferences", mock.Anything, user, mock.Anything).Return(nil, circaerrors.ErrInvalidReminderLeadTime)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:           "error - invalid body",
+			body:           `{"reminderChannels": "email"}`,
+			setupMocks:     func(m *remindermocks.MockReminderService) {},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPut, "/me/notification-preferences", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockReminder := remindermocks.NewMockReminderService(t)
+			tt.setupMocks(mockReminder)
+
+			handler := &Handler{
+				authService:     mockAuth,
+				reminderService: mockReminder,
+			}
+
+			err := handler.UpdateNotificationPreferences(c)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockReminder.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package handler
+
+import (
+	"circa/api"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/payoutorder"
+	"errors"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// CreatePayoutOrder handles POST /groups/{groupId}/payout-orders
+func (h *Handler) CreatePayoutOrder(ctx echo.Context, groupId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.CreatePayoutOrderRequest
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	params := payoutorder.CreateParams{
+		GroupID:  groupId,
+		Actor:    *user,
+		Strategy: string(req.Strategy),
+	}
+	if req.Participants != nil {
+		params.Participants = fromAPIAddresses(*req.Participants)
+	}
+	if req.Order != nil {
+		params.Order = fromAPIAddresses(*req.Order)
+	}
+	if req.ChainId != nil {
+		chainID := int64(*req.ChainId)
+		params.ChainID = &chainID
+	}
+	if req.BiddingEndsAt != nil {
+		biddingEndsAt := time.Time(*req.BiddingEndsAt)
+		params.BiddingEndsAt = &biddingEndsAt
+	}
+	if req.RevealEndsAt != nil {
+		revealEndsAt := time.Time(*req.RevealEndsAt)
+		params.RevealEndsAt = &revealEndsAt
+	}
+
+	details, err := h.payoutOrderService.CreatePayoutOrder(ctx.Request().Context(), params)
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to create payout order")
+	}
+
+	return ctx.JSON(201, toAPIPayoutOrder(details))
+}
+
+// GetPayoutOrder handles GET /groups/{groupId}/payout-orders/{orderId}
+func (h *Handler) GetPayoutOrder(ctx echo.Context, groupId api.UUID, orderId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	details, err := h.payoutOrderService.GetPayoutOrder(ctx.Request().Context(), groupId, orderId, *user)
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to get payout order")
+	}
+
+	return ctx.JSON(200, toAPIPayoutOrder(details))
+}
+
+// ProposePayoutOrder handles PUT /groups/{groupId}/payout-orders/{orderId}/order
+func (h *Handler) ProposePayoutOrder(ctx echo.Context, groupId api.UUID, orderId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.ProposePayoutOrderRequest
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	details, err := h.payoutOrderService.ProposeOrder(ctx.Request().Context(), payoutorder.ProposeParams{
+		GroupID: groupId,
+		OrderID: orderId,
+		Actor:   *user,
+		Order:   fromAPIAddresses(req.Order),
+	})
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to propose payout order")
+	}
+
+	return ctx.JSON(200, toAPIPayoutOrder(details))
+}
+
+// ApprovePayoutOrder handles POST /groups/{groupId}/payout-orders/{orderId}/approvals
+func (h *Handler) ApprovePayoutOrder(ctx echo.Context, groupId api.UUID, orderId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.ApprovePayoutOrderRequest
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	details, err := h.payoutOrderService.ApproveOrder(ctx.Request().Context(), payoutorder.ApproveParams{
+		GroupID:  groupId,
+		OrderID:  orderId,
+		Actor:    *user,
+		Revision: int32(req.Revision),
+	})
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to approve payout order")
+	}
+
+	return ctx.JSON(200, toAPIPayoutOrder(details))
+}
+
+// CommitPayoutOrderBid handles POST /groups/{groupId}/payout-orders/{orderId}/bids
+func (h *Handler) CommitPayoutOrderBid(ctx echo.Context, groupId api.UUID, orderId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.CommitPayoutOrderBidRequest
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	details, err := h.payoutOrderService.CommitBid(ctx.Request().Context(), payoutorder.CommitBidParams{
+		GroupID:    groupId,
+		OrderID:    orderId,
+		Actor:      *user,
+		Commitment: req.Commitment,
+	})
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to commit payout order bid")
+	}
+
+	return ctx.JSON(200, toAPIPayoutOrder(details))
+}
+
+// RevealPayoutOrderBid handles POST /groups/{groupId}/payout-orders/{orderId}/bids/reveal
+func (h *Handler) RevealPayoutOrderBid(ctx echo.Context, groupId api.UUID, orderId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.RevealPayoutOrderBidRequest
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	details, err := h.payoutOrderService.RevealBid(ctx.Request().Context(), payoutorder.RevealBidParams{
+		GroupID:  groupId,
+		OrderID:  orderId,
+		Actor:    *user,
+		Discount: req.Discount,
+		Salt:     req.Salt,
+	})
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to reveal payout order bid")
+	}
+
+	return ctx.JSON(200, toAPIPayoutOrder(details))
+}
+
+// FinalizePayoutOrder handles POST /groups/{groupId}/payout-orders/{orderId}/finalize
+func (h *Handler) FinalizePayoutOrder(ctx echo.Context, groupId api.UUID, orderId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	details, err := h.payoutOrderService.FinalizePayoutOrder(ctx.Request().Context(), groupId, orderId, *user)
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to finalize payout order")
+	}
+
+	return ctx.JSON(200, toAPIPayoutOrder(details))
+}
+
+// ExportPayoutOrder handles GET /groups/{groupId}/payout-orders/{orderId}/export
+func (h *Handler) ExportPayoutOrder(ctx echo.Context, groupId api.UUID, orderId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	export, err := h.payoutOrderService.ExportPayoutOrder(ctx.Request().Context(), groupId, orderId, *user)
+	if err != nil {
+		return h.payoutOrderError(ctx, err, "Failed to export payout order")
+	}
+
+	return ctx.JSON(200, api.PayoutOrderExport{
+		OrderId:         export.OrderID,
+		Strategy:        api.PayoutStrategy(export.Strategy),
+		Members:         toAPIAddresses(export.Members),
+		Discounts:       export.Discounts,
+		SeedBlockNumber: export.SeedBlockNumber,
+		SeedBlockHash:   export.SeedBlockHash,
+	})
+}
+
+// toAPIPayoutOrder converts a payout order. Revealed discounts stay hidden
+// until the reveal deadline so late revealers cannot react to them.
+func toAPIPayoutOrder(d *payoutorder.Details) api.PayoutOrder {
+	o := d.Order
+	response := api.PayoutOrder{
+		Id:            o.ID,
+		GroupId:       o.GroupID,
+		Strategy:      api.PayoutStrategy(o.Strategy),
+		Status:        api.PayoutOrderStatus(o.Status),
+		Participants:  toAPIAddresses(o.Participants),
+		ProposedOrder: toAPIAddresses(o.ProposedOrder),
+		Revision:      int(o.Revision),
+		SeedBlockHash: o.SeedBlockHash,
+		Approvals:     make([]api.PayoutOrderApproval, 0, len(d.Approvals)),
+		Bids:          make([]api.PayoutOrderBid, 0, len(d.Bids)),
+		CreatedAt:     api.Timestamp(o.CreatedAt.Time),
+	}
+	if o.ChainID.Valid {
+		chainID := api.ChainId(o.ChainID.Int64)
+		response.ChainId = &chainID
+	}
+	if o.SeedBlockNumber.Valid {
+		response.SeedBlockNumber = &o.SeedBlockNumber.Int64
+	}
+	if o.BiddingEndsAt.Valid {
+		biddingEndsAt := api.Timestamp(o.BiddingEndsAt.Time)
+		response.BiddingEndsAt = &biddingEndsAt
+	}
+	if o.RevealEndsAt.Valid {
+		revealEndsAt := api.Timestamp(o.RevealEndsAt.Time)
+		response.RevealEndsAt = &revealEndsAt
+	}
+	if o.Status == payoutorder.StatusFinalized {
+		finalOrder := toAPIAddresses(o.FinalOrder)
+		response.FinalOrder = &finalOrder
+		finalDiscounts := o.FinalDiscounts
+		response.FinalDiscounts = &finalDiscounts
+	}
+	if o.FinalizedAt.Valid {
+		finalizedAt := api.Timestamp(o.FinalizedAt.Time)
+		response.FinalizedAt = &finalizedAt
+	}
+
+	for _, a := range d.Approvals {
+		response.Approvals = append(response.Approvals, api.PayoutOrderApproval{
+			Address:  api.Address(a.Address),
+			Revision: int(a.Revision),
+		})
+	}
+
+	revealClosed := o.Status == payoutorder.StatusFinalized || (o.RevealEndsAt.Valid && !time.Now().Before(o.RevealEndsAt.Time))
+	for _, b := range d.Bids {
+		bid := api.PayoutOrderBid{
+			Address:  api.Address(b.Address),
+			Revealed: b.Discount != nil,
+		}
+		if revealClosed {
+			bid.Discount = b.Discount
+		}
+		response.Bids = append(response.Bids, bid)
+	}
+
+	return response
+}
+
+func fromAPIAddresses(addresses []api.Address) []string {
+	out := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		out = append(out, string(a))
+	}
+	return out
+}
+
+// payoutOrderError maps payout order service errors onto API responses.
+func (h *Handler) payoutOrderError(ctx echo.Context, err error, logMsg string) error {
+	switch {
+	case errors.Is(err, circaerrors.ErrPayoutOrderNotFound):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: "Payout order not found",
+		})
+	case errors.Is(err, circaerrors.ErrNotPayoutParticipant):
+		return ctx.JSON(403, api.ErrorForbidden{
+			Code:    403,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrPayoutOrderFinalized),
+		errors.Is(err, circaerrors.ErrPayoutOrderNotReady),
+		errors.Is(err, circaerrors.ErrPayoutActionNotAllowed),
+		errors.Is(err, circaerrors.ErrStaleRevision):
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:    409,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrInvalidPayoutStrategy),
+		errors.Is(err, circaerrors.ErrInvalidPayoutOrder),
+		errors.Is(err, circaerrors.ErrInvalidParticipants),
+		errors.Is(err, circaerrors.ErrInvalidBiddingWindow),
+		errors.Is(err, circaerrors.ErrInvalidBid):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
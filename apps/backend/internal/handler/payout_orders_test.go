@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	payoutordermocks "circa/internal/handler/mocks/payoutorder"
+	"circa/internal/service/auth"
+	"circa/internal/service/payoutorder"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	payoutAddressA = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	payoutAddressB = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func createTestPayoutOrder(groupID uuid.UUID, strategy string) sqlc.PayoutOrder {
+	return sqlc.PayoutOrder{
+		ID:            uuid.New(),
+		GroupID:       groupID,
+		Strategy:      strategy,
+		Status:        payoutorder.StatusOpen,
+		Participants:  []string{payoutAddressA, payoutAddressB},
+		ProposedOrder: []string{payoutAddressA, payoutAddressB},
+		Revision:      1,
+		CreatedAt:     pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func newPayoutOrderHandler(t *testing.T, user sqlc.User) (*Handler, *payoutordermocks.MockPayoutOrderService) {
+	mockAuth := authmocks.NewMockAuthService(t)
+	mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+		Return(&auth.GetSessionUserResult{User: user}, nil)
+	mockPayoutOrder := payoutordermocks.NewMockPayoutOrderService(t)
+
+	return &Handler{
+		authService:        mockAuth,
+		payoutOrderService: mockPayoutOrder,
+	}, mockPayoutOrder
+}
+
+func TestHandler_CreatePayoutOrder(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*payoutordermocks.MockPayoutOrderService)
+		expectedStatus int
+	}{
+		{
+			name: "success - fixed order",
+			body: `{"strategy": "fixed", "order": ["` + payoutAddressB + `", "` + payoutAddressA + `"]}`,
+			setupMocks: func(m *payoutordermocks.MockPayoutOrderService) {
+				m.On("CreatePayoutOrder", mock.Anything, payoutorder.CreateParams{
+					GroupID:  groupID,
+					Actor:    user,
+					Strategy: payoutorder.StrategyFixed,
+					Order:    []string{payoutAddressB, payoutAddressA},
+				}).Return(&payoutorder.Details{Order: createTestPayoutOrder(groupID, payoutorder.StrategyFixed)}, nil)
+			},
+			expectedStatus: 201,
+		},
+		{
+			name: "random on unconfigured chain",
+			body: `{"strategy": "random", "chainId": 1}`,
+			setupMocks: func(m *payoutordermocks.MockPayoutOrderService) {
+				m.On("CreatePayoutOrder", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrUnsupportedChain)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name: "not owner",
+			body: `{"strategy": "consensus"}`,
+			setupMocks: func(m *payoutordermocks.MockPayoutOrderService) {
+				m.On("CreatePayoutOrder", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrNotGroupOwner)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/groups/"+groupID.String()+"/payout-orders", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler, mockPayoutOrder := newPayoutOrderHandler(t, user)
+			tt.setupMocks(mockPayoutOrder)
+
+			require.NoError(t, handler.CreatePayoutOrder(c, groupID))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestHandler_GetPayoutOrder_HidesDiscountsDuringReveal(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	discount := "25"
+
+	order := createTestPayoutOrder(groupID, payoutorder.StrategyAuction)
+	order.BiddingEndsAt = pgtype.Timestamp{Time: time.Now().Add(-time.Hour), Valid: true}
+	order.RevealEndsAt = pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true}
+	bids := []sqlc.PayoutOrderBid{
+		{Address: payoutAddressA, Commitment: "0x01", Discount: &discount},
+		{Address: payoutAddressB, Commitment: "0x02"},
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/payout-orders/"+order.ID.String(), nil)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler, mockPayoutOrder := newPayoutOrderHandler(t, user)
+	mockPayoutOrder.On("GetPayoutOrder", mock.Anything, groupID, order.ID, user).
+		Return(&payoutorder.Details{Order: order, Bids: bids}, nil)
+
+	require.NoError(t, handler.GetPayoutOrder(c, groupID, order.ID))
+	assert.Equal(t, 200, rec.Code)
+
+	var response api.PayoutOrder
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Bids, 2)
+	assert.True(t, response.Bids[0].Revealed)
+	assert.Nil(t, response.Bids[0].Discount)
+	assert.False(t, response.Bids[1].Revealed)
+	assert.Nil(t, response.FinalOrder)
+}
+
+func TestHandler_ApprovePayoutOrder_StaleRevision(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	orderID := uuid.New()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/groups/"+groupID.String()+"/payout-orders/"+orderID.String()+"/approvals", strings.NewReader(`{"revision": 1}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler, mockPayoutOrder := newPayoutOrderHandler(t, user)
+	mockPayoutOrder.On("ApproveOrder", mock.Anything, payoutorder.ApproveParams{
+		GroupID:  groupID,
+		OrderID:  orderID,
+		Actor:    user,
+		Revision: 1,
+	}).Return(nil, circaerrors.ErrStaleRevision)
+
+	require.NoError(t, handler.ApprovePayoutOrder(c, groupID, orderID))
+	assert.Equal(t, 409, rec.Code)
+}
+
+func TestHandler_ExportPayoutOrder(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	orderID := uuid.New()
+	seedBlock := int64(120)
+	seedHash := "0x" + strings.Repeat("ab", 32)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/groups/"+groupID.String()+"/payout-orders/"+orderID.String()+"/export", nil)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler, mockPayoutOrder := newPayoutOrderHandler(t, user)
+	mockPayoutOrder.On("ExportPayoutOrder", mock.Anything, groupID, orderID, user).Return(&payoutorder.Export{
+		OrderID:         orderID,
+		Strategy:        payoutorder.StrategyRandom,
+		Members:         []string{"0xBbBbBbBbBbBbBbBbBbBbBbBbBbBbBbBbBbBbBbBb", "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"},
+		Discounts:       []string{"0", "0"},
+		SeedBlockNumber: &seedBlock,
+		SeedBlockHash:   &seedHash,
+	}, nil)
+
+	require.NoError(t, handler.ExportPayoutOrder(c, groupID, orderID))
+	assert.Equal(t, 200, rec.Code)
+
+	var response api.PayoutOrderExport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, api.PayoutStrategy("random"), response.Strategy)
+	assert.Len(t, response.Members, 2)
+	assert.Equal(t, &seedBlock, response.SeedBlockNumber)
+}
@@ -0,0 +1,399 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/prices"
+	"circa/internal/service/round"
+	"circa/internal/tokens"
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// CreateRound handles POST /groups/{groupId}/rounds
+func (h *Handler) CreateRound(ctx echo.Context, groupId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.CreateRoundJSONRequestBody
+	if err := ctx.Bind(&req); err != nil || (req.DeploymentBlock != nil && *req.DeploymentBlock < 0) {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	var deploymentBlock *uint64
+	if req.DeploymentBlock != nil {
+		block := uint64(*req.DeploymentBlock)
+		deploymentBlock = &block
+	}
+
+	created, err := h.roundService.CreateRound(ctx.Request().Context(), round.CreateRoundParams{
+		GroupID:               groupId,
+		Actor:                 *user,
+		ChainID:               int64(req.ChainId),
+		ContractAddress:       req.ContractAddress,
+		ContributionAmount:    req.ContributionAmount,
+		CurrencySymbol:        req.CurrencySymbol,
+		PeriodDurationSeconds: int64(req.PeriodDurationSeconds),
+		DeploymentBlock:       deploymentBlock,
+	})
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to create round")
+	}
+
+	return ctx.JSON(201, toAPIRound(*created, h.tokens))
+}
+
+// TransitionRound handles POST /rounds/{roundId}/transitions
+func (h *Handler) TransitionRound(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.TransitionRoundJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+	if req.Reason != nil && len(*req.Reason) > 500 {
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "reason must be at most 500 characters",
+		})
+	}
+
+	updated, err := h.roundService.TransitionRound(ctx.Request().Context(), round.TransitionRoundParams{
+		RoundID: roundId,
+		Actor:   *user,
+		Action:  string(req.Action),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to transition round")
+	}
+
+	return ctx.JSON(200, toAPIRound(*updated, h.tokens))
+}
+
+// GetRoundPeriods handles GET /rounds/{roundId}/periods
+func (h *Handler) GetRoundPeriods(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	periods, err := h.roundService.GetRoundPeriods(ctx.Request().Context(), roundId, *user)
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to get round periods")
+	}
+
+	response := make([]api.RoundPeriodStatus, 0, len(periods))
+	for _, p := range periods {
+		response = append(response, toAPIRoundPeriod(p))
+	}
+
+	return ctx.JSON(200, response)
+}
+
+// GetRoundBalances handles GET /rounds/{roundId}/balances
+func (h *Handler) GetRoundBalances(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	balances, err := h.roundService.GetRoundBalances(ctx.Request().Context(), roundId, *user)
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to get round balances")
+	}
+
+	members := make([]api.MemberBalance, 0, len(balances.Members))
+	for _, m := range balances.Members {
+		members = append(members, api.MemberBalance{
+			Address:     api.Address(m.Address),
+			Contributed: m.Contributed.String(),
+			Received:    m.Received.String(),
+			Fees:        m.Fees.String(),
+			Arrears:     m.Arrears.String(),
+			Net:         m.Net().String(),
+		})
+	}
+
+	return ctx.JSON(200, api.RoundBalances{
+		Pot:              balances.Pot.String(),
+		TotalContributed: balances.Totals.Contributed.String(),
+		TotalReceived:    balances.Totals.Received.String(),
+		TotalFees:        balances.Totals.Fees.String(),
+		Members:          members,
+	})
+}
+
+// ListRounds handles GET /rounds
+func (h *Handler) ListRounds(ctx echo.Context, params api.ListRoundsParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	result, err := h.roundService.ListRounds(ctx.Request().Context(), round.ListRoundsParams{
+		User:    *user,
+		Status:  (*string)(params.Status),
+		GroupID: params.GroupId,
+		Limit:   params.Limit,
+		Cursor:  params.Cursor,
+	})
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to list rounds")
+	}
+
+	items := make([]api.RoundSummary, 0, len(result.Items))
+	for _, summary := range result.Items {
+		items = append(items, toAPIRoundSummary(summary, h.tokens))
+	}
+
+	return ctx.JSON(200, api.RoundPage{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	})
+}
+
+// GetRound handles GET /rounds/{roundId}
+func (h *Handler) GetRound(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	detail, err := h.roundService.GetRound(ctx.Request().Context(), roundId, *user)
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to get round")
+	}
+
+	r := detail.Round
+	return ctx.JSON(200, api.RoundDetail{
+		Id:                          r.ID,
+		GroupId:                     r.GroupID,
+		GroupName:                   detail.GroupName,
+		ChainId:                     api.ChainId(r.ChainID),
+		ContractAddress:             api.Address(r.ContractAddress),
+		Token:                       (*api.Address)(r.TokenAddress),
+		ContributionAmount:          r.ContributionAmount,
+		FormattedContributionAmount: h.tokens.FormatAmount(r.ChainID, r.TokenAddress, r.ContributionAmount, r.CurrencySymbol),
+		CurrencySymbol:              r.CurrencySymbol,
+		PeriodDurationSeconds:       int(r.PeriodDurationSeconds),
+		Status:                      api.RoundDetailStatus(r.Status),
+		CreatedAt:                   api.Timestamp(r.CreatedAt.Time),
+		MemberCount:                 int(r.MemberCount),
+		CurrentPeriod:               detail.CurrentPeriod,
+		PaidCount:                   detail.PaidCount,
+		NextPayoutAddress:           (*api.Address)(detail.NextPayoutAddress),
+		TotalContributed:            detail.TotalContributed,
+		ContributionAmountFiat:      toAPIFiatAmount(detail.ContributionAmountFiat),
+		TotalContributedFiat:        toAPIFiatAmount(detail.TotalContributedFiat),
+	})
+}
+
+// GetRoundActivity handles GET /rounds/{roundId}/activity
+func (h *Handler) GetRoundActivity(ctx echo.Context, roundId api.UUID, params api.GetRoundActivityParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	result, err := h.roundService.ListActivity(ctx.Request().Context(), round.ListActivityParams{
+		RoundID: roundId,
+		User:    *user,
+		Type:    (*string)(params.Type),
+		Limit:   params.Limit,
+		Cursor:  params.Cursor,
+	})
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to get round activity")
+	}
+
+	items := make([]api.ActivityItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, toAPIActivityItem(item))
+	}
+
+	return ctx.JSON(200, api.ActivityPage{
+		Items:      items,
+		NextCursor: result.NextCursor,
+	})
+}
+
+// ExportRoundActivity handles GET /rounds/{roundId}/activity/export
+func (h *Handler) ExportRoundActivity(ctx echo.Context, roundId api.UUID, params api.ExportRoundActivityParams) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	data, err := h.roundService.ExportActivity(ctx.Request().Context(), round.ExportActivityParams{
+		RoundID: roundId,
+		User:    *user,
+		Type:    (*string)(params.Type),
+	})
+	if err != nil {
+		return h.roundError(ctx, err, "Failed to export round activity")
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="round-%s-activity.csv"`, roundId))
+	return ctx.Blob(200, "text/csv; charset=utf-8", data)
+}
+
+func toAPIFiatAmount(a *prices.Amount) *api.FiatAmount {
+	if a == nil {
+		return nil
+	}
+	return &api.FiatAmount{Currency: api.Currency(a.Currency), Value: a.Value}
+}
+
+func toAPIRound(r sqlc.Round, registry *tokens.Registry) api.Round {
+	return api.Round{
+		Id:                          r.ID,
+		GroupId:                     r.GroupID,
+		ChainId:                     api.ChainId(r.ChainID),
+		ContractAddress:             api.Address(r.ContractAddress),
+		Token:                       (*api.Address)(r.TokenAddress),
+		ContributionAmount:          r.ContributionAmount,
+		FormattedContributionAmount: registry.FormatAmount(r.ChainID, r.TokenAddress, r.ContributionAmount, r.CurrencySymbol),
+		CurrencySymbol:              r.CurrencySymbol,
+		PeriodDurationSeconds:       int(r.PeriodDurationSeconds),
+		Status:                      api.RoundStatus(r.Status),
+		CreatedAt:                   api.Timestamp(r.CreatedAt.Time),
+	}
+}
+
+func toAPIRoundSummary(summary round.RoundSummary, registry *tokens.Registry) api.RoundSummary {
+	r := summary.Round
+	groupName := summary.GroupName
+	memberCount := int(r.MemberCount)
+	return api.RoundSummary{
+		Id:                          r.ID,
+		GroupId:                     r.GroupID,
+		GroupName:                   &groupName,
+		ChainId:                     api.ChainId(r.ChainID),
+		ContractAddress:             api.Address(r.ContractAddress),
+		Token:                       (*api.Address)(r.TokenAddress),
+		ContributionAmount:          r.ContributionAmount,
+		FormattedContributionAmount: registry.FormatAmount(r.ChainID, r.TokenAddress, r.ContributionAmount, r.CurrencySymbol),
+		CurrencySymbol:              r.CurrencySymbol,
+		PeriodDurationSeconds:       int(r.PeriodDurationSeconds),
+		Status:                      api.RoundSummaryStatus(r.Status),
+		CreatedAt:                   api.Timestamp(r.CreatedAt.Time),
+		MemberCount:                 &memberCount,
+		CurrentPeriod:               summary.CurrentPeriod,
+		PaidCount:                   summary.PaidCount,
+	}
+}
+
+func toAPIRoundPeriod(p round.Period) api.RoundPeriodStatus {
+	paid := toAPIAddresses(p.PaidAddresses)
+	late := toAPIAddresses(p.LateAddresses)
+	missed := toAPIAddresses(p.MissedAddresses)
+
+	period := api.RoundPeriodStatus{
+		Period:                p.Number,
+		Status:                api.RoundPeriodStatusStatus(p.Status),
+		PaidAddresses:         &paid,
+		LateAddresses:         &late,
+		MissedAddresses:       &missed,
+		PayoutAddress:         (*api.Address)(p.PayoutAddress),
+		PayoutTransactionHash: p.PayoutTransactionHash,
+	}
+	if p.Start != nil {
+		start := api.Timestamp(*p.Start)
+		period.StartTime = &start
+	}
+	if p.End != nil {
+		end := api.Timestamp(*p.End)
+		period.EndTime = &end
+	}
+	return period
+}
+
+func toAPIAddresses(addresses []string) []api.Address {
+	items := make([]api.Address, 0, len(addresses))
+	for _, address := range addresses {
+		items = append(items, api.Address(address))
+	}
+	return items
+}
+
+func toAPIActivityItem(item round.ActivityItem) api.ActivityItem {
+	address := api.Address(item.Address)
+	amount := item.Amount
+	txHash := item.TxHash
+	blockNumber := int(item.BlockNumber)
+	period := int(item.Period)
+	var counterparty *api.Address
+	if item.Counterparty != nil {
+		c := api.Address(*item.Counterparty)
+		counterparty = &c
+	}
+	var formatted *string
+	if item.FormattedAmount != "" {
+		formatted = &item.FormattedAmount
+	}
+	return api.ActivityItem{
+		Id:                  item.ID,
+		Type:                api.ActivityItemType(item.Type),
+		Status:              api.ActivityItemStatus(item.Status),
+		Address:             &address,
+		CounterpartyAddress: counterparty,
+		DisplayName:         item.DisplayName,
+		Amount:              &amount,
+		FormattedAmount:     formatted,
+		TransactionHash:     &txHash,
+		BlockNumber:         &blockNumber,
+		Period:              &period,
+		Timestamp:           api.Timestamp(item.Timestamp),
+	}
+}
+
+// roundError maps round service errors onto API responses.
+func (h *Handler) roundError(ctx echo.Context, err error, logMsg string) error {
+	var verificationErr *round.VerificationError
+	if errors.As(err, &verificationErr) {
+		fields := make([]api.FieldError, 0, len(verificationErr.Fields))
+		for _, f := range verificationErr.Fields {
+			fields = append(fields, api.FieldError{Field: f.Field, Message: f.Message})
+		}
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: circaerrors.ErrRoundVerificationFailed.Error(),
+			Fields:  &fields,
+		})
+	}
+
+	switch {
+	case errors.Is(err, circaerrors.ErrRoundAlreadyExists),
+		errors.Is(err, circaerrors.ErrInvalidRoundTransition):
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:    409,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrInvalidActivityType),
+		errors.Is(err, circaerrors.ErrInvalidRoundStatus),
+		errors.Is(err, circaerrors.ErrInvalidRoundAction):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
@@ -0,0 +1,844 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	roundmocks "circa/internal/handler/mocks/round"
+	"circa/internal/ledger"
+	"circa/internal/service/auth"
+	"circa/internal/service/round"
+	"circa/internal/tokens"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CreateRound(t *testing.T) {
+	groupID := uuid.New()
+	owner := createTestSessionUser()
+	contractAddress := "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+	validBody := `{"chainId": 31337, "contractAddress": "` + contractAddress + `", "contributionAmount": "1000000", "periodDurationSeconds": 604800}`
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - round registered",
+			body: validBody,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("CreateRound", mock.Anything, round.CreateRoundParams{
+					GroupID:               groupID,
+					Actor:                 owner,
+					ChainID:               31337,
+					ContractAddress:       contractAddress,
+					ContributionAmount:    "1000000",
+					PeriodDurationSeconds: 604800,
+				}).Return(&sqlc.Round{
+					ID:                    uuid.New(),
+					GroupID:               groupID,
+					ChainID:               31337,
+					ContractAddress:       contractAddress,
+					ContributionAmount:    "1000000",
+					PeriodDurationSeconds: 604800,
+					Status:                round.StatusPending,
+					CreatedAt:             pgtype.Timestamp{Time: time.Now(), Valid: true},
+				}, nil)
+			},
+			expectedStatus: 201,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.Round
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, groupID, response.GroupId)
+				assert.Equal(t, contractAddress, string(response.ContractAddress))
+				assert.Equal(t, 604800, response.PeriodDurationSeconds)
+				assert.Equal(t, api.RoundStatus(round.StatusPending), response.Status)
+			},
+		},
+		{
+			name: "error - verification failures are reported per field",
+			body: validBody,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("CreateRound", mock.Anything, mock.Anything).Return(nil, &round.VerificationError{
+					Fields: []round.FieldError{
+						{Field: "contributionAmount", Message: "does not match the contract"},
+						{Field: "periodDurationSeconds", Message: "does not match the contract"},
+					},
+				})
+			},
+			expectedStatus: 400,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.ErrorBadRequest
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				require.NotNil(t, response.Fields)
+				require.Len(t, *response.Fields, 2)
+				assert.Equal(t, "contributionAmount", (*response.Fields)[0].Field)
+				assert.Equal(t, "periodDurationSeconds", (*response.Fields)[1].Field)
+			},
+		},
+		{
+			name: "error - round already registered",
+			body: validBody,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("CreateRound", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrRoundAlreadyExists)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "error - not a member",
+			body: validBody,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("CreateRound", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name:           "error - malformed body",
+			body:           `{"chainId": "mainnet"}`,
+			setupMocks:     func(m *roundmocks.MockRoundService) {},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/groups/"+groupID.String()+"/rounds", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: owner}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+			}
+
+			err := handler.CreateRound(c, groupID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetRoundPeriods(t *testing.T) {
+	roundID := uuid.New()
+	user := createTestSessionUser()
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+	payoutAddress := "0x1111111111111111111111111111111111111111"
+	txHash := "0x" + strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - returns period status",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRoundPeriods", mock.Anything, roundID, user).Return([]round.Period{
+					{
+						Number:                0,
+						Status:                round.StatusCompleted,
+						Start:                 &start,
+						End:                   &end,
+						PaidAddresses:         []string{payoutAddress},
+						PayoutAddress:         &payoutAddress,
+						PayoutTransactionHash: &txHash,
+					},
+					{
+						Number:        1,
+						Status:        round.StatusPending,
+						PaidAddresses: []string{},
+					},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response []api.RoundPeriodStatus
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				require.Len(t, response, 2)
+
+				assert.Equal(t, api.RoundPeriodStatusStatusCompleted, response[0].Status)
+				require.NotNil(t, response[0].PayoutTransactionHash)
+				assert.Equal(t, txHash, *response[0].PayoutTransactionHash)
+				require.NotNil(t, response[0].StartTime)
+				assert.True(t, start.Equal(time.Time(*response[0].StartTime)))
+				require.NotNil(t, response[0].PaidAddresses)
+				assert.Len(t, *response[0].PaidAddresses, 1)
+
+				assert.Equal(t, 1, response[1].Period)
+				assert.Equal(t, api.RoundPeriodStatusStatusPending, response[1].Status)
+				assert.Nil(t, response[1].StartTime)
+				assert.Nil(t, response[1].PayoutAddress)
+			},
+		},
+		{
+			name: "error - round not found",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRoundPeriods", mock.Anything, roundID, user).Return(nil, circaerrors.ErrRoundNotFound)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name: "error - not a member",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRoundPeriods", mock.Anything, roundID, user).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/rounds/"+roundID.String()+"/periods", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+			}
+
+			err := handler.GetRoundPeriods(c, roundID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetRoundBalances(t *testing.T) {
+	roundID := uuid.New()
+	user := createTestSessionUser()
+	member := "0x2222222222222222222222222222222222222222"
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - returns ledger balances",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRoundBalances", mock.Anything, roundID, user).Return(&round.Balances{
+					Pot: big.NewInt(100),
+					Totals: ledger.Position{
+						Contributed: big.NewInt(300),
+						Received:    big.NewInt(200),
+						Fees:        big.NewInt(0),
+					},
+					Members: []round.MemberBalance{{
+						Address: member,
+						Position: ledger.Position{
+							Contributed: big.NewInt(100),
+							Received:    big.NewInt(200),
+							Fees:        big.NewInt(0),
+						},
+						Arrears: big.NewInt(100),
+					}},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.JSONEq(t, `{
+					"pot": "100",
+					"totalContributed": "300",
+					"totalReceived": "200",
+					"totalFees": "0",
+					"members": [{
+						"address": "0x2222222222222222222222222222222222222222",
+						"contributed": "100",
+						"received": "200",
+						"fees": "0",
+						"arrears": "100",
+						"net": "-100"
+					}]
+				}`, rec.Body.String())
+			},
+		},
+		{
+			name: "error - not a member",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRoundBalances", mock.Anything, roundID, user).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/rounds/"+roundID.String()+"/balances", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+			}
+
+			err := handler.GetRoundBalances(c, roundID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetRoundActivity(t *testing.T) {
+	roundID := uuid.New()
+	user := createTestSessionUser()
+	next := "next-cursor"
+	name := "Ada"
+	payment := api.ActivityTypePayment
+
+	tests := []struct {
+		name           string
+		params         api.GetRoundActivityParams
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - returns a page of activity",
+			params: api.GetRoundActivityParams{Type: &payment, Limit: intPtr(1)},
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("ListActivity", mock.Anything, round.ListActivityParams{
+					RoundID: roundID,
+					User:    user,
+					Type:    stringPtr("payment"),
+					Limit:   intPtr(1),
+				}).Return(&round.ListActivityResult{
+					Items: []round.ActivityItem{{
+						ID:          uuid.New(),
+						Type:        round.ActivityPayment,
+						Status:      round.ActivityStatusConfirmed,
+						Address:     "0x1111111111111111111111111111111111111111",
+						DisplayName: &name,
+						Amount:      "1000000",
+						TxHash:      "0x" + strings.Repeat("ab", 32),
+						BlockNumber: 11,
+						Period:      0,
+						Timestamp:   time.Now(),
+					}},
+					NextCursor: &next,
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.ActivityPage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				require.Len(t, response.Items, 1)
+				item := response.Items[0]
+				assert.Equal(t, api.ActivityItemTypePayment, item.Type)
+				assert.Equal(t, api.ActivityItemStatusConfirmed, item.Status)
+				require.NotNil(t, item.DisplayName)
+				assert.Equal(t, "Ada", *item.DisplayName)
+				require.NotNil(t, item.BlockNumber)
+				assert.Equal(t, 11, *item.BlockNumber)
+				require.NotNil(t, response.NextCursor)
+				assert.Equal(t, next, *response.NextCursor)
+			},
+		},
+		{
+			name:   "error - invalid cursor",
+			params: api.GetRoundActivityParams{Cursor: stringPtr("bogus")},
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("ListActivity", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidCursor)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name: "error - not a member",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("ListActivity", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/rounds/"+roundID.String()+"/activity", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+			}
+
+			err := handler.GetRoundActivity(c, roundID, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_ExportRoundActivity(t *testing.T) {
+	roundID := uuid.New()
+	user := createTestSessionUser()
+	payout := api.ActivityTypePayout
+
+	tests := []struct {
+		name           string
+		params         api.ExportRoundActivityParams
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - returns a CSV attachment",
+			params: api.ExportRoundActivityParams{Type: &payout},
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("ExportActivity", mock.Anything, round.ExportActivityParams{
+					RoundID: roundID,
+					User:    user,
+					Type:    stringPtr("payout"),
+				}).Return([]byte("timestamp,type\n"), nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "round-"+roundID.String()+"-activity.csv")
+				assert.Equal(t, "timestamp,type\n", rec.Body.String())
+			},
+		},
+		{
+			name: "error - round not found",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("ExportActivity", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrRoundNotFound)
+			},
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/rounds/"+roundID.String()+"/activity/export", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+			}
+
+			err := handler.ExportRoundActivity(c, roundID, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_ListRounds(t *testing.T) {
+	user := createTestSessionUser()
+	groupID := uuid.New()
+	active := api.ListRoundsParamsStatusActive
+	next := "next-cursor"
+
+	tests := []struct {
+		name           string
+		params         api.ListRoundsParams
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - filters are passed through",
+			params: api.ListRoundsParams{Status: &active, GroupId: &groupID, Limit: intPtr(10)},
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("ListRounds", mock.Anything, round.ListRoundsParams{
+					User:    user,
+					Status:  stringPtr("active"),
+					GroupID: &groupID,
+					Limit:   intPtr(10),
+				}).Return(&round.ListRoundsResult{
+					Items: []round.RoundSummary{{
+						Round: sqlc.Round{
+							ID:                 uuid.New(),
+							GroupID:            groupID,
+							ContributionAmount: "1000",
+							Status:             round.StatusActive,
+							MemberCount:        4,
+						},
+						GroupName:     "Friday Ajo",
+						CurrentPeriod: intPtr(2),
+						PaidCount:     intPtr(3),
+					}},
+					NextCursor: &next,
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.RoundPage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				require.Len(t, response.Items, 1)
+				item := response.Items[0]
+				assert.Equal(t, stringPtr("Friday Ajo"), item.GroupName)
+				assert.Equal(t, intPtr(4), item.MemberCount)
+				assert.Equal(t, intPtr(2), item.CurrentPeriod)
+				assert.Equal(t, intPtr(3), item.PaidCount)
+				assert.Equal(t, &next, response.NextCursor)
+			},
+		},
+		{
+			name:   "error - invalid status",
+			params: api.ListRoundsParams{},
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("ListRounds", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidRoundStatus)
+			},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/rounds", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+			}
+
+			err := handler.ListRounds(c, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetRound(t *testing.T) {
+	roundID := uuid.New()
+	user := createTestSessionUser()
+	nextPayout := "0x2222222222222222222222222222222222222222"
+	usdc := "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913"
+	registry := mustRegistry(t, []tokens.Token{
+		{ChainID: 8453, Address: common.HexToAddress(usdc), Symbol: "USDC", Decimals: 6},
+	})
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - returns round detail",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRound", mock.Anything, roundID, user).Return(&round.RoundDetail{
+					RoundSummary: round.RoundSummary{
+						Round: sqlc.Round{
+							ID:                 roundID,
+							ContributionAmount: "1000",
+							Status:             round.StatusActive,
+							MemberCount:        4,
+						},
+						GroupName:     "Friday Ajo",
+						CurrentPeriod: intPtr(1),
+						PaidCount:     intPtr(2),
+					},
+					NextPayoutAddress: &nextPayout,
+					TotalContributed:  stringPtr("6000"),
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.RoundDetail
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, roundID, response.Id)
+				assert.Equal(t, "Friday Ajo", response.GroupName)
+				assert.Equal(t, 4, response.MemberCount)
+				assert.Equal(t, intPtr(1), response.CurrentPeriod)
+				assert.Equal(t, intPtr(2), response.PaidCount)
+				require.NotNil(t, response.NextPayoutAddress)
+				assert.Equal(t, nextPayout, string(*response.NextPayoutAddress))
+				assert.Equal(t, stringPtr("6000"), response.TotalContributed)
+				assert.Equal(t, "1000", response.FormattedContributionAmount)
+				assert.Nil(t, response.Token)
+			},
+		},
+		{
+			name: "success - formats amount of a registered token",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRound", mock.Anything, roundID, user).Return(&round.RoundDetail{
+					RoundSummary: round.RoundSummary{
+						Round: sqlc.Round{
+							ID:                 roundID,
+							ChainID:            8453,
+							TokenAddress:       &usdc,
+							ContributionAmount: "12500000",
+							Status:             round.StatusActive,
+							MemberCount:        4,
+						},
+						GroupName: "Friday Ajo",
+					},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.RoundDetail
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "12.5 USDC", response.FormattedContributionAmount)
+				require.NotNil(t, response.Token)
+				assert.Equal(t, usdc, string(*response.Token))
+			},
+		},
+		{
+			name: "error - round not found",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRound", mock.Anything, roundID, user).Return(nil, circaerrors.ErrRoundNotFound)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name: "error - not a member",
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("GetRound", mock.Anything, roundID, user).Return(nil, circaerrors.ErrNotGroupMember)
+			},
+			expectedStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/rounds/"+roundID.String(), nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+				tokens:       registry,
+			}
+
+			err := handler.GetRound(c, roundID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_TransitionRound(t *testing.T) {
+	roundID := uuid.New()
+	owner := createTestSessionUser()
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMocks     func(*roundmocks.MockRoundService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - round paused",
+			body: `{"action": "pause", "reason": "Holiday break"}`,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("TransitionRound", mock.Anything, round.TransitionRoundParams{
+					RoundID: roundID,
+					Actor:   owner,
+					Action:  round.ActionPause,
+					Reason:  stringPtr("Holiday break"),
+				}).Return(&sqlc.Round{
+					ID:        roundID,
+					GroupID:   uuid.New(),
+					Status:    round.StatusPaused,
+					CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.Round
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, roundID, response.Id)
+				assert.Equal(t, api.RoundStatus(round.StatusPaused), response.Status)
+			},
+		},
+		{
+			name: "error - transition not allowed",
+			body: `{"action": "resume"}`,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("TransitionRound", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidRoundTransition)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "error - unknown action",
+			body: `{"action": "complete"}`,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("TransitionRound", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidRoundAction)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name: "error - not the group owner",
+			body: `{"action": "cancel"}`,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("TransitionRound", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrNotGroupOwner)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name: "error - round not found",
+			body: `{"action": "cancel"}`,
+			setupMocks: func(m *roundmocks.MockRoundService) {
+				m.On("TransitionRound", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrRoundNotFound)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name:           "error - reason too long",
+			body:           `{"action": "default", "reason": "` + strings.Repeat("a", 501) + `"}`,
+			setupMocks:     func(m *roundmocks.MockRoundService) {},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/rounds/"+roundID.String()+"/transitions", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: owner}, nil)
+			mockRound := roundmocks.NewMockRoundService(t)
+			tt.setupMocks(mockRound)
+
+			handler := &Handler{
+				authService:  mockAuth,
+				roundService: mockRound,
+			}
+
+			err := handler.TransitionRound(c, roundID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+
+			mockAuth.AssertExpectations(t)
+			mockRound.AssertExpectations(t)
+		})
+	}
+}
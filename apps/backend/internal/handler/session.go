@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// sessionUser resolves the circa_session cookie to the signed-in user. When
+// there is no usable session the error response has already been written and
+// a nil user is returned; callers should return the error as-is.
+func (h *Handler) sessionUser(ctx echo.Context) (*sqlc.User, error) {
+	cookie, err := ctx.Cookie("circa_session")
+	if err != nil || cookie == nil || cookie.Value == "" {
+		return nil, ctx.JSON(401, api.ErrorUnauthorized{
+			Code:    401,
+			Message: "Unauthorized - no valid session",
+		})
+	}
+
+	result, err := h.authService.GetSessionUser(ctx.Request().Context(), cookie.Value)
+	if err != nil {
+		if errors.Is(err, circaerrors.ErrInvalidSession) {
+			return nil, ctx.JSON(401, api.ErrorUnauthorized{
+				Code:    401,
+				Message: "Unauthorized - invalid or expired session",
+			})
+		}
+		log.Error().Err(err).Msg("Failed to get session user")
+		return nil, ctx.JSON(500, api.ErrorInternalServerError{
+			Code:    500,
+			Message: "Internal server error",
+		})
+	}
+
+	return &result.User, nil
+}
+
+func toAPIUser(u sqlc.User) api.User {
+	user := api.User{
+		Id:                u.ID,
+		Address:           api.Address(u.Address),
+		CreatedAt:         api.Timestamp(u.CreatedAt.Time),
+		DisplayName:       u.DisplayName,
+		AvatarUrl:         u.AvatarUrl,
+		PreferredCurrency: (*api.Currency)(u.PreferredCurrency),
+	}
+	if u.UpdatedAt.Valid {
+		updatedAt := api.Timestamp(u.UpdatedAt.Time)
+		user.UpdatedAt = &updatedAt
+	}
+	return user
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"circa/api"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/slotswap"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// ListSlotSwaps handles GET /rounds/{roundId}/swaps
+func (h *Handler) ListSlotSwaps(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	swaps, err := h.slotSwapService.ListSwaps(ctx.Request().Context(), roundId, *user)
+	if err != nil {
+		return h.slotSwapError(ctx, err, "Failed to list slot swaps")
+	}
+
+	response := make([]api.SlotSwap, 0, len(swaps))
+	for i := range swaps {
+		response = append(response, toAPISlotSwap(&swaps[i]))
+	}
+	return ctx.JSON(200, response)
+}
+
+// ProposeSlotSwap handles POST /rounds/{roundId}/swaps
+func (h *Handler) ProposeSlotSwap(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.ProposeSlotSwapRequest
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	swap, err := h.slotSwapService.ProposeSwap(ctx.Request().Context(), slotswap.ProposeParams{
+		RoundID:             roundId,
+		User:                *user,
+		CounterpartyAddress: string(req.CounterpartyAddress),
+	})
+	if err != nil {
+		return h.slotSwapError(ctx, err, "Failed to propose slot swap")
+	}
+
+	return ctx.JSON(201, toAPISlotSwap(swap))
+}
+
+// AcceptSlotSwap handles POST /rounds/{roundId}/swaps/{swapId}/accept
+func (h *Handler) AcceptSlotSwap(ctx echo.Context, roundId api.UUID, swapId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	var req api.AcceptSlotSwapRequest
+	if err := ctx.Bind(&req); err != nil {
+		log.Error().Err(err).Msg("Failed to bind request")
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: "Invalid request body",
+		})
+	}
+
+	swap, err := h.slotSwapService.AcceptSwap(ctx.Request().Context(), slotswap.AcceptParams{
+		RoundID:   roundId,
+		SwapID:    swapId,
+		User:      *user,
+		Signature: req.Signature,
+	})
+	if err != nil {
+		return h.slotSwapError(ctx, err, "Failed to accept slot swap")
+	}
+
+	return ctx.JSON(200, toAPISlotSwap(swap))
+}
+
+// CancelSlotSwap handles POST /rounds/{roundId}/swaps/{swapId}/cancel
+func (h *Handler) CancelSlotSwap(ctx echo.Context, roundId api.UUID, swapId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	swap, err := h.slotSwapService.CancelSwap(ctx.Request().Context(), roundId, swapId, *user)
+	if err != nil {
+		return h.slotSwapError(ctx, err, "Failed to cancel slot swap")
+	}
+
+	return ctx.JSON(200, toAPISlotSwap(swap))
+}
+
+func toAPISlotSwap(s *slotswap.Swap) api.SlotSwap {
+	swap := api.SlotSwap{
+		Id:                  s.Swap.ID,
+		RoundId:             s.Swap.RoundID,
+		ProposerAddress:     api.Address(s.Swap.ProposerAddress),
+		CounterpartyAddress: api.Address(s.Swap.CounterpartyAddress),
+		ProposerSlot:        int(s.Swap.ProposerSlot),
+		CounterpartySlot:    int(s.Swap.CounterpartySlot),
+		Nonce:               s.Swap.Nonce,
+		Deadline:            api.Timestamp(s.Swap.Deadline.Time),
+		Status:              api.SlotSwapStatus(s.Status),
+		TypedData:           toAPITypedData(s.TypedData),
+		CreatedAt:           api.Timestamp(s.Swap.CreatedAt.Time),
+	}
+	if s.Swap.AcceptedAt.Valid {
+		acceptedAt := api.Timestamp(s.Swap.AcceptedAt.Time)
+		swap.AcceptedAt = &acceptedAt
+	}
+	if s.Swap.AppliedAt.Valid {
+		appliedAt := api.Timestamp(s.Swap.AppliedAt.Time)
+		swap.AppliedAt = &appliedAt
+	}
+	if s.Payload != nil {
+		swap.Payload = &api.SlotSwapPayload{
+			To:      api.Address(s.Payload.To),
+			ChainId: s.Payload.ChainID,
+			Data:    s.Payload.Data,
+		}
+	}
+	return swap
+}
+
+// toAPITypedData shapes typed data the way wallets expect it for
+// eth_signTypedData_v4: a numeric chainId and no salt, which the domain
+// does not declare.
+func toAPITypedData(td apitypes.TypedData) map[string]interface{} {
+	domain := map[string]interface{}{
+		"name":              td.Domain.Name,
+		"version":           td.Domain.Version,
+		"verifyingContract": td.Domain.VerifyingContract,
+	}
+	if td.Domain.ChainId != nil {
+		domain["chainId"] = (*big.Int)(td.Domain.ChainId).Int64()
+	}
+	return map[string]interface{}{
+		"types":       td.Types,
+		"primaryType": td.PrimaryType,
+		"domain":      domain,
+		"message":     map[string]interface{}(td.Message),
+	}
+}
+
+// slotSwapError maps slot swap service errors onto API responses.
+func (h *Handler) slotSwapError(ctx echo.Context, err error, logMsg string) error {
+	switch {
+	case errors.Is(err, circaerrors.ErrSlotSwapNotFound):
+		return ctx.JSON(404, api.ErrorNotFound{
+			Code:    404,
+			Message: "Slot swap not found",
+		})
+	case errors.Is(err, circaerrors.ErrNotSwapParty):
+		return ctx.JSON(403, api.ErrorForbidden{
+			Code:    403,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrRoundNotSwappable),
+		errors.Is(err, circaerrors.ErrSlotAlreadyPaid),
+		errors.Is(err, circaerrors.ErrSwapAlreadyOpen),
+		errors.Is(err, circaerrors.ErrSwapClosed),
+		errors.Is(err, circaerrors.ErrSwapExpired),
+		errors.Is(err, circaerrors.ErrSwapStale):
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:    409,
+			Message: err.Error(),
+		})
+	case errors.Is(err, circaerrors.ErrInvalidSwapCounterparty),
+		errors.Is(err, circaerrors.ErrInvalidSwapSignature):
+		return ctx.JSON(400, api.ErrorBadRequest{
+			Code:    400,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
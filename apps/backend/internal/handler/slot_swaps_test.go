@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"circa/api"
+	"circa/internal/contracts"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	slotswapmocks "circa/internal/handler/mocks/slotswap"
+	"circa/internal/service/auth"
+	"circa/internal/service/slotswap"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	swapCounterparty = "0x2222222222222222222222222222222222222222"
+	swapContract     = "0x5555555555555555555555555555555555555555"
+)
+
+func createTestSlotSwap(roundID uuid.UUID, proposer string) *slotswap.Swap {
+	swap := sqlc.SlotSwap{
+		ID:                  uuid.New(),
+		RoundID:             roundID,
+		ProposerAddress:     proposer,
+		CounterpartyAddress: swapCounterparty,
+		ProposerSlot:        2,
+		CounterpartySlot:    0,
+		Nonce:               "7",
+		Deadline:            pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true},
+		Status:              slotswap.StatusProposed,
+		CreatedAt:           pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+	return &slotswap.Swap{
+		Swap:   swap,
+		Status: swap.Status,
+		TypedData: contracts.SlotSwapTypedData(8453, common.HexToAddress(swapContract), contracts.SlotSwap{
+			Member:           common.HexToAddress(proposer),
+			Counterparty:     common.HexToAddress(swapCounterparty),
+			MemberSlot:       big.NewInt(2),
+			CounterpartySlot: big.NewInt(0),
+			Nonce:            big.NewInt(7),
+			Deadline:         big.NewInt(swap.Deadline.Time.Unix()),
+		}),
+	}
+}
+
+func newSlotSwapHandler(t *testing.T, user sqlc.User) (*Handler, *slotswapmocks.MockSlotSwapService) {
+	mockAuth := authmocks.NewMockAuthService(t)
+	mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+		Return(&auth.GetSessionUserResult{User: user}, nil)
+	mockSlotSwap := slotswapmocks.NewMockSlotSwapService(t)
+
+	return &Handler{
+		authService:     mockAuth,
+		slotSwapService: mockSlotSwap,
+	}, mockSlotSwap
+}
+
+func TestHandler_ProposeSlotSwap(t *testing.T) {
+	user := createTestSessionUser()
+	roundID := uuid.New()
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*slotswapmocks.MockSlotSwapService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - returns typed data to sign",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService) {
+				m.On("ProposeSwap", mock.Anything, slotswap.ProposeParams{
+					RoundID:             roundID,
+					User:                user,
+					CounterpartyAddress: swapCounterparty,
+				}).Return(createTestSlotSwap(roundID, user.Address), nil)
+			},
+			expectedStatus: 201,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.SlotSwap
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, api.SlotSwapStatusProposed, response.Status)
+				assert.Equal(t, 2, response.ProposerSlot)
+				assert.Nil(t, response.Payload)
+				assert.Equal(t, "SlotSwap", response.TypedData["primaryType"])
+
+				domain, ok := response.TypedData["domain"].(map[string]interface{})
+				require.True(t, ok)
+				assert.Equal(t, float64(8453), domain["chainId"])
+				assert.NotContains(t, domain, "salt")
+			},
+		},
+		{
+			name: "error - counterparty already paid",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService) {
+				m.On("ProposeSwap", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrSlotAlreadyPaid)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "error - counterparty not in round",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService) {
+				m.On("ProposeSwap", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidSwapCounterparty)
+			},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/rounds/"+roundID.String()+"/swaps", strings.NewReader(`{"counterpartyAddress": "`+swapCounterparty+`"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler, mockSlotSwap := newSlotSwapHandler(t, user)
+			tt.setupMocks(mockSlotSwap)
+
+			require.NoError(t, handler.ProposeSlotSwap(c, roundID))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+		})
+	}
+}
+
+func TestHandler_AcceptSlotSwap(t *testing.T) {
+	user := createTestSessionUser()
+	roundID := uuid.New()
+	signature := "0x" + strings.Repeat("ab", 65)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*slotswapmocks.MockSlotSwapService, uuid.UUID)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - returns the contract payload",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService, swapID uuid.UUID) {
+				swap := createTestSlotSwap(roundID, "0x1111111111111111111111111111111111111111")
+				swap.Swap.ID = swapID
+				swap.Swap.Status = slotswap.StatusAccepted
+				swap.Swap.AcceptedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+				swap.Status = slotswap.StatusAccepted
+				swap.Payload = &slotswap.Payload{To: swapContract, ChainID: 8453, Data: "0x1234"}
+
+				m.On("AcceptSwap", mock.Anything, slotswap.AcceptParams{
+					RoundID:   roundID,
+					SwapID:    swapID,
+					User:      user,
+					Signature: signature,
+				}).Return(swap, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.SlotSwap
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, api.SlotSwapStatusAccepted, response.Status)
+				assert.NotNil(t, response.AcceptedAt)
+				require.NotNil(t, response.Payload)
+				assert.Equal(t, api.Address(swapContract), response.Payload.To)
+				assert.Equal(t, int64(8453), response.Payload.ChainId)
+				assert.Equal(t, "0x1234", response.Payload.Data)
+			},
+		},
+		{
+			name: "error - signature from another address",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService, _ uuid.UUID) {
+				m.On("AcceptSwap", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrInvalidSwapSignature)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name: "error - not the counterparty",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService, _ uuid.UUID) {
+				m.On("AcceptSwap", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrNotSwapParty)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name: "error - swap not found",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService, _ uuid.UUID) {
+				m.On("AcceptSwap", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrSlotSwapNotFound)
+			},
+			expectedStatus: 404,
+		},
+		{
+			name: "error - slots changed",
+			setupMocks: func(m *slotswapmocks.MockSlotSwapService, _ uuid.UUID) {
+				m.On("AcceptSwap", mock.Anything, mock.Anything).Return(nil, circaerrors.ErrSwapStale)
+			},
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			swapID := uuid.New()
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/rounds/"+roundID.String()+"/swaps/"+swapID.String()+"/accept", strings.NewReader(`{"signature": "`+signature+`"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler, mockSlotSwap := newSlotSwapHandler(t, user)
+			tt.setupMocks(mockSlotSwap, swapID)
+
+			require.NoError(t, handler.AcceptSlotSwap(c, roundID, swapID))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+		})
+	}
+}
+
+func TestHandler_CancelSlotSwap_Closed(t *testing.T) {
+	user := createTestSessionUser()
+	roundID := uuid.New()
+	swapID := uuid.New()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/rounds/"+roundID.String()+"/swaps/"+swapID.String()+"/cancel", nil)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler, mockSlotSwap := newSlotSwapHandler(t, user)
+	mockSlotSwap.On("CancelSwap", mock.Anything, roundID, swapID, user).Return(nil, circaerrors.ErrSwapClosed)
+
+	require.NoError(t, handler.CancelSlotSwap(c, roundID, swapID))
+	assert.Equal(t, 409, rec.Code)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"circa/api"
+	"circa/internal/tokens"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ListTokens handles GET /tokens
+func (h *Handler) ListTokens(ctx echo.Context) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	registered := h.tokens.Tokens()
+	items := make([]api.Token, 0, len(registered))
+	for _, t := range registered {
+		items = append(items, toAPIToken(t))
+	}
+
+	return ctx.JSON(200, items)
+}
+
+func toAPIToken(t tokens.Token) api.Token {
+	token := api.Token{
+		ChainId:  api.ChainId(t.ChainID),
+		Address:  api.Address(t.Address.Hex()),
+		Symbol:   t.Symbol,
+		Decimals: int(t.Decimals),
+	}
+	if t.LogoURL != "" {
+		token.LogoUrl = &t.LogoURL
+	}
+	return token
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"circa/api"
+	authmocks "circa/internal/handler/mocks"
+	"circa/internal/service/auth"
+	"circa/internal/tokens"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListTokens(t *testing.T) {
+	user := createTestSessionUser()
+	usdc := common.HexToAddress("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913")
+	dai := common.HexToAddress("0x50c5725949A6F0c72E6C4a641F24049A917DB0Cb")
+
+	tests := []struct {
+		name     string
+		registry *tokens.Registry
+		expected []api.Token
+	}{
+		{
+			name:     "no registry",
+			expected: []api.Token{},
+		},
+		{
+			name: "registered tokens",
+			registry: mustRegistry(t, []tokens.Token{
+				{ChainID: 8453, Address: usdc, Symbol: "USDC", Decimals: 6, LogoURL: "https://example.com/usdc.png"},
+				{ChainID: 8453, Address: dai, Symbol: "DAI", Decimals: 18},
+			}),
+			expected: []api.Token{
+				{ChainId: 8453, Address: api.Address(dai.Hex()), Symbol: "DAI", Decimals: 18},
+				{ChainId: 8453, Address: api.Address(usdc.Hex()), Symbol: "USDC", Decimals: 6, LogoUrl: stringPtr("https://example.com/usdc.png")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/tokens", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			mockAuth := authmocks.NewMockAuthService(t)
+			mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+				Return(&auth.GetSessionUserResult{User: user}, nil)
+
+			handler := &Handler{authService: mockAuth, tokens: tt.registry}
+
+			require.NoError(t, handler.ListTokens(c))
+			assert.Equal(t, 200, rec.Code)
+
+			var response []api.Token
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tt.expected, response)
+		})
+	}
+}
+
+func mustRegistry(t *testing.T, tokenList []tokens.Token) *tokens.Registry {
+	t.Helper()
+	registry, err := tokens.NewRegistry(tokenList)
+	require.NoError(t, err)
+	return registry
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"circa/api"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/txbuilder"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/labstack/echo/v4"
+)
+
+// BuildContribution handles GET /rounds/{roundId}/transactions/contribution
+func (h *Handler) BuildContribution(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	plan, err := h.txBuilderService.BuildContribution(ctx.Request().Context(), roundId, *user)
+	if err != nil {
+		return h.transactionError(ctx, err, "Failed to build contribution transactions")
+	}
+
+	transactions := make([]api.TransactionRequest, 0, len(plan.Transactions))
+	for i := range plan.Transactions {
+		transactions = append(transactions, toAPITransactionRequest(&plan.Transactions[i]))
+	}
+	return ctx.JSON(200, api.ContributionPlan{
+		Token:        api.Address(plan.Token.Hex()),
+		Amount:       plan.Amount.String(),
+		Allowance:    plan.Allowance.String(),
+		Balance:      plan.Balance.String(),
+		Transactions: transactions,
+	})
+}
+
+// BuildPayoutClaim handles GET /rounds/{roundId}/transactions/payout-claim
+func (h *Handler) BuildPayoutClaim(ctx echo.Context, roundId api.UUID) error {
+	user, err := h.sessionUser(ctx)
+	if user == nil {
+		return err
+	}
+
+	tx, err := h.txBuilderService.BuildPayoutClaim(ctx.Request().Context(), roundId, *user)
+	if err != nil {
+		return h.transactionError(ctx, err, "Failed to build payout claim transaction")
+	}
+
+	return ctx.JSON(200, toAPITransactionRequest(tx))
+}
+
+func toAPITransactionRequest(tx *txbuilder.Transaction) api.TransactionRequest {
+	request := api.TransactionRequest{
+		Kind:    api.TransactionRequestKind(tx.Kind),
+		ChainId: tx.ChainID,
+		From:    api.Address(tx.From.Hex()),
+		To:      api.Address(tx.To.Hex()),
+		Data:    hexutil.Encode(tx.Data),
+		Value:   hexutil.EncodeBig(tx.Value),
+	}
+	if tx.Gas != nil {
+		gas := hexutil.EncodeUint64(*tx.Gas)
+		request.Gas = &gas
+	}
+	if tx.MaxFeePerGas != nil {
+		maxFee := hexutil.EncodeBig(tx.MaxFeePerGas)
+		request.MaxFeePerGas = &maxFee
+	}
+	if tx.MaxPriorityFeePerGas != nil {
+		tip := hexutil.EncodeBig(tx.MaxPriorityFeePerGas)
+		request.MaxPriorityFeePerGas = &tip
+	}
+	if tx.GasPrice != nil {
+		gasPrice := hexutil.EncodeBig(tx.GasPrice)
+		request.GasPrice = &gasPrice
+	}
+	return request
+}
+
+// transactionError maps transaction builder errors onto API responses.
+func (h *Handler) transactionError(ctx echo.Context, err error, logMsg string) error {
+	switch {
+	case errors.Is(err, circaerrors.ErrRoundNotActive),
+		errors.Is(err, circaerrors.ErrPayoutAlreadyReceived),
+		errors.Is(err, circaerrors.ErrTransactionWouldRevert):
+		return ctx.JSON(409, api.ErrorConflict{
+			Code:    409,
+			Message: err.Error(),
+		})
+	}
+	return h.serviceError(ctx, err, logMsg)
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"circa/api"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	authmocks "circa/internal/handler/mocks"
+	txbuildermocks "circa/internal/handler/mocks/txbuilder"
+	"circa/internal/service/auth"
+	"circa/internal/service/txbuilder"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTxBuilderHandler(t *testing.T, user sqlc.User) (*Handler, *txbuildermocks.MockTxBuilderService) {
+	mockAuth := authmocks.NewMockAuthService(t)
+	mockAuth.On("GetSessionUser", mock.Anything, "session-id").
+		Return(&auth.GetSessionUserResult{User: user}, nil)
+	mockTxBuilder := txbuildermocks.NewMockTxBuilderService(t)
+
+	return &Handler{
+		authService:      mockAuth,
+		txBuilderService: mockTxBuilder,
+	}, mockTxBuilder
+}
+
+func TestHandler_BuildContribution(t *testing.T) {
+	user := createTestSessionUser()
+	roundID := uuid.New()
+	gas := uint64(60_000)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*txbuildermocks.MockTxBuilderService)
+		expectedStatus int
+		expectedBody   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - approval then contribution",
+			setupMocks: func(m *txbuildermocks.MockTxBuilderService) {
+				m.On("BuildContribution", mock.Anything, roundID, user).Return(&txbuilder.ContributionPlan{
+					Token:     common.HexToAddress("0x7777777777777777777777777777777777777777"),
+					Amount:    big.NewInt(1_000_000),
+					Allowance: big.NewInt(0),
+					Balance:   big.NewInt(2_000_000),
+					Transactions: []txbuilder.Transaction{
+						{
+							Kind:                 txbuilder.KindApprove,
+							ChainID:              8453,
+							To:                   common.HexToAddress("0x7777777777777777777777777777777777777777"),
+							Data:                 []byte{0x09, 0x5e, 0xa7, 0xb3},
+							Value:                big.NewInt(0),
+							Gas:                  &gas,
+							MaxFeePerGas:         big.NewInt(2_000_000),
+							MaxPriorityFeePerGas: big.NewInt(1_000),
+						},
+						{
+							Kind:    txbuilder.KindContribute,
+							ChainID: 8453,
+							To:      common.HexToAddress("0x5555555555555555555555555555555555555555"),
+							Data:    []byte{0xd7, 0xbb, 0x99, 0xba},
+							Value:   big.NewInt(0),
+						},
+					},
+				}, nil)
+			},
+			expectedStatus: 200,
+			expectedBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var response api.ContributionPlan
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "1000000", response.Amount)
+				assert.Equal(t, "0", response.Allowance)
+				require.Len(t, response.Transactions, 2)
+
+				approve := response.Transactions[0]
+				assert.Equal(t, api.Approve, approve.Kind)
+				assert.Equal(t, "0x095ea7b3", approve.Data)
+				assert.Equal(t, "0x0", approve.Value)
+				require.NotNil(t, approve.Gas)
+				assert.Equal(t, "0xea60", *approve.Gas)
+				require.NotNil(t, approve.MaxFeePerGas)
+				assert.Equal(t, "0x1e8480", *approve.MaxFeePerGas)
+				assert.Nil(t, approve.GasPrice)
+
+				contribute := response.Transactions[1]
+				assert.Equal(t, api.Contribute, contribute.Kind)
+				assert.Nil(t, contribute.Gas)
+			},
+		},
+		{
+			name: "error - round paused",
+			setupMocks: func(m *txbuildermocks.MockTxBuilderService) {
+				m.On("BuildContribution", mock.Anything, roundID, user).Return(nil, circaerrors.ErrRoundNotActive)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "error - not in the round",
+			setupMocks: func(m *txbuildermocks.MockTxBuilderService) {
+				m.On("BuildContribution", mock.Anything, roundID, user).Return(nil, circaerrors.ErrNotRoundMember)
+			},
+			expectedStatus: 403,
+		},
+		{
+			name: "error - chain not configured",
+			setupMocks: func(m *txbuildermocks.MockTxBuilderService) {
+				m.On("BuildContribution", mock.Anything, roundID, user).Return(nil, circaerrors.ErrUnsupportedChain)
+			},
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/rounds/"+roundID.String()+"/transactions/contribution", nil)
+			req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			handler, mockTxBuilder := newTxBuilderHandler(t, user)
+			tt.setupMocks(mockTxBuilder)
+
+			require.NoError(t, handler.BuildContribution(c, roundID))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != nil {
+				tt.expectedBody(t, rec)
+			}
+		})
+	}
+}
+
+func TestHandler_BuildPayoutClaim_WouldRevert(t *testing.T) {
+	user := createTestSessionUser()
+	roundID := uuid.New()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/rounds/"+roundID.String()+"/transactions/payout-claim", nil)
+	req.AddCookie(&http.Cookie{Name: "circa_session", Value: "session-id"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	handler, mockTxBuilder := newTxBuilderHandler(t, user)
+	mockTxBuilder.On("BuildPayoutClaim", mock.Anything, roundID, user).
+		Return(nil, fmt.Errorf("%w: execution reverted: payout not due", circaerrors.ErrTransactionWouldRevert))
+
+	require.NoError(t, handler.BuildPayoutClaim(c, roundID))
+	assert.Equal(t, 409, rec.Code)
+
+	var response api.ErrorConflict
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Contains(t, response.Message, "payout not due")
+}
@@ -0,0 +1,151 @@
+package indexer
+
+import (
+	"circa/internal/contracts"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+)
+
+const (
+	EventContribution = "contribution"
+	EventPayout       = "payout"
+	EventSwap         = "swap"
+)
+
+// eventTypes maps a Round contract event name to the event_type stored in
+// round_events.
+var eventTypes = map[string]string{
+	"ContributionReceived": EventContribution,
+	"PayoutReleased":       EventPayout,
+	"SlotsSwapped":         EventSwap,
+}
+
+// eventTopics returns the topic0 of every indexed event, for filtering logs.
+func eventTopics() []common.Hash {
+	topics := make([]common.Hash, 0, len(eventTypes))
+	for name := range eventTypes {
+		topics = append(topics, contracts.RoundABI.Events[name].ID)
+	}
+	return topics
+}
+
+// decodeLog decodes a Round contract log. The block time is filled in by the
+// caller since logs do not carry it.
+func decodeLog(log types.Log) (Event, error) {
+	if len(log.Topics) == 0 {
+		return Event{}, fmt.Errorf("log %s:%d has no topics", log.TxHash, log.Index)
+	}
+
+	event, err := contracts.RoundABI.EventByID(log.Topics[0])
+	if err != nil {
+		return Event{}, fmt.Errorf("unknown event %s: %w", log.Topics[0], err)
+	}
+	eventType, ok := eventTypes[event.Name]
+	if !ok {
+		return Event{}, fmt.Errorf("unsupported event %s", event.Name)
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	fields := map[string]any{}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return Event{}, fmt.Errorf("decode %s topics: %w", event.Name, err)
+	}
+	if err := contracts.RoundABI.UnpackIntoMap(fields, event.Name, log.Data); err != nil {
+		return Event{}, fmt.Errorf("decode %s data: %w", event.Name, err)
+	}
+
+	address, ok := fields[event.Inputs[0].Name].(common.Address)
+	if !ok {
+		return Event{}, fmt.Errorf("decode %s: missing %s", event.Name, event.Inputs[0].Name)
+	}
+	period, ok := fields["period"].(*big.Int)
+	if !ok || !period.IsUint64() {
+		return Event{}, fmt.Errorf("decode %s: invalid period", event.Name)
+	}
+
+	decoded := Event{
+		Type:        eventType,
+		Address:     strings.ToLower(address.Hex()),
+		Period:      period.Uint64(),
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+	}
+
+	// Swaps move no funds; they name the member the slot was swapped with.
+	if eventType == EventSwap {
+		counterparty, ok := fields["counterparty"].(common.Address)
+		if !ok {
+			return Event{}, fmt.Errorf("decode %s: missing counterparty", event.Name)
+		}
+		decoded.Counterparty = strings.ToLower(counterparty.Hex())
+		decoded.Amount = new(big.Int)
+		return decoded, nil
+	}
+
+	amount, ok := fields["amount"].(*big.Int)
+	if !ok {
+		return Event{}, fmt.Errorf("decode %s: invalid amount", event.Name)
+	}
+	decoded.Amount = amount
+	return decoded, nil
+}
+
+// decodeRoundCreated decodes a RoundFactory RoundCreated log.
+func decodeRoundCreated(chainID int64, log types.Log) (RoundCreated, error) {
+	event := contracts.FactoryABI.Events["RoundCreated"]
+	if len(log.Topics) == 0 || log.Topics[0] != event.ID {
+		return RoundCreated{}, fmt.Errorf("log %s:%d is not a RoundCreated event", log.TxHash, log.Index)
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	fields := map[string]any{}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return RoundCreated{}, fmt.Errorf("decode %s topics: %w", event.Name, err)
+	}
+	if err := contracts.FactoryABI.UnpackIntoMap(fields, event.Name, log.Data); err != nil {
+		return RoundCreated{}, fmt.Errorf("decode %s data: %w", event.Name, err)
+	}
+
+	round, ok := fields["round"].(common.Address)
+	if !ok {
+		return RoundCreated{}, fmt.Errorf("decode %s: missing round", event.Name)
+	}
+	groupID, ok := fields["groupId"].([16]byte)
+	if !ok {
+		return RoundCreated{}, fmt.Errorf("decode %s: invalid groupId", event.Name)
+	}
+	creator, ok := fields["creator"].(common.Address)
+	if !ok {
+		return RoundCreated{}, fmt.Errorf("decode %s: missing creator", event.Name)
+	}
+
+	return RoundCreated{
+		ChainID:     chainID,
+		Factory:     log.Address,
+		Round:       round,
+		GroupID:     uuid.UUID(groupID),
+		Creator:     creator,
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+	}, nil
+}
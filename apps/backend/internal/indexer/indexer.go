@@ -0,0 +1,366 @@
+package indexer
+
+import (
+	"circa/internal/contracts"
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultBlockRange caps the number of blocks requested per eth_getLogs
+	// call, which most RPC providers limit.
+	DefaultBlockRange uint64 = 2000
+
+	// DefaultConfirmations is the confirmation depth used for chains
+	// without an explicit setting.
+	DefaultConfirmations uint64 = 12
+)
+
+// ChainClient is the subset of the Ethereum JSON-RPC API used by the
+// indexer. Both ethclient.Client and the simulated backend satisfy it.
+type ChainClient interface {
+	ethereum.BlockNumberReader
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Chain is an indexed chain. An event is confirmed once its block has
+// Confirmations blocks on top of it, counting its own. Factories are the
+// RoundFactory contracts whose deployments are discovered, from
+// FactoryStartBlock on.
+type Chain struct {
+	Client            ChainClient
+	Confirmations     uint64
+	Factories         []common.Address
+	FactoryStartBlock uint64
+}
+
+// Contract is a Round contract to index. StartBlock is where indexing
+// begins when the contract has no cursor yet.
+type Contract struct {
+	RoundID    uuid.UUID
+	ChainID    int64
+	Address    common.Address
+	StartBlock uint64
+}
+
+// Event is a decoded contribution, payout or slot swap log. For a swap,
+// Address is the member who submitted it, Counterparty the member whose
+// slot they took and Period the period it was applied in.
+type Event struct {
+	Type         string
+	Address      string
+	Counterparty string
+	Period       uint64
+	Amount       *big.Int
+	BlockNumber  uint64
+	BlockHash    common.Hash
+	TxHash       common.Hash
+	LogIndex     uint
+	BlockTime    time.Time
+}
+
+// RoundCreated is a Round contract deployed by a factory for a group.
+type RoundCreated struct {
+	ChainID     int64
+	Factory     common.Address
+	Round       common.Address
+	GroupID     uuid.UUID
+	Creator     common.Address
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// Discoverer registers the rounds factories deploy. It returns an error
+// only when discovery should be retried: a deployment that cannot be
+// registered is logged and skipped. A deployment may be discovered more
+// than once.
+type Discoverer interface {
+	DiscoverRound(ctx context.Context, created RoundCreated) error
+}
+
+// Block is a tracked block header.
+type Block struct {
+	Number     uint64
+	Hash       common.Hash
+	ParentHash common.Hash
+}
+
+// Repository persists indexed events, per-contract cursors and the recent
+// block headers used for reorg detection.
+type Repository interface {
+	ListContracts(ctx context.Context) ([]Contract, error)
+	// GetCursor returns the last indexed block, or false when the contract
+	// has never been indexed.
+	GetCursor(ctx context.Context, contract Contract) (uint64, bool, error)
+	// SaveEvents stores events and advances the cursor to lastBlock
+	// atomically. Events that were already stored are ignored.
+	SaveEvents(ctx context.Context, contract Contract, events []Event, lastBlock uint64) error
+
+	// LatestBlock returns the highest tracked block of a chain.
+	LatestBlock(ctx context.Context, chainID int64) (Block, bool, error)
+	// GetBlock returns a tracked block by number.
+	GetBlock(ctx context.Context, chainID int64, number uint64) (Block, bool, error)
+	// SaveBlocks tracks new blocks and forgets those below pruneBelow.
+	SaveBlocks(ctx context.Context, chainID int64, blocks []Block, pruneBelow uint64) error
+	// Rollback removes every event and tracked block above fork and rewinds
+	// cursors past it.
+	Rollback(ctx context.Context, chainID int64, fork uint64) error
+	// ConfirmEvents marks events up to and including block upTo confirmed.
+	ConfirmEvents(ctx context.Context, chainID int64, upTo uint64) error
+	// Resync removes a contract's events from block from on and rewinds its
+	// cursor before it, so the next poll indexes them again from the chain.
+	Resync(ctx context.Context, contract Contract, from uint64) error
+	// ReplaceEvents makes a contract's stored events in blocks from to to
+	// match events, without moving its cursor. Stored events that differ
+	// are replaced. It returns the number of events written.
+	ReplaceEvents(ctx context.Context, contract Contract, events []Event, from, to uint64) (int, error)
+}
+
+type Indexer struct {
+	repo         Repository
+	chains       map[int64]Chain
+	pollInterval time.Duration
+	blockRange   uint64
+	discoverer   Discoverer
+}
+
+func New(repo Repository, chains map[int64]Chain, pollInterval time.Duration) *Indexer {
+	return &Indexer{
+		repo:         repo,
+		chains:       chains,
+		pollInterval: pollInterval,
+		blockRange:   DefaultBlockRange,
+	}
+}
+
+// SetDiscoverer makes the indexer watch the chains' factories and pass the
+// rounds they deploy to discoverer.
+func (ix *Indexer) SetDiscoverer(discoverer Discoverer) {
+	ix.discoverer = discoverer
+}
+
+// Start polls every registered contract until ctx is cancelled.
+func (ix *Indexer) Start(ctx context.Context) {
+	ticker := time.NewTicker(ix.pollInterval)
+	defer ticker.Stop()
+
+	log.Info().Int("chains", len(ix.chains)).Msg("Indexer started")
+
+	for {
+		ix.Poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Indexer stopping")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll brings every configured chain up to its current head: reorgs are
+// rolled back first, then each contract is indexed and events deep enough
+// are confirmed. A failing chain or contract is logged and retried on the
+// next poll without blocking the others.
+func (ix *Indexer) Poll(ctx context.Context) {
+	contracts, err := ix.repo.ListContracts(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list contracts to index")
+		return
+	}
+
+	byChain := map[int64][]Contract{}
+	for _, contract := range contracts {
+		byChain[contract.ChainID] = append(byChain[contract.ChainID], contract)
+	}
+
+	for chainID, chain := range ix.chains {
+		if err := ix.pollChain(ctx, chainID, chain, byChain[chainID]); err != nil {
+			log.Error().Err(err).Int64("chain_id", chainID).Msg("Failed to index chain")
+		}
+	}
+}
+
+func (ix *Indexer) pollChain(ctx context.Context, chainID int64, chain Chain, contracts []Contract) error {
+	head, err := ix.syncBlocks(ctx, chainID, chain)
+	if err != nil {
+		return err
+	}
+
+	if ix.discoverer != nil {
+		for _, factory := range chain.Factories {
+			if err := ix.watchFactory(ctx, chainID, chain, factory, head); err != nil {
+				log.Error().
+					Err(err).
+					Int64("chain_id", chainID).
+					Str("factory_address", factory.Hex()).
+					Msg("Failed to watch round factory")
+			}
+		}
+	}
+
+	for _, contract := range contracts {
+		if err := ix.IndexContract(ctx, chain.Client, contract, head); err != nil {
+			log.Error().
+				Err(err).
+				Int64("chain_id", chainID).
+				Str("contract_address", contract.Address.Hex()).
+				Msg("Failed to index contract")
+		}
+	}
+
+	if confirmations := max(chain.Confirmations, 1); head+1 >= confirmations {
+		if err := ix.repo.ConfirmEvents(ctx, chainID, head+1-confirmations); err != nil {
+			return fmt.Errorf("confirm events: %w", err)
+		}
+	}
+	return nil
+}
+
+// IndexContract indexes a contract from its cursor, or its start block the
+// first time, up to head, one block range at a time. The cursor is advanced
+// after each range so progress survives restarts.
+func (ix *Indexer) IndexContract(ctx context.Context, client ChainClient, contract Contract, head uint64) error {
+	from := contract.StartBlock
+	last, ok, err := ix.repo.GetCursor(ctx, contract)
+	if err != nil {
+		return fmt.Errorf("get cursor: %w", err)
+	}
+	if ok {
+		from = last + 1
+	}
+
+	for from <= head {
+		to := min(from+ix.blockRange-1, head)
+
+		events, err := FetchEvents(ctx, client, contract, from, to)
+		if err != nil {
+			return err
+		}
+		if err := ix.repo.SaveEvents(ctx, contract, events, to); err != nil {
+			return fmt.Errorf("save events: %w", err)
+		}
+
+		if len(events) > 0 {
+			log.Info().
+				Int64("chain_id", contract.ChainID).
+				Str("contract_address", contract.Address.Hex()).
+				Uint64("from_block", from).
+				Uint64("to_block", to).
+				Int("events", len(events)).
+				Msg("Indexed round events")
+		}
+		from = to + 1
+	}
+	return nil
+}
+
+// watchFactory discovers the rounds a factory deployed up to the last
+// confirmed block, so deployments that are reorganised away are never
+// registered. A factory's cursor is kept like a contract's; a factory seen
+// for the first time is watched from the chain's factory start block on.
+func (ix *Indexer) watchFactory(ctx context.Context, chainID int64, chain Chain, factory common.Address, head uint64) error {
+	confirmations := max(chain.Confirmations, 1)
+	if head+1 < confirmations {
+		return nil
+	}
+	confirmed := head + 1 - confirmations
+
+	cursor := Contract{ChainID: chainID, Address: factory}
+	from := chain.FactoryStartBlock
+	last, ok, err := ix.repo.GetCursor(ctx, cursor)
+	if err != nil {
+		return fmt.Errorf("get cursor: %w", err)
+	}
+	if ok {
+		from = last + 1
+	}
+
+	for from <= confirmed {
+		to := min(from+ix.blockRange-1, confirmed)
+
+		logs, err := chain.Client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{factory},
+			Topics:    [][]common.Hash{{contracts.FactoryABI.Events["RoundCreated"].ID}},
+		})
+		if err != nil {
+			return fmt.Errorf("filter logs %d-%d: %w", from, to, err)
+		}
+
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			created, err := decodeRoundCreated(chainID, l)
+			if err != nil {
+				log.Warn().Err(err).Str("tx_hash", l.TxHash.Hex()).Msg("Skipping undecodable factory log")
+				continue
+			}
+			if err := ix.discoverer.DiscoverRound(ctx, created); err != nil {
+				return fmt.Errorf("discover round %s: %w", created.Round.Hex(), err)
+			}
+		}
+
+		if err := ix.repo.SaveEvents(ctx, cursor, nil, to); err != nil {
+			return fmt.Errorf("save cursor: %w", err)
+		}
+		from = to + 1
+	}
+	return nil
+}
+
+// FetchEvents reads and decodes a contract's events in blocks from to to,
+// inclusive.
+func FetchEvents(ctx context.Context, client ChainClient, contract Contract, from, to uint64) ([]Event, error) {
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{contract.Address},
+		Topics:    [][]common.Hash{eventTopics()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("filter logs %d-%d: %w", from, to, err)
+	}
+
+	headers := map[uint64]*types.Header{}
+	events := make([]Event, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+
+		event, err := decodeLog(l)
+		if err != nil {
+			return nil, err
+		}
+
+		header, ok := headers[l.BlockNumber]
+		if !ok {
+			header, err = client.HeaderByNumber(ctx, new(big.Int).SetUint64(l.BlockNumber))
+			if err != nil {
+				return nil, fmt.Errorf("get block %d: %w", l.BlockNumber, err)
+			}
+			headers[l.BlockNumber] = header
+		}
+		// The chain moved between the two calls. Give up on this range; the
+		// next poll sees the reorg and starts from a consistent state.
+		if header.Hash() != l.BlockHash {
+			return nil, fmt.Errorf("block %d changed while indexing", l.BlockNumber)
+		}
+		event.BlockTime = time.Unix(int64(header.Time), 0).UTC()
+
+		events = append(events, event)
+	}
+	return events, nil
+}
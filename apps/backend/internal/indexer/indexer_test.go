@@ -0,0 +1,642 @@
+package indexer
+
+import (
+	"circa/internal/contracts"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logEmitterCode deploys a contract that emits LOG3 with the first three
+// calldata words as topics and the fourth as data, which is enough to
+// reproduce the Round contract events without a Solidity toolchain.
+var logEmitterCode = common.FromHex("6016600c60003960166000f3" + "6020606060003760403560203560003560206000a300")
+
+func TestIndexer_EndToEnd(t *testing.T) {
+	chain := newTestChain(t)
+	contract := chain.deployRound(t)
+	other := chain.deployRound(t)
+
+	alice := common.HexToAddress("0xAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	bob := common.HexToAddress("0xBbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 0, big.NewInt(100))
+	chain.emit(t, contract.Address, "ContributionReceived", bob, 0, big.NewInt(100))
+	chain.emit(t, other.Address, "ContributionReceived", bob, 0, big.NewInt(7))
+	chain.emitRaw(t, contract.Address, crypto.Keccak256Hash([]byte("Unrelated(uint256)")), common.Hash{}, common.Hash{}, big.NewInt(1))
+	chain.emit(t, contract.Address, "PayoutReleased", alice, 0, big.NewInt(200))
+
+	repo := newMemoryRepository(contract, other)
+	ix := New(repo, map[int64]Chain{chain.id: {Client: chain.client, Confirmations: 1}}, 0)
+	ix.blockRange = 2
+
+	ix.Poll(context.Background())
+
+	events := repo.events[contract.RoundID]
+	require.Len(t, events, 3)
+	assert.Equal(t, EventContribution, events[0].Type)
+	assert.Equal(t, strings.ToLower(alice.Hex()), events[0].Address)
+	assert.Equal(t, "100", events[0].Amount.String())
+	assert.Equal(t, EventContribution, events[1].Type)
+	assert.Equal(t, strings.ToLower(bob.Hex()), events[1].Address)
+	assert.Equal(t, EventPayout, events[2].Type)
+	assert.Equal(t, "200", events[2].Amount.String())
+	assert.False(t, events[2].BlockTime.IsZero())
+
+	require.Len(t, repo.events[other.RoundID], 1)
+	assert.Equal(t, "7", repo.events[other.RoundID][0].Amount.String())
+
+	head, err := chain.client.BlockNumber(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, head, repo.cursors[contract.RoundID])
+	assert.Equal(t, head, repo.cursors[other.RoundID])
+
+	// New blocks are picked up from the cursor.
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 1, big.NewInt(100))
+	ix.Poll(context.Background())
+	require.Len(t, repo.events[contract.RoundID], 4)
+	assert.Equal(t, uint64(1), repo.events[contract.RoundID][3].Period)
+
+	// Replaying from genesis does not duplicate anything.
+	delete(repo.cursors, contract.RoundID)
+	ix.Poll(context.Background())
+	assert.Len(t, repo.events[contract.RoundID], 4)
+}
+
+func TestIndexer_StartsAtStartBlock(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	contract := chain.deployRound(t)
+
+	alice := common.HexToAddress("0xAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 0, big.NewInt(100))
+	head, err := chain.client.BlockNumber(ctx)
+	require.NoError(t, err)
+	contract.StartBlock = head + 1
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 1, big.NewInt(100))
+
+	client := &recordingClient{ChainClient: chain.client}
+	repo := newMemoryRepository(contract)
+	ix := New(repo, map[int64]Chain{chain.id: {Client: client, Confirmations: 1}}, 0)
+	ix.blockRange = 1
+	ix.Poll(ctx)
+
+	events := repo.events[contract.RoundID]
+	require.Len(t, events, 1, "blocks before the start block are not indexed")
+	assert.Equal(t, uint64(1), events[0].Period)
+	require.NotEmpty(t, client.from)
+	assert.Equal(t, contract.StartBlock, client.from[0], "a new contract is not scanned from genesis")
+}
+
+func TestIndexer_SkipsUnconfiguredChains(t *testing.T) {
+	chain := newTestChain(t)
+	contract := chain.deployRound(t)
+	contract.ChainID = 1
+	chain.emit(t, contract.Address, "ContributionReceived", common.Address{1}, 0, big.NewInt(1))
+
+	repo := newMemoryRepository(contract)
+	New(repo, map[int64]Chain{chain.id: {Client: chain.client, Confirmations: 1}}, 0).Poll(context.Background())
+
+	assert.Empty(t, repo.events)
+	assert.Empty(t, repo.cursors)
+}
+
+func TestIndexer_Reorg(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	contract := chain.deployRound(t)
+
+	alice := common.HexToAddress("0xAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	bob := common.HexToAddress("0xBbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 0, big.NewInt(100))
+	forkParent, err := chain.client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	bobNonce := chain.emit(t, contract.Address, "ContributionReceived", bob, 0, big.NewInt(100))
+
+	repo := newMemoryRepository(contract)
+	ix := New(repo, map[int64]Chain{chain.id: {Client: chain.client, Confirmations: 3}}, 0)
+	ix.Poll(ctx)
+
+	require.Len(t, repo.events[contract.RoundID], 2)
+	assert.False(t, repo.isConfirmed(repo.events[contract.RoundID][1]))
+
+	// Bob's payment is replaced on a longer side chain, so it never happened.
+	require.NoError(t, chain.backend.Fork(forkParent.Hash()))
+	chain.backend.Rollback()
+	chain.sendWithNonce(t, bobNonce, &bob, nil, big.NewInt(params.GWei*2))
+	chain.backend.Commit()
+	chain.backend.Commit()
+	chain.backend.Commit()
+
+	head, err := chain.client.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, forkParent.Number.Uint64()+3, head, "side chain did not become canonical")
+
+	ix.Poll(ctx)
+
+	events := repo.events[contract.RoundID]
+	require.Len(t, events, 1)
+	assert.Equal(t, strings.ToLower(alice.Hex()), events[0].Address)
+	assert.Equal(t, head, repo.cursors[contract.RoundID])
+
+	canonical, err := chain.client.HeaderByNumber(ctx, new(big.Int).SetUint64(head))
+	require.NoError(t, err)
+	assert.Equal(t, canonical.Hash(), repo.blocks[chain.id][head].Hash)
+	_, stale := repo.blocks[chain.id][head+1]
+	assert.False(t, stale)
+
+	// Alice's payment is buried deep enough to be confirmed; a new one is not.
+	assert.True(t, repo.isConfirmed(events[0]))
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 1, big.NewInt(100))
+	ix.Poll(ctx)
+	require.Len(t, repo.events[contract.RoundID], 2)
+	assert.False(t, repo.isConfirmed(repo.events[contract.RoundID][1]))
+
+	chain.backend.Commit()
+	chain.backend.Commit()
+	ix.Poll(ctx)
+	assert.True(t, repo.isConfirmed(repo.events[contract.RoundID][1]))
+}
+
+func TestIndexer_Resync(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	contract := chain.deployRound(t)
+
+	alice := common.HexToAddress("0xAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	bob := common.HexToAddress("0xBbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 0, big.NewInt(100))
+	chain.emit(t, contract.Address, "ContributionReceived", bob, 0, big.NewInt(100))
+
+	repo := newMemoryRepository(contract)
+	ix := New(repo, map[int64]Chain{chain.id: {Client: chain.client, Confirmations: 1}}, 0)
+	ix.Poll(ctx)
+	require.Len(t, repo.events[contract.RoundID], 2)
+	head := repo.cursors[contract.RoundID]
+
+	// Bob's log went missing while the cursor moved past it.
+	missed := repo.events[contract.RoundID][1]
+	repo.events[contract.RoundID] = repo.events[contract.RoundID][:1]
+	delete(repo.seen, eventKey(missed))
+	ix.Poll(ctx)
+	require.Len(t, repo.events[contract.RoundID], 1, "polling alone does not look back")
+
+	require.NoError(t, repo.Resync(ctx, contract, missed.BlockNumber))
+	assert.Equal(t, missed.BlockNumber-1, repo.cursors[contract.RoundID])
+
+	ix.Poll(ctx)
+	events := repo.events[contract.RoundID]
+	require.Len(t, events, 2)
+	assert.Equal(t, strings.ToLower(bob.Hex()), events[1].Address)
+	assert.Equal(t, head, repo.cursors[contract.RoundID])
+}
+
+type fakeDiscoverer struct {
+	err     error
+	created []RoundCreated
+}
+
+func (d *fakeDiscoverer) DiscoverRound(ctx context.Context, created RoundCreated) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.created = append(d.created, created)
+	return nil
+}
+
+func TestIndexer_DiscoversFactoryRounds(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	factory := chain.deployRound(t).Address
+	roundCreated := contracts.FactoryABI.Events["RoundCreated"].ID
+
+	beforeStart := common.HexToAddress("0x00000000000000000000000000000000000000E0")
+	earlier := common.HexToAddress("0x00000000000000000000000000000000000000E1")
+	round := common.HexToAddress("0x00000000000000000000000000000000000000E2")
+	groupID := uuid.New()
+
+	chain.emitRaw(t, factory, roundCreated, common.BytesToHash(beforeStart.Bytes()), contracts.GroupIDTopic(groupID), new(big.Int).SetBytes(chain.from.Bytes()))
+	head, err := chain.client.BlockNumber(ctx)
+	require.NoError(t, err)
+	chain.emitRaw(t, factory, roundCreated, common.BytesToHash(earlier.Bytes()), contracts.GroupIDTopic(groupID), new(big.Int).SetBytes(chain.from.Bytes()))
+
+	repo := newMemoryRepository()
+	discoverer := &fakeDiscoverer{}
+	ix := New(repo, map[int64]Chain{chain.id: {
+		Client:            chain.client,
+		Confirmations:     1,
+		Factories:         []common.Address{factory},
+		FactoryStartBlock: head + 1,
+	}}, 0)
+	ix.SetDiscoverer(discoverer)
+	ix.Poll(ctx)
+	require.Len(t, discoverer.created, 1, "deployments before the first poll are discovered")
+	assert.Equal(t, earlier, discoverer.created[0].Round, "blocks before the start block are not scanned")
+
+	chain.emitRaw(t, factory, roundCreated, common.BytesToHash(round.Bytes()), contracts.GroupIDTopic(groupID), new(big.Int).SetBytes(chain.from.Bytes()))
+
+	discoverer.err = errors.New("database unavailable")
+	ix.Poll(ctx)
+	discoverer.err = nil
+	ix.Poll(ctx)
+
+	require.Len(t, discoverer.created, 2, "a failed discovery is retried")
+	created := discoverer.created[1]
+	assert.Equal(t, chain.id, created.ChainID)
+	assert.Equal(t, factory, created.Factory)
+	assert.Equal(t, round, created.Round)
+	assert.Equal(t, groupID, created.GroupID)
+	assert.Equal(t, chain.from, created.Creator)
+
+	ix.Poll(ctx)
+	assert.Len(t, discoverer.created, 2)
+}
+
+func TestFetchEvents_Backfill(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(t)
+	contract := chain.deployRound(t)
+
+	alice := common.HexToAddress("0xAaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	bob := common.HexToAddress("0xBbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	chain.emit(t, contract.Address, "ContributionReceived", alice, 0, big.NewInt(100))
+	chain.emit(t, contract.Address, "ContributionReceived", bob, 0, big.NewInt(100))
+
+	repo := newMemoryRepository(contract)
+	ix := New(repo, map[int64]Chain{chain.id: {Client: chain.client, Confirmations: 1}}, 0)
+	ix.Poll(ctx)
+	require.Len(t, repo.events[contract.RoundID], 2)
+	head := repo.cursors[contract.RoundID]
+
+	missed := repo.events[contract.RoundID][1]
+	repo.events[contract.RoundID] = repo.events[contract.RoundID][:1]
+	delete(repo.seen, eventKey(missed))
+
+	events, err := FetchEvents(ctx, chain.client, contract, missed.BlockNumber, missed.BlockNumber)
+	require.NoError(t, err)
+	require.Len(t, events, 1, "only the requested range is fetched")
+
+	n, err := repo.ReplaceEvents(ctx, contract, events, missed.BlockNumber, missed.BlockNumber)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	stored := repo.events[contract.RoundID]
+	require.Len(t, stored, 2)
+	assert.Equal(t, strings.ToLower(bob.Hex()), stored[1].Address)
+	assert.Equal(t, head, repo.cursors[contract.RoundID], "the live cursor is left alone")
+}
+
+func TestDecodeLog(t *testing.T) {
+	member := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	log := types.Log{
+		Topics: []common.Hash{
+			contracts.RoundABI.Events["PayoutReleased"].ID,
+			common.BytesToHash(member.Bytes()),
+			common.BigToHash(big.NewInt(3)),
+		},
+		Data:        common.BigToHash(big.NewInt(500)).Bytes(),
+		BlockNumber: 12,
+		Index:       4,
+	}
+
+	event, err := decodeLog(log)
+	require.NoError(t, err)
+	assert.Equal(t, EventPayout, event.Type)
+	assert.Equal(t, strings.ToLower(member.Hex()), event.Address)
+	assert.Equal(t, uint64(3), event.Period)
+	assert.Equal(t, "500", event.Amount.String())
+	assert.Equal(t, uint64(12), event.BlockNumber)
+	assert.Equal(t, uint(4), event.LogIndex)
+
+	log.Topics[0] = common.Hash{1}
+	_, err = decodeLog(log)
+	assert.Error(t, err)
+}
+
+func TestDecodeLog_Swap(t *testing.T) {
+	member := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	counterparty := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	log := types.Log{
+		Topics: []common.Hash{
+			contracts.RoundABI.Events["SlotsSwapped"].ID,
+			common.BytesToHash(member.Bytes()),
+			common.BytesToHash(counterparty.Bytes()),
+		},
+		Data:        common.BigToHash(big.NewInt(2)).Bytes(),
+		BlockNumber: 30,
+		Index:       1,
+	}
+
+	event, err := decodeLog(log)
+	require.NoError(t, err)
+	assert.Equal(t, EventSwap, event.Type)
+	assert.Equal(t, strings.ToLower(member.Hex()), event.Address)
+	assert.Equal(t, strings.ToLower(counterparty.Hex()), event.Counterparty)
+	assert.Equal(t, uint64(2), event.Period)
+	assert.Equal(t, "0", event.Amount.String())
+}
+
+type testChain struct {
+	id      int64
+	backend *simulated.Backend
+	client  simulated.Client
+	key     *ecdsa.PrivateKey
+	from    common.Address
+	signer  types.Signer
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	client := backend.Client()
+	chainID, err := client.ChainID(context.Background())
+	require.NoError(t, err)
+
+	return &testChain{
+		id:      chainID.Int64(),
+		backend: backend,
+		client:  client,
+		key:     key,
+		from:    from,
+		signer:  types.LatestSignerForChainID(chainID),
+	}
+}
+
+func (c *testChain) deployRound(t *testing.T) Contract {
+	t.Helper()
+	nonce := c.send(t, nil, logEmitterCode)
+	return Contract{
+		RoundID: uuid.New(),
+		ChainID: c.id,
+		Address: crypto.CreateAddress(c.from, nonce),
+	}
+}
+
+func (c *testChain) emit(t *testing.T, contract common.Address, event string, who common.Address, period int64, amount *big.Int) uint64 {
+	t.Helper()
+	return c.emitRaw(t, contract, contracts.RoundABI.Events[event].ID, common.BytesToHash(who.Bytes()), common.BigToHash(big.NewInt(period)), amount)
+}
+
+func (c *testChain) emitRaw(t *testing.T, contract common.Address, topic0, topic1, topic2 common.Hash, data *big.Int) uint64 {
+	t.Helper()
+	calldata := append(append(append(topic0.Bytes(), topic1.Bytes()...), topic2.Bytes()...), common.BigToHash(data).Bytes()...)
+	return c.send(t, &contract, calldata)
+}
+
+// send mines a transaction in its own block and returns the nonce it used.
+func (c *testChain) send(t *testing.T, to *common.Address, data []byte) uint64 {
+	t.Helper()
+	ctx := context.Background()
+
+	nonce, err := c.client.PendingNonceAt(ctx, c.from)
+	require.NoError(t, err)
+	tx := c.sendWithNonce(t, nonce, to, data, big.NewInt(params.GWei))
+	c.backend.Commit()
+
+	receipt, err := c.client.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	return nonce
+}
+
+// sendWithNonce submits a transaction without mining it. A higher tip
+// replaces a pending transaction with the same nonce.
+func (c *testChain) sendWithNonce(t *testing.T, nonce uint64, to *common.Address, data []byte, tip *big.Int) *types.Transaction {
+	t.Helper()
+	tx, err := types.SignNewTx(c.key, c.signer, &types.DynamicFeeTx{
+		Nonce:     nonce,
+		To:        to,
+		Gas:       200_000,
+		GasFeeCap: new(big.Int).Mul(tip, big.NewInt(10)),
+		GasTipCap: tip,
+		Data:      data,
+	})
+	require.NoError(t, err)
+	require.NoError(t, c.client.SendTransaction(context.Background(), tx))
+	return tx
+}
+
+// recordingClient records the first block of every log query.
+type recordingClient struct {
+	ChainClient
+	from []uint64
+}
+
+func (c *recordingClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.from = append(c.from, q.FromBlock.Uint64())
+	return c.ChainClient.FilterLogs(ctx, q)
+}
+
+// memoryRepository mirrors the Postgres repository: events are unique by
+// (tx hash, log index).
+type memoryRepository struct {
+	mu        sync.Mutex
+	contracts []Contract
+	events    map[uuid.UUID][]Event
+	cursors   map[uuid.UUID]uint64
+	seen      map[string]bool
+	confirmed map[string]bool
+	blocks    map[int64]map[uint64]Block
+}
+
+func newMemoryRepository(contracts ...Contract) *memoryRepository {
+	return &memoryRepository{
+		contracts: contracts,
+		events:    map[uuid.UUID][]Event{},
+		cursors:   map[uuid.UUID]uint64{},
+		seen:      map[string]bool{},
+		confirmed: map[string]bool{},
+		blocks:    map[int64]map[uint64]Block{},
+	}
+}
+
+func eventKey(event Event) string {
+	return fmt.Sprintf("%s:%d", event.TxHash.Hex(), event.LogIndex)
+}
+
+func (r *memoryRepository) isConfirmed(event Event) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.confirmed[eventKey(event)]
+}
+
+func (r *memoryRepository) ListContracts(ctx context.Context) ([]Contract, error) {
+	return r.contracts, nil
+}
+
+func (r *memoryRepository) GetCursor(ctx context.Context, contract Contract) (uint64, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last, ok := r.cursors[contract.RoundID]
+	return last, ok, nil
+}
+
+func (r *memoryRepository) SaveEvents(ctx context.Context, contract Contract, events []Event, lastBlock uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, event := range events {
+		if r.seen[eventKey(event)] {
+			continue
+		}
+		r.seen[eventKey(event)] = true
+		r.events[contract.RoundID] = append(r.events[contract.RoundID], event)
+	}
+	sort.SliceStable(r.events[contract.RoundID], func(i, j int) bool {
+		return r.events[contract.RoundID][i].BlockNumber < r.events[contract.RoundID][j].BlockNumber
+	})
+	r.cursors[contract.RoundID] = lastBlock
+	return nil
+}
+
+func (r *memoryRepository) LatestBlock(ctx context.Context, chainID int64) (Block, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var latest Block
+	found := false
+	for number, block := range r.blocks[chainID] {
+		if !found || number > latest.Number {
+			latest, found = block, true
+		}
+	}
+	return latest, found, nil
+}
+
+func (r *memoryRepository) GetBlock(ctx context.Context, chainID int64, number uint64) (Block, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	block, ok := r.blocks[chainID][number]
+	return block, ok, nil
+}
+
+func (r *memoryRepository) SaveBlocks(ctx context.Context, chainID int64, blocks []Block, pruneBelow uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.blocks[chainID] == nil {
+		r.blocks[chainID] = map[uint64]Block{}
+	}
+	for _, block := range blocks {
+		r.blocks[chainID][block.Number] = block
+	}
+	for number := range r.blocks[chainID] {
+		if number < pruneBelow {
+			delete(r.blocks[chainID], number)
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) Rollback(ctx context.Context, chainID int64, fork uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, contract := range r.contracts {
+		if contract.ChainID != chainID {
+			continue
+		}
+		kept := []Event{}
+		for _, event := range r.events[contract.RoundID] {
+			if event.BlockNumber > fork {
+				delete(r.seen, eventKey(event))
+				delete(r.confirmed, eventKey(event))
+				continue
+			}
+			kept = append(kept, event)
+		}
+		r.events[contract.RoundID] = kept
+		if last, ok := r.cursors[contract.RoundID]; ok && last > fork {
+			r.cursors[contract.RoundID] = fork
+		}
+	}
+	for number := range r.blocks[chainID] {
+		if number > fork {
+			delete(r.blocks[chainID], number)
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepository) Resync(ctx context.Context, contract Contract, from uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := []Event{}
+	for _, event := range r.events[contract.RoundID] {
+		if event.BlockNumber >= from {
+			delete(r.seen, eventKey(event))
+			delete(r.confirmed, eventKey(event))
+			continue
+		}
+		kept = append(kept, event)
+	}
+	r.events[contract.RoundID] = kept
+	if from == 0 {
+		delete(r.cursors, contract.RoundID)
+	} else if last, ok := r.cursors[contract.RoundID]; ok && last >= from {
+		r.cursors[contract.RoundID] = from - 1
+	}
+	return nil
+}
+
+func (r *memoryRepository) ReplaceEvents(ctx context.Context, contract Contract, events []Event, from, to uint64) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := []Event{}
+	for _, event := range r.events[contract.RoundID] {
+		if event.BlockNumber >= from && event.BlockNumber <= to {
+			delete(r.seen, eventKey(event))
+			continue
+		}
+		kept = append(kept, event)
+	}
+	for _, event := range events {
+		r.seen[eventKey(event)] = true
+		kept = append(kept, event)
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		return kept[i].BlockNumber < kept[j].BlockNumber
+	})
+	r.events[contract.RoundID] = kept
+	return len(events), nil
+}
+
+func (r *memoryRepository) ConfirmEvents(ctx context.Context, chainID int64, upTo uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, contract := range r.contracts {
+		if contract.ChainID != chainID {
+			continue
+		}
+		for _, event := range r.events[contract.RoundID] {
+			if event.BlockNumber <= upTo {
+				r.confirmed[eventKey(event)] = true
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+// minTrackedBlocks is the smallest number of recent headers kept per chain,
+// which bounds the deepest reorg that can be rolled back precisely.
+const minTrackedBlocks uint64 = 64
+
+// syncBlocks tracks the headers between the last tracked block and the
+// current head. When a header's parent hash does not match the tracked
+// predecessor, the chain was reorganized: the fork point is located and
+// everything indexed above it is rolled back. It returns the head that is
+// safe to index up to.
+func (ix *Indexer) syncBlocks(ctx context.Context, chainID int64, chain Chain) (uint64, error) {
+	head, err := chain.Client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get chain head: %w", err)
+	}
+
+	window := max(2*chain.Confirmations, minTrackedBlocks)
+	start := uint64(0)
+	if head >= window {
+		start = head - window + 1
+	}
+
+	latest, ok, err := ix.repo.LatestBlock(ctx, chainID)
+	if err != nil {
+		return 0, fmt.Errorf("get latest block: %w", err)
+	}
+
+	var parent *Block
+	if ok {
+		switch {
+		case latest.Number > head:
+			// The chain got shorter, which only happens when it was replaced.
+			if err := ix.rollback(ctx, chainID, chain.Client, head); err != nil {
+				return 0, err
+			}
+			return ix.syncBlocks(ctx, chainID, chain)
+		case latest.Number == head:
+			return head, nil
+		case latest.Number+1 >= start:
+			start = latest.Number + 1
+			parent = &latest
+		}
+	}
+
+	blocks := make([]Block, 0, head-start+1)
+	for number := start; number <= head; number++ {
+		header, err := chain.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return 0, fmt.Errorf("get block %d: %w", number, err)
+		}
+		block := blockFromHeader(header)
+
+		if parent != nil && block.ParentHash != parent.Hash {
+			if len(blocks) > 0 {
+				// The reorg happened while we were walking new blocks.
+				return 0, fmt.Errorf("block %d changed while tracking", number)
+			}
+			if err := ix.rollback(ctx, chainID, chain.Client, parent.Number); err != nil {
+				return 0, err
+			}
+			// Track the new branch from the fork point.
+			return ix.syncBlocks(ctx, chainID, chain)
+		}
+
+		blocks = append(blocks, block)
+		parent = &blocks[len(blocks)-1]
+	}
+
+	pruneBelow := uint64(0)
+	if head >= window {
+		pruneBelow = head - window + 1
+	}
+	if err := ix.repo.SaveBlocks(ctx, chainID, blocks, pruneBelow); err != nil {
+		return 0, fmt.Errorf("save blocks: %w", err)
+	}
+	return head, nil
+}
+
+// rollback walks back from block number until the tracked hash matches the
+// canonical chain and rolls everything above that fork point back.
+func (ix *Indexer) rollback(ctx context.Context, chainID int64, client ChainClient, number uint64) error {
+	fork := number
+	for {
+		tracked, ok, err := ix.repo.GetBlock(ctx, chainID, fork)
+		if err != nil {
+			return fmt.Errorf("get tracked block %d: %w", fork, err)
+		}
+		if !ok {
+			log.Warn().
+				Int64("chain_id", chainID).
+				Uint64("block", fork).
+				Msg("Reorg is deeper than the tracked blocks")
+			break
+		}
+
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(fork))
+		if err != nil {
+			return fmt.Errorf("get block %d: %w", fork, err)
+		}
+		if header.Hash() == tracked.Hash || fork == 0 {
+			break
+		}
+		fork--
+	}
+
+	log.Warn().
+		Int64("chain_id", chainID).
+		Uint64("fork_block", fork).
+		Uint64("tracked_block", number).
+		Msg("Chain reorganization detected, rolling back")
+
+	if err := ix.repo.Rollback(ctx, chainID, fork); err != nil {
+		return fmt.Errorf("rollback to block %d: %w", fork, err)
+	}
+	return nil
+}
+
+func blockFromHeader(header *types.Header) Block {
+	return Block{
+		Number:     header.Number.Uint64(),
+		Hash:       header.Hash(),
+		ParentHash: header.ParentHash,
+	}
+}
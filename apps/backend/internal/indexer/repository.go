@@ -0,0 +1,538 @@
+package indexer
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/ledger"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// Invalidator is told when committed writes changed data that caches are
+// built from, so they can be dropped. Besides the indexer, the group and
+// round services use it.
+type Invalidator interface {
+	Invalidate(ctx context.Context) error
+}
+
+// StatusSyncer moves a round along its lifecycle from its indexed events.
+// It runs inside the transaction that stored them. Rollbacks do not undo
+// transitions: the canonical chain normally carries the same transactions,
+// which are indexed again.
+type StatusSyncer interface {
+	SyncStatus(ctx context.Context, q sqlc.Querier, roundID uuid.UUID) error
+}
+
+type pgRepository struct {
+	store       db.Store
+	invalidator Invalidator
+	statuses    StatusSyncer
+}
+
+// NewRepository returns a Repository backed by Postgres. The invalidator and
+// status syncer may be nil.
+func NewRepository(store db.Store, invalidator Invalidator, statuses StatusSyncer) Repository {
+	return &pgRepository{store: store, invalidator: invalidator, statuses: statuses}
+}
+
+func (r *pgRepository) ListContracts(ctx context.Context) ([]Contract, error) {
+	rounds, err := r.store.ListIndexableRounds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]Contract, 0, len(rounds))
+	for _, round := range rounds {
+		if !common.IsHexAddress(round.ContractAddress) {
+			log.Warn().
+				Str("round_id", round.ID.String()).
+				Str("contract_address", round.ContractAddress).
+				Msg("Skipping round with invalid contract address")
+			continue
+		}
+		contracts = append(contracts, Contract{
+			RoundID:    round.ID,
+			ChainID:    round.ChainID,
+			Address:    common.HexToAddress(round.ContractAddress),
+			StartBlock: uint64(round.StartBlock),
+		})
+	}
+	return contracts, nil
+}
+
+func (r *pgRepository) GetCursor(ctx context.Context, contract Contract) (uint64, bool, error) {
+	last, err := r.store.GetIndexerCursor(ctx, sqlc.GetIndexerCursorParams{
+		ChainID:         contract.ChainID,
+		ContractAddress: contractKey(contract),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return uint64(last), true, nil
+}
+
+func (r *pgRepository) SaveEvents(ctx context.Context, contract Contract, events []Event, lastBlock uint64) error {
+	pgxStore, ok := r.store.(*db.PGXStore)
+	if !ok {
+		return errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgxStore.Queries.WithTx(tx)
+
+	inserted, err := insertEvents(ctx, qtx, contract, events)
+	if err != nil {
+		return err
+	}
+
+	if inserted > 0 {
+		if err := r.syncRound(ctx, qtx, contract.RoundID); err != nil {
+			return err
+		}
+	}
+
+	if err := qtx.UpsertIndexerCursor(ctx, sqlc.UpsertIndexerCursorParams{
+		ChainID:         contract.ChainID,
+		ContractAddress: contractKey(contract),
+		LastBlock:       int64(lastBlock),
+	}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	if inserted > 0 {
+		r.invalidate(ctx)
+	}
+	return nil
+}
+
+func (r *pgRepository) LatestBlock(ctx context.Context, chainID int64) (Block, bool, error) {
+	block, err := r.store.GetLatestChainBlock(ctx, chainID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Block{}, false, nil
+		}
+		return Block{}, false, err
+	}
+	return blockFromRow(block), true, nil
+}
+
+func (r *pgRepository) GetBlock(ctx context.Context, chainID int64, number uint64) (Block, bool, error) {
+	block, err := r.store.GetChainBlock(ctx, sqlc.GetChainBlockParams{
+		ChainID:     chainID,
+		BlockNumber: int64(number),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Block{}, false, nil
+		}
+		return Block{}, false, err
+	}
+	return blockFromRow(block), true, nil
+}
+
+func (r *pgRepository) SaveBlocks(ctx context.Context, chainID int64, blocks []Block, pruneBelow uint64) error {
+	pgxStore, ok := r.store.(*db.PGXStore)
+	if !ok {
+		return errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgxStore.Queries.WithTx(tx)
+
+	for _, block := range blocks {
+		if err := qtx.UpsertChainBlock(ctx, sqlc.UpsertChainBlockParams{
+			ChainID:     chainID,
+			BlockNumber: int64(block.Number),
+			BlockHash:   block.Hash.Hex(),
+			ParentHash:  block.ParentHash.Hex(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := qtx.DeleteChainBlocksBefore(ctx, sqlc.DeleteChainBlocksBeforeParams{
+		ChainID:     chainID,
+		BlockNumber: int64(pruneBelow),
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *pgRepository) Rollback(ctx context.Context, chainID int64, fork uint64) error {
+	pgxStore, ok := r.store.(*db.PGXStore)
+	if !ok {
+		return errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgxStore.Queries.WithTx(tx)
+
+	roundIDs, err := qtx.DeleteChainRoundEventsAfter(ctx, sqlc.DeleteChainRoundEventsAfterParams{
+		ChainID:     chainID,
+		BlockNumber: int64(fork),
+	})
+	if err != nil {
+		return err
+	}
+
+	synced := map[uuid.UUID]bool{}
+	for _, roundID := range roundIDs {
+		if synced[roundID] {
+			continue
+		}
+		synced[roundID] = true
+		if err := syncRoundTotals(ctx, qtx, roundID); err != nil {
+			return err
+		}
+	}
+
+	if err := qtx.RewindIndexerCursors(ctx, sqlc.RewindIndexerCursorsParams{
+		ChainID:   chainID,
+		LastBlock: int64(fork),
+	}); err != nil {
+		return err
+	}
+
+	if err := qtx.DeleteChainBlocksAfter(ctx, sqlc.DeleteChainBlocksAfterParams{
+		ChainID:     chainID,
+		BlockNumber: int64(fork),
+	}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	if len(synced) > 0 {
+		r.invalidate(ctx)
+	}
+
+	log.Info().
+		Int64("chain_id", chainID).
+		Uint64("fork_block", fork).
+		Int("rounds", len(synced)).
+		Msg("Rolled back reorganized round events")
+	return nil
+}
+
+func (r *pgRepository) Resync(ctx context.Context, contract Contract, from uint64) error {
+	pgxStore, ok := r.store.(*db.PGXStore)
+	if !ok {
+		return errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgxStore.Queries.WithTx(tx)
+
+	if err := qtx.DeleteRoundEventsFrom(ctx, sqlc.DeleteRoundEventsFromParams{
+		RoundID:     contract.RoundID,
+		BlockNumber: int64(from),
+	}); err != nil {
+		return err
+	}
+	if err := syncRoundTotals(ctx, qtx, contract.RoundID); err != nil {
+		return err
+	}
+
+	if from == 0 {
+		err = qtx.DeleteIndexerCursor(ctx, sqlc.DeleteIndexerCursorParams{
+			ChainID:         contract.ChainID,
+			ContractAddress: contractKey(contract),
+		})
+	} else {
+		err = qtx.RewindIndexerCursor(ctx, sqlc.RewindIndexerCursorParams{
+			ChainID:         contract.ChainID,
+			ContractAddress: contractKey(contract),
+			LastBlock:       int64(from - 1),
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	r.invalidate(ctx)
+
+	log.Info().
+		Str("round_id", contract.RoundID.String()).
+		Int64("chain_id", contract.ChainID).
+		Uint64("from_block", from).
+		Msg("Reset round events for re-indexing")
+	return nil
+}
+
+func (r *pgRepository) ReplaceEvents(ctx context.Context, contract Contract, events []Event, from, to uint64) (int, error) {
+	pgxStore, ok := r.store.(*db.PGXStore)
+	if !ok {
+		return 0, errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgxStore.Queries.WithTx(tx)
+
+	// Taken before reading the stored events, so the live indexer cannot
+	// change them until this commits.
+	if err := qtx.LockRoundEvents(ctx, contract.RoundID); err != nil {
+		return 0, err
+	}
+
+	stored, err := qtx.ListRoundEventsBetween(ctx, sqlc.ListRoundEventsBetweenParams{
+		RoundID:   contract.RoundID,
+		FromBlock: int64(from),
+		ToBlock:   int64(to),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	fetched := make(map[string]Event, len(events))
+	for _, event := range events {
+		fetched[logKey(event.TxHash.Hex(), int32(event.LogIndex))] = event
+	}
+	var stale []uuid.UUID
+	for _, row := range stored {
+		event, ok := fetched[logKey(row.TxHash, row.LogIndex)]
+		if !ok || !sameEvent(row, event) {
+			stale = append(stale, row.ID)
+		}
+	}
+
+	// Stale events are removed and journaled as such before their
+	// replacements are inserted, so the ledger reverses their entries.
+	if len(stale) > 0 {
+		if err := qtx.DeleteRoundEventsByID(ctx, stale); err != nil {
+			return 0, err
+		}
+		if err := syncRoundTotals(ctx, qtx, contract.RoundID); err != nil {
+			return 0, err
+		}
+	}
+
+	inserted, err := insertEvents(ctx, qtx, contract, events)
+	if err != nil {
+		return 0, err
+	}
+
+	if inserted > 0 || len(stale) > 0 {
+		if err := r.syncRound(ctx, qtx, contract.RoundID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	if inserted > 0 || len(stale) > 0 {
+		r.invalidate(ctx)
+		log.Info().
+			Str("round_id", contract.RoundID.String()).
+			Uint64("from_block", from).
+			Uint64("to_block", to).
+			Int64("inserted", inserted).
+			Int("removed", len(stale)).
+			Msg("Replaced round events")
+	}
+	return int(inserted), nil
+}
+
+func (r *pgRepository) ConfirmEvents(ctx context.Context, chainID int64, upTo uint64) error {
+	confirmed, err := r.store.ConfirmChainRoundEvents(ctx, sqlc.ConfirmChainRoundEventsParams{
+		ChainID:     chainID,
+		BlockNumber: int64(upTo),
+	})
+	if err != nil {
+		return err
+	}
+
+	if confirmed > 0 {
+		r.invalidate(ctx)
+	}
+	return nil
+}
+
+// invalidate drops caches after a write. Failures are logged rather than
+// returned: the write is committed, and caches expire on their own.
+func (r *pgRepository) invalidate(ctx context.Context) {
+	if r.invalidator == nil {
+		return
+	}
+	if err := r.invalidator.Invalidate(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to invalidate caches after indexing")
+	}
+}
+
+// insertEvents stores events that are not stored yet and returns how many
+// were.
+func insertEvents(ctx context.Context, qtx *sqlc.Queries, contract Contract, events []Event) (int64, error) {
+	var inserted int64
+	for _, event := range events {
+		var counterparty *string
+		if event.Counterparty != "" {
+			counterparty = &event.Counterparty
+		}
+		n, err := qtx.InsertRoundEvent(ctx, sqlc.InsertRoundEventParams{
+			RoundID:      contract.RoundID,
+			EventType:    event.Type,
+			Address:      event.Address,
+			Period:       int64(event.Period),
+			Amount:       event.Amount.String(),
+			BlockNumber:  int64(event.BlockNumber),
+			BlockHash:    event.BlockHash.Hex(),
+			TxHash:       event.TxHash.Hex(),
+			LogIndex:     int32(event.LogIndex),
+			BlockTime:    pgtype.Timestamptz{Time: event.BlockTime, Valid: true},
+			Counterparty: counterparty,
+		})
+		if err != nil {
+			return 0, err
+		}
+		inserted += n
+	}
+	return inserted, nil
+}
+
+// sameEvent reports whether a stored event matches a decoded one.
+func sameEvent(row sqlc.RoundEvent, event Event) bool {
+	counterparty := ""
+	if row.Counterparty != nil {
+		counterparty = *row.Counterparty
+	}
+	return row.EventType == event.Type &&
+		row.Address == event.Address &&
+		counterparty == event.Counterparty &&
+		row.Period == int64(event.Period) &&
+		row.Amount == event.Amount.String() &&
+		row.BlockNumber == int64(event.BlockNumber) &&
+		strings.EqualFold(row.BlockHash, event.BlockHash.Hex())
+}
+
+func logKey(txHash string, logIndex int32) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(txHash), logIndex)
+}
+
+// syncRound brings a round's totals and status in line with its events
+// after new ones were stored.
+func (r *pgRepository) syncRound(ctx context.Context, qtx *sqlc.Queries, roundID uuid.UUID) error {
+	if err := syncRoundTotals(ctx, qtx, roundID); err != nil {
+		return err
+	}
+	if r.statuses != nil {
+		return r.statuses.SyncStatus(ctx, qtx, roundID)
+	}
+	return nil
+}
+
+// syncRoundTotals recomputes a round's read-model counters from its indexed
+// events after events were inserted or rolled back, and journals the change.
+// It holds the round's lock until the transaction ends, so concurrent
+// writers recompute one after the other.
+func syncRoundTotals(ctx context.Context, qtx *sqlc.Queries, roundID uuid.UUID) error {
+	if err := qtx.LockRoundEvents(ctx, roundID); err != nil {
+		return err
+	}
+	if err := qtx.SyncRoundMemberTotals(ctx, roundID); err != nil {
+		return err
+	}
+	if err := qtx.SyncRoundTotals(ctx, roundID); err != nil {
+		return err
+	}
+	if err := qtx.DeleteRoundPeriodTotals(ctx, roundID); err != nil {
+		return err
+	}
+	if err := qtx.InsertRoundPeriodTotals(ctx, roundID); err != nil {
+		return err
+	}
+	if err := syncMemberPositions(ctx, qtx, roundID); err != nil {
+		return err
+	}
+	if err := ledger.Sync(ctx, qtx, roundID); err != nil {
+		return err
+	}
+	return qtx.MarkSlotSwapsApplied(ctx, roundID)
+}
+
+// syncMemberPositions replays the round's indexed slot swaps over the
+// registered payout order, so positions stay right when swap events are
+// indexed again or rolled back.
+func syncMemberPositions(ctx context.Context, qtx *sqlc.Queries, roundID uuid.UUID) error {
+	if err := qtx.ResetRoundMemberPositions(ctx, roundID); err != nil {
+		return err
+	}
+
+	swaps, err := qtx.ListRoundSwapEvents(ctx, roundID)
+	if err != nil {
+		return err
+	}
+	for _, swap := range swaps {
+		if swap.Counterparty == nil {
+			continue
+		}
+		if err := qtx.SwapRoundMemberPositions(ctx, sqlc.SwapRoundMemberPositionsParams{
+			RoundID:      roundID,
+			Member:       swap.Address,
+			Counterparty: *swap.Counterparty,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func blockFromRow(row sqlc.ChainBlock) Block {
+	return Block{
+		Number:     uint64(row.BlockNumber),
+		Hash:       common.HexToHash(row.BlockHash),
+		ParentHash: common.HexToHash(row.ParentHash),
+	}
+}
+
+// contractKey is the lowercase hex address used to key cursors.
+func contractKey(contract Contract) string {
+	return strings.ToLower(contract.Address.Hex())
+}
@@ -0,0 +1,287 @@
+// Package ledger keeps a double-entry journal of the money moving through a
+// round, so "who owes what" and "how much has the pot paid out" are read
+// from account balances instead of being recounted from events.
+//
+// Every round has a pot account holding what the contract holds, and each
+// member has a contributed, a received and a fees account. Postings are
+// signed base units of the round's token: debits are positive, credits
+// negative, and every entry sums to zero.
+//
+//	contribution  Dr pot               Cr contributed(member)
+//	payout        Dr received(member)  Cr pot
+//	fee           Dr fees(member)      Cr pot
+//
+// Sync also keeps each account's balance on the account, so reads use
+// LoadBalances. Replaying the journal with Load is left to reconciliation
+// and to tests that check the two agree.
+//
+// The Round contract does not charge fees yet, so indexed rounds have no fee
+// entries.
+package ledger
+
+import (
+	"circa/internal/errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Account kinds.
+const (
+	AccountPot         = "pot"
+	AccountContributed = "contributed"
+	AccountReceived    = "received"
+	AccountFees        = "fees"
+)
+
+// Entry kinds. Contributions and payouts share their names with the indexed
+// event types they are posted from.
+const (
+	EntryContribution = "contribution"
+	EntryPayout       = "payout"
+	EntryFee          = "fee"
+)
+
+// Account is one of a round's accounts. Member is the lowercase address the
+// account belongs to, and empty for the pot.
+type Account struct {
+	Kind   string
+	Member string
+}
+
+// Pot returns the round's pot account.
+func Pot() Account {
+	return Account{Kind: AccountPot}
+}
+
+// Posting moves Amount into (debit, positive) or out of (credit, negative)
+// an account.
+type Posting struct {
+	Account Account
+	Amount  *big.Int
+}
+
+// Entry is an immutable journal entry. Entries posted from an indexed event
+// carry its transaction hash and log index. Reverses is set on entries that
+// cancel an earlier one whose event was dropped by a reorg.
+type Entry struct {
+	Kind       string
+	Period     int64
+	TxHash     string
+	LogIndex   int32
+	OccurredAt time.Time
+	Reverses   *uuid.UUID
+	Postings   []Posting
+}
+
+// Contribution records a member paying amount into the pot.
+func Contribution(member string, amount *big.Int) []Posting {
+	return transfer(Pot(), Account{Kind: AccountContributed, Member: member}, amount)
+}
+
+// Payout records the pot paying amount out to a member.
+func Payout(member string, amount *big.Int) []Posting {
+	return transfer(Account{Kind: AccountReceived, Member: member}, Pot(), amount)
+}
+
+// Fee records amount kept from the pot as a fee charged to a member.
+func Fee(member string, amount *big.Int) []Posting {
+	return transfer(Account{Kind: AccountFees, Member: member}, Pot(), amount)
+}
+
+func transfer(debit, credit Account, amount *big.Int) []Posting {
+	return []Posting{
+		{Account: debit, Amount: new(big.Int).Set(amount)},
+		{Account: credit, Amount: new(big.Int).Neg(amount)},
+	}
+}
+
+// FromEvent returns the postings for an indexed round event, or false for
+// events that move no funds.
+func FromEvent(eventType, member, amount string) ([]Posting, bool, error) {
+	var postings func(string, *big.Int) []Posting
+	switch eventType {
+	case EntryContribution:
+		postings = Contribution
+	case EntryPayout:
+		postings = Payout
+	default:
+		return nil, false, nil
+	}
+
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, false, fmt.Errorf("invalid %s amount %q", eventType, amount)
+	}
+	return postings(member, value), true, nil
+}
+
+// Validate checks that an entry moves funds between at least two accounts
+// and that its debits equal its credits.
+func (e Entry) Validate() error {
+	if len(e.Postings) < 2 {
+		return errors.ErrUnbalancedEntry
+	}
+	sum := new(big.Int)
+	for _, p := range e.Postings {
+		if p.Amount == nil {
+			return errors.ErrUnbalancedEntry
+		}
+		sum.Add(sum, p.Amount)
+	}
+	if sum.Sign() != 0 {
+		return errors.ErrUnbalancedEntry
+	}
+	return nil
+}
+
+// Reversal returns the entry that cancels e, posted at time at.
+func (e Entry) Reversal(id uuid.UUID, at time.Time) Entry {
+	reversal := e
+	reversal.OccurredAt = at
+	reversal.Reverses = &id
+	reversal.Postings = make([]Posting, len(e.Postings))
+	for i, p := range e.Postings {
+		reversal.Postings[i] = Posting{Account: p.Account, Amount: new(big.Int).Neg(p.Amount)}
+	}
+	return reversal
+}
+
+// Balances are account balances: positive for a net debit.
+type Balances map[Account]*big.Int
+
+// Balance returns an account's balance, zero for an account without one.
+func (b Balances) Balance(a Account) *big.Int {
+	if balance, ok := b[a]; ok {
+		return new(big.Int).Set(balance)
+	}
+	return new(big.Int)
+}
+
+// Members returns every member with an account, sorted.
+func (b Balances) Members() []string {
+	seen := map[string]bool{}
+	members := []string{}
+	for a := range b {
+		if a.Member != "" && !seen[a.Member] {
+			seen[a.Member] = true
+			members = append(members, a.Member)
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+// Position derives a member's position from their account balances.
+func (b Balances) Position(member string) Position {
+	return Position{
+		Contributed: new(big.Int).Neg(b.Balance(Account{Kind: AccountContributed, Member: member})),
+		Received:    b.Balance(Account{Kind: AccountReceived, Member: member}),
+		Fees:        b.Balance(Account{Kind: AccountFees, Member: member}),
+	}
+}
+
+// Totals sums the positions of every member.
+func (b Balances) Totals() Position {
+	total := Position{Contributed: new(big.Int), Received: new(big.Int), Fees: new(big.Int)}
+	for _, member := range b.Members() {
+		p := b.Position(member)
+		total.Contributed.Add(total.Contributed, p.Contributed)
+		total.Received.Add(total.Received, p.Received)
+		total.Fees.Add(total.Fees, p.Fees)
+	}
+	return total
+}
+
+// Journal is an append-only list of entries with the balances they add up
+// to.
+type Journal struct {
+	entries  []Entry
+	balances Balances
+}
+
+func NewJournal() *Journal {
+	return &Journal{balances: Balances{}}
+}
+
+// Post appends an entry after checking that it balances.
+func (j *Journal) Post(e Entry) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+	postings := make([]Posting, len(e.Postings))
+	for i, p := range e.Postings {
+		postings[i] = Posting{Account: p.Account, Amount: new(big.Int).Set(p.Amount)}
+		balance, ok := j.balances[p.Account]
+		if !ok {
+			balance = new(big.Int)
+			j.balances[p.Account] = balance
+		}
+		balance.Add(balance, p.Amount)
+	}
+	e.Postings = postings
+	j.entries = append(j.entries, e)
+	return nil
+}
+
+// Entries returns the posted entries in order.
+func (j *Journal) Entries() []Entry {
+	return append([]Entry(nil), j.entries...)
+}
+
+// Balances returns a copy of the balances the entries add up to.
+func (j *Journal) Balances() Balances {
+	balances := make(Balances, len(j.balances))
+	for a, balance := range j.balances {
+		balances[a] = new(big.Int).Set(balance)
+	}
+	return balances
+}
+
+// Balance returns an account's balance: positive for a net debit.
+func (j *Journal) Balance(a Account) *big.Int {
+	return j.balances.Balance(a)
+}
+
+// Members returns every member with an account, sorted.
+func (j *Journal) Members() []string {
+	return j.balances.Members()
+}
+
+// Position derives a member's position from their account balances.
+func (j *Journal) Position(member string) Position {
+	return j.balances.Position(member)
+}
+
+// Totals sums the positions of every member.
+func (j *Journal) Totals() Position {
+	return j.balances.Totals()
+}
+
+// Position is what a member has put into and taken out of a round.
+type Position struct {
+	Contributed *big.Int
+	Received    *big.Int
+	Fees        *big.Int
+}
+
+// Net is what the pot still owes the member, or negative what the member
+// has taken out beyond what they put in.
+func (p Position) Net() *big.Int {
+	net := new(big.Int).Sub(p.Contributed, p.Received)
+	return net.Sub(net, p.Fees)
+}
+
+// Arrears is how far a member's contributions are behind dueCount
+// contributions of amount each. It is never negative.
+func (p Position) Arrears(dueCount int64, amount *big.Int) *big.Int {
+	arrears := new(big.Int).Mul(amount, big.NewInt(dueCount))
+	arrears.Sub(arrears, p.Contributed)
+	if arrears.Sign() < 0 {
+		return new(big.Int)
+	}
+	return arrears
+}
@@ -0,0 +1,203 @@
+package ledger
+
+import (
+	circaerrors "circa/internal/errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// completedRound is a randomly generated round played to the end: every
+// member contributes in every period, in a random order, and each period's
+// recipient is paid the pot less a random fee. Some entries are reversed
+// and posted again, as happens when a reorg drops and re-includes events.
+type completedRound struct {
+	Members      []string
+	Contribution *big.Int
+	Entries      []Entry
+}
+
+func (completedRound) Generate(r *rand.Rand, _ int) reflect.Value {
+	round := completedRound{
+		Contribution: big.NewInt(r.Int63n(1_000_000_000) + 1),
+	}
+	n := r.Intn(8) + 2
+	for i := range n {
+		round.Members = append(round.Members, fmt.Sprintf("0x%040x", i+1))
+	}
+
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	post := func(kind string, period int, postings []Posting) {
+		at = at.Add(time.Minute)
+		entry := Entry{Kind: kind, Period: int64(period), TxHash: fmt.Sprintf("0x%x", at.Unix()), OccurredAt: at, Postings: postings}
+		round.Entries = append(round.Entries, entry)
+		if r.Intn(4) == 0 {
+			round.Entries = append(round.Entries, entry.Reversal(uuid.New(), at), entry)
+		}
+	}
+
+	pot := new(big.Int).Mul(round.Contribution, big.NewInt(int64(n)))
+	for period, recipient := range r.Perm(n) {
+		for _, member := range r.Perm(n) {
+			post(EntryContribution, period, Contribution(round.Members[member], round.Contribution))
+		}
+		fee := new(big.Int).Rand(r, new(big.Int).Add(pot, big.NewInt(1)))
+		payout := new(big.Int).Sub(pot, fee)
+		post(EntryPayout, period, Payout(round.Members[recipient], payout))
+		if fee.Sign() > 0 {
+			post(EntryFee, period, Fee(round.Members[recipient], fee))
+		}
+	}
+	return reflect.ValueOf(round)
+}
+
+func TestJournal_CompletedRoundNetsToZero(t *testing.T) {
+	property := func(round completedRound) bool {
+		journal := NewJournal()
+		for _, entry := range round.Entries {
+			if err := journal.Post(entry); err != nil {
+				t.Log(err)
+				return false
+			}
+		}
+
+		if journal.Balance(Pot()).Sign() != 0 {
+			t.Logf("pot holds %s after the last payout", journal.Balance(Pot()))
+			return false
+		}
+
+		n := int64(len(round.Members))
+		sum := new(big.Int)
+		for _, member := range round.Members {
+			position := journal.Position(member)
+			if position.Net().Sign() != 0 {
+				t.Logf("%s nets %s", member, position.Net())
+				return false
+			}
+			if position.Arrears(n, round.Contribution).Sign() != 0 {
+				t.Logf("%s is in arrears", member)
+				return false
+			}
+			for _, kind := range []string{AccountContributed, AccountReceived, AccountFees} {
+				sum.Add(sum, journal.Balance(Account{Kind: kind, Member: member}))
+			}
+		}
+		if sum.Sign() != 0 {
+			t.Logf("member accounts sum to %s", sum)
+			return false
+		}
+
+		totals := journal.Totals()
+		expected := new(big.Int).Mul(round.Contribution, big.NewInt(n*n))
+		if totals.Contributed.Cmp(expected) != 0 {
+			t.Logf("total contributed %s, expected %s", totals.Contributed, expected)
+			return false
+		}
+		received := new(big.Int).Add(totals.Received, totals.Fees)
+		return received.Cmp(expected) == 0
+	}
+
+	require.NoError(t, quick.Check(property, &quick.Config{MaxCount: 200}))
+}
+
+func TestJournal_EveryEntryBalances(t *testing.T) {
+	property := func(amounts []int64) bool {
+		journal := NewJournal()
+		postings := make([]Posting, 0, len(amounts))
+		sum := new(big.Int)
+		for i, amount := range amounts {
+			postings = append(postings, Posting{Account: Account{Kind: AccountContributed, Member: fmt.Sprint(i)}, Amount: big.NewInt(amount)})
+			sum.Add(sum, big.NewInt(amount))
+		}
+
+		err := journal.Post(Entry{Kind: EntryContribution, Postings: postings})
+		balanced := len(postings) >= 2 && sum.Sign() == 0
+		if balanced {
+			return err == nil && len(journal.Entries()) == 1
+		}
+		return err == circaerrors.ErrUnbalancedEntry && len(journal.Entries()) == 0
+	}
+
+	require.NoError(t, quick.Check(property, nil))
+}
+
+func TestJournal_Position(t *testing.T) {
+	alice := "0x00000000000000000000000000000000000000a1"
+	bob := "0x00000000000000000000000000000000000000b2"
+	contribution := big.NewInt(100)
+
+	journal := NewJournal()
+	for _, postings := range [][]Posting{
+		Contribution(alice, contribution),
+		Contribution(bob, contribution),
+		Payout(alice, big.NewInt(190)),
+		Fee(alice, big.NewInt(10)),
+		Contribution(alice, contribution),
+	} {
+		require.NoError(t, journal.Post(Entry{Postings: postings}))
+	}
+
+	assert.Equal(t, []string{alice, bob}, journal.Members())
+	assert.Equal(t, "100", journal.Balance(Pot()).String())
+
+	position := journal.Position(alice)
+	assert.Equal(t, "200", position.Contributed.String())
+	assert.Equal(t, "190", position.Received.String())
+	assert.Equal(t, "10", position.Fees.String())
+	assert.Equal(t, "0", position.Net().String())
+	assert.Equal(t, "0", position.Arrears(2, contribution).String())
+
+	position = journal.Position(bob)
+	assert.Equal(t, "100", position.Net().String(), "the pot still holds bob's contribution")
+	assert.Equal(t, "100", position.Arrears(2, contribution).String())
+	assert.Equal(t, "0", position.Arrears(1, contribution).String())
+
+	totals := journal.Totals()
+	assert.Equal(t, "300", totals.Contributed.String())
+	assert.Equal(t, "190", totals.Received.String())
+}
+
+func TestEntry_Reversal(t *testing.T) {
+	member := "0x00000000000000000000000000000000000000a1"
+	entry := Entry{Kind: EntryContribution, Postings: Contribution(member, big.NewInt(5))}
+	id := uuid.New()
+
+	journal := NewJournal()
+	require.NoError(t, journal.Post(entry))
+	reversal := entry.Reversal(id, time.Now())
+	require.NoError(t, journal.Post(reversal))
+
+	assert.Equal(t, &id, reversal.Reverses)
+	assert.Equal(t, "5", entry.Postings[0].Amount.String(), "reversing does not change the original")
+	assert.Equal(t, "0", journal.Balance(Pot()).String())
+	assert.Equal(t, "0", journal.Position(member).Contributed.String())
+}
+
+func TestFromEvent(t *testing.T) {
+	member := "0x00000000000000000000000000000000000000a1"
+
+	postings, ok, err := FromEvent(EntryContribution, member, "250")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Contribution(member, big.NewInt(250)), postings)
+
+	postings, ok, err = FromEvent(EntryPayout, member, "500")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, Payout(member, big.NewInt(500)), postings)
+
+	_, ok, err = FromEvent("swap", member, "0")
+	require.NoError(t, err)
+	assert.False(t, ok, "swaps move no funds")
+
+	_, _, err = FromEvent(EntryPayout, member, "-1")
+	assert.Error(t, err)
+}
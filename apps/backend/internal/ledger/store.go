@@ -0,0 +1,207 @@
+package ledger
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Sync brings a round's journal in line with its indexed events. Entries
+// whose event was rolled back are reversed, then every contribution and
+// payout without a standing entry is posted, adding its postings to the
+// account balances. It is meant to run in the transaction that changed the
+// events, and is a no-op when nothing did.
+func Sync(ctx context.Context, q sqlc.Querier, roundID uuid.UUID) error {
+	orphans, err := q.ListOrphanedLedgerEntries(ctx, roundID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, orphan := range orphans {
+		rows, err := q.ListLedgerEntryPostings(ctx, orphan.ID)
+		if err != nil {
+			return err
+		}
+		entry := Entry{
+			Kind:       orphan.Kind,
+			Period:     orphan.Period,
+			TxHash:     orphan.TxHash,
+			LogIndex:   orphan.LogIndex,
+			OccurredAt: orphan.OccurredAt.Time,
+		}
+		for _, row := range rows {
+			posting, err := postingFromRow(row.AccountKind, row.Member, row.Amount)
+			if err != nil {
+				return fmt.Errorf("ledger entry %s: %w", orphan.ID, err)
+			}
+			entry.Postings = append(entry.Postings, posting)
+		}
+		if err := write(ctx, q, roundID, entry.Reversal(orphan.ID, now), orphan.OccurredAt.Time); err != nil {
+			return err
+		}
+	}
+
+	events, err := q.ListUnjournaledRoundEvents(ctx, roundID)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		postings, ok, err := FromEvent(e.EventType, strings.ToLower(e.Address), e.Amount)
+		if err != nil {
+			return fmt.Errorf("round event %s: %w", e.ID, err)
+		}
+		if !ok {
+			continue
+		}
+		if err := write(ctx, q, roundID, Entry{
+			Kind:       e.EventType,
+			Period:     e.Period,
+			TxHash:     e.TxHash,
+			LogIndex:   e.LogIndex,
+			OccurredAt: e.BlockTime.Time,
+			Postings:   postings,
+		}, e.BlockTime.Time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load replays a round's journal from its postings.
+func Load(ctx context.Context, q sqlc.Querier, roundID uuid.UUID) (*Journal, error) {
+	rows, err := q.ListRoundLedgerPostings(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	journal := NewJournal()
+	var entry *Entry
+	var entryID uuid.UUID
+	flush := func() error {
+		if entry == nil {
+			return nil
+		}
+		if err := journal.Post(*entry); err != nil {
+			return fmt.Errorf("ledger entry %s: %w", entryID, err)
+		}
+		return nil
+	}
+
+	for _, row := range rows {
+		if entry == nil || row.EntryID != entryID {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			entryID = row.EntryID
+			entry = &Entry{
+				Kind:       row.Kind,
+				Period:     row.Period,
+				TxHash:     row.TxHash,
+				LogIndex:   row.LogIndex,
+				OccurredAt: row.OccurredAt.Time,
+			}
+			if row.Reverses.Valid {
+				reverses := uuid.UUID(row.Reverses.Bytes)
+				entry.Reverses = &reverses
+			}
+		}
+		posting, err := postingFromRow(row.AccountKind, row.Member, row.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("ledger entry %s: %w", row.EntryID, err)
+		}
+		entry.Postings = append(entry.Postings, posting)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return journal, nil
+}
+
+// LoadBalances reads a round's account balances as Sync left them.
+func LoadBalances(ctx context.Context, q sqlc.Querier, roundID uuid.UUID) (Balances, error) {
+	accounts, err := q.ListRoundLedgerAccounts(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(Balances, len(accounts))
+	for _, a := range accounts {
+		balance, ok := new(big.Int).SetString(a.Balance, 10)
+		if !ok {
+			return nil, fmt.Errorf("ledger account %s %q: invalid balance %q", a.Kind, a.Member, a.Balance)
+		}
+		balances[Account{Kind: a.Kind, Member: a.Member}] = balance
+	}
+	return balances, nil
+}
+
+// write stores a balanced entry with its postings and adds them to the
+// balances of the accounts they touch, opening those accounts. What a
+// contribution adds to the pot is also added to the hour of contributedAt,
+// when the contribution being posted or reversed was made.
+func write(ctx context.Context, q sqlc.Querier, roundID uuid.UUID, e Entry, contributedAt time.Time) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	var reverses pgtype.UUID
+	if e.Reverses != nil {
+		reverses = pgtype.UUID{Bytes: *e.Reverses, Valid: true}
+	}
+	stored, err := q.InsertLedgerEntry(ctx, sqlc.InsertLedgerEntryParams{
+		RoundID:    roundID,
+		Kind:       e.Kind,
+		Period:     e.Period,
+		TxHash:     e.TxHash,
+		LogIndex:   e.LogIndex,
+		OccurredAt: pgtype.Timestamptz{Time: e.OccurredAt, Valid: true},
+		Reverses:   reverses,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range e.Postings {
+		if err := q.AddLedgerAccountBalance(ctx, sqlc.AddLedgerAccountBalanceParams{
+			RoundID: roundID,
+			Kind:    p.Account.Kind,
+			Member:  p.Account.Member,
+			Balance: p.Amount.String(),
+		}); err != nil {
+			return err
+		}
+		if err := q.InsertLedgerPosting(ctx, sqlc.InsertLedgerPostingParams{
+			EntryID:     stored.ID,
+			RoundID:     roundID,
+			AccountKind: p.Account.Kind,
+			Member:      p.Account.Member,
+			Amount:      p.Amount.String(),
+		}); err != nil {
+			return err
+		}
+		if e.Kind == EntryContribution && p.Account.Kind == AccountPot {
+			if err := q.AddLedgerHourlyContribution(ctx, sqlc.AddLedgerHourlyContributionParams{
+				RoundID: roundID,
+				Hour:    pgtype.Timestamptz{Time: contributedAt.Truncate(time.Hour), Valid: true},
+				Amount:  p.Amount.String(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func postingFromRow(kind, member, amount string) (Posting, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return Posting{}, fmt.Errorf("invalid posting amount %q", amount)
+	}
+	return Posting{Account: Account{Kind: kind, Member: member}, Amount: value}, nil
+}
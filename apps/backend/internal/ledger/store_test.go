@@ -0,0 +1,259 @@
+package ledger
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"math/big"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSync(t *testing.T) {
+	ctx := context.Background()
+	roundID := uuid.New()
+	member := "0x00000000000000000000000000000000000000a1"
+	orphanID := uuid.New()
+	blockTime := time.Date(2026, 1, 11, 9, 0, 0, 0, time.UTC)
+	contributedAt := time.Date(2026, 1, 4, 9, 40, 0, 0, time.UTC)
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("ListOrphanedLedgerEntries", ctx, roundID).Return([]sqlc.LedgerEntry{{
+		ID:         orphanID,
+		RoundID:    roundID,
+		Kind:       EntryContribution,
+		TxHash:     "0xdropped",
+		LogIndex:   1,
+		OccurredAt: pgtype.Timestamptz{Time: contributedAt, Valid: true},
+	}}, nil)
+	mockStore.On("ListLedgerEntryPostings", ctx, orphanID).Return([]sqlc.LedgerPosting{
+		{EntryID: orphanID, AccountKind: AccountContributed, Member: member, Amount: "-100"},
+		{EntryID: orphanID, AccountKind: AccountPot, Amount: "100"},
+	}, nil)
+	mockStore.On("ListUnjournaledRoundEvents", ctx, roundID).Return([]sqlc.RoundEvent{
+		{
+			ID:        uuid.New(),
+			RoundID:   roundID,
+			EventType: EntryPayout,
+			Address:   "0x00000000000000000000000000000000000000A1",
+			Period:    0,
+			Amount:    "200",
+			TxHash:    "0xpayout",
+			LogIndex:  2,
+			BlockTime: pgtype.Timestamptz{Time: blockTime, Valid: true},
+		},
+		{ID: uuid.New(), RoundID: roundID, EventType: "swap", Amount: "0"},
+	}, nil)
+
+	reversalID := uuid.New()
+	payoutID := uuid.New()
+	mockStore.On("InsertLedgerEntry", ctx, mock.MatchedBy(func(p sqlc.InsertLedgerEntryParams) bool {
+		return p.Reverses.Valid && p.Reverses.Bytes == orphanID && p.TxHash == "0xdropped"
+	})).Return(sqlc.LedgerEntry{ID: reversalID}, nil).Once()
+	mockStore.On("InsertLedgerEntry", ctx, sqlc.InsertLedgerEntryParams{
+		RoundID:    roundID,
+		Kind:       EntryPayout,
+		TxHash:     "0xpayout",
+		LogIndex:   2,
+		OccurredAt: pgtype.Timestamptz{Time: blockTime, Valid: true},
+	}).Return(sqlc.LedgerEntry{ID: payoutID}, nil).Once()
+
+	balances := map[Account]string{}
+	mockStore.On("AddLedgerAccountBalance", ctx, mock.Anything).
+		Run(func(args mock.Arguments) {
+			p := args.Get(1).(sqlc.AddLedgerAccountBalanceParams)
+			balances[Account{Kind: p.Kind, Member: p.Member}] = p.Balance
+		}).
+		Return(nil)
+	mockStore.On("AddLedgerHourlyContribution", ctx, sqlc.AddLedgerHourlyContributionParams{
+		RoundID: roundID,
+		Hour:    pgtype.Timestamptz{Time: contributedAt.Truncate(time.Hour), Valid: true},
+		Amount:  "-100",
+	}).Return(nil).Once()
+
+	var postings []sqlc.InsertLedgerPostingParams
+	mockStore.On("InsertLedgerPosting", ctx, mock.Anything).
+		Run(func(args mock.Arguments) {
+			postings = append(postings, args.Get(1).(sqlc.InsertLedgerPostingParams))
+		}).
+		Return(nil)
+
+	require.NoError(t, Sync(ctx, mockStore, roundID))
+
+	assert.Equal(t, []sqlc.InsertLedgerPostingParams{
+		{EntryID: reversalID, RoundID: roundID, AccountKind: AccountContributed, Member: member, Amount: "100"},
+		{EntryID: reversalID, RoundID: roundID, AccountKind: AccountPot, Amount: "-100"},
+		{EntryID: payoutID, RoundID: roundID, AccountKind: AccountReceived, Member: member, Amount: "200"},
+		{EntryID: payoutID, RoundID: roundID, AccountKind: AccountPot, Amount: "-200"},
+	}, postings)
+	assert.Equal(t, map[Account]string{
+		{Kind: AccountContributed, Member: member}: "100",
+		{Kind: AccountReceived, Member: member}:    "200",
+		Pot():                                      "-200",
+	}, balances, "the last amount added to each account")
+	mockStore.AssertNumberOfCalls(t, "AddLedgerAccountBalance", 4)
+}
+
+// TestSync_BalancesMatchReplay posts the events of random rounds through
+// Sync and checks that the balances it adds up on the accounts are what
+// replaying the postings it wrote gives, and that its hourly contributions
+// add up to what was contributed.
+func TestSync_BalancesMatchReplay(t *testing.T) {
+	property := func(round completedRound) bool {
+		ctx := context.Background()
+		roundID := uuid.New()
+		events := []sqlc.RoundEvent{}
+		for _, e := range round.Entries {
+			var member string
+			switch {
+			case e.Reverses != nil:
+				continue
+			case e.Kind == EntryContribution:
+				member = e.Postings[1].Account.Member
+			case e.Kind == EntryPayout:
+				member = e.Postings[0].Account.Member
+			default:
+				continue
+			}
+			events = append(events, sqlc.RoundEvent{
+				ID:        uuid.New(),
+				RoundID:   roundID,
+				EventType: e.Kind,
+				Address:   member,
+				Period:    e.Period,
+				Amount:    new(big.Int).Abs(e.Postings[0].Amount).String(),
+				TxHash:    e.TxHash,
+				BlockTime: pgtype.Timestamptz{Time: e.OccurredAt, Valid: true},
+			})
+		}
+
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("ListOrphanedLedgerEntries", ctx, roundID).Return([]sqlc.LedgerEntry{}, nil)
+		mockStore.On("ListUnjournaledRoundEvents", ctx, roundID).Return(events, nil)
+		mockStore.On("InsertLedgerEntry", ctx, mock.Anything).Return(func(_ context.Context, _ sqlc.InsertLedgerEntryParams) (sqlc.LedgerEntry, error) {
+			return sqlc.LedgerEntry{ID: uuid.New()}, nil
+		})
+		hourly := new(big.Int)
+		mockStore.On("AddLedgerHourlyContribution", ctx, mock.Anything).
+			Run(func(args mock.Arguments) {
+				amount, _ := new(big.Int).SetString(args.Get(1).(sqlc.AddLedgerHourlyContributionParams).Amount, 10)
+				hourly.Add(hourly, amount)
+			}).
+			Return(nil)
+
+		materialized := Balances{}
+		mockStore.On("AddLedgerAccountBalance", ctx, mock.Anything).
+			Run(func(args mock.Arguments) {
+				p := args.Get(1).(sqlc.AddLedgerAccountBalanceParams)
+				amount, _ := new(big.Int).SetString(p.Balance, 10)
+				account := Account{Kind: p.Kind, Member: p.Member}
+				materialized[account] = new(big.Int).Add(materialized.Balance(account), amount)
+			}).
+			Return(nil)
+
+		rows := []sqlc.ListRoundLedgerPostingsRow{}
+		mockStore.On("InsertLedgerPosting", ctx, mock.Anything).
+			Run(func(args mock.Arguments) {
+				p := args.Get(1).(sqlc.InsertLedgerPostingParams)
+				rows = append(rows, sqlc.ListRoundLedgerPostingsRow{
+					EntryID:     p.EntryID,
+					AccountKind: p.AccountKind,
+					Member:      p.Member,
+					Amount:      p.Amount,
+				})
+			}).
+			Return(nil)
+		mockStore.On("ListRoundLedgerPostings", ctx, roundID).Return(func(context.Context, uuid.UUID) ([]sqlc.ListRoundLedgerPostingsRow, error) {
+			return rows, nil
+		})
+
+		if err := Sync(ctx, mockStore, roundID); err != nil {
+			t.Log(err)
+			return false
+		}
+		journal, err := Load(ctx, mockStore, roundID)
+		if err != nil {
+			t.Log(err)
+			return false
+		}
+
+		replayed := journal.Balances()
+		if len(replayed) != len(materialized) {
+			return false
+		}
+		for account, balance := range replayed {
+			if materialized.Balance(account).Cmp(balance) != 0 {
+				t.Logf("%v: materialized %s, replayed %s", account, materialized.Balance(account), balance)
+				return false
+			}
+		}
+		if hourly.Cmp(materialized.Totals().Contributed) != 0 {
+			t.Logf("hourly contributions add up to %s, contributed %s", hourly, materialized.Totals().Contributed)
+			return false
+		}
+		return true
+	}
+	require.NoError(t, quick.Check(property, &quick.Config{MaxCount: 50}))
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	roundID := uuid.New()
+	member := "0x00000000000000000000000000000000000000a1"
+	first, second := uuid.New(), uuid.New()
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("ListRoundLedgerPostings", ctx, roundID).Return([]sqlc.ListRoundLedgerPostingsRow{
+		{EntryID: first, Kind: EntryContribution, AccountKind: AccountContributed, Member: member, Amount: "-100"},
+		{EntryID: first, Kind: EntryContribution, AccountKind: AccountPot, Amount: "100"},
+		{EntryID: second, Kind: EntryContribution, Reverses: pgtype.UUID{Bytes: first, Valid: true}, AccountKind: AccountContributed, Member: member, Amount: "100"},
+		{EntryID: second, Kind: EntryContribution, Reverses: pgtype.UUID{Bytes: first, Valid: true}, AccountKind: AccountPot, Amount: "-100"},
+	}, nil)
+
+	journal, err := Load(ctx, mockStore, roundID)
+	require.NoError(t, err)
+	require.Len(t, journal.Entries(), 2)
+	assert.Equal(t, &first, journal.Entries()[1].Reverses)
+	assert.Equal(t, "0", journal.Balance(Pot()).String())
+
+	mockStore = dbmocks.NewMockStore(t)
+	mockStore.On("ListRoundLedgerPostings", ctx, roundID).Return([]sqlc.ListRoundLedgerPostingsRow{
+		{EntryID: first, AccountKind: AccountContributed, Member: member, Amount: "-100"},
+		{EntryID: first, AccountKind: AccountPot, Amount: "90"},
+	}, nil)
+	_, err = Load(ctx, mockStore, roundID)
+	assert.Error(t, err, "an unbalanced stored entry is reported")
+}
+
+func TestLoadBalances(t *testing.T) {
+	ctx := context.Background()
+	roundID := uuid.New()
+	member := "0x00000000000000000000000000000000000000a1"
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("ListRoundLedgerAccounts", ctx, roundID).Return([]sqlc.LedgerAccount{
+		{RoundID: roundID, Kind: AccountContributed, Member: member, Balance: "-300"},
+		{RoundID: roundID, Kind: AccountPot, Balance: "100"},
+		{RoundID: roundID, Kind: AccountReceived, Member: member, Balance: "200"},
+	}, nil)
+
+	balances, err := LoadBalances(ctx, mockStore, roundID)
+	require.NoError(t, err)
+	assert.Equal(t, "100", balances.Balance(Pot()).String())
+	assert.Equal(t, "300", balances.Position(member).Contributed.String())
+	assert.Equal(t, "100", balances.Position(member).Net().String())
+
+	mockStore = dbmocks.NewMockStore(t)
+	mockStore.On("ListRoundLedgerAccounts", ctx, roundID).Return([]sqlc.LedgerAccount{
+		{RoundID: roundID, Kind: AccountPot, Balance: "1e3"},
+	}, nil)
+	_, err = LoadBalances(ctx, mockStore, roundID)
+	assert.Error(t, err, "an invalid stored balance is reported")
+}
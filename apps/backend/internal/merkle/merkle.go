@@ -0,0 +1,105 @@
+// Package merkle builds the Merkle trees a Round contract checks membership
+// against, so a group's members do not have to be published on-chain.
+//
+// Trees follow OpenZeppelin's MerkleProof: the two children of a node are
+// hashed in sorted order, so a proof is only the sibling hashes from the
+// leaf up and carries no left/right flags. A node without a sibling is
+// carried up to the next layer unchanged.
+//
+// A member's leaf commits to their address and payout position and is
+// hashed twice, as OpenZeppelin's StandardMerkleTree does, so no leaf can
+// be mistaken for an inner node:
+//
+//	keccak256(bytes.concat(keccak256(abi.encode(member, position))))
+package merkle
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrNoLeaves     = errors.New("merkle tree needs at least one leaf")
+	ErrLeafNotFound = errors.New("leaf index is out of range")
+)
+
+// MemberLeaf returns the leaf of member at a zero-based payout position.
+func MemberLeaf(member common.Address, position int) common.Hash {
+	encoded := make([]byte, 0, 64)
+	encoded = append(encoded, common.LeftPadBytes(member.Bytes(), 32)...)
+	encoded = append(encoded, common.LeftPadBytes(big.NewInt(int64(position)).Bytes(), 32)...)
+	return crypto.Keccak256Hash(crypto.Keccak256(encoded))
+}
+
+// Tree is a Merkle tree over leaves in the order they were given.
+type Tree struct {
+	// layers[0] holds the leaves and the last layer the root.
+	layers [][]common.Hash
+}
+
+// New builds a tree over leaves.
+func New(leaves []common.Hash) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, ErrNoLeaves
+	}
+
+	layer := append([]common.Hash(nil), leaves...)
+	layers := [][]common.Hash{layer}
+	for len(layer) > 1 {
+		next := make([]common.Hash, 0, (len(layer)+1)/2)
+		for i := 0; i < len(layer); i += 2 {
+			if i+1 == len(layer) {
+				next = append(next, layer[i])
+				continue
+			}
+			next = append(next, hashPair(layer[i], layer[i+1]))
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+	return &Tree{layers: layers}, nil
+}
+
+// Root returns the tree's root.
+func (t *Tree) Root() common.Hash {
+	return t.layers[len(t.layers)-1][0]
+}
+
+// Proof returns the sibling hashes that lead from the leaf at index to the
+// root.
+func (t *Tree) Proof(index int) ([]common.Hash, error) {
+	if index < 0 || index >= len(t.layers[0]) {
+		return nil, ErrLeafNotFound
+	}
+
+	proof := []common.Hash{}
+	for _, layer := range t.layers[:len(t.layers)-1] {
+		sibling := index ^ 1
+		if sibling < len(layer) {
+			proof = append(proof, layer[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+// Verify reports whether proof leads from leaf to root, as
+// MerkleProof.verify does on-chain.
+func Verify(proof []common.Hash, root, leaf common.Hash) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = hashPair(computed, sibling)
+	}
+	return computed == root
+}
+
+func hashPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
@@ -0,0 +1,87 @@
+package merkle
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func leaves(n int) []common.Hash {
+	hashes := make([]common.Hash, 0, n)
+	for i := range n {
+		hashes = append(hashes, MemberLeaf(common.HexToAddress(fmt.Sprintf("0x%040x", i+1)), i))
+	}
+	return hashes
+}
+
+func TestMemberLeaf(t *testing.T) {
+	addressType, err := abi.NewType("address", "", nil)
+	require.NoError(t, err)
+	uintType, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+
+	member := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	encoded, err := abi.Arguments{{Type: addressType}, {Type: uintType}}.Pack(member, big.NewInt(3))
+	require.NoError(t, err)
+
+	assert.Equal(t, crypto.Keccak256Hash(crypto.Keccak256(encoded)), MemberLeaf(member, 3))
+	assert.NotEqual(t, MemberLeaf(member, 3), MemberLeaf(member, 4))
+}
+
+func TestTree_Proofs(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		t.Run(fmt.Sprintf("%d leaves", n), func(t *testing.T) {
+			hashes := leaves(n)
+			tree, err := New(hashes)
+			require.NoError(t, err)
+
+			for i, leaf := range hashes {
+				proof, err := tree.Proof(i)
+				require.NoError(t, err)
+				assert.True(t, Verify(proof, tree.Root(), leaf), "leaf %d", i)
+
+				// The leaf of another position does not verify.
+				assert.False(t, Verify(proof, tree.Root(), MemberLeaf(common.HexToAddress(fmt.Sprintf("0x%040x", i+1)), i+1)))
+			}
+		})
+	}
+}
+
+func TestTree_Root(t *testing.T) {
+	hashes := leaves(3)
+
+	single, err := New(hashes[:1])
+	require.NoError(t, err)
+	assert.Equal(t, hashes[0], single.Root())
+
+	tree, err := New(hashes)
+	require.NoError(t, err)
+	assert.Equal(t, hashPair(hashPair(hashes[0], hashes[1]), hashes[2]), tree.Root())
+
+	// Sorted pair hashing makes siblings interchangeable, but moving a leaf
+	// to another subtree changes the root.
+	swapped, err := New([]common.Hash{hashes[1], hashes[0], hashes[2]})
+	require.NoError(t, err)
+	assert.Equal(t, tree.Root(), swapped.Root())
+	moved, err := New([]common.Hash{hashes[2], hashes[1], hashes[0]})
+	require.NoError(t, err)
+	assert.NotEqual(t, tree.Root(), moved.Root())
+}
+
+func TestTree_Errors(t *testing.T) {
+	_, err := New(nil)
+	assert.ErrorIs(t, err, ErrNoLeaves)
+
+	tree, err := New(leaves(2))
+	require.NoError(t, err)
+	_, err = tree.Proof(2)
+	assert.ErrorIs(t, err, ErrLeafNotFound)
+	_, err = tree.Proof(-1)
+	assert.ErrorIs(t, err, ErrLeafNotFound)
+}
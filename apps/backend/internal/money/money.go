@@ -0,0 +1,72 @@
+// Package money converts token amounts between their on-chain base units
+// and decimal strings for people.
+package money
+
+import (
+	"circa/internal/errors"
+	"math/big"
+	"strings"
+)
+
+// Parse converts a decimal amount such as "12.5" to base units. It rejects
+// negative amounts and more fractional digits than the token has.
+func Parse(value string, decimals uint8) (*big.Int, error) {
+	whole, fraction, hasPoint := strings.Cut(strings.TrimSpace(value), ".")
+	if whole == "" && fraction == "" {
+		return nil, errors.ErrInvalidAmount
+	}
+	if hasPoint && fraction == "" {
+		return nil, errors.ErrInvalidAmount
+	}
+	if len(fraction) > int(decimals) || !isDigits(whole) || !isDigits(fraction) {
+		return nil, errors.ErrInvalidAmount
+	}
+
+	digits := whole + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+	amount, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, errors.ErrInvalidAmount
+	}
+	return amount, nil
+}
+
+// Format renders a base unit amount with decimals fractional digits,
+// dropping trailing zeros: 1500000 with 6 decimals is "1.5".
+func Format(amount *big.Int, decimals uint8) string {
+	digits := new(big.Int).Abs(amount).String()
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+	if decimals == 0 {
+		return sign + digits
+	}
+
+	if pad := int(decimals) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(decimals)
+	whole, fraction := digits[:point], strings.TrimRight(digits[point:], "0")
+	if fraction == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
+
+// FormatUnits formats a base unit amount stored as a decimal string.
+func FormatUnits(value string, decimals uint8) (string, error) {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return "", errors.ErrInvalidAmount
+	}
+	return Format(amount, decimals), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package money
+
+import (
+	"circa/internal/errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals uint8
+		want     string
+	}{
+		{value: "12.5", decimals: 6, want: "12500000"},
+		{value: "0.000001", decimals: 6, want: "1"},
+		{value: ".5", decimals: 2, want: "50"},
+		{value: "100", decimals: 0, want: "100"},
+		{value: "1", decimals: 18, want: "1000000000000000000"},
+		{value: " 7.25 ", decimals: 2, want: "725"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			amount, err := Parse(tt.value, tt.decimals)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, amount.String())
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, value := range []string{"", ".", "1.", "-1", "1.2.3", "1e6", "0.0000001", "abc", "1,000"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := Parse(value, 6)
+			assert.ErrorIs(t, err, errors.ErrInvalidAmount)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	tests := []struct {
+		name     string
+		amount   *big.Int
+		decimals uint8
+		want     string
+	}{
+		{name: "whole", amount: big.NewInt(5_000_000), decimals: 6, want: "5"},
+		{name: "trailing zeros dropped", amount: big.NewInt(1_500_000), decimals: 6, want: "1.5"},
+		{name: "below one", amount: big.NewInt(1), decimals: 6, want: "0.000001"},
+		{name: "zero", amount: big.NewInt(0), decimals: 18, want: "0"},
+		{name: "no decimals", amount: big.NewInt(42), decimals: 0, want: "42"},
+		{name: "negative", amount: big.NewInt(-2_500), decimals: 3, want: "-2.5"},
+		{name: "wei", amount: huge, decimals: 18, want: "123456789012.34567890123456789"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Format(tt.amount, tt.decimals))
+		})
+	}
+}
+
+func TestFormat_RoundTrip(t *testing.T) {
+	for _, value := range []string{"0.1", "1", "1000.000001", "0.000000000000000001"} {
+		amount, err := Parse(value, 18)
+		require.NoError(t, err)
+		assert.Equal(t, value, Format(amount, 18))
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	formatted, err := FormatUnits("2500000", 6)
+	require.NoError(t, err)
+	assert.Equal(t, "2.5", formatted)
+
+	_, err = FormatUnits("12.5", 6)
+	assert.ErrorIs(t, err, errors.ErrInvalidAmount)
+}
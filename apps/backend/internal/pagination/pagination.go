@@ -0,0 +1,197 @@
+// Package pagination implements opaque keyset cursors for list endpoints.
+//
+// A cursor records the sort key and id of the last item on a page. List
+// queries order by (sort key, id) and resume strictly after that pair, so
+// rows inserted between requests never shift later pages. Cursors are signed
+// with HMAC-SHA256 so clients cannot forge or edit them.
+package pagination
+
+import (
+	"bytes"
+	"circa/internal/errors"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	DefaultLimit = 50
+	MinLimit     = 1
+	MaxLimit     = 200
+)
+
+// Cursor is the keyset position of the last item on a page.
+type Cursor struct {
+	Key string    `json:"k"`
+	ID  uuid.UUID `json:"id"`
+}
+
+// AtTime returns a cursor for queries ordered by a timestamp.
+func AtTime(t time.Time, id uuid.UUID) Cursor {
+	return Cursor{Key: t.UTC().Format(time.RFC3339Nano), ID: id}
+}
+
+// AtFloat returns a cursor for queries ordered by a float, such as a search
+// rank.
+func AtFloat(f float64, id uuid.UUID) Cursor {
+	return Cursor{Key: strconv.FormatFloat(f, 'g', -1, 64), ID: id}
+}
+
+// AtString returns a cursor for queries ordered by a text column.
+func AtString(s string, id uuid.UUID) Cursor {
+	return Cursor{Key: s, ID: id}
+}
+
+// AtLog returns a cursor for queries ordered by chain position.
+func AtLog(blockNumber int64, logIndex int32, id uuid.UUID) Cursor {
+	return Cursor{Key: fmt.Sprintf("%d:%d", blockNumber, logIndex), ID: id}
+}
+
+// Timestamp returns the sort key as a query parameter. A nil cursor gives a
+// NULL parameter, which keyset queries treat as "from the start".
+func (c *Cursor) Timestamp() (pgtype.Timestamp, error) {
+	if c == nil {
+		return pgtype.Timestamp{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return pgtype.Timestamp{}, errors.ErrInvalidCursor
+	}
+	return pgtype.Timestamp{Time: t, Valid: true}, nil
+}
+
+// Float8 returns the sort key as a query parameter.
+func (c *Cursor) Float8() (pgtype.Float8, error) {
+	if c == nil {
+		return pgtype.Float8{}, nil
+	}
+	f, err := strconv.ParseFloat(c.Key, 64)
+	if err != nil {
+		return pgtype.Float8{}, errors.ErrInvalidCursor
+	}
+	return pgtype.Float8{Float64: f, Valid: true}, nil
+}
+
+// Log returns the block number and log index sort keys as query parameters.
+func (c *Cursor) Log() (pgtype.Int8, pgtype.Int4, error) {
+	if c == nil {
+		return pgtype.Int8{}, pgtype.Int4{}, nil
+	}
+	block, index, found := strings.Cut(c.Key, ":")
+	if !found {
+		return pgtype.Int8{}, pgtype.Int4{}, errors.ErrInvalidCursor
+	}
+	blockNumber, err := strconv.ParseInt(block, 10, 64)
+	if err != nil {
+		return pgtype.Int8{}, pgtype.Int4{}, errors.ErrInvalidCursor
+	}
+	logIndex, err := strconv.ParseInt(index, 10, 32)
+	if err != nil {
+		return pgtype.Int8{}, pgtype.Int4{}, errors.ErrInvalidCursor
+	}
+	return pgtype.Int8{Int64: blockNumber, Valid: true}, pgtype.Int4{Int32: int32(logIndex), Valid: true}, nil
+}
+
+// Text returns the sort key as a query parameter.
+func (c *Cursor) Text() *string {
+	if c == nil {
+		return nil
+	}
+	key := c.Key
+	return &key
+}
+
+// UUID returns the id tie-breaker as a query parameter.
+func (c *Cursor) UUID() pgtype.UUID {
+	if c == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: c.ID, Valid: true}
+}
+
+// Paginator signs and verifies cursors.
+type Paginator struct {
+	secret []byte
+}
+
+func New(secret string) *Paginator {
+	return &Paginator{secret: []byte(secret)}
+}
+
+// Encode returns the signed, URL-safe form of the cursor.
+func (p *Paginator) Encode(c Cursor) string {
+	payload, _ := json.Marshal(c)
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + base64.RawURLEncoding.EncodeToString(p.sign(body))
+}
+
+// Decode verifies and parses a cursor from a request. A nil or empty cursor
+// means the first page and returns nil.
+func (p *Paginator) Decode(cursor *string) (*Cursor, error) {
+	if cursor == nil || *cursor == "" {
+		return nil, nil
+	}
+
+	body, sig, found := strings.Cut(*cursor, ".")
+	if !found {
+		return nil, errors.ErrInvalidCursor
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, p.sign(body)) {
+		return nil, errors.ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	var c Cursor
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&c); err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+func (p *Paginator) sign(body string) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte(body))
+	return mac.Sum(nil)
+}
+
+// Limit validates a requested page size, defaulting when none is given.
+func Limit(requested *int) (int, error) {
+	if requested == nil {
+		return DefaultLimit, nil
+	}
+	if *requested < MinLimit || *requested > MaxLimit {
+		return 0, errors.ErrInvalidLimit
+	}
+	return *requested, nil
+}
+
+// PageSize is the LIMIT to pass to a keyset query: one more than the page so
+// Paginate can tell whether another page follows.
+func PageSize(limit int) int32 {
+	return int32(limit + 1)
+}
+
+// Paginate trims rows fetched with PageSize(limit) down to the page and
+// returns the signed cursor for the next page, or nil on the last page.
+func Paginate[T any](p *Paginator, rows []T, limit int, key func(T) Cursor) ([]T, *string) {
+	if len(rows) <= limit {
+		return rows, nil
+	}
+	rows = rows[:limit]
+	next := p.Encode(key(rows[limit-1]))
+	return rows, &next
+}
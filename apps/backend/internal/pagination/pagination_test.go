@@ -0,0 +1,272 @@
+package pagination_test
+
+import (
+	"bytes"
+	circaerrors "circa/internal/errors"
+	"circa/internal/pagination"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginator_EncodeDecode(t *testing.T) {
+	p := pagination.New("secret")
+	id := uuid.New()
+	createdAt := time.Date(2026, 1, 10, 9, 30, 0, 123456789, time.UTC)
+
+	encoded := p.Encode(pagination.AtTime(createdAt, id))
+
+	cursor, err := p.Decode(&encoded)
+	require.NoError(t, err)
+	assert.Equal(t, id, cursor.ID)
+
+	ts, err := cursor.Timestamp()
+	require.NoError(t, err)
+	assert.True(t, ts.Valid)
+	assert.True(t, createdAt.Equal(ts.Time))
+	assert.Equal(t, id, uuid.UUID(cursor.UUID().Bytes))
+}
+
+func TestPaginator_Decode(t *testing.T) {
+	p := pagination.New("secret")
+	valid := p.Encode(pagination.AtString("0xab", uuid.New()))
+	body, sig, _ := strings.Cut(valid, ".")
+
+	tests := []struct {
+		name          string
+		cursor        *string
+		expectNil     bool
+		expectedError error
+	}{
+		{name: "nil cursor is the first page", cursor: nil, expectNil: true},
+		{name: "empty cursor is the first page", cursor: stringPtr(""), expectNil: true},
+		{name: "valid cursor", cursor: &valid},
+		{name: "missing signature", cursor: stringPtr(body), expectedError: circaerrors.ErrInvalidCursor},
+		{name: "tampered payload", cursor: stringPtr(body + "x." + sig), expectedError: circaerrors.ErrInvalidCursor},
+		{name: "signed with another secret", cursor: stringPtr(pagination.New("other").Encode(pagination.AtString("0xab", uuid.New()))), expectedError: circaerrors.ErrInvalidCursor},
+		{name: "garbage", cursor: stringPtr("not-a-cursor"), expectedError: circaerrors.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := p.Decode(tt.cursor)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, cursor)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectNil {
+				assert.Nil(t, cursor)
+			} else {
+				assert.NotNil(t, cursor)
+			}
+		})
+	}
+}
+
+func TestCursor_NilParams(t *testing.T) {
+	var cursor *pagination.Cursor
+
+	ts, err := cursor.Timestamp()
+	require.NoError(t, err)
+	assert.False(t, ts.Valid)
+
+	f, err := cursor.Float8()
+	require.NoError(t, err)
+	assert.False(t, f.Valid)
+
+	assert.Nil(t, cursor.Text())
+	assert.False(t, cursor.UUID().Valid)
+}
+
+func TestCursor_Float8(t *testing.T) {
+	rank := 0.1 + 0.2
+	cursor := pagination.AtFloat(rank, uuid.New())
+
+	f, err := cursor.Float8()
+	require.NoError(t, err)
+	assert.Equal(t, rank, f.Float64)
+
+	invalid := pagination.AtString("abc", uuid.New())
+	_, err = invalid.Float8()
+	assert.ErrorIs(t, err, circaerrors.ErrInvalidCursor)
+}
+
+func TestCursor_Log(t *testing.T) {
+	cursor := pagination.AtLog(18000000, 42, uuid.New())
+
+	block, index, err := cursor.Log()
+	require.NoError(t, err)
+	assert.Equal(t, int64(18000000), block.Int64)
+	assert.Equal(t, int32(42), index.Int32)
+
+	var none *pagination.Cursor
+	block, index, err = none.Log()
+	require.NoError(t, err)
+	assert.False(t, block.Valid)
+	assert.False(t, index.Valid)
+
+	for _, key := range []string{"18000000", "x:1", "1:x", "1:99999999999"} {
+		invalid := pagination.AtString(key, uuid.New())
+		_, _, err = invalid.Log()
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidCursor, key)
+	}
+}
+
+func TestLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		requested     *int
+		expected      int
+		expectedError error
+	}{
+		{name: "default", requested: nil, expected: pagination.DefaultLimit},
+		{name: "minimum", requested: intPtr(1), expected: 1},
+		{name: "maximum", requested: intPtr(200), expected: 200},
+		{name: "zero", requested: intPtr(0), expectedError: circaerrors.ErrInvalidLimit},
+		{name: "too large", requested: intPtr(201), expectedError: circaerrors.ErrInvalidLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, err := pagination.Limit(tt.requested)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, limit)
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	p := pagination.New("secret")
+	rows := []row{{id: uuid.New()}, {id: uuid.New()}, {id: uuid.New()}}
+
+	page, next := pagination.Paginate(p, rows, 3, rowCursor)
+	assert.Len(t, page, 3)
+	assert.Nil(t, next)
+
+	page, next = pagination.Paginate(p, rows, 2, rowCursor)
+	assert.Len(t, page, 2)
+	require.NotNil(t, next)
+	cursor, err := p.Decode(next)
+	require.NoError(t, err)
+	assert.Equal(t, rows[1].id, cursor.ID)
+}
+
+// TestPaginate_StableUnderConcurrentInserts walks a newest-first listing
+// while rows are inserted between page requests, including rows sharing the
+// cursor's timestamp. Every row that existed before the walk started must be
+// returned exactly once.
+func TestPaginate_StableUnderConcurrentInserts(t *testing.T) {
+	p := pagination.New("secret")
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	table := &table{}
+	original := map[uuid.UUID]bool{}
+	for i := 0; i < 25; i++ {
+		// Groups of three rows share a timestamp so the id tie-breaker matters.
+		r := row{id: uuid.New(), createdAt: base.Add(time.Duration(i/3) * time.Minute)}
+		table.insert(r)
+		original[r.id] = true
+	}
+
+	seen := map[uuid.UUID]int{}
+	var cursor *string
+	for page := 0; ; page++ {
+		decoded, err := p.Decode(cursor)
+		require.NoError(t, err)
+
+		rows := table.listNewestFirst(decoded, int(pagination.PageSize(4)))
+		rows, cursor = pagination.Paginate(p, rows, 4, rowCursor)
+		for _, r := range rows {
+			seen[r.id]++
+		}
+
+		// Concurrent writers: a brand new row, and one that ties with the
+		// last row on the page.
+		table.insert(row{id: uuid.New(), createdAt: base.Add(time.Hour + time.Duration(page)*time.Second)})
+		if len(rows) > 0 {
+			table.insert(row{id: uuid.New(), createdAt: rows[len(rows)-1].createdAt})
+		}
+
+		if cursor == nil {
+			break
+		}
+		require.Less(t, page, 50, "pagination did not terminate")
+	}
+
+	for id := range original {
+		assert.Equal(t, 1, seen[id], "row %s", id)
+	}
+	for id, count := range seen {
+		assert.Equal(t, 1, count, "row %s returned more than once", id)
+	}
+}
+
+type row struct {
+	id        uuid.UUID
+	createdAt time.Time
+}
+
+func rowCursor(r row) pagination.Cursor {
+	return pagination.AtTime(r.createdAt, r.id)
+}
+
+// table mimics a keyset query:
+//
+//	WHERE $cursor IS NULL OR (created_at, id) < ($cursor_created_at, $cursor_id)
+//	ORDER BY created_at DESC, id DESC LIMIT $page_size
+type table struct {
+	rows []row
+}
+
+func (t *table) insert(r row) {
+	t.rows = append(t.rows, r)
+}
+
+func (t *table) listNewestFirst(cursor *pagination.Cursor, pageSize int) []row {
+	sorted := append([]row(nil), t.rows...)
+	sort.Slice(sorted, func(i, j int) bool { return less(sorted[j], sorted[i]) })
+
+	var after *row
+	if cursor != nil {
+		ts, _ := cursor.Timestamp()
+		after = &row{id: cursor.ID, createdAt: ts.Time}
+	}
+
+	var out []row
+	for _, r := range sorted {
+		if after != nil && !less(r, *after) {
+			continue
+		}
+		out = append(out, r)
+		if len(out) == pageSize {
+			break
+		}
+	}
+	return out
+}
+
+func less(a, b row) bool {
+	if !a.createdAt.Equal(b.createdAt) {
+		return a.createdAt.Before(b.createdAt)
+	}
+	return bytes.Compare(a.id[:], b.id[:]) < 0
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
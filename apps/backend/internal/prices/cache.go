@@ -0,0 +1,65 @@
+package prices
+
+import (
+	"circa/internal/errors"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/rs/zerolog/log"
+)
+
+// unavailable is cached for pairs the feed does not know, so they are not
+// requested again until the entry expires.
+const unavailable = "-"
+
+// CachedFeed keeps prices from another feed in Redis. Without a client or
+// with a zero TTL it passes every lookup through. Redis failures are logged
+// and treated as misses.
+type CachedFeed struct {
+	feed   Feed
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewCachedFeed(feed Feed, client *redis.Client, ttl time.Duration) *CachedFeed {
+	return &CachedFeed{feed: feed, client: client, ttl: ttl}
+}
+
+func (c *CachedFeed) Price(ctx context.Context, symbol, currency string, at time.Time) (*big.Rat, error) {
+	if c.client == nil || c.ttl <= 0 {
+		return c.feed.Price(ctx, symbol, currency, at)
+	}
+
+	key := fmt.Sprintf("price:%s:%s:%d", strings.ToUpper(symbol), currency, at.Unix())
+	cached, err := c.client.Get(ctx, key).Result()
+	switch {
+	case err == nil && cached == unavailable:
+		return nil, errors.ErrPriceUnavailable
+	case err == nil:
+		if price, ok := new(big.Rat).SetString(cached); ok {
+			return price, nil
+		}
+		log.Warn().Str("key", key).Msg("Ignoring invalid cached price")
+	case err != redis.Nil:
+		log.Warn().Err(err).Msg("Failed to read cached price")
+	}
+
+	price, err := c.feed.Price(ctx, symbol, currency, at)
+	value := unavailable
+	if err == nil {
+		value = price.RatString()
+	} else if err != errors.ErrPriceUnavailable {
+		return nil, err
+	}
+	if err := c.client.Set(ctx, key, value, c.ttl).Err(); err != nil {
+		log.Warn().Err(err).Msg("Failed to cache price")
+	}
+	if value == unavailable {
+		return nil, errors.ErrPriceUnavailable
+	}
+	return price, nil
+}
@@ -0,0 +1,53 @@
+package prices
+
+import (
+	circaerrors "circa/internal/errors"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedFeed(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	at := time.Date(2026, 1, 11, 15, 0, 0, 0, time.UTC)
+	feed := &dailyFeed{}
+	cached := NewCachedFeed(feed, client, time.Minute)
+
+	for range 2 {
+		price, err := cached.Price(ctx, "USDC", "NGN", at)
+		require.NoError(t, err)
+		assert.Equal(t, "1510.00", price.FloatString(2))
+	}
+	assert.Len(t, feed.asked, 1, "the second lookup should be served from Redis")
+
+	for range 2 {
+		_, err := cached.Price(ctx, "USDC", "EUR", at)
+		assert.ErrorIs(t, err, circaerrors.ErrPriceUnavailable)
+	}
+	assert.Len(t, feed.asked, 2, "unknown pairs are cached too")
+
+	server.FastForward(time.Minute)
+	_, err := cached.Price(ctx, "USDC", "NGN", at)
+	require.NoError(t, err)
+	assert.Len(t, feed.asked, 3, "expired prices are requested again")
+}
+
+func TestCachedFeed_Disabled(t *testing.T) {
+	feed := &dailyFeed{}
+	cached := NewCachedFeed(feed, nil, time.Minute)
+
+	for range 2 {
+		_, err := cached.Price(context.Background(), "USDC", "NGN", time.Now())
+		require.NoError(t, err)
+	}
+	assert.Len(t, feed.asked, 2)
+}
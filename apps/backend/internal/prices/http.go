@@ -0,0 +1,70 @@
+package prices
+
+import (
+	"circa/internal/errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// HTTPFeed asks a price service for historical prices:
+//
+//	GET {baseURL}/price?symbol=USDC&currency=NGN&timestamp=1767225600
+//	200 {"price": "1550.25"}
+//
+// A 404 means the pair is unknown. Anything speaking this protocol can
+// stand in for the provider, including a local server in tests.
+type HTTPFeed struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewHTTPFeed(baseURL string, client *http.Client) *HTTPFeed {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &HTTPFeed{baseURL: baseURL, client: client}
+}
+
+func (f *HTTPFeed) Price(ctx context.Context, symbol, currency string, at time.Time) (*big.Rat, error) {
+	query := url.Values{
+		"symbol":    {symbol},
+		"currency":  {currency},
+		"timestamp": {strconv.FormatInt(at.Unix(), 10)},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.baseURL+"/price?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request price: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errors.ErrPriceUnavailable
+	default:
+		return nil, fmt.Errorf("price feed returned %s", resp.Status)
+	}
+
+	var body struct {
+		Price string `json:"price"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode price: %w", err)
+	}
+	price, ok := new(big.Rat).SetString(body.Price)
+	if !ok || price.Sign() < 0 {
+		return nil, fmt.Errorf("price feed returned an invalid price %q", body.Price)
+	}
+	return price, nil
+}
@@ -0,0 +1,71 @@
+package prices
+
+import (
+	circaerrors "circa/internal/errors"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPFeed(t *testing.T) {
+	at := time.Date(2026, 1, 11, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		expectedPrice string
+		expectedError error
+	}{
+		{
+			name:          "success",
+			status:        http.StatusOK,
+			body:          `{"price": "1550.25"}`,
+			expectedPrice: "1550.25",
+		},
+		{
+			name:          "unknown pair",
+			status:        http.StatusNotFound,
+			expectedError: circaerrors.ErrPriceUnavailable,
+		},
+		{
+			name:   "server error",
+			status: http.StatusBadGateway,
+		},
+		{
+			name:   "invalid price",
+			status: http.StatusOK,
+			body:   `{"price": "lots"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/price", r.URL.Path)
+				assert.Equal(t, "USDC", r.URL.Query().Get("symbol"))
+				assert.Equal(t, "NGN", r.URL.Query().Get("currency"))
+				assert.Equal(t, "1768143600", r.URL.Query().Get("timestamp"))
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			price, err := NewHTTPFeed(server.URL, server.Client()).Price(context.Background(), "USDC", "NGN", at)
+			if tt.expectedPrice != "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPrice, price.FloatString(2))
+				return
+			}
+			require.Error(t, err)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Package prices converts token amounts to fiat currencies for display,
+// using a pluggable price feed.
+package prices
+
+import (
+	"circa/internal/errors"
+	"circa/internal/tokens"
+	"context"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Resolution is the granularity of historical prices. Lookups are rounded
+// down to it, so nearby timestamps share a cached price.
+const Resolution = time.Hour
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Feed quotes the price of one whole token, by symbol, in a fiat currency
+// at a point in time. It returns ErrPriceUnavailable for pairs it does not
+// know.
+type Feed interface {
+	Price(ctx context.Context, symbol, currency string, at time.Time) (*big.Rat, error)
+}
+
+// NormalizeCurrency upper-cases an ISO 4217 code and validates it.
+func NormalizeCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if !currencyPattern.MatchString(currency) {
+		return "", errors.ErrInvalidCurrency
+	}
+	return currency, nil
+}
+
+// Amount is a fiat amount rounded to two decimals, such as "15502.50".
+type Amount struct {
+	Currency string `json:"currency"`
+	Value    string `json:"value"`
+}
+
+func (a Amount) String() string {
+	return a.Currency + " " + a.Value
+}
+
+// Dated is a base unit amount paid at a point in time.
+type Dated struct {
+	Amount string
+	At     time.Time
+}
+
+// Converter turns base unit amounts of registered tokens into fiat. A nil
+// Converter, or one without a feed, converts nothing.
+type Converter struct {
+	feed            Feed
+	tokens          *tokens.Registry
+	defaultCurrency string
+}
+
+// NewConverter creates a converter. defaultCurrency applies to users who
+// have not chosen one; when empty they see no fiat amounts.
+func NewConverter(feed Feed, registry *tokens.Registry, defaultCurrency string) *Converter {
+	return &Converter{feed: feed, tokens: registry, defaultCurrency: defaultCurrency}
+}
+
+// Currency returns the currency to show a user, or "" for none.
+func (c *Converter) Currency(preferred *string) string {
+	if c == nil {
+		return ""
+	}
+	if preferred != nil && *preferred != "" {
+		return *preferred
+	}
+	return c.defaultCurrency
+}
+
+// Supports reports whether amounts of a round's token can be converted.
+func (c *Converter) Supports(chainID int64, token *string) bool {
+	_, ok := c.token(chainID, token)
+	return ok && c.feed != nil
+}
+
+// Convert prices an amount of a round's token at a point in time. It
+// reports false when the token, currency or price is unknown; fiat amounts
+// are optional, so failures are logged rather than returned.
+func (c *Converter) Convert(ctx context.Context, chainID int64, token *string, amount, currency string, at time.Time) (*Amount, bool) {
+	return c.Total(ctx, chainID, token, currency, []Dated{{Amount: amount, At: at}})
+}
+
+// Total prices a series of payments, each at its own time, and sums them.
+func (c *Converter) Total(ctx context.Context, chainID int64, token *string, currency string, amounts []Dated) (*Amount, bool) {
+	if currency == "" || !c.Supports(chainID, token) {
+		return nil, false
+	}
+	t, _ := c.token(chainID, token)
+	unit := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(t.Decimals)), nil))
+
+	total := new(big.Rat)
+	for _, a := range amounts {
+		value, ok := new(big.Int).SetString(a.Amount, 10)
+		if !ok {
+			log.Warn().Str("amount", a.Amount).Msg("Cannot convert an invalid amount")
+			return nil, false
+		}
+		price, err := c.feed.Price(ctx, t.Symbol, currency, a.At.Truncate(Resolution))
+		if err != nil {
+			if err != errors.ErrPriceUnavailable {
+				log.Warn().Err(err).Str("symbol", t.Symbol).Str("currency", currency).Msg("Failed to get token price")
+			}
+			return nil, false
+		}
+		whole := new(big.Rat).Quo(new(big.Rat).SetInt(value), unit)
+		total.Add(total, whole.Mul(whole, price))
+	}
+	return &Amount{Currency: currency, Value: total.FloatString(2)}, true
+}
+
+func (c *Converter) token(chainID int64, token *string) (tokens.Token, bool) {
+	if c == nil || token == nil {
+		return tokens.Token{}, false
+	}
+	return c.tokens.Lookup(chainID, *token)
+}
@@ -0,0 +1,146 @@
+package prices
+
+import (
+	circaerrors "circa/internal/errors"
+	"circa/internal/tokens"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const usdcAddress = "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913"
+
+// dailyFeed prices USDC in NGN at 1500 plus one naira per day since
+// January 1st 2026, and records the times it was asked for.
+type dailyFeed struct {
+	asked []time.Time
+}
+
+func (f *dailyFeed) Price(_ context.Context, symbol, currency string, at time.Time) (*big.Rat, error) {
+	f.asked = append(f.asked, at)
+	if symbol != "USDC" || currency != "NGN" {
+		return nil, circaerrors.ErrPriceUnavailable
+	}
+	days := int64(at.Sub(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+	return new(big.Rat).SetInt64(1500 + days), nil
+}
+
+func newTestRegistry(t *testing.T) *tokens.Registry {
+	t.Helper()
+	registry, err := tokens.NewRegistry([]tokens.Token{
+		{ChainID: 8453, Address: common.HexToAddress(usdcAddress), Symbol: "USDC", Decimals: 6},
+	})
+	require.NoError(t, err)
+	return registry
+}
+
+func TestNormalizeCurrency(t *testing.T) {
+	currency, err := NormalizeCurrency(" ngn ")
+	require.NoError(t, err)
+	assert.Equal(t, "NGN", currency)
+
+	for _, invalid := range []string{"", "NG", "NAIRA", "N1N"} {
+		_, err := NormalizeCurrency(invalid)
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidCurrency, invalid)
+	}
+}
+
+func TestConverter_Currency(t *testing.T) {
+	preferred := "USD"
+	empty := ""
+
+	converter := NewConverter(nil, nil, "NGN")
+	assert.Equal(t, "USD", converter.Currency(&preferred))
+	assert.Equal(t, "NGN", converter.Currency(&empty))
+	assert.Equal(t, "NGN", converter.Currency(nil))
+
+	var none *Converter
+	assert.Equal(t, "", none.Currency(&preferred))
+}
+
+func TestConverter_Convert(t *testing.T) {
+	ctx := context.Background()
+	token := usdcAddress
+	unknown := "0x0000000000000000000000000000000000000abc"
+	at := time.Date(2026, 1, 11, 15, 42, 0, 0, time.UTC)
+
+	feed := &dailyFeed{}
+	converter := NewConverter(feed, newTestRegistry(t), "NGN")
+
+	amount, ok := converter.Convert(ctx, 8453, &token, "12500000", "NGN", at)
+	require.True(t, ok)
+	assert.Equal(t, Amount{Currency: "NGN", Value: "18875.00"}, *amount)
+	assert.Equal(t, "NGN 18875.00", amount.String())
+	assert.Equal(t, []time.Time{at.Truncate(Resolution)}, feed.asked, "lookups are rounded to the resolution")
+
+	tests := []struct {
+		name      string
+		converter *Converter
+		chainID   int64
+		token     *string
+		amount    string
+		currency  string
+	}{
+		{name: "nil converter", chainID: 8453, token: &token, amount: "1", currency: "NGN"},
+		{name: "no feed", converter: NewConverter(nil, newTestRegistry(t), "NGN"), chainID: 8453, token: &token, amount: "1", currency: "NGN"},
+		{name: "no currency", converter: converter, chainID: 8453, token: &token, amount: "1"},
+		{name: "no token", converter: converter, chainID: 8453, amount: "1", currency: "NGN"},
+		{name: "unregistered token", converter: converter, chainID: 8453, token: &unknown, amount: "1", currency: "NGN"},
+		{name: "other chain", converter: converter, chainID: 1, token: &token, amount: "1", currency: "NGN"},
+		{name: "unknown currency", converter: converter, chainID: 8453, token: &token, amount: "1", currency: "EUR"},
+		{name: "invalid amount", converter: converter, chainID: 8453, token: &token, amount: "1e6", currency: "NGN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, ok := tt.converter.Convert(ctx, tt.chainID, tt.token, tt.amount, tt.currency, at)
+			assert.False(t, ok)
+			assert.Nil(t, amount)
+		})
+	}
+}
+
+func TestConverter_Total(t *testing.T) {
+	token := usdcAddress
+	converter := NewConverter(&dailyFeed{}, newTestRegistry(t), "NGN")
+
+	// Each payment is priced on its own day: 10 USDC at 1500 and 10 USDC
+	// at 1510.
+	total, ok := converter.Total(context.Background(), 8453, &token, "NGN", []Dated{
+		{Amount: "10000000", At: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)},
+		{Amount: "10000000", At: time.Date(2026, 1, 11, 9, 0, 0, 0, time.UTC)},
+	})
+	require.True(t, ok)
+	assert.Equal(t, "30100.00", total.Value)
+
+	total, ok = converter.Total(context.Background(), 8453, &token, "NGN", nil)
+	require.True(t, ok)
+	assert.Equal(t, "0.00", total.Value)
+}
+
+func TestStaticFeed(t *testing.T) {
+	feed, err := NewStaticFeed(map[string]map[string]string{
+		"usdc": {"ngn": "1550.25", "USD": "1"},
+	})
+	require.NoError(t, err)
+
+	price, err := feed.Price(context.Background(), "USDC", "NGN", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "1550.25", price.FloatString(2))
+
+	_, err = feed.Price(context.Background(), "USDC", "EUR", time.Now())
+	assert.ErrorIs(t, err, circaerrors.ErrPriceUnavailable)
+	_, err = feed.Price(context.Background(), "DAI", "USD", time.Now())
+	assert.ErrorIs(t, err, circaerrors.ErrPriceUnavailable)
+
+	_, err = NewStaticFeed(map[string]map[string]string{"USDC": {"naira": "1"}})
+	assert.ErrorIs(t, err, circaerrors.ErrInvalidCurrency)
+	_, err = NewStaticFeed(map[string]map[string]string{"USDC": {"NGN": "-1"}})
+	assert.Error(t, err)
+	_, err = NewStaticFeed(map[string]map[string]string{"USDC": {"NGN": "cheap"}})
+	assert.Error(t, err)
+}
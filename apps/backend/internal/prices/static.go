@@ -0,0 +1,61 @@
+package prices
+
+import (
+	"circa/internal/errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// StaticFeed serves fixed prices, whatever the time. It suits stablecoins
+// pegged to a currency and deployments without a price provider.
+type StaticFeed struct {
+	prices map[string]map[string]*big.Rat
+}
+
+// NewStaticFeed builds a feed from decimal prices by token symbol, then
+// currency: {"USDC": {"NGN": "1550.25"}}.
+func NewStaticFeed(prices map[string]map[string]string) (*StaticFeed, error) {
+	f := &StaticFeed{prices: make(map[string]map[string]*big.Rat, len(prices))}
+	for symbol, quotes := range prices {
+		symbol = strings.ToUpper(symbol)
+		f.prices[symbol] = make(map[string]*big.Rat, len(quotes))
+		for currency, value := range quotes {
+			normalized, err := NormalizeCurrency(currency)
+			if err != nil {
+				return nil, fmt.Errorf("price of %s in %q: %w", symbol, currency, err)
+			}
+			price, ok := new(big.Rat).SetString(value)
+			if !ok || price.Sign() < 0 {
+				return nil, fmt.Errorf("price of %s in %s is not a non-negative decimal: %q", symbol, normalized, value)
+			}
+			f.prices[symbol][normalized] = price
+		}
+	}
+	return f, nil
+}
+
+// LoadStaticFile reads a static feed from a JSON file.
+func LoadStaticFile(path string) (*StaticFeed, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read price file: %w", err)
+	}
+	var prices map[string]map[string]string
+	if err := json.Unmarshal(data, &prices); err != nil {
+		return nil, fmt.Errorf("parse price file %s: %w", path, err)
+	}
+	return NewStaticFeed(prices)
+}
+
+func (f *StaticFeed) Price(_ context.Context, symbol, currency string, _ time.Time) (*big.Rat, error) {
+	price, ok := f.prices[strings.ToUpper(symbol)][currency]
+	if !ok {
+		return nil, errors.ErrPriceUnavailable
+	}
+	return new(big.Rat).Set(price), nil
+}
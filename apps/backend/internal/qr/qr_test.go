@@ -0,0 +1,35 @@
+package qr
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPNG(t *testing.T) {
+	data, err := PNG("https://circa.example/invite?code=abc", 256)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 256, img.Bounds().Dx())
+	assert.Equal(t, 256, img.Bounds().Dy())
+}
+
+func TestSVG(t *testing.T) {
+	svg, err := SVG("https://circa.example/invite?code=abc")
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg"`))
+	assert.True(t, strings.HasSuffix(svg, "</svg>"))
+	assert.Contains(t, svg, `<path fill="#000" d="M`)
+}
+
+func TestSVG_TooLong(t *testing.T) {
+	_, err := SVG(strings.Repeat("x", 5000))
+	assert.Error(t, err)
+}
@@ -1,8 +1,9 @@
-// Code generated by mockery v2.53.5. DO NOT EDIT.
+// Code generated by mockery v2.53.7. DO NOT EDIT.
 
 package email
 
 import (
+	email "circa/internal/email"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -21,17 +22,158 @@ func (_m *MockEmailService) EXPECT() *MockEmailService_Expecter {
 	return &MockEmailService_Expecter{mock: &_m.Mock}
 }
 
-// SendMagicLink provides a mock function with given fields: ctx, toEmail, toName, magicLinkURL
-func (_m *MockEmailService) SendMagicLink(ctx context.Context, toEmail string, toName string, magicLinkURL string) error {
-	ret := _m.Called(ctx, toEmail, toName, magicLinkURL)
+// SendContributionReminder provides a mock function with given fields: ctx, reminder
+func (_m *MockEmailService) SendContributionReminder(ctx context.Context, reminder email.ContributionReminder) error {
+	ret := _m.Called(ctx, reminder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendContributionReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, email.ContributionReminder) error); ok {
+		r0 = rf(ctx, reminder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendContributionReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendContributionReminder'
+type MockEmailService_SendContributionReminder_Call struct {
+	*mock.Call
+}
+
+// SendContributionReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reminder email.ContributionReminder
+func (_e *MockEmailService_Expecter) SendContributionReminder(ctx interface{}, reminder interface{}) *MockEmailService_SendContributionReminder_Call {
+	return &MockEmailService_SendContributionReminder_Call{Call: _e.mock.On("SendContributionReminder", ctx, reminder)}
+}
+
+func (_c *MockEmailService_SendContributionReminder_Call) Run(run func(ctx context.Context, reminder email.ContributionReminder)) *MockEmailService_SendContributionReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(email.ContributionReminder))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendContributionReminder_Call) Return(_a0 error) *MockEmailService_SendContributionReminder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendContributionReminder_Call) RunAndReturn(run func(context.Context, email.ContributionReminder) error) *MockEmailService_SendContributionReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDelinquencyNotice provides a mock function with given fields: ctx, notice
+func (_m *MockEmailService) SendDelinquencyNotice(ctx context.Context, notice email.DelinquencyNotice) error {
+	ret := _m.Called(ctx, notice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDelinquencyNotice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, email.DelinquencyNotice) error); ok {
+		r0 = rf(ctx, notice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendDelinquencyNotice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDelinquencyNotice'
+type MockEmailService_SendDelinquencyNotice_Call struct {
+	*mock.Call
+}
+
+// SendDelinquencyNotice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notice email.DelinquencyNotice
+func (_e *MockEmailService_Expecter) SendDelinquencyNotice(ctx interface{}, notice interface{}) *MockEmailService_SendDelinquencyNotice_Call {
+	return &MockEmailService_SendDelinquencyNotice_Call{Call: _e.mock.On("SendDelinquencyNotice", ctx, notice)}
+}
+
+func (_c *MockEmailService_SendDelinquencyNotice_Call) Run(run func(ctx context.Context, notice email.DelinquencyNotice)) *MockEmailService_SendDelinquencyNotice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(email.DelinquencyNotice))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendDelinquencyNotice_Call) Return(_a0 error) *MockEmailService_SendDelinquencyNotice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendDelinquencyNotice_Call) RunAndReturn(run func(context.Context, email.DelinquencyNotice) error) *MockEmailService_SendDelinquencyNotice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDiscrepancyAlert provides a mock function with given fields: ctx, alert
+func (_m *MockEmailService) SendDiscrepancyAlert(ctx context.Context, alert email.DiscrepancyAlert) error {
+	ret := _m.Called(ctx, alert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDiscrepancyAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, email.DiscrepancyAlert) error); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendDiscrepancyAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDiscrepancyAlert'
+type MockEmailService_SendDiscrepancyAlert_Call struct {
+	*mock.Call
+}
+
+// SendDiscrepancyAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert email.DiscrepancyAlert
+func (_e *MockEmailService_Expecter) SendDiscrepancyAlert(ctx interface{}, alert interface{}) *MockEmailService_SendDiscrepancyAlert_Call {
+	return &MockEmailService_SendDiscrepancyAlert_Call{Call: _e.mock.On("SendDiscrepancyAlert", ctx, alert)}
+}
+
+func (_c *MockEmailService_SendDiscrepancyAlert_Call) Run(run func(ctx context.Context, alert email.DiscrepancyAlert)) *MockEmailService_SendDiscrepancyAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(email.DiscrepancyAlert))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendDiscrepancyAlert_Call) Return(_a0 error) *MockEmailService_SendDiscrepancyAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendDiscrepancyAlert_Call) RunAndReturn(run func(context.Context, email.DiscrepancyAlert) error) *MockEmailService_SendDiscrepancyAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMagicLink provides a mock function with given fields: ctx, toEmail, toName, magicLinkURL, isLogin
+func (_m *MockEmailService) SendMagicLink(ctx context.Context, toEmail string, toName string, magicLinkURL string, isLogin bool) error {
+	ret := _m.Called(ctx, toEmail, toName, magicLinkURL, isLogin)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendMagicLink")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, toEmail, toName, magicLinkURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) error); ok {
+		r0 = rf(ctx, toEmail, toName, magicLinkURL, isLogin)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -49,13 +191,14 @@ type MockEmailService_SendMagicLink_Call struct {
 //   - toEmail string
 //   - toName string
 //   - magicLinkURL string
-func (_e *MockEmailService_Expecter) SendMagicLink(ctx interface{}, toEmail interface{}, toName interface{}, magicLinkURL interface{}) *MockEmailService_SendMagicLink_Call {
-	return &MockEmailService_SendMagicLink_Call{Call: _e.mock.On("SendMagicLink", ctx, toEmail, toName, magicLinkURL)}
+//   - isLogin bool
+func (_e *MockEmailService_Expecter) SendMagicLink(ctx interface{}, toEmail interface{}, toName interface{}, magicLinkURL interface{}, isLogin interface{}) *MockEmailService_SendMagicLink_Call {
+	return &MockEmailService_SendMagicLink_Call{Call: _e.mock.On("SendMagicLink", ctx, toEmail, toName, magicLinkURL, isLogin)}
 }
 
-func (_c *MockEmailService_SendMagicLink_Call) Run(run func(ctx context.Context, toEmail string, toName string, magicLinkURL string)) *MockEmailService_SendMagicLink_Call {
+func (_c *MockEmailService_SendMagicLink_Call) Run(run func(ctx context.Context, toEmail string, toName string, magicLinkURL string, isLogin bool)) *MockEmailService_SendMagicLink_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
 	})
 	return _c
 }
@@ -65,7 +208,54 @@ func (_c *MockEmailService_SendMagicLink_Call) Return(_a0 error) *MockEmailServi
 	return _c
 }
 
-func (_c *MockEmailService_SendMagicLink_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockEmailService_SendMagicLink_Call {
+func (_c *MockEmailService_SendMagicLink_Call) RunAndReturn(run func(context.Context, string, string, string, bool) error) *MockEmailService_SendMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendRoundReady provides a mock function with given fields: ctx, notice
+func (_m *MockEmailService) SendRoundReady(ctx context.Context, notice email.RoundReady) error {
+	ret := _m.Called(ctx, notice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRoundReady")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, email.RoundReady) error); ok {
+		r0 = rf(ctx, notice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEmailService_SendRoundReady_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendRoundReady'
+type MockEmailService_SendRoundReady_Call struct {
+	*mock.Call
+}
+
+// SendRoundReady is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notice email.RoundReady
+func (_e *MockEmailService_Expecter) SendRoundReady(ctx interface{}, notice interface{}) *MockEmailService_SendRoundReady_Call {
+	return &MockEmailService_SendRoundReady_Call{Call: _e.mock.On("SendRoundReady", ctx, notice)}
+}
+
+func (_c *MockEmailService_SendRoundReady_Call) Run(run func(ctx context.Context, notice email.RoundReady)) *MockEmailService_SendRoundReady_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(email.RoundReady))
+	})
+	return _c
+}
+
+func (_c *MockEmailService_SendRoundReady_Call) Return(_a0 error) *MockEmailService_SendRoundReady_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEmailService_SendRoundReady_Call) RunAndReturn(run func(context.Context, email.RoundReady) error) *MockEmailService_SendRoundReady_Call {
 	_c.Call.Return(run)
 	return _c
 }
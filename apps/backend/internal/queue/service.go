@@ -26,6 +26,11 @@ func NewService(store db.Store) *Service {
 type JobPayload map[string]interface{}
 
 func (s *Service) Enqueue(ctx context.Context, jobType string, payload JobPayload, maxRetries *int) (*sqlc.Job, error) {
+	return s.EnqueueAt(ctx, jobType, payload, time.Now(), maxRetries)
+}
+
+// EnqueueAt enqueues a job that will not be picked up before scheduledAt.
+func (s *Service) EnqueueAt(ctx context.Context, jobType string, payload JobPayload, scheduledAt time.Time, maxRetries *int) (*sqlc.Job, error) {
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to marshal job payload")
@@ -41,7 +46,7 @@ func (s *Service) Enqueue(ctx context.Context, jobType string, payload JobPayloa
 		Type:        jobType,
 		Payload:     payloadJSON,
 		MaxRetries:  int32(retries),
-		ScheduledAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		ScheduledAt: pgtype.Timestamptz{Time: scheduledAt, Valid: true},
 	}
 
 	job, err := s.store.CreateJob(ctx, params)
@@ -53,11 +58,28 @@ func (s *Service) Enqueue(ctx context.Context, jobType string, payload JobPayloa
 	log.Info().
 		Str("job_id", job.ID.String()).
 		Str("job_type", jobType).
+		Time("scheduled_at", scheduledAt).
 		Msg("Job enqueued successfully")
 
 	return &job, nil
 }
 
+// EnsureScheduled enqueues a job of the given type at scheduledAt unless one
+// is already pending. Recurring jobs use it so that restarts and multiple
+// workers don't pile up duplicate runs.
+func (s *Service) EnsureScheduled(ctx context.Context, jobType string, payload JobPayload, scheduledAt time.Time) (*sqlc.Job, error) {
+	job, err := s.store.GetPendingJobByType(ctx, jobType)
+	if err == nil {
+		return &job, nil
+	}
+	if err != pgx.ErrNoRows {
+		log.Error().Err(err).Str("job_type", jobType).Msg("Failed to get pending job")
+		return nil, err
+	}
+
+	return s.EnqueueAt(ctx, jobType, payload, scheduledAt, nil)
+}
+
 func (s *Service) GetNextPendingJob(ctx context.Context) (*sqlc.Job, error) {
 	job, err := s.store.GetNextPendingJob(ctx)
 	if err != nil {
@@ -119,6 +119,60 @@ func TestService_Enqueue(t *testing.T) {
 	}
 }
 
+func TestService_EnsureScheduled(t *testing.T) {
+	scheduledAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+	}{
+		{
+			name: "success - pending job already exists",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetPendingJobByType", mock.Anything, "recurring_job").Return(createTestJob(), nil)
+			},
+		},
+		{
+			name: "success - enqueues when none pending",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetPendingJobByType", mock.Anything, "recurring_job").Return(sqlc.Job{}, pgx.ErrNoRows)
+				ms.On("CreateJob", mock.Anything, mock.MatchedBy(func(params sqlc.CreateJobParams) bool {
+					return params.Type == "recurring_job" && params.ScheduledAt.Time.Equal(scheduledAt)
+				})).Return(createTestJob(), nil)
+			},
+		},
+		{
+			name: "error - database error",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetPendingJobByType", mock.Anything, "recurring_job").Return(sqlc.Job{}, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := queue.NewService(mockStore)
+
+			job, err := service.EnsureScheduled(context.Background(), "recurring_job", queue.JobPayload{}, scheduledAt)
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, job)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, job)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_GetNextPendingJob(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -10,9 +10,20 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// JobHandler processes a single job. Returning an error retries the job
+// until it runs out of retries.
+type JobHandler func(ctx context.Context, job *sqlc.Job) error
+
+type schedule struct {
+	payload  JobPayload
+	interval time.Duration
+}
+
 type Worker struct {
 	queueService *Service
 	emailService email.EmailService
+	handlers     map[string]JobHandler
+	schedules    map[string]schedule
 	stopChan     chan struct{}
 }
 
@@ -20,14 +31,31 @@ func NewWorker(queueService *Service, emailService email.EmailService) *Worker {
 	return &Worker{
 		queueService: queueService,
 		emailService: emailService,
+		handlers:     make(map[string]JobHandler),
+		schedules:    make(map[string]schedule),
 		stopChan:     make(chan struct{}),
 	}
 }
 
+// Register sets the handler for a job type. It must be called before Start.
+func (w *Worker) Register(jobType string, handler JobHandler) {
+	w.handlers[jobType] = handler
+}
+
+// Schedule makes a registered job type recurring: a run is enqueued when the
+// worker starts and the next one is enqueued interval after each run.
+func (w *Worker) Schedule(jobType string, payload JobPayload, interval time.Duration) {
+	w.schedules[jobType] = schedule{payload: payload, interval: interval}
+}
+
 func (w *Worker) Start(ctx context.Context) {
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 
+	for jobType, sched := range w.schedules {
+		w.ensureScheduled(ctx, jobType, sched, time.Now())
+	}
+
 	log.Info().Msg("Queue worker started")
 
 	for {
@@ -67,13 +95,63 @@ func (w *Worker) ProcessJobs(ctx context.Context) {
 	case "send_magic_link_email":
 		w.handleSendMagicLinkEmail(ctx, job)
 	default:
-		log.Warn().
+		handler, ok := w.handlers[job.Type]
+		if !ok {
+			log.Warn().
+				Str("job_type", job.Type).
+				Msg("Unknown job type")
+			w.queueService.MarkJobFailed(ctx, job.ID, "Unknown job type")
+			return
+		}
+		w.runHandler(ctx, job, handler)
+	}
+}
+
+func (w *Worker) runHandler(ctx context.Context, job *sqlc.Job, handler JobHandler) {
+	if err := handler(ctx, job); err != nil {
+		log.Error().
+			Err(err).
+			Str("job_id", job.ID.String()).
 			Str("job_type", job.Type).
-			Msg("Unknown job type")
-		w.queueService.MarkJobFailed(ctx, job.ID, "Unknown job type")
+			Msg("Job failed")
+		w.retryOrFail(ctx, job, err)
+	} else if err := w.queueService.MarkJobCompleted(ctx, job.ID); err != nil {
+		log.Error().Err(err).Str("job_id", job.ID.String()).Msg("Failed to mark job as completed")
+	} else {
+		log.Info().
+			Str("job_id", job.ID.String()).
+			Str("job_type", job.Type).
+			Msg("Job completed")
+	}
+
+	if sched, ok := w.schedules[job.Type]; ok {
+		w.ensureScheduled(ctx, job.Type, sched, time.Now().Add(sched.interval))
+	}
+}
+
+func (w *Worker) ensureScheduled(ctx context.Context, jobType string, sched schedule, at time.Time) {
+	if _, err := w.queueService.EnsureScheduled(ctx, jobType, sched.payload, at); err != nil {
+		log.Error().Err(err).Str("job_type", jobType).Msg("Failed to schedule recurring job")
 	}
 }
 
+// retryOrFail puts the job back in the queue if it has retries left and
+// marks it failed otherwise.
+func (w *Worker) retryOrFail(ctx context.Context, job *sqlc.Job, err error) {
+	if job.RetryCount < job.MaxRetries {
+		if retryErr := w.queueService.RetryJob(ctx, job.ID, err.Error()); retryErr != nil {
+			log.Error().Err(retryErr).Str("job_id", job.ID.String()).Msg("Failed to retry job")
+		} else {
+			log.Info().
+				Str("job_id", job.ID.String()).
+				Int32("retry_count", job.RetryCount+1).
+				Msg("Job scheduled for retry")
+		}
+		return
+	}
+	w.queueService.MarkJobFailed(ctx, job.ID, err.Error())
+}
+
 func (w *Worker) handleSendMagicLinkEmail(ctx context.Context, job *sqlc.Job) {
 	var payload struct {
 		Email        string `json:"email"`
@@ -102,18 +180,7 @@ func (w *Worker) handleSendMagicLinkEmail(ctx context.Context, job *sqlc.Job) {
 			Int32("max_retries", job.MaxRetries).
 			Msg("Failed to send magic link email")
 
-		if job.RetryCount < job.MaxRetries {
-			if retryErr := w.queueService.RetryJob(ctx, job.ID, err.Error()); retryErr != nil {
-				log.Error().Err(retryErr).Str("job_id", job.ID.String()).Msg("Failed to retry job")
-			} else {
-				log.Info().
-					Str("job_id", job.ID.String()).
-					Int32("retry_count", job.RetryCount+1).
-					Msg("Job scheduled for retry")
-			}
-		} else {
-			w.queueService.MarkJobFailed(ctx, job.ID, err.Error())
-		}
+		w.retryOrFail(ctx, job, err)
 		return
 	}
 
@@ -14,7 +14,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWorker_ProcessJobs(t *testing.T) {
@@ -43,7 +45,7 @@ func TestWorker_ProcessJobs(t *testing.T) {
 					"magic_link_url": "https://example.com/verify?token=abc123",
 				})
 				ms.On("GetNextPendingJob", mock.Anything).Return(job, nil).Once()
-				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123").
+				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123", false).
 					Return(nil).Once()
 				ms.On("UpdateJobStatus", mock.Anything, mock.MatchedBy(func(params sqlc.UpdateJobStatusParams) bool {
 					return params.Status == "completed"
@@ -131,7 +133,7 @@ func TestWorker_ProcessJobs(t *testing.T) {
 				job.RetryCount = 0
 				job.MaxRetries = 3
 				ms.On("GetNextPendingJob", mock.Anything).Return(job, nil).Once()
-				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123").
+				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123", false).
 					Return(errors.New("email service error")).Once()
 				ms.On("IncrementJobRetry", mock.Anything, mock.MatchedBy(func(params sqlc.IncrementJobRetryParams) bool {
 					return params.ErrorMessage != nil &&
@@ -154,7 +156,7 @@ func TestWorker_ProcessJobs(t *testing.T) {
 				job.RetryCount = 3
 				job.MaxRetries = 3
 				ms.On("GetNextPendingJob", mock.Anything).Return(job, nil).Once()
-				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123").
+				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123", false).
 					Return(errors.New("email service error")).Once()
 				ms.On("UpdateJobStatus", mock.Anything, mock.MatchedBy(func(params sqlc.UpdateJobStatusParams) bool {
 					if params.Status != "failed" {
@@ -181,7 +183,7 @@ func TestWorker_ProcessJobs(t *testing.T) {
 					"magic_link_url": "https://example.com/verify?token=abc123",
 				})
 				ms.On("GetNextPendingJob", mock.Anything).Return(job, nil).Once()
-				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123").
+				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123", false).
 					Return(nil).Once()
 				ms.On("UpdateJobStatus", mock.Anything, mock.Anything).
 					Return(sqlc.Job{}, errors.New("database error")).Once()
@@ -202,7 +204,7 @@ func TestWorker_ProcessJobs(t *testing.T) {
 				job.RetryCount = 1
 				job.MaxRetries = 3
 				ms.On("GetNextPendingJob", mock.Anything).Return(job, nil).Once()
-				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123").
+				es.On("SendMagicLink", mock.Anything, "test@example.com", "Test User", "https://example.com/verify?token=abc123", false).
 					Return(errors.New("email service error")).Once()
 				ms.On("IncrementJobRetry", mock.Anything, mock.Anything).
 					Return(sqlc.Job{}, errors.New("database error")).Once()
@@ -239,6 +241,71 @@ func TestWorker_ProcessJobs(t *testing.T) {
 	}
 }
 
+func TestWorker_RegisteredHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		handlerErr error
+		scheduled  bool
+		setupMocks func(*dbmocks.MockStore, sqlc.Job)
+	}{
+		{
+			name: "success - handler completes job",
+			setupMocks: func(ms *dbmocks.MockStore, job sqlc.Job) {
+				ms.On("UpdateJobStatus", mock.Anything, mock.MatchedBy(func(params sqlc.UpdateJobStatusParams) bool {
+					return params.Status == "completed" && params.ID == job.ID
+				})).Return(job, nil).Once()
+			},
+		},
+		{
+			name:       "error - handler failure is retried",
+			handlerErr: errors.New("boom"),
+			setupMocks: func(ms *dbmocks.MockStore, job sqlc.Job) {
+				ms.On("IncrementJobRetry", mock.Anything, mock.MatchedBy(func(params sqlc.IncrementJobRetryParams) bool {
+					return params.ErrorMessage != nil && *params.ErrorMessage == "boom"
+				})).Return(job, nil).Once()
+			},
+		},
+		{
+			name:      "success - scheduled job enqueues its next run",
+			scheduled: true,
+			setupMocks: func(ms *dbmocks.MockStore, job sqlc.Job) {
+				ms.On("UpdateJobStatus", mock.Anything, mock.Anything).Return(job, nil).Once()
+				ms.On("GetPendingJobByType", mock.Anything, "custom_job").Return(sqlc.Job{}, pgx.ErrNoRows).Once()
+				ms.On("CreateJob", mock.Anything, mock.MatchedBy(func(params sqlc.CreateJobParams) bool {
+					return params.Type == "custom_job" && params.ScheduledAt.Time.After(time.Now().Add(59*time.Minute))
+				})).Return(job, nil).Once()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			job := createTestJobWithType("custom_job", map[string]interface{}{"key": "value"})
+			job.MaxRetries = 3
+			mockStore.On("GetNextPendingJob", mock.Anything).Return(job, nil).Once()
+			tt.setupMocks(mockStore, job)
+
+			worker := queue.NewWorker(queue.NewService(mockStore), nil)
+
+			var handled *sqlc.Job
+			worker.Register("custom_job", func(ctx context.Context, j *sqlc.Job) error {
+				handled = j
+				return tt.handlerErr
+			})
+			if tt.scheduled {
+				worker.Schedule("custom_job", queue.JobPayload{}, time.Hour)
+			}
+
+			worker.ProcessJobs(context.Background())
+
+			require.NotNil(t, handled)
+			assert.Equal(t, job.ID, handled.ID)
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
 func TestWorker_Start(t *testing.T) {
 	mockStore := dbmocks.NewMockStore(t)
 	mockEmailService := mocks.NewMockEmailService(t)
@@ -25,6 +25,13 @@ type GetSessionUserResult struct {
 	User sqlc.User
 }
 
+// UpdateProfileParams changes the fields that are set and keeps the others.
+type UpdateProfileParams struct {
+	DisplayName       *string
+	AvatarURL         *string
+	PreferredCurrency *string
+}
+
 type AuthService interface {
 	CreatePendingSignup(ctx context.Context, fullName, email string, displayName *string) (*SignupResult, error)
 	CreateLoginMagicLink(ctx context.Context, email string) (*LoginResult, error)
@@ -33,4 +40,5 @@ type AuthService interface {
 	GetSignupSession(ctx context.Context, sessionID string) (map[string]interface{}, error)
 	CompleteSignup(ctx context.Context, sessionID, address, signature, message string) (*CompleteSignupResult, error)
 	GetSessionUser(ctx context.Context, sessionID string) (*GetSessionUserResult, error)
+	UpdateProfile(ctx context.Context, user sqlc.User, params UpdateProfileParams) (*sqlc.User, error)
 }
@@ -4,6 +4,7 @@ import (
 	"circa/internal/db"
 	sqlc "circa/internal/db/sqlc/generated"
 	"circa/internal/errors"
+	"circa/internal/prices"
 	"circa/internal/queue"
 	circaredis "circa/internal/redis"
 	"context"
@@ -783,3 +784,30 @@ func (s *Service) GetSessionUser(ctx context.Context, sessionID string) (*GetSes
 		User: user,
 	}, nil
 }
+
+// UpdateProfile updates the user's display name, avatar and preferred
+// currency. The currency is stored upper-cased.
+func (s *Service) UpdateProfile(ctx context.Context, user sqlc.User, params UpdateProfileParams) (*sqlc.User, error) {
+	if params.PreferredCurrency != nil {
+		currency, err := prices.NormalizeCurrency(*params.PreferredCurrency)
+		if err != nil {
+			return nil, err
+		}
+		params.PreferredCurrency = &currency
+	}
+
+	updated, err := s.store.UpdateUserProfile(ctx, sqlc.UpdateUserProfileParams{
+		ID:                user.ID,
+		DisplayName:       params.DisplayName,
+		AvatarUrl:         params.AvatarURL,
+		PreferredCurrency: params.PreferredCurrency,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrInvalidSession
+		}
+		log.Error().Err(err).Msg("Failed to update user profile")
+		return nil, err
+	}
+	return &updated, nil
+}
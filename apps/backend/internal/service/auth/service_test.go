@@ -129,6 +129,62 @@ func TestService_CreatePendingSignup(t *testing.T) {
 	}
 }
 
+func TestService_UpdateProfile(t *testing.T) {
+	user := createTestUser()
+
+	tests := []struct {
+		name          string
+		params        UpdateProfileParams
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+	}{
+		{
+			name:   "success - currency is upper-cased",
+			params: UpdateProfileParams{DisplayName: stringPtr("ada"), PreferredCurrency: stringPtr(" ngn ")},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("UpdateUserProfile", mock.Anything, sqlc.UpdateUserProfileParams{
+					ID:                user.ID,
+					DisplayName:       stringPtr("ada"),
+					PreferredCurrency: stringPtr("NGN"),
+				}).Return(user, nil)
+			},
+		},
+		{
+			name:          "error - invalid currency",
+			params:        UpdateProfileParams{PreferredCurrency: stringPtr("naira")},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidCurrency,
+		},
+		{
+			name:   "error - user no longer exists",
+			params: UpdateProfileParams{DisplayName: stringPtr("ada")},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("UpdateUserProfile", mock.Anything, mock.Anything).
+					Return(sqlc.User{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrInvalidSession,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, nil, "https://example.com", 15*time.Minute)
+
+			result, err := service.UpdateProfile(context.Background(), user, tt.params)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, user.ID, result.ID)
+		})
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
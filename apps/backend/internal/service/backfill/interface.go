@@ -0,0 +1,32 @@
+package backfill
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/indexer"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Backfill statuses.
+const (
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+// ChunkJob is the queue job type that backfills the next chunk of blocks
+// of a backfill and queues the chunk after it.
+const ChunkJob = "backfill_chunk"
+
+// EventReplacer stores the events of a block range in place of the ones
+// indexed for it. The indexer repository implements it.
+type EventReplacer interface {
+	ReplaceEvents(ctx context.Context, contract indexer.Contract, events []indexer.Event, from, to uint64) (int, error)
+}
+
+type BackfillService interface {
+	Start(ctx context.Context, roundID uuid.UUID, from uint64, requestedBy *uuid.UUID) (*sqlc.IndexerBackfill, error)
+	Resume(ctx context.Context, backfillID uuid.UUID) (*sqlc.IndexerBackfill, error)
+	List(ctx context.Context, roundID uuid.UUID) ([]sqlc.IndexerBackfill, error)
+}
@@ -0,0 +1,293 @@
+package backfill
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/queue"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const chunkRetries = 3
+
+type Service struct {
+	store        db.Store
+	queueService *queue.Service
+	chains       map[int64]indexer.Chain
+	events       EventReplacer
+	blockRange   uint64
+	delay        time.Duration
+}
+
+// NewService returns a backfill service that reads blockRange blocks per
+// chunk and waits delay between chunks.
+func NewService(store db.Store, queueService *queue.Service, chains map[int64]indexer.Chain, events EventReplacer, blockRange uint64, delay time.Duration) *Service {
+	if blockRange == 0 {
+		blockRange = indexer.DefaultBlockRange
+	}
+	return &Service{
+		store:        store,
+		queueService: queueService,
+		chains:       chains,
+		events:       events,
+		blockRange:   blockRange,
+		delay:        delay,
+	}
+}
+
+// Start backfills a round's events from block from up to the block the
+// live indexer has reached, or the last confirmed block when it has not
+// indexed the round yet. Blocks are read in chunks by queued jobs, so a
+// backfill carries on where it was after a restart. Events already indexed
+// in the range are replaced by the chain's, and the live indexer keeps
+// running meanwhile. A round has at most one running backfill.
+func (s *Service) Start(ctx context.Context, roundID uuid.UUID, from uint64, requestedBy *uuid.UUID) (*sqlc.IndexerBackfill, error) {
+	contract, chain, err := s.contract(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.store.GetRunningIndexerBackfill(ctx, roundID); err == nil {
+		return nil, circaerrors.ErrBackfillInProgress
+	} else if err != pgx.ErrNoRows {
+		log.Error().Err(err).Msg("Failed to get running backfill")
+		return nil, err
+	}
+
+	to, err := s.lastBlock(ctx, contract, chain)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, circaerrors.ErrInvalidBackfillRange
+	}
+
+	var requester pgtype.UUID
+	if requestedBy != nil {
+		requester = pgtype.UUID{Bytes: *requestedBy, Valid: true}
+	}
+	backfill, err := s.store.CreateIndexerBackfill(ctx, sqlc.CreateIndexerBackfillParams{
+		RoundID:     roundID,
+		FromBlock:   int64(from),
+		ToBlock:     int64(to),
+		RequestedBy: requester,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create backfill")
+		return nil, err
+	}
+
+	if err := s.enqueueChunk(ctx, backfill.ID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("backfill_id", backfill.ID.String()).
+		Str("round_id", roundID.String()).
+		Uint64("from_block", from).
+		Uint64("to_block", to).
+		Msg("Backfill started")
+	return &backfill, nil
+}
+
+// Resume restarts a failed backfill from the first chunk it did not
+// complete.
+func (s *Service) Resume(ctx context.Context, backfillID uuid.UUID) (*sqlc.IndexerBackfill, error) {
+	backfill, err := s.store.GetIndexerBackfill(ctx, backfillID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, circaerrors.ErrBackfillNotFound
+		}
+		return nil, err
+	}
+	if backfill.Status != StatusFailed {
+		return nil, circaerrors.ErrBackfillNotResumable
+	}
+	if _, err := s.store.GetRunningIndexerBackfill(ctx, backfill.RoundID); err == nil {
+		return nil, circaerrors.ErrBackfillInProgress
+	} else if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	backfill, err = s.store.ResumeIndexerBackfill(ctx, backfillID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, circaerrors.ErrBackfillNotResumable
+		}
+		return nil, err
+	}
+
+	if err := s.enqueueChunk(ctx, backfill.ID, time.Now()); err != nil {
+		return nil, err
+	}
+	return &backfill, nil
+}
+
+// List returns a round's backfills, newest first.
+func (s *Service) List(ctx context.Context, roundID uuid.UUID) ([]sqlc.IndexerBackfill, error) {
+	if _, err := s.store.GetRoundByID(ctx, roundID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, circaerrors.ErrRoundNotFound
+		}
+		return nil, err
+	}
+	return s.store.ListRoundIndexerBackfills(ctx, roundID)
+}
+
+// HandleChunkJob backfills the next chunk of a running backfill for a
+// queued job and queues the following chunk. A chunk that is processed
+// twice, after a crash or by a duplicate job, is written once. A backfill
+// is marked failed when its chunk runs out of retries.
+func (s *Service) HandleChunkJob(ctx context.Context, job *sqlc.Job) error {
+	var payload struct {
+		BackfillID uuid.UUID `json:"backfill_id"`
+	}
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+
+	backfill, err := s.store.GetIndexerBackfill(ctx, payload.BackfillID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			log.Warn().Str("backfill_id", payload.BackfillID.String()).Msg("Skipping chunk of missing backfill")
+			return nil
+		}
+		return err
+	}
+	if backfill.Status != StatusRunning {
+		return nil
+	}
+
+	if err := s.runChunk(ctx, backfill); err != nil {
+		if job.RetryCount >= job.MaxRetries {
+			message := err.Error()
+			if failErr := s.store.FailIndexerBackfill(ctx, sqlc.FailIndexerBackfillParams{
+				ID:           backfill.ID,
+				ErrorMessage: &message,
+			}); failErr != nil {
+				log.Error().Err(failErr).Str("backfill_id", backfill.ID.String()).Msg("Failed to mark backfill failed")
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) runChunk(ctx context.Context, backfill sqlc.IndexerBackfill) error {
+	contract, chain, err := s.contract(ctx, backfill.RoundID)
+	if err != nil {
+		return err
+	}
+
+	from := uint64(backfill.NextBlock)
+	to := min(from+s.blockRange-1, uint64(backfill.ToBlock))
+
+	events, err := indexer.FetchEvents(ctx, chain.Client, contract, from, to)
+	if err != nil {
+		return err
+	}
+	written, err := s.events.ReplaceEvents(ctx, contract, events, from, to)
+	if err != nil {
+		return err
+	}
+
+	advanced, err := s.store.AdvanceIndexerBackfill(ctx, sqlc.AdvanceIndexerBackfillParams{
+		NextBlock:     int64(to) + 1,
+		EventsWritten: int64(written),
+		ID:            backfill.ID,
+		ChunkStart:    int64(from),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// Another job got to this chunk first and carries on from it.
+			return nil
+		}
+		return err
+	}
+
+	log.Info().
+		Str("backfill_id", backfill.ID.String()).
+		Uint64("from_block", from).
+		Uint64("to_block", to).
+		Int("events", written).
+		Msg("Backfilled chunk")
+
+	if advanced.Status != StatusRunning {
+		log.Info().
+			Str("backfill_id", backfill.ID.String()).
+			Int64("events_written", advanced.EventsWritten).
+			Msg("Backfill completed")
+		return nil
+	}
+	return s.enqueueChunk(ctx, backfill.ID, time.Now().Add(s.delay))
+}
+
+// contract returns a round's contract and the chain it is read from.
+func (s *Service) contract(ctx context.Context, roundID uuid.UUID) (indexer.Contract, indexer.Chain, error) {
+	r, err := s.store.GetRoundByID(ctx, roundID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return indexer.Contract{}, indexer.Chain{}, circaerrors.ErrRoundNotFound
+		}
+		return indexer.Contract{}, indexer.Chain{}, err
+	}
+	chain, ok := s.chains[r.ChainID]
+	if !ok {
+		return indexer.Contract{}, indexer.Chain{}, circaerrors.ErrUnsupportedChain
+	}
+	if !common.IsHexAddress(r.ContractAddress) {
+		return indexer.Contract{}, indexer.Chain{}, fmt.Errorf("round %s has an invalid contract address %q", r.ID, r.ContractAddress)
+	}
+
+	return indexer.Contract{
+		RoundID: r.ID,
+		ChainID: r.ChainID,
+		Address: common.HexToAddress(r.ContractAddress),
+	}, chain, nil
+}
+
+// lastBlock returns the last block a backfill of contract may read: the
+// live indexer's cursor, so blocks it has yet to read are left to it, and
+// never a block that may still be reorganised.
+func (s *Service) lastBlock(ctx context.Context, contract indexer.Contract, chain indexer.Chain) (uint64, error) {
+	head, err := chain.Client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get head block: %w", err)
+	}
+	confirmations := max(chain.Confirmations, 1)
+	if head+1 < confirmations {
+		return 0, circaerrors.ErrInvalidBackfillRange
+	}
+	last := head + 1 - confirmations
+
+	cursor, err := s.store.GetIndexerCursor(ctx, sqlc.GetIndexerCursorParams{
+		ChainID:         contract.ChainID,
+		ContractAddress: strings.ToLower(contract.Address.Hex()),
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		return 0, err
+	}
+	if err == nil && cursor >= 0 && uint64(cursor) < last {
+		last = uint64(cursor)
+	}
+	return last, nil
+}
+
+func (s *Service) enqueueChunk(ctx context.Context, backfillID uuid.UUID, at time.Time) error {
+	retries := chunkRetries
+	_, err := s.queueService.EnqueueAt(ctx, ChunkJob, queue.JobPayload{
+		"backfill_id": backfillID.String(),
+	}, at, &retries)
+	return err
+}
@@ -0,0 +1,340 @@
+package backfill
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/queue"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	contractAddress = "0x00000000000000000000000000000000000000C1"
+	testChainID     = int64(84532)
+)
+
+// fakeClient is a chain at block head without any logs.
+type fakeClient struct {
+	head    uint64
+	err     error
+	queries []ethereum.FilterQuery
+}
+
+func (c *fakeClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.head, nil
+}
+
+func (c *fakeClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries = append(c.queries, q)
+	return nil, c.err
+}
+
+func (c *fakeClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number}, nil
+}
+
+type replacedRange struct {
+	from, to uint64
+}
+
+type fakeReplacer struct {
+	written int
+	ranges  []replacedRange
+}
+
+func (r *fakeReplacer) ReplaceEvents(ctx context.Context, contract indexer.Contract, events []indexer.Event, from, to uint64) (int, error) {
+	r.ranges = append(r.ranges, replacedRange{from, to})
+	return r.written, nil
+}
+
+func newTestService(ms *dbmocks.MockStore, client *fakeClient, replacer *fakeReplacer) *Service {
+	chains := map[int64]indexer.Chain{testChainID: {Client: client, Confirmations: 10}}
+	return NewService(ms, queue.NewService(ms), chains, replacer, 100, time.Minute)
+}
+
+// captureJobs records the jobs enqueued through ms.
+func captureJobs(ms *dbmocks.MockStore) *[]sqlc.CreateJobParams {
+	var jobs []sqlc.CreateJobParams
+	ms.On("CreateJob", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			jobs = append(jobs, args.Get(1).(sqlc.CreateJobParams))
+		}).
+		Return(sqlc.Job{}, nil).
+		Maybe()
+	return &jobs
+}
+
+func TestService_Start(t *testing.T) {
+	round := sqlc.Round{ID: uuid.New(), ChainID: testChainID, ContractAddress: contractAddress}
+	requester := uuid.New()
+	cursorParams := sqlc.GetIndexerCursorParams{
+		ChainID:         testChainID,
+		ContractAddress: strings.ToLower(contractAddress),
+	}
+
+	tests := []struct {
+		name          string
+		from          uint64
+		setupMocks    func(*dbmocks.MockStore)
+		expectedTo    int64
+		expectedError error
+	}{
+		{
+			name: "success - stops at the live cursor",
+			from: 100,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetRunningIndexerBackfill", mock.Anything, round.ID).Return(sqlc.IndexerBackfill{}, pgx.ErrNoRows)
+				ms.On("GetIndexerCursor", mock.Anything, cursorParams).Return(int64(500), nil)
+			},
+			expectedTo: 500,
+		},
+		{
+			name: "success - not indexed yet stops at the last confirmed block",
+			from: 100,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetRunningIndexerBackfill", mock.Anything, round.ID).Return(sqlc.IndexerBackfill{}, pgx.ErrNoRows)
+				ms.On("GetIndexerCursor", mock.Anything, cursorParams).Return(int64(0), pgx.ErrNoRows)
+			},
+			expectedTo: 991,
+		},
+		{
+			name: "error - already running",
+			from: 100,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetRunningIndexerBackfill", mock.Anything, round.ID).Return(sqlc.IndexerBackfill{ID: uuid.New()}, nil)
+			},
+			expectedError: circaerrors.ErrBackfillInProgress,
+		},
+		{
+			name: "error - from block past the cursor",
+			from: 501,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetRunningIndexerBackfill", mock.Anything, round.ID).Return(sqlc.IndexerBackfill{}, pgx.ErrNoRows)
+				ms.On("GetIndexerCursor", mock.Anything, cursorParams).Return(int64(500), nil)
+			},
+			expectedError: circaerrors.ErrInvalidBackfillRange,
+		},
+		{
+			name: "error - round not found",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrRoundNotFound,
+		},
+		{
+			name: "error - chain not indexed",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(sqlc.Round{ID: round.ID, ChainID: 1, ContractAddress: contractAddress}, nil)
+			},
+			expectedError: circaerrors.ErrUnsupportedChain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+			jobs := captureJobs(mockStore)
+
+			backfillID := uuid.New()
+			if tt.expectedError == nil {
+				mockStore.On("CreateIndexerBackfill", mock.Anything, sqlc.CreateIndexerBackfillParams{
+					RoundID:     round.ID,
+					FromBlock:   int64(tt.from),
+					ToBlock:     tt.expectedTo,
+					RequestedBy: pgtype.UUID{Bytes: requester, Valid: true},
+				}).Return(sqlc.IndexerBackfill{ID: backfillID, RoundID: round.ID, Status: StatusRunning}, nil)
+			}
+
+			service := newTestService(mockStore, &fakeClient{head: 1000}, &fakeReplacer{})
+			backfill, err := service.Start(context.Background(), round.ID, tt.from, &requester)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Empty(t, *jobs)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, backfillID, backfill.ID)
+			require.Len(t, *jobs, 1)
+			assert.Equal(t, ChunkJob, (*jobs)[0].Type)
+			assert.Equal(t, backfillID, chunkJobBackfill(t, (*jobs)[0]))
+		})
+	}
+}
+
+func TestService_HandleChunkJob(t *testing.T) {
+	round := sqlc.Round{ID: uuid.New(), ChainID: testChainID, ContractAddress: contractAddress}
+	backfill := sqlc.IndexerBackfill{
+		ID:        uuid.New(),
+		RoundID:   round.ID,
+		FromBlock: 100,
+		ToBlock:   250,
+		NextBlock: 200,
+		Status:    StatusRunning,
+	}
+	payload, err := json.Marshal(queue.JobPayload{"backfill_id": backfill.ID.String()})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		backfill       sqlc.IndexerBackfill
+		job            sqlc.Job
+		chainErr       error
+		setupMocks     func(*dbmocks.MockStore)
+		expectedRanges []replacedRange
+		expectedJobs   int
+		expectedError  bool
+	}{
+		{
+			name:     "success - writes a chunk and queues the next",
+			backfill: sqlc.IndexerBackfill{ID: backfill.ID, RoundID: round.ID, ToBlock: 1000, NextBlock: 200, Status: StatusRunning},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("AdvanceIndexerBackfill", mock.Anything, sqlc.AdvanceIndexerBackfillParams{
+					NextBlock:     300,
+					EventsWritten: 2,
+					ID:            backfill.ID,
+					ChunkStart:    200,
+				}).Return(sqlc.IndexerBackfill{Status: StatusRunning}, nil)
+			},
+			expectedRanges: []replacedRange{{200, 299}},
+			expectedJobs:   1,
+		},
+		{
+			name:     "success - last chunk stops at the end of the range",
+			backfill: backfill,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("AdvanceIndexerBackfill", mock.Anything, sqlc.AdvanceIndexerBackfillParams{
+					NextBlock:     251,
+					EventsWritten: 2,
+					ID:            backfill.ID,
+					ChunkStart:    200,
+				}).Return(sqlc.IndexerBackfill{Status: StatusCompleted}, nil)
+			},
+			expectedRanges: []replacedRange{{200, 250}},
+		},
+		{
+			name:     "success - chunk already advanced by another job",
+			backfill: backfill,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("AdvanceIndexerBackfill", mock.Anything, mock.Anything).Return(sqlc.IndexerBackfill{}, pgx.ErrNoRows)
+			},
+			expectedRanges: []replacedRange{{200, 250}},
+		},
+		{
+			name:       "success - backfill no longer running",
+			backfill:   sqlc.IndexerBackfill{ID: backfill.ID, RoundID: round.ID, Status: StatusFailed},
+			setupMocks: func(ms *dbmocks.MockStore) {},
+		},
+		{
+			name:          "error - chain error with retries left",
+			backfill:      backfill,
+			job:           sqlc.Job{RetryCount: 1, MaxRetries: 3},
+			chainErr:      errors.New("rate limited"),
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: true,
+		},
+		{
+			name:     "error - last retry fails the backfill",
+			backfill: backfill,
+			job:      sqlc.Job{RetryCount: 3, MaxRetries: 3},
+			chainErr: errors.New("rate limited"),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("FailIndexerBackfill", mock.Anything, mock.MatchedBy(func(p sqlc.FailIndexerBackfillParams) bool {
+					return p.ID == backfill.ID && p.ErrorMessage != nil && strings.Contains(*p.ErrorMessage, "rate limited")
+				})).Return(nil)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			mockStore.On("GetIndexerBackfill", mock.Anything, backfill.ID).Return(tt.backfill, nil)
+			mockStore.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil).Maybe()
+			tt.setupMocks(mockStore)
+			jobs := captureJobs(mockStore)
+
+			replacer := &fakeReplacer{written: 2}
+			service := newTestService(mockStore, &fakeClient{head: 1000, err: tt.chainErr}, replacer)
+			job := tt.job
+			job.Payload = payload
+			err := service.HandleChunkJob(context.Background(), &job)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedRanges, replacer.ranges)
+			require.Len(t, *jobs, tt.expectedJobs)
+			for _, created := range *jobs {
+				assert.Equal(t, backfill.ID, chunkJobBackfill(t, created))
+				assert.True(t, created.ScheduledAt.Time.After(time.Now().Add(30*time.Second)), "chunks are throttled")
+			}
+		})
+	}
+}
+
+func TestService_Resume(t *testing.T) {
+	roundID := uuid.New()
+	failed := sqlc.IndexerBackfill{ID: uuid.New(), RoundID: roundID, Status: StatusFailed}
+	completed := sqlc.IndexerBackfill{ID: uuid.New(), RoundID: roundID, Status: StatusCompleted}
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetIndexerBackfill", mock.Anything, failed.ID).Return(failed, nil)
+	mockStore.On("GetIndexerBackfill", mock.Anything, completed.ID).Return(completed, nil)
+	missing := uuid.New()
+	mockStore.On("GetIndexerBackfill", mock.Anything, missing).Return(sqlc.IndexerBackfill{}, pgx.ErrNoRows)
+	mockStore.On("GetRunningIndexerBackfill", mock.Anything, roundID).Return(sqlc.IndexerBackfill{}, pgx.ErrNoRows)
+	mockStore.On("ResumeIndexerBackfill", mock.Anything, failed.ID).
+		Return(sqlc.IndexerBackfill{ID: failed.ID, RoundID: roundID, Status: StatusRunning}, nil)
+	jobs := captureJobs(mockStore)
+
+	service := newTestService(mockStore, &fakeClient{}, &fakeReplacer{})
+
+	resumed, err := service.Resume(context.Background(), failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, resumed.Status)
+	require.Len(t, *jobs, 1)
+	assert.Equal(t, failed.ID, chunkJobBackfill(t, (*jobs)[0]))
+
+	_, err = service.Resume(context.Background(), completed.ID)
+	assert.ErrorIs(t, err, circaerrors.ErrBackfillNotResumable)
+	_, err = service.Resume(context.Background(), missing)
+	assert.ErrorIs(t, err, circaerrors.ErrBackfillNotFound)
+}
+
+// chunkJobBackfill decodes the backfill ID of a chunk job.
+func chunkJobBackfill(t *testing.T, job sqlc.CreateJobParams) uuid.UUID {
+	var payload struct {
+		BackfillID uuid.UUID `json:"backfill_id"`
+	}
+	require.NoError(t, json.Unmarshal(job.Payload, &payload))
+	return payload.BackfillID
+}
@@ -0,0 +1,101 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const generationKey = "dashboard:generation"
+
+// Cache keeps dashboards in Redis for a short time. Keys embed a generation
+// counter so every cached dashboard can be dropped at once by bumping it;
+// the stale entries then expire on their own.
+//
+// A Cache without a client or with a zero TTL caches nothing. Redis failures
+// are logged and treated as misses so the dashboard is always served.
+type Cache struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func NewCache(client *redis.Client, ttl time.Duration) *Cache {
+	return &Cache{client: client, ttl: ttl}
+}
+
+func (c *Cache) enabled() bool {
+	return c != nil && c.client != nil && c.ttl > 0
+}
+
+// Key returns the user's cache key at the current generation, or "" when
+// nothing can be cached. The key includes the currency amounts are priced
+// in, so changing it is seen on the next read. It is read once, before the
+// dashboard is built, and passed to both Get and Set: a dashboard built
+// while Invalidate runs is then stored under the old generation, where no
+// request reads it.
+func (c *Cache) Key(ctx context.Context, userID uuid.UUID, currency string) string {
+	if !c.enabled() {
+		return ""
+	}
+
+	generation, err := c.client.Get(ctx, generationKey).Int64()
+	if err != nil && err != redis.Nil {
+		log.Warn().Err(err).Msg("Failed to read dashboard cache generation")
+		return ""
+	}
+	return fmt.Sprintf("dashboard:%d:%s:%s", generation, userID, currency)
+}
+
+// Get returns the dashboard cached under key, if any.
+func (c *Cache) Get(ctx context.Context, key string) (*Dashboard, bool) {
+	if !c.enabled() || key == "" {
+		return nil, false
+	}
+
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			log.Warn().Err(err).Msg("Failed to read cached dashboard")
+		}
+		return nil, false
+	}
+
+	var d Dashboard
+	if err := json.Unmarshal(data, &d); err != nil {
+		log.Warn().Err(err).Msg("Failed to decode cached dashboard")
+		return nil, false
+	}
+	return &d, true
+}
+
+// Set caches a dashboard under key for the cache TTL.
+func (c *Cache) Set(ctx context.Context, key string, d *Dashboard) {
+	if !c.enabled() || key == "" {
+		return
+	}
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to encode dashboard")
+		return
+	}
+
+	if err := c.client.Set(ctx, key, data, c.ttl).Err(); err != nil {
+		log.Warn().Err(err).Msg("Failed to cache dashboard")
+	}
+}
+
+// Invalidate drops every cached dashboard. It is called after indexer
+// writes, which can change any user's rounds, payouts and activity, and
+// after group and round writes, which change their members' dashboards.
+func (c *Cache) Invalidate(ctx context.Context) error {
+	if !c.enabled() {
+		return nil
+	}
+	return c.client.Incr(ctx, generationKey).Err()
+}
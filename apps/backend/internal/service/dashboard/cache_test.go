@@ -0,0 +1,100 @@
+package dashboard
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	cache := NewCache(client, time.Minute)
+	userID := uuid.New()
+	createdAt := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	d := &Dashboard{
+		Groups: []sqlc.ListUserGroupsRow{{
+			ID:        uuid.New(),
+			Name:      "Family",
+			CreatedAt: pgtype.Timestamp{Time: createdAt, Valid: true},
+		}},
+	}
+
+	_, ok := cache.Get(ctx, cache.Key(ctx, userID, "USD"))
+	assert.False(t, ok, "empty cache should miss")
+
+	cache.Set(ctx, cache.Key(ctx, userID, "USD"), d)
+	cached, ok := cache.Get(ctx, cache.Key(ctx, userID, "USD"))
+	require.True(t, ok)
+	require.Len(t, cached.Groups, 1)
+	assert.Equal(t, d.Groups[0].ID, cached.Groups[0].ID)
+	assert.True(t, createdAt.Equal(cached.Groups[0].CreatedAt.Time))
+
+	_, ok = cache.Get(ctx, cache.Key(ctx, uuid.New(), "USD"))
+	assert.False(t, ok, "entries are per user")
+	_, ok = cache.Get(ctx, cache.Key(ctx, userID, "EUR"))
+	assert.False(t, ok, "entries are per currency")
+
+	require.NoError(t, cache.Invalidate(ctx))
+	_, ok = cache.Get(ctx, cache.Key(ctx, userID, "USD"))
+	assert.False(t, ok, "invalidation should drop cached dashboards")
+
+	cache.Set(ctx, cache.Key(ctx, userID, "USD"), d)
+	server.FastForward(time.Minute + time.Second)
+	_, ok = cache.Get(ctx, cache.Key(ctx, userID, "USD"))
+	assert.False(t, ok, "entries should expire after the TTL")
+}
+
+func TestCache_InvalidateWhileBuilding(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	cache := NewCache(client, time.Minute)
+	userID := uuid.New()
+
+	// A request misses, the indexer invalidates while the request builds
+	// the dashboard, then the request stores what it built.
+	key := cache.Key(ctx, userID, "USD")
+	_, ok := cache.Get(ctx, key)
+	require.False(t, ok)
+	require.NoError(t, cache.Invalidate(ctx))
+	cache.Set(ctx, key, &Dashboard{})
+
+	_, ok = cache.Get(ctx, cache.Key(ctx, userID, "USD"))
+	assert.False(t, ok, "a dashboard built before invalidation must not be served after it")
+}
+
+func TestCache_Disabled(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	for name, cache := range map[string]*Cache{
+		"nil cache": nil,
+		"no client": NewCache(nil, time.Minute),
+		"zero TTL":  NewCache(client, 0),
+	} {
+		t.Run(name, func(t *testing.T) {
+			userID := uuid.New()
+			cache.Set(ctx, cache.Key(ctx, userID, "USD"), &Dashboard{})
+			_, ok := cache.Get(ctx, cache.Key(ctx, userID, "USD"))
+			assert.False(t, ok)
+			assert.NoError(t, cache.Invalidate(ctx))
+		})
+	}
+	assert.Empty(t, server.Keys())
+}
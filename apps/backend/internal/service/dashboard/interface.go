@@ -0,0 +1,42 @@
+package dashboard
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/prices"
+	"circa/internal/service/round"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Dashboard is everything the home screen shows, gathered in one call.
+type Dashboard struct {
+	Groups  []sqlc.ListUserGroupsRow
+	Invites []sqlc.ListUserPendingInvitesRow
+	// Rounds are the active rounds in which the user has not paid the
+	// current period yet.
+	Rounds          []round.RoundSummary
+	UpcomingPayouts []UpcomingPayout
+	RecentActivity  []round.ActivityItem
+}
+
+// UpcomingPayout is a payout the user has not received yet. EstimatedTime is
+// the end of the payout period, or nil while the round has not started.
+// FormattedExpectedAmount is ExpectedAmount in whole tokens with its symbol,
+// and ExpectedAmountFiat its value in the user's currency when it can be
+// priced.
+type UpcomingPayout struct {
+	RoundID                 uuid.UUID
+	GroupID                 uuid.UUID
+	GroupName               string
+	Period                  int
+	ExpectedAmount          string
+	FormattedExpectedAmount string
+	ExpectedAmountFiat      *prices.Amount
+	EstimatedTime           *time.Time
+}
+
+type DashboardService interface {
+	GetDashboard(ctx context.Context, user sqlc.User) (*Dashboard, error)
+}
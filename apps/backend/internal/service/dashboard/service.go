@@ -0,0 +1,263 @@
+package dashboard
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/prices"
+	"circa/internal/service/round"
+	"circa/internal/tokens"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	groupLimit      = 50
+	inviteLimit     = 20
+	membershipLimit = 100
+	activityLimit   = 10
+)
+
+type Service struct {
+	store  db.Store
+	cache  *Cache
+	tokens *tokens.Registry
+	prices *prices.Converter
+}
+
+func NewService(store db.Store, cache *Cache, registry *tokens.Registry, converter *prices.Converter) *Service {
+	return &Service{store: store, cache: cache, tokens: registry, prices: converter}
+}
+
+// GetDashboard returns the user's dashboard, from the cache when possible.
+// Its sections are loaded concurrently.
+func (s *Service) GetDashboard(ctx context.Context, user sqlc.User) (*Dashboard, error) {
+	currency := s.prices.Currency(user.PreferredCurrency)
+	cacheKey := s.cache.Key(ctx, user.ID, currency)
+	if cached, ok := s.cache.Get(ctx, cacheKey); ok {
+		return cached, nil
+	}
+
+	var (
+		d           Dashboard
+		memberships []sqlc.ListUserRoundMembershipsRow
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		rows, err := s.store.ListUserGroups(gctx, sqlc.ListUserGroupsParams{
+			UserID:   user.ID,
+			Archived: false,
+			PageSize: groupLimit,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list user groups")
+			return err
+		}
+		d.Groups = rows
+		return nil
+	})
+	g.Go(func() error {
+		rows, err := s.store.ListUserPendingInvites(gctx, sqlc.ListUserPendingInvitesParams{
+			UserID: user.ID,
+			Limit:  inviteLimit,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list pending invites")
+			return err
+		}
+		d.Invites = rows
+		return nil
+	})
+	g.Go(func() error {
+		rows, err := s.store.ListUserRoundMemberships(gctx, sqlc.ListUserRoundMembershipsParams{
+			Address: user.Address,
+			Limit:   membershipLimit,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list round memberships")
+			return err
+		}
+		memberships = rows
+		return nil
+	})
+	g.Go(func() error {
+		rows, err := s.store.ListUserRecentActivity(gctx, sqlc.ListUserRecentActivityParams{
+			UserID: user.ID,
+			Limit:  activityLimit,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list recent activity")
+			return err
+		}
+		d.RecentActivity = make([]round.ActivityItem, 0, len(rows))
+		for _, r := range rows {
+			item := round.ActivityItemFromRow(recentActivityRow(r))
+			round.FormatActivityAmount(&item, s.tokens, r.ChainID, r.TokenAddress, r.CurrencySymbol)
+			d.RecentActivity = append(d.RecentActivity, item)
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	rounds, err := s.roundsNeedingAction(ctx, memberships, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	d.Rounds = rounds
+	d.UpcomingPayouts = upcomingPayouts(memberships, s.tokens)
+	s.priceUpcomingPayouts(ctx, d.UpcomingPayouts, memberships, currency)
+
+	s.cache.Set(ctx, cacheKey, &d)
+	return &d, nil
+}
+
+// roundsNeedingAction keeps the active rounds whose current period the user
+// has not paid. Contributions are paid in period order, so the user owes the
+// current period while they have paid no more periods than its number.
+func (s *Service) roundsNeedingAction(ctx context.Context, memberships []sqlc.ListUserRoundMembershipsRow, now time.Time) ([]round.RoundSummary, error) {
+	var (
+		rounds   []sqlc.Round
+		names    []string
+		periods  []int
+		roundIDs []uuid.UUID
+	)
+	for _, m := range memberships {
+		r := membershipRound(m)
+		current := round.CurrentPeriod(r, now)
+		if r.Status != round.StatusActive || current == nil || int(m.ContributionsPaid) > *current {
+			continue
+		}
+		rounds = append(rounds, r)
+		names = append(names, m.GroupName)
+		periods = append(periods, *current)
+		roundIDs = append(roundIDs, r.ID)
+	}
+
+	summaries := make([]round.RoundSummary, 0, len(rounds))
+	if len(rounds) == 0 {
+		return summaries, nil
+	}
+
+	totals, err := s.store.ListRoundPeriodTotals(ctx, roundIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round period totals")
+		return nil, err
+	}
+	paid := make(map[uuid.UUID]map[int64]int, len(rounds))
+	for _, t := range totals {
+		if paid[t.RoundID] == nil {
+			paid[t.RoundID] = make(map[int64]int)
+		}
+		paid[t.RoundID][t.Period] = int(t.PaidCount)
+	}
+
+	for i, r := range rounds {
+		current := periods[i]
+		paidCount := paid[r.ID][int64(current)]
+		summaries = append(summaries, round.RoundSummary{
+			Round:         r,
+			GroupName:     names[i],
+			CurrentPeriod: &current,
+			PaidCount:     &paidCount,
+		})
+	}
+	return summaries, nil
+}
+
+// upcomingPayouts lists the payouts the user is still waiting for. A payout
+// is the contribution of every member, made at the end of the period matching
+// the user's payout position.
+func upcomingPayouts(memberships []sqlc.ListUserRoundMembershipsRow, registry *tokens.Registry) []UpcomingPayout {
+	payouts := make([]UpcomingPayout, 0, len(memberships))
+	for _, m := range memberships {
+		if m.PayoutReceivedAt.Valid {
+			continue
+		}
+
+		amount, ok := new(big.Int).SetString(m.ContributionAmount, 10)
+		if !ok {
+			log.Warn().
+				Str("round_id", m.ID.String()).
+				Str("contribution_amount", m.ContributionAmount).
+				Msg("Round has an invalid contribution amount")
+			continue
+		}
+
+		expected := amount.Mul(amount, big.NewInt(int64(m.MemberCount))).String()
+		payout := UpcomingPayout{
+			RoundID:                 m.ID,
+			GroupID:                 m.GroupID,
+			GroupName:               m.GroupName,
+			Period:                  int(m.PayoutPosition),
+			ExpectedAmount:          expected,
+			FormattedExpectedAmount: registry.FormatAmount(m.ChainID, m.TokenAddress, expected, m.CurrencySymbol),
+		}
+		if m.StartedAt.Valid {
+			duration := time.Duration(m.PeriodDurationSeconds) * time.Second
+			estimated := m.StartedAt.Time.Add(time.Duration(m.PayoutPosition+1) * duration)
+			payout.EstimatedTime = &estimated
+		}
+		payouts = append(payouts, payout)
+	}
+	return payouts
+}
+
+// priceUpcomingPayouts sets the fiat value of each payout at today's price,
+// the best estimate of what it will be worth.
+func (s *Service) priceUpcomingPayouts(ctx context.Context, payouts []UpcomingPayout, memberships []sqlc.ListUserRoundMembershipsRow, currency string) {
+	if currency == "" {
+		return
+	}
+	rounds := make(map[uuid.UUID]sqlc.ListUserRoundMembershipsRow, len(memberships))
+	for _, m := range memberships {
+		rounds[m.ID] = m
+	}
+	now := time.Now()
+	for i := range payouts {
+		m := rounds[payouts[i].RoundID]
+		payouts[i].ExpectedAmountFiat, _ = s.prices.Convert(ctx, m.ChainID, m.TokenAddress, payouts[i].ExpectedAmount, currency, now)
+	}
+}
+
+func membershipRound(m sqlc.ListUserRoundMembershipsRow) sqlc.Round {
+	return sqlc.Round{
+		ID:                    m.ID,
+		GroupID:               m.GroupID,
+		ChainID:               m.ChainID,
+		ContractAddress:       m.ContractAddress,
+		ContributionAmount:    m.ContributionAmount,
+		CurrencySymbol:        m.CurrencySymbol,
+		TokenAddress:          m.TokenAddress,
+		PeriodDurationSeconds: m.PeriodDurationSeconds,
+		Status:                m.Status,
+		StartedAt:             m.StartedAt,
+		CreatedAt:             m.CreatedAt,
+		MemberCount:           m.MemberCount,
+	}
+}
+
+// recentActivityRow drops the round columns the recent activity query adds
+// so the row can share the round activity conversion.
+func recentActivityRow(r sqlc.ListUserRecentActivityRow) sqlc.ExportRoundActivityRow {
+	return sqlc.ExportRoundActivityRow{
+		ID:           r.ID,
+		EventType:    r.EventType,
+		Address:      r.Address,
+		DisplayName:  r.DisplayName,
+		Period:       r.Period,
+		Amount:       r.Amount,
+		BlockNumber:  r.BlockNumber,
+		LogIndex:     r.LogIndex,
+		TxHash:       r.TxHash,
+		BlockTime:    r.BlockTime,
+		Confirmed:    r.Confirmed,
+		Counterparty: r.Counterparty,
+	}
+}
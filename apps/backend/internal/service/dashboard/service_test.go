@@ -0,0 +1,273 @@
+package dashboard
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/indexer"
+	"circa/internal/prices"
+	"circa/internal/service/group"
+	"circa/internal/service/round"
+	"circa/internal/tokens"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userAddress  = "0x1111111111111111111111111111111111111111"
+	tokenAddress = "0x0000000000000000000000000000000000000abc"
+	week         = 7 * 24 * time.Hour
+)
+
+func TestService_GetDashboard(t *testing.T) {
+	user := createTestUser()
+	startedAt := time.Now().Add(-week - week/2).UTC().Truncate(time.Second)
+
+	token := tokenAddress
+	registry, err := tokens.NewRegistry([]tokens.Token{
+		{ChainID: 31337, Address: common.HexToAddress(token), Symbol: "USDC", Decimals: 6},
+	})
+	require.NoError(t, err)
+	feed, err := prices.NewStaticFeed(map[string]map[string]string{"USDC": {"NGN": "1500"}})
+	require.NoError(t, err)
+	converter := prices.NewConverter(feed, registry, "NGN")
+
+	// Owes period 1 and waits for the payout of period 2.
+	owing := createTestMembership(round.StatusActive, startedAt)
+	owing.ContributionsPaid = 1
+	owing.PayoutPosition = 2
+	owing.TokenAddress = &token
+	// Paid up and already paid out.
+	settled := createTestMembership(round.StatusActive, startedAt)
+	settled.ContributionsPaid = 2
+	settled.PayoutReceivedAt = pgtype.Timestamp{Time: startedAt.Add(week), Valid: true}
+	// Not started yet.
+	pending := createTestMembership(round.StatusPending, time.Time{})
+	pending.PayoutPosition = 1
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		validate      func(*testing.T, *Dashboard)
+	}{
+		{
+			name: "success - sections are aggregated",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListUserGroups", mock.Anything, sqlc.ListUserGroupsParams{
+					UserID:   user.ID,
+					PageSize: groupLimit,
+				}).Return([]sqlc.ListUserGroupsRow{{ID: owing.GroupID, Name: "Family", MemberCount: 3}}, nil)
+				ms.On("ListUserPendingInvites", mock.Anything, sqlc.ListUserPendingInvitesParams{
+					UserID: user.ID,
+					Limit:  inviteLimit,
+				}).Return([]sqlc.ListUserPendingInvitesRow{{ID: uuid.New(), GroupName: "Work"}}, nil)
+				ms.On("ListUserRoundMemberships", mock.Anything, sqlc.ListUserRoundMembershipsParams{
+					Address: user.Address,
+					Limit:   membershipLimit,
+				}).Return([]sqlc.ListUserRoundMembershipsRow{owing, settled, pending}, nil)
+				ms.On("ListUserRecentActivity", mock.Anything, sqlc.ListUserRecentActivityParams{
+					UserID: user.ID,
+					Limit:  activityLimit,
+				}).Return([]sqlc.ListUserRecentActivityRow{{
+					ID:           uuid.New(),
+					EventType:    indexer.EventPayout,
+					Address:      userAddress,
+					Amount:       "3000000",
+					BlockTime:    pgtype.Timestamptz{Time: startedAt, Valid: true},
+					Confirmed:    true,
+					ChainID:      31337,
+					TokenAddress: &token,
+				}}, nil)
+				ms.On("ListRoundPeriodTotals", mock.Anything, []uuid.UUID{owing.ID}).
+					Return([]sqlc.RoundPeriodTotal{
+						{RoundID: owing.ID, Period: 0, PaidCount: 3},
+						{RoundID: owing.ID, Period: 1, PaidCount: 2},
+					}, nil)
+			},
+			validate: func(t *testing.T, d *Dashboard) {
+				require.Len(t, d.Groups, 1)
+				assert.Equal(t, "Family", d.Groups[0].Name)
+				require.Len(t, d.Invites, 1)
+				assert.Equal(t, "Work", d.Invites[0].GroupName)
+
+				require.Len(t, d.Rounds, 1)
+				assert.Equal(t, owing.ID, d.Rounds[0].Round.ID)
+				assert.Equal(t, "Family", d.Rounds[0].GroupName)
+				assert.Equal(t, intPtr(1), d.Rounds[0].CurrentPeriod)
+				assert.Equal(t, intPtr(2), d.Rounds[0].PaidCount)
+
+				require.Len(t, d.UpcomingPayouts, 2)
+				assert.Equal(t, owing.ID, d.UpcomingPayouts[0].RoundID)
+				assert.Equal(t, 2, d.UpcomingPayouts[0].Period)
+				assert.Equal(t, "3000000", d.UpcomingPayouts[0].ExpectedAmount)
+				assert.Equal(t, "3 USDC", d.UpcomingPayouts[0].FormattedExpectedAmount)
+				assert.Equal(t, &prices.Amount{Currency: "NGN", Value: "4500.00"}, d.UpcomingPayouts[0].ExpectedAmountFiat)
+				require.NotNil(t, d.UpcomingPayouts[0].EstimatedTime)
+				assert.True(t, startedAt.Add(3*week).Equal(*d.UpcomingPayouts[0].EstimatedTime))
+				assert.Equal(t, pending.ID, d.UpcomingPayouts[1].RoundID)
+				assert.Nil(t, d.UpcomingPayouts[1].EstimatedTime)
+				assert.Equal(t, "3000000", d.UpcomingPayouts[1].FormattedExpectedAmount)
+				assert.Nil(t, d.UpcomingPayouts[1].ExpectedAmountFiat, "rounds without a registered token are not priced")
+
+				require.Len(t, d.RecentActivity, 1)
+				assert.Equal(t, round.ActivityPayout, d.RecentActivity[0].Type)
+				assert.Equal(t, round.ActivityStatusConfirmed, d.RecentActivity[0].Status)
+				assert.Equal(t, "3 USDC", d.RecentActivity[0].FormattedAmount)
+			},
+		},
+		{
+			name: "success - no rounds skips period totals",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListUserGroups", mock.Anything, mock.Anything).Return([]sqlc.ListUserGroupsRow{}, nil)
+				ms.On("ListUserPendingInvites", mock.Anything, mock.Anything).Return([]sqlc.ListUserPendingInvitesRow{}, nil)
+				ms.On("ListUserRoundMemberships", mock.Anything, mock.Anything).Return([]sqlc.ListUserRoundMembershipsRow{}, nil)
+				ms.On("ListUserRecentActivity", mock.Anything, mock.Anything).Return([]sqlc.ListUserRecentActivityRow{}, nil)
+			},
+			validate: func(t *testing.T, d *Dashboard) {
+				assert.Empty(t, d.Rounds)
+				assert.Empty(t, d.UpcomingPayouts)
+				assert.Empty(t, d.RecentActivity)
+			},
+		},
+		{
+			name: "error - a failing section fails the dashboard",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListUserGroups", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+				ms.On("ListUserPendingInvites", mock.Anything, mock.Anything).Return([]sqlc.ListUserPendingInvitesRow{}, nil).Maybe()
+				ms.On("ListUserRoundMemberships", mock.Anything, mock.Anything).Return([]sqlc.ListUserRoundMembershipsRow{}, nil).Maybe()
+				ms.On("ListUserRecentActivity", mock.Anything, mock.Anything).Return([]sqlc.ListUserRecentActivityRow{}, nil).Maybe()
+			},
+			expectedError: errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, NewCache(nil, 0), registry, converter)
+			d, err := service.GetDashboard(context.Background(), user)
+
+			if tt.expectedError != nil {
+				require.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, d)
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, d)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetDashboard_Cached(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	user := createTestUser()
+	family := sqlc.ListUserGroupsRow{ID: uuid.New(), Name: "Family"}
+
+	emptySections := func(ms *dbmocks.MockStore) {
+		ms.On("ListUserPendingInvites", mock.Anything, mock.Anything).Return([]sqlc.ListUserPendingInvitesRow{}, nil)
+		ms.On("ListUserRoundMemberships", mock.Anything, mock.Anything).Return([]sqlc.ListUserRoundMembershipsRow{}, nil)
+		ms.On("ListUserRecentActivity", mock.Anything, mock.Anything).Return([]sqlc.ListUserRecentActivityRow{}, nil)
+	}
+
+	t.Run("group change is seen on the next read", func(t *testing.T) {
+		server.FlushAll()
+		cache := NewCache(client, time.Minute)
+		g := sqlc.Group{ID: family.ID, Name: family.Name, OwnerID: user.ID}
+
+		mockStore := dbmocks.NewMockStore(t)
+		emptySections(mockStore)
+		mockStore.On("ListUserGroups", mock.Anything, mock.Anything).Return([]sqlc.ListUserGroupsRow{family}, nil).Once()
+		mockStore.On("ListUserGroups", mock.Anything, mock.Anything).Return([]sqlc.ListUserGroupsRow{}, nil).Once()
+		mockStore.On("GetGroupByID", mock.Anything, g.ID).Return(g, nil)
+		mockStore.On("CountUnfinishedGroupRounds", mock.Anything, g.ID).Return(int64(0), nil)
+		mockStore.On("ArchiveGroup", mock.Anything, g.ID).Return(g, nil)
+
+		service := NewService(mockStore, cache, nil, nil)
+		groups := group.NewService(mockStore, nil, cache)
+
+		d, err := service.GetDashboard(ctx, user)
+		require.NoError(t, err)
+		require.Len(t, d.Groups, 1)
+		d, err = service.GetDashboard(ctx, user)
+		require.NoError(t, err)
+		require.Len(t, d.Groups, 1, "second read should be cached")
+
+		require.NoError(t, groups.ArchiveGroup(ctx, g.ID, user))
+
+		d, err = service.GetDashboard(ctx, user)
+		require.NoError(t, err)
+		assert.Empty(t, d.Groups)
+		mockStore.AssertNumberOfCalls(t, "ListUserGroups", 2)
+	})
+
+	t.Run("currency change is seen on the next read", func(t *testing.T) {
+		server.FlushAll()
+		cache := NewCache(client, time.Minute)
+
+		mockStore := dbmocks.NewMockStore(t)
+		emptySections(mockStore)
+		mockStore.On("ListUserGroups", mock.Anything, mock.Anything).Return([]sqlc.ListUserGroupsRow{family}, nil)
+
+		service := NewService(mockStore, cache, nil, prices.NewConverter(nil, nil, "NGN"))
+
+		_, err := service.GetDashboard(ctx, user)
+		require.NoError(t, err)
+		_, err = service.GetDashboard(ctx, user)
+		require.NoError(t, err)
+		mockStore.AssertNumberOfCalls(t, "ListUserGroups", 1)
+
+		usd := "USD"
+		user := user
+		user.PreferredCurrency = &usd
+		_, err = service.GetDashboard(ctx, user)
+		require.NoError(t, err)
+		mockStore.AssertNumberOfCalls(t, "ListUserGroups", 2)
+	})
+}
+
+func createTestUser() sqlc.User {
+	return sqlc.User{
+		ID:        uuid.New(),
+		Address:   userAddress,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func createTestMembership(status string, startedAt time.Time) sqlc.ListUserRoundMembershipsRow {
+	return sqlc.ListUserRoundMembershipsRow{
+		ID:                    uuid.New(),
+		GroupID:               uuid.New(),
+		GroupName:             "Family",
+		ChainID:               31337,
+		ContractAddress:       "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		ContributionAmount:    "1000000",
+		PeriodDurationSeconds: int64(week / time.Second),
+		Status:                status,
+		StartedAt:             pgtype.Timestamp{Time: startedAt, Valid: !startedAt.IsZero()},
+		MemberCount:           3,
+		CreatedAt:             pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,56 @@
+package delinquency
+
+import (
+	"strings"
+	"time"
+)
+
+// Contribution is a period a member has contributed to.
+type Contribution struct {
+	Address string
+	Period  int
+}
+
+// Detect returns the overdue contributions of a round at now. There is one
+// period per member, and every member owes every period. A contribution is
+// due when its period ends: it is late until the grace period after that has
+// passed and missed from then on. It has no side effects, so the rules can
+// be tested without a database.
+func Detect(startedAt time.Time, periodDuration, grace time.Duration, members []string, paid []Contribution, now time.Time) []Finding {
+	if periodDuration <= 0 {
+		return nil
+	}
+
+	contributed := make(map[Contribution]bool, len(paid))
+	for _, c := range paid {
+		contributed[Contribution{Address: strings.ToLower(c.Address), Period: c.Period}] = true
+	}
+
+	var findings []Finding
+	for period := range members {
+		end := startedAt.Add(time.Duration(period+1) * periodDuration)
+		if now.Before(end) {
+			break
+		}
+		graceEnds := end.Add(grace)
+
+		status := StatusLate
+		if !now.Before(graceEnds) {
+			status = StatusMissed
+		}
+
+		for _, member := range members {
+			address := strings.ToLower(member)
+			if contributed[Contribution{Address: address, Period: period}] {
+				continue
+			}
+			findings = append(findings, Finding{
+				Address:   address,
+				Period:    period,
+				Status:    status,
+				GraceEnds: graceEnds,
+			})
+		}
+	}
+	return findings
+}
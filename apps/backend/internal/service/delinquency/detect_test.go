@@ -0,0 +1,87 @@
+package delinquency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := 7 * 24 * time.Hour
+	grace := 24 * time.Hour
+	members := []string{"0xAAA", "0xbbb", "0xccc"}
+
+	tests := []struct {
+		name     string
+		paid     []Contribution
+		now      time.Time
+		expected []Finding
+	}{
+		{
+			name: "first period still open",
+			now:  start.Add(period - time.Second),
+		},
+		{
+			name: "unpaid contributions are late during the grace period",
+			paid: []Contribution{{Address: "0xaaa", Period: 0}},
+			now:  start.Add(period),
+			expected: []Finding{
+				{Address: "0xbbb", Period: 0, Status: StatusLate, GraceEnds: start.Add(period + grace)},
+				{Address: "0xccc", Period: 0, Status: StatusLate, GraceEnds: start.Add(period + grace)},
+			},
+		},
+		{
+			name: "unpaid contributions are missed once the grace period ends",
+			paid: []Contribution{{Address: "0xaaa", Period: 0}, {Address: "0xBBB", Period: 0}},
+			now:  start.Add(period + grace),
+			expected: []Finding{
+				{Address: "0xccc", Period: 0, Status: StatusMissed, GraceEnds: start.Add(period + grace)},
+			},
+		},
+		{
+			name: "each ended period is checked on its own clock",
+			paid: []Contribution{
+				{Address: "0xaaa", Period: 0},
+				{Address: "0xbbb", Period: 0},
+				{Address: "0xccc", Period: 0},
+				{Address: "0xaaa", Period: 1},
+			},
+			now: start.Add(2*period + time.Hour),
+			expected: []Finding{
+				{Address: "0xbbb", Period: 1, Status: StatusLate, GraceEnds: start.Add(2*period + grace)},
+				{Address: "0xccc", Period: 1, Status: StatusLate, GraceEnds: start.Add(2*period + grace)},
+			},
+		},
+		{
+			name: "periods past the last member are never due",
+			paid: []Contribution{
+				{Address: "0xaaa", Period: 0}, {Address: "0xbbb", Period: 0}, {Address: "0xccc", Period: 0},
+				{Address: "0xaaa", Period: 1}, {Address: "0xbbb", Period: 1}, {Address: "0xccc", Period: 1},
+				{Address: "0xaaa", Period: 2}, {Address: "0xbbb", Period: 2},
+			},
+			now: start.Add(10 * period),
+			expected: []Finding{
+				{Address: "0xccc", Period: 2, Status: StatusMissed, GraceEnds: start.Add(3*period + grace)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Detect(start, period, grace, members, tt.paid, tt.now))
+		})
+	}
+}
+
+func TestDetect_NoGracePeriod(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := time.Hour
+
+	findings := Detect(start, period, 0, []string{"0xaaa"}, nil, start.Add(period))
+
+	assert.Equal(t, []Finding{
+		{Address: "0xaaa", Period: 0, Status: StatusMissed, GraceEnds: start.Add(period)},
+	}, findings)
+}
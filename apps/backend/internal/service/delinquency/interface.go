@@ -0,0 +1,25 @@
+package delinquency
+
+import "time"
+
+const (
+	StatusLate   = "late"
+	StatusMissed = "missed"
+)
+
+const (
+	// CheckDelinquenciesJob is the recurring queue job type that flags
+	// overdue contributions in active rounds.
+	CheckDelinquenciesJob = "check_delinquencies"
+
+	// SendNoticeJob is the queue job type that emails a delinquency notice.
+	SendNoticeJob = "send_delinquency_email"
+)
+
+// Finding is a contribution that is overdue at the time of a check.
+type Finding struct {
+	Address   string
+	Period    int
+	Status    string
+	GraceEnds time.Time
+}
@@ -0,0 +1,201 @@
+package delinquency
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	"circa/internal/prices"
+	"circa/internal/queue"
+	"circa/internal/tokens"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+const noticeRetries = 3
+
+type Service struct {
+	store        db.Store
+	queueService *queue.Service
+	emailService email.EmailService
+	tokens       *tokens.Registry
+	prices       *prices.Converter
+}
+
+func NewService(store db.Store, queueService *queue.Service, emailService email.EmailService, registry *tokens.Registry, converter *prices.Converter) *Service {
+	return &Service{
+		store:        store,
+		queueService: queueService,
+		emailService: emailService,
+		tokens:       registry,
+		prices:       converter,
+	}
+}
+
+// HandleCheckDelinquenciesJob runs CheckDelinquencies for a queued job.
+func (s *Service) HandleCheckDelinquenciesJob(ctx context.Context, job *sqlc.Job) error {
+	flagged, err := s.CheckDelinquencies(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	log.Info().Int("flagged", flagged).Msg("Checked rounds for overdue contributions")
+	return nil
+}
+
+// CheckDelinquencies evaluates every active round against its period clock
+// and its group's grace period, flags overdue contributions and emails the
+// members concerned. Missed contributions are escalated to the group owner.
+// Each contribution is flagged, and emailed about, at most once per status,
+// so the check can run as often as needed. It returns the number of new
+// flags. A failing round does not stop the others.
+func (s *Service) CheckDelinquencies(ctx context.Context, now time.Time) (int, error) {
+	rounds, err := s.store.ListDelinquencyCheckRounds(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list rounds to check for delinquencies")
+		return 0, err
+	}
+
+	var (
+		flagged int
+		errs    []error
+	)
+	for _, r := range rounds {
+		n, err := s.checkRound(ctx, r, now)
+		flagged += n
+		if err != nil {
+			log.Error().Err(err).Str("round_id", r.ID.String()).Msg("Failed to check round for delinquencies")
+			errs = append(errs, fmt.Errorf("round %s: %w", r.ID, err))
+		}
+	}
+	return flagged, errors.Join(errs...)
+}
+
+func (s *Service) checkRound(ctx context.Context, r sqlc.ListDelinquencyCheckRoundsRow, now time.Time) (int, error) {
+	members, err := s.store.ListRoundMembers(ctx, r.ID)
+	if err != nil {
+		return 0, err
+	}
+	addresses := make([]string, 0, len(members))
+	for _, m := range members {
+		addresses = append(addresses, m.Address)
+	}
+
+	rows, err := s.store.ListRoundContributionPeriods(ctx, r.ID)
+	if err != nil {
+		return 0, err
+	}
+	paid := make([]Contribution, 0, len(rows))
+	for _, row := range rows {
+		paid = append(paid, Contribution{Address: row.Address, Period: int(row.Period)})
+	}
+
+	findings := Detect(
+		r.StartedAt.Time,
+		time.Duration(r.PeriodDurationSeconds)*time.Second,
+		time.Duration(r.GracePeriodSeconds)*time.Second,
+		addresses,
+		paid,
+		now,
+	)
+
+	flagged := 0
+	for _, f := range findings {
+		n, err := s.store.InsertRoundDelinquency(ctx, sqlc.InsertRoundDelinquencyParams{
+			RoundID: r.ID,
+			Address: f.Address,
+			Period:  int64(f.Period),
+			Status:  f.Status,
+		})
+		if err != nil {
+			return flagged, err
+		}
+		if n == 0 {
+			continue
+		}
+		flagged++
+		s.notify(ctx, r, f)
+	}
+	return flagged, nil
+}
+
+// notify enqueues the emails for a new flag. Users without an email address
+// are skipped. Failures are logged rather than returned: the flag is
+// recorded and will not be emailed about again.
+func (s *Service) notify(ctx context.Context, r sqlc.ListDelinquencyCheckRoundsRow, f Finding) {
+	member, err := s.store.GetUserByAddress(ctx, f.Address)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			log.Error().Err(err).Str("address", f.Address).Msg("Failed to get delinquent member")
+		}
+		return
+	}
+
+	memberName := f.Address
+	if member.DisplayName != nil {
+		memberName = *member.DisplayName
+	}
+	amount := s.tokens.FormatAmount(r.ChainID, r.TokenAddress, r.ContributionAmount, r.CurrencySymbol)
+	notice := email.DelinquencyNotice{
+		GroupName:  r.GroupName,
+		MemberName: memberName,
+		Period:     f.Period,
+		Amount:     amount,
+		Missed:     f.Status == StatusMissed,
+		GraceEnds:  f.GraceEnds,
+	}
+
+	s.enqueueNotice(ctx, r, member, notice)
+
+	if f.Status != StatusMissed || r.OwnerID == member.ID {
+		return
+	}
+	owner, err := s.store.GetUserByID(ctx, r.OwnerID)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			log.Error().Err(err).Str("group_id", r.GroupID.String()).Msg("Failed to get group owner")
+		}
+		return
+	}
+	notice.Escalation = true
+	s.enqueueNotice(ctx, r, owner, notice)
+}
+
+func (s *Service) enqueueNotice(ctx context.Context, r sqlc.ListDelinquencyCheckRoundsRow, to sqlc.User, notice email.DelinquencyNotice) {
+	if !to.Email.Valid || s.queueService == nil {
+		return
+	}
+	notice.ToEmail = to.Email.String
+	notice.ToName = to.Address
+	if to.DisplayName != nil {
+		notice.ToName = *to.DisplayName
+	}
+	currency := s.prices.Currency(to.PreferredCurrency)
+	if fiat, ok := s.prices.Convert(ctx, r.ChainID, r.TokenAddress, r.ContributionAmount, currency, time.Now()); ok {
+		notice.FiatAmount = fiat.String()
+	}
+
+	retries := noticeRetries
+	if _, err := s.queueService.Enqueue(ctx, SendNoticeJob, queue.JobPayload{"notice": notice}, &retries); err != nil {
+		log.Error().Err(err).Str("user_id", to.ID.String()).Msg("Failed to enqueue delinquency email")
+	}
+}
+
+// HandleSendNoticeJob emails a delinquency notice for a queued job.
+func (s *Service) HandleSendNoticeJob(ctx context.Context, job *sqlc.Job) error {
+	var payload struct {
+		Notice email.DelinquencyNotice `json:"notice"`
+	}
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+	if s.emailService == nil {
+		return errors.New("email service not configured")
+	}
+	return s.emailService.SendDelinquencyNotice(ctx, payload.Notice)
+}
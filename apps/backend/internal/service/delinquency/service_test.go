@@ -0,0 +1,247 @@
+package delinquency
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	"circa/internal/queue"
+	emailmocks "circa/internal/queue/mocks"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ownerAddress  = "0x1111111111111111111111111111111111111111"
+	memberAddress = "0x2222222222222222222222222222222222222222"
+)
+
+var (
+	testStart  = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	testPeriod = 7 * 24 * time.Hour
+	testGrace  = 24 * time.Hour
+)
+
+func createTestUser(address, mail string) sqlc.User {
+	return sqlc.User{
+		ID:      uuid.New(),
+		Address: address,
+		Email:   pgtype.Text{String: mail, Valid: mail != ""},
+	}
+}
+
+func createTestRound(ownerID uuid.UUID) sqlc.ListDelinquencyCheckRoundsRow {
+	symbol := "USDC"
+	return sqlc.ListDelinquencyCheckRoundsRow{
+		ID:                    uuid.New(),
+		GroupID:               uuid.New(),
+		GroupName:             "Savings Circle",
+		OwnerID:               ownerID,
+		GracePeriodSeconds:    int64(testGrace / time.Second),
+		ContributionAmount:    "100",
+		CurrencySymbol:        &symbol,
+		PeriodDurationSeconds: int64(testPeriod / time.Second),
+		StartedAt:             pgtype.Timestamp{Time: testStart, Valid: true},
+		MemberCount:           2,
+	}
+}
+
+// expectRound sets up a two-member round where only the owner contributed
+// to the first period.
+func expectRound(ms *dbmocks.MockStore, r sqlc.ListDelinquencyCheckRoundsRow) {
+	ms.On("ListRoundMembers", mock.Anything, r.ID).Return([]sqlc.RoundMember{
+		{RoundID: r.ID, Address: ownerAddress, PayoutPosition: 0},
+		{RoundID: r.ID, Address: memberAddress, PayoutPosition: 1},
+	}, nil)
+	ms.On("ListRoundContributionPeriods", mock.Anything, r.ID).Return([]sqlc.ListRoundContributionPeriodsRow{
+		{Address: ownerAddress, Period: 0},
+	}, nil)
+}
+
+// noticesFor returns the notices enqueued to an email address.
+func noticesFor(jobs []sqlc.CreateJobParams, to string) []email.DelinquencyNotice {
+	var notices []email.DelinquencyNotice
+	for _, job := range jobs {
+		var payload struct {
+			Notice email.DelinquencyNotice `json:"notice"`
+		}
+		if err := json.Unmarshal(job.Payload, &payload); err == nil && payload.Notice.ToEmail == to {
+			notices = append(notices, payload.Notice)
+		}
+	}
+	return notices
+}
+
+func TestService_CheckDelinquencies(t *testing.T) {
+	owner := createTestUser(ownerAddress, "owner@example.com")
+	member := createTestUser(memberAddress, "member@example.com")
+	r := createTestRound(owner.ID)
+
+	tests := []struct {
+		name            string
+		now             time.Time
+		setupMocks      func(*dbmocks.MockStore)
+		expectedFlagged int
+		expectedError   string
+		validateJobs    func(*testing.T, []sqlc.CreateJobParams)
+	}{
+		{
+			name: "success - late contribution reminds the member",
+			now:  testStart.Add(testPeriod + time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListDelinquencyCheckRounds", mock.Anything).Return([]sqlc.ListDelinquencyCheckRoundsRow{r}, nil)
+				expectRound(ms, r)
+				ms.On("InsertRoundDelinquency", mock.Anything, sqlc.InsertRoundDelinquencyParams{
+					RoundID: r.ID, Address: memberAddress, Period: 0, Status: StatusLate,
+				}).Return(int64(1), nil)
+				ms.On("GetUserByAddress", mock.Anything, memberAddress).Return(member, nil)
+			},
+			expectedFlagged: 1,
+			validateJobs: func(t *testing.T, jobs []sqlc.CreateJobParams) {
+				require.Len(t, jobs, 1)
+				assert.Equal(t, SendNoticeJob, jobs[0].Type)
+				assert.Equal(t, int32(noticeRetries), jobs[0].MaxRetries)
+
+				notices := noticesFor(jobs, "member@example.com")
+				require.Len(t, notices, 1)
+				assert.Equal(t, "Savings Circle", notices[0].GroupName)
+				assert.Equal(t, "100 USDC", notices[0].Amount)
+				assert.False(t, notices[0].Missed)
+				assert.False(t, notices[0].Escalation)
+				assert.True(t, notices[0].GraceEnds.Equal(testStart.Add(testPeriod+testGrace)))
+			},
+		},
+		{
+			name: "success - missed contribution is escalated to the owner",
+			now:  testStart.Add(testPeriod + testGrace),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListDelinquencyCheckRounds", mock.Anything).Return([]sqlc.ListDelinquencyCheckRoundsRow{r}, nil)
+				expectRound(ms, r)
+				ms.On("InsertRoundDelinquency", mock.Anything, sqlc.InsertRoundDelinquencyParams{
+					RoundID: r.ID, Address: memberAddress, Period: 0, Status: StatusMissed,
+				}).Return(int64(1), nil)
+				ms.On("GetUserByAddress", mock.Anything, memberAddress).Return(member, nil)
+				ms.On("GetUserByID", mock.Anything, owner.ID).Return(owner, nil)
+			},
+			expectedFlagged: 1,
+			validateJobs: func(t *testing.T, jobs []sqlc.CreateJobParams) {
+				require.Len(t, jobs, 2)
+
+				toMember := noticesFor(jobs, "member@example.com")
+				require.Len(t, toMember, 1)
+				assert.True(t, toMember[0].Missed)
+				assert.False(t, toMember[0].Escalation)
+
+				toOwner := noticesFor(jobs, "owner@example.com")
+				require.Len(t, toOwner, 1)
+				assert.True(t, toOwner[0].Escalation)
+				assert.Equal(t, memberAddress, toOwner[0].MemberName)
+			},
+		},
+		{
+			name: "success - already flagged contributions are not emailed again",
+			now:  testStart.Add(testPeriod + testGrace),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListDelinquencyCheckRounds", mock.Anything).Return([]sqlc.ListDelinquencyCheckRoundsRow{r}, nil)
+				expectRound(ms, r)
+				ms.On("InsertRoundDelinquency", mock.Anything, mock.Anything).Return(int64(0), nil)
+			},
+			expectedFlagged: 0,
+		},
+		{
+			name: "success - members without an email are flagged silently",
+			now:  testStart.Add(testPeriod + time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListDelinquencyCheckRounds", mock.Anything).Return([]sqlc.ListDelinquencyCheckRoundsRow{r}, nil)
+				expectRound(ms, r)
+				ms.On("InsertRoundDelinquency", mock.Anything, mock.Anything).Return(int64(1), nil)
+				ms.On("GetUserByAddress", mock.Anything, memberAddress).Return(createTestUser(memberAddress, ""), nil)
+			},
+			expectedFlagged: 1,
+		},
+		{
+			name: "error - failing round does not stop the others",
+			now:  testStart.Add(testPeriod + time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				broken := createTestRound(owner.ID)
+				ms.On("ListDelinquencyCheckRounds", mock.Anything).Return([]sqlc.ListDelinquencyCheckRoundsRow{broken, r}, nil)
+				ms.On("ListRoundMembers", mock.Anything, broken.ID).Return(nil, errors.New("database error"))
+				expectRound(ms, r)
+				ms.On("InsertRoundDelinquency", mock.Anything, mock.Anything).Return(int64(1), nil)
+				ms.On("GetUserByAddress", mock.Anything, memberAddress).Return(sqlc.User{}, pgx.ErrNoRows)
+			},
+			expectedFlagged: 1,
+			expectedError:   "database error",
+		},
+		{
+			name: "error - listing rounds fails",
+			now:  testStart,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListDelinquencyCheckRounds", mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedError: "database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			var jobs []sqlc.CreateJobParams
+			mockStore.On("CreateJob", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					jobs = append(jobs, args.Get(1).(sqlc.CreateJobParams))
+				}).
+				Return(sqlc.Job{}, nil).
+				Maybe()
+
+			service := NewService(mockStore, queue.NewService(mockStore), nil, nil, nil)
+			flagged, err := service.CheckDelinquencies(context.Background(), tt.now)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedFlagged, flagged)
+			if tt.validateJobs != nil {
+				tt.validateJobs(t, jobs)
+			} else {
+				assert.Empty(t, jobs)
+			}
+		})
+	}
+}
+
+func TestService_HandleSendNoticeJob(t *testing.T) {
+	notice := email.DelinquencyNotice{
+		ToEmail:    "member@example.com",
+		ToName:     "Member",
+		GroupName:  "Savings Circle",
+		MemberName: "Member",
+		Period:     2,
+		Amount:     "100 USDC",
+		GraceEnds:  testStart.Add(testPeriod + testGrace),
+	}
+	payload, err := json.Marshal(queue.JobPayload{"notice": notice})
+	require.NoError(t, err)
+
+	mockEmail := emailmocks.NewMockEmailService(t)
+	mockEmail.On("SendDelinquencyNotice", mock.Anything, mock.MatchedBy(func(n email.DelinquencyNotice) bool {
+		return n.ToEmail == notice.ToEmail && n.Period == notice.Period && n.GraceEnds.Equal(notice.GraceEnds)
+	})).Return(nil)
+
+	service := NewService(dbmocks.NewMockStore(t), nil, mockEmail, nil, nil)
+	require.NoError(t, service.HandleSendNoticeJob(context.Background(), &sqlc.Job{Payload: payload}))
+}
@@ -0,0 +1,19 @@
+package discovery
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+// SendRoundReadyJob is the queue job type that emails a member about a
+// discovered round.
+const SendRoundReadyJob = "send_round_ready"
+
+// RoundCreator registers the rounds factories deploy. The round service
+// implements it.
+type RoundCreator interface {
+	CreateDiscoveredRound(ctx context.Context, chainID int64, factory, address common.Address, groupID uuid.UUID, deploymentBlock uint64) (*sqlc.Round, error)
+}
@@ -0,0 +1,131 @@
+package discovery
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/queue"
+	"circa/internal/tokens"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+const emailRetries = 3
+
+type Service struct {
+	store        db.Store
+	queueService *queue.Service
+	emailService email.EmailService
+	rounds       RoundCreator
+	tokens       *tokens.Registry
+	frontendURL  string
+}
+
+func NewService(store db.Store, queueService *queue.Service, emailService email.EmailService, rounds RoundCreator, registry *tokens.Registry, frontendURL string) *Service {
+	return &Service{
+		store:        store,
+		queueService: queueService,
+		emailService: emailService,
+		rounds:       rounds,
+		tokens:       registry,
+		frontendURL:  frontendURL,
+	}
+}
+
+// DiscoverRound registers a round a factory deployed as a pending round of
+// the group named in the deployment, and emails the group's members that it
+// is ready to join. Deployments for unknown or archived groups, already
+// registered contracts and contracts that do not match their group are
+// logged and skipped; other errors are returned so the indexer retries.
+func (s *Service) DiscoverRound(ctx context.Context, created indexer.RoundCreated) error {
+	round, err := s.rounds.CreateDiscoveredRound(ctx, created.ChainID, created.Factory, created.Round, created.GroupID, created.BlockNumber)
+	if err != nil {
+		if skipped(err) {
+			log.Warn().
+				Err(err).
+				Int64("chain_id", created.ChainID).
+				Str("contract_address", created.Round.Hex()).
+				Str("group_id", created.GroupID.String()).
+				Str("tx_hash", created.TxHash.Hex()).
+				Msg("Skipping discovered round")
+			return nil
+		}
+		return err
+	}
+
+	log.Info().
+		Str("round_id", round.ID.String()).
+		Str("group_id", round.GroupID.String()).
+		Str("tx_hash", created.TxHash.Hex()).
+		Msg("Discovered round")
+
+	s.notify(ctx, *round)
+	return nil
+}
+
+// skipped reports whether a deployment can never be registered, so retrying
+// it is pointless.
+func skipped(err error) bool {
+	return errors.Is(err, circaerrors.ErrGroupNotFound) ||
+		errors.Is(err, circaerrors.ErrGroupArchived) ||
+		errors.Is(err, circaerrors.ErrRoundAlreadyExists) ||
+		errors.Is(err, circaerrors.ErrUnsupportedChain) ||
+		errors.Is(err, circaerrors.ErrRoundVerificationFailed)
+}
+
+// notify queues an email to every member of the round with an email
+// address. Failures are logged: the round is registered either way.
+func (s *Service) notify(ctx context.Context, round sqlc.Round) {
+	g, err := s.store.GetGroupByID(ctx, round.GroupID)
+	if err != nil {
+		log.Error().Err(err).Str("round_id", round.ID.String()).Msg("Failed to get group of discovered round")
+		return
+	}
+	recipients, err := s.store.ListReminderRecipients(ctx, round.ID)
+	if err != nil {
+		log.Error().Err(err).Str("round_id", round.ID.String()).Msg("Failed to list members of discovered round")
+		return
+	}
+
+	notice := email.RoundReady{
+		GroupName: g.Name,
+		Amount:    s.tokens.FormatAmount(round.ChainID, round.TokenAddress, round.ContributionAmount, round.CurrencySymbol),
+		RoundURL:  fmt.Sprintf("%s/rounds/%s", s.frontendURL, round.ID),
+	}
+	for _, recipient := range recipients {
+		if !recipient.Email.Valid {
+			continue
+		}
+		notice.ToEmail = recipient.Email.String
+		notice.ToName = recipient.Address
+		if recipient.DisplayName != nil {
+			notice.ToName = *recipient.DisplayName
+		}
+
+		retries := emailRetries
+		if _, err := s.queueService.Enqueue(ctx, SendRoundReadyJob, queue.JobPayload{"notice": notice}, &retries); err != nil {
+			log.Error().Err(err).Str("round_id", round.ID.String()).Msg("Failed to enqueue round ready email")
+		}
+	}
+}
+
+// HandleSendRoundReadyJob emails a member about a discovered round for a
+// queued job.
+func (s *Service) HandleSendRoundReadyJob(ctx context.Context, job *sqlc.Job) error {
+	var payload struct {
+		Notice email.RoundReady `json:"notice"`
+	}
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+	if s.emailService == nil {
+		return errors.New("email service not configured")
+	}
+	return s.emailService.SendRoundReady(ctx, payload.Notice)
+}
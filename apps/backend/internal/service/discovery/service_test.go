@@ -0,0 +1,170 @@
+package discovery
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/queue"
+	emailmocks "circa/internal/queue/mocks"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ownerAddress  = "0x1111111111111111111111111111111111111111"
+	memberAddress = "0x2222222222222222222222222222222222222222"
+	testChainID   = int64(84532)
+)
+
+// fakeRounds returns round, or err, for any deployment.
+type fakeRounds struct {
+	round           *sqlc.Round
+	err             error
+	calls           int
+	deploymentBlock uint64
+}
+
+func (f *fakeRounds) CreateDiscoveredRound(ctx context.Context, chainID int64, factory, address common.Address, groupID uuid.UUID, deploymentBlock uint64) (*sqlc.Round, error) {
+	f.calls++
+	f.deploymentBlock = deploymentBlock
+	return f.round, f.err
+}
+
+func TestService_DiscoverRound(t *testing.T) {
+	groupID := uuid.New()
+	symbol := "USDC"
+	round := &sqlc.Round{
+		ID:                 uuid.New(),
+		GroupID:            groupID,
+		ChainID:            testChainID,
+		ContractAddress:    "0x00000000000000000000000000000000000000c1",
+		ContributionAmount: "50",
+		CurrencySymbol:     &symbol,
+	}
+	created := indexer.RoundCreated{
+		ChainID:     testChainID,
+		Factory:     common.HexToAddress("0x00000000000000000000000000000000000000F1"),
+		Round:       common.HexToAddress(round.ContractAddress),
+		GroupID:     groupID,
+		BlockNumber: 4321,
+	}
+
+	tests := []struct {
+		name          string
+		rounds        *fakeRounds
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError bool
+		validateJobs  func(*testing.T, []sqlc.CreateJobParams)
+	}{
+		{
+			name:   "success - members with an email are notified",
+			rounds: &fakeRounds{round: round},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, groupID).Return(sqlc.Group{ID: groupID, Name: "Friday Ajo"}, nil)
+				name := "Ada"
+				ms.On("ListReminderRecipients", mock.Anything, round.ID).Return([]sqlc.ListReminderRecipientsRow{
+					{Address: ownerAddress, Email: pgtype.Text{String: "owner@example.com", Valid: true}, DisplayName: &name},
+					{Address: memberAddress},
+				}, nil)
+			},
+			validateJobs: func(t *testing.T, jobs []sqlc.CreateJobParams) {
+				require.Len(t, jobs, 1)
+				assert.Equal(t, SendRoundReadyJob, jobs[0].Type)
+
+				var payload struct {
+					Notice email.RoundReady `json:"notice"`
+				}
+				require.NoError(t, json.Unmarshal(jobs[0].Payload, &payload))
+				assert.Equal(t, email.RoundReady{
+					ToEmail:   "owner@example.com",
+					ToName:    "Ada",
+					GroupName: "Friday Ajo",
+					Amount:    "50 USDC",
+					RoundURL:  fmt.Sprintf("https://circa.example/rounds/%s", round.ID),
+				}, payload.Notice)
+			},
+		},
+		{
+			name:       "success - members do not match the group is skipped",
+			rounds:     &fakeRounds{err: fmt.Errorf("%w: contract members do not match", circaerrors.ErrRoundVerificationFailed)},
+			setupMocks: func(ms *dbmocks.MockStore) {},
+		},
+		{
+			name:       "success - registered by hand is skipped",
+			rounds:     &fakeRounds{err: circaerrors.ErrRoundAlreadyExists},
+			setupMocks: func(ms *dbmocks.MockStore) {},
+		},
+		{
+			name:       "success - unknown group is skipped",
+			rounds:     &fakeRounds{err: circaerrors.ErrGroupNotFound},
+			setupMocks: func(ms *dbmocks.MockStore) {},
+		},
+		{
+			name:          "error - chain read failure is retried",
+			rounds:        &fakeRounds{err: errors.New("call members: connection refused")},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			var jobs []sqlc.CreateJobParams
+			mockStore.On("CreateJob", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					jobs = append(jobs, args.Get(1).(sqlc.CreateJobParams))
+				}).
+				Return(sqlc.Job{}, nil).
+				Maybe()
+
+			service := NewService(mockStore, queue.NewService(mockStore), nil, tt.rounds, nil, "https://circa.example")
+			err := service.DiscoverRound(context.Background(), created)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, 1, tt.rounds.calls)
+			assert.Equal(t, created.BlockNumber, tt.rounds.deploymentBlock, "the round is indexed from its deployment")
+			if tt.validateJobs != nil {
+				tt.validateJobs(t, jobs)
+			} else {
+				assert.Empty(t, jobs)
+			}
+		})
+	}
+}
+
+func TestService_HandleSendRoundReadyJob(t *testing.T) {
+	notice := email.RoundReady{
+		ToEmail:   "member@example.com",
+		ToName:    "Member",
+		GroupName: "Friday Ajo",
+		Amount:    "50 USDC",
+		RoundURL:  "https://circa.example/rounds/" + uuid.NewString(),
+	}
+	payload, err := json.Marshal(queue.JobPayload{"notice": notice})
+	require.NoError(t, err)
+
+	mockEmail := emailmocks.NewMockEmailService(t)
+	mockEmail.On("SendRoundReady", mock.Anything, notice).Return(nil)
+
+	service := NewService(dbmocks.NewMockStore(t), nil, mockEmail, &fakeRounds{}, nil, "")
+	require.NoError(t, service.HandleSendRoundReadyJob(context.Background(), &sqlc.Job{Payload: payload}))
+}
@@ -0,0 +1,234 @@
+package group
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/merkle"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// GetAllowlist returns the latest version of the group's allowlist, the
+// Merkle root over its accepted members in payout order that a Round
+// contract is deployed with. Versions are stored by the writes that change
+// the members or their order. Only accepted members can view it.
+func (s *Service) GetAllowlist(ctx context.Context, groupID uuid.UUID, user sqlc.User) (*sqlc.GroupAllowlist, error) {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireAcceptedMember(ctx, group.ID, user); err != nil {
+		return nil, err
+	}
+
+	allowlist, err := s.store.GetLatestGroupAllowlist(ctx, group.ID)
+	if err == pgx.ErrNoRows {
+		return nil, errors.ErrAllowlistNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get latest group allowlist")
+		return nil, err
+	}
+	return &allowlist, nil
+}
+
+// GetAllowlistProof returns the proof that the user is in a version of the
+// group's allowlist, or in the latest version when version is nil. Older
+// versions serve contracts that were deployed before membership changed.
+func (s *Service) GetAllowlistProof(ctx context.Context, groupID uuid.UUID, user sqlc.User, version *int32) (*AllowlistProof, error) {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireAcceptedMember(ctx, group.ID, user); err != nil {
+		return nil, err
+	}
+
+	var allowlist sqlc.GroupAllowlist
+	if version == nil {
+		allowlist, err = s.store.GetLatestGroupAllowlist(ctx, group.ID)
+	} else {
+		allowlist, err = s.store.GetGroupAllowlist(ctx, sqlc.GetGroupAllowlistParams{
+			GroupID: group.ID,
+			Version: *version,
+		})
+	}
+	if err == pgx.ErrNoRows {
+		return nil, errors.ErrAllowlistNotFound
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get group allowlist")
+		return nil, err
+	}
+
+	address := strings.ToLower(user.Address)
+	position := slices.Index(allowlist.Members, address)
+	if position < 0 {
+		return nil, errors.ErrNotInAllowlist
+	}
+
+	tree, err := allowlistTree(allowlist.Members)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := tree.Proof(position)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowlistProof{
+		Allowlist: allowlist,
+		Address:   address,
+		Position:  position,
+		Leaf:      merkle.MemberLeaf(common.HexToAddress(address), position),
+		Proof:     proof,
+	}, nil
+}
+
+// SyncAllowlists brings the allowlist of the group, or of every group when
+// groupID is nil, up to date with its members and returns the latest
+// versions. It covers groups whose members changed before versions were
+// stored with each change.
+func (s *Service) SyncAllowlists(ctx context.Context, groupID *uuid.UUID) ([]sqlc.GroupAllowlist, error) {
+	groupIDs := []uuid.UUID{}
+	if groupID != nil {
+		groupIDs = append(groupIDs, *groupID)
+	} else {
+		var err error
+		if groupIDs, err = s.store.ListActiveGroupIDs(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to list groups")
+			return nil, err
+		}
+	}
+
+	pgxStore, ok := s.store.(*db.PGXStore)
+	if !ok {
+		return nil, errors.ErrInvalidStore
+	}
+
+	allowlists := make([]sqlc.GroupAllowlist, 0, len(groupIDs))
+	for _, id := range groupIDs {
+		allowlist, err := syncGroupAllowlist(ctx, pgxStore, id)
+		if err != nil {
+			return allowlists, err
+		}
+		allowlists = append(allowlists, allowlist)
+	}
+	return allowlists, nil
+}
+
+func syncGroupAllowlist(ctx context.Context, pgxStore *db.PGXStore, groupID uuid.UUID) (sqlc.GroupAllowlist, error) {
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to begin transaction")
+		return sqlc.GroupAllowlist{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	allowlist, err := SyncAllowlist(ctx, pgxStore.Queries.WithTx(tx), groupID)
+	if err != nil {
+		return sqlc.GroupAllowlist{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to commit transaction")
+		return sqlc.GroupAllowlist{}, err
+	}
+	return allowlist, nil
+}
+
+// SyncAllowlist stores the next version of the group's allowlist when its
+// root no longer matches the latest one, and returns the latest version.
+// Writes that change the group's accepted members or their payout order
+// call it in their own transaction, so a version never lags behind the
+// change that caused it. The group row stays locked until q's transaction
+// ends, so concurrent changes each see the other's members.
+func SyncAllowlist(ctx context.Context, q sqlc.Querier, groupID uuid.UUID) (sqlc.GroupAllowlist, error) {
+	if err := q.LockGroup(ctx, groupID); err != nil {
+		log.Error().Err(err).Msg("Failed to lock group")
+		return sqlc.GroupAllowlist{}, err
+	}
+
+	members, payoutOrderID, err := allowlistMembers(ctx, q, groupID)
+	if err != nil {
+		return sqlc.GroupAllowlist{}, err
+	}
+	tree, err := allowlistTree(members)
+	if err != nil {
+		return sqlc.GroupAllowlist{}, err
+	}
+	root := tree.Root().Hex()
+
+	latest, err := q.GetLatestGroupAllowlist(ctx, groupID)
+	if err == nil && latest.Root == root {
+		return latest, nil
+	}
+	if err != nil && err != pgx.ErrNoRows {
+		log.Error().Err(err).Msg("Failed to get latest group allowlist")
+		return sqlc.GroupAllowlist{}, err
+	}
+
+	allowlist, err := q.CreateGroupAllowlist(ctx, sqlc.CreateGroupAllowlistParams{
+		GroupID:       groupID,
+		Root:          root,
+		Members:       members,
+		PayoutOrderID: payoutOrderID,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create group allowlist")
+		return sqlc.GroupAllowlist{}, err
+	}
+
+	log.Info().
+		Str("group_id", groupID.String()).
+		Int32("version", allowlist.Version).
+		Str("root", allowlist.Root).
+		Msg("Group allowlist versioned")
+	return allowlist, nil
+}
+
+// allowlistMembers returns the group's accepted members in payout order.
+// The order is the group's latest finalized payout order when it lists
+// exactly the accepted members, and address order otherwise.
+func allowlistMembers(ctx context.Context, q sqlc.Querier, groupID uuid.UUID) ([]string, pgtype.UUID, error) {
+	accepted, err := q.ListAcceptedGroupMemberAddresses(ctx, groupID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list accepted group members")
+		return nil, pgtype.UUID{}, err
+	}
+
+	order, err := q.GetLatestFinalizedPayoutOrder(ctx, groupID)
+	if err == pgx.ErrNoRows {
+		return accepted, pgtype.UUID{}, nil
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get latest finalized payout order")
+		return nil, pgtype.UUID{}, err
+	}
+
+	ordered := make([]string, 0, len(order.FinalOrder))
+	for _, address := range order.FinalOrder {
+		ordered = append(ordered, strings.ToLower(address))
+	}
+	sorted := slices.Sorted(slices.Values(ordered))
+	if !slices.Equal(sorted, accepted) {
+		return accepted, pgtype.UUID{}, nil
+	}
+	return ordered, pgtype.UUID{Bytes: order.ID, Valid: true}, nil
+}
+
+func allowlistTree(members []string) (*merkle.Tree, error) {
+	leaves := make([]common.Hash, 0, len(members))
+	for i, address := range members {
+		leaves = append(leaves, merkle.MemberLeaf(common.HexToAddress(address), i))
+	}
+	return merkle.New(leaves)
+}
@@ -0,0 +1,289 @@
+package group
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/merkle"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	aliceAddress = "0x1111111111111111111111111111111111111111"
+	bobAddress   = "0x2222222222222222222222222222222222222222"
+	carolAddress = "0x3333333333333333333333333333333333333333"
+)
+
+func allowlistRoot(t *testing.T, members ...string) string {
+	tree, err := allowlistTree(members)
+	require.NoError(t, err)
+	return tree.Root().Hex()
+}
+
+func TestService_GetAllowlist(t *testing.T) {
+	alice := createTestUser(aliceAddress)
+	group := createTestGroup(alice.ID)
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+	}{
+		{
+			name: "error - caller is not a member",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+		{
+			name: "error - no version stored yet",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleOwner), nil)
+				ms.On("GetLatestGroupAllowlist", mock.Anything, group.ID).Return(sqlc.GroupAllowlist{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrAllowlistNotFound,
+		},
+		{
+			name: "success - latest version",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleOwner), nil)
+				ms.On("GetLatestGroupAllowlist", mock.Anything, group.ID).Return(sqlc.GroupAllowlist{
+					Version: 2,
+					Root:    allowlistRoot(t, aliceAddress, bobAddress),
+					Members: []string{aliceAddress, bobAddress},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			mockStore.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, testPaginator, nil)
+			allowlist, err := service.GetAllowlist(context.Background(), group.ID, alice)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int32(2), allowlist.Version)
+			mockStore.AssertNotCalled(t, "CreateGroupAllowlist", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestSyncAllowlist(t *testing.T) {
+	groupID := uuid.New()
+	orderID := uuid.New()
+
+	stored := func(ms *dbmocks.MockStore, version int32, members []string, payoutOrderID pgtype.UUID) {
+		root := allowlistRoot(t, members...)
+		ms.On("CreateGroupAllowlist", mock.Anything, sqlc.CreateGroupAllowlistParams{
+			GroupID:       groupID,
+			Root:          root,
+			Members:       members,
+			PayoutOrderID: payoutOrderID,
+		}).Return(sqlc.GroupAllowlist{GroupID: groupID, Version: version, Root: root, Members: members, PayoutOrderID: payoutOrderID}, nil)
+	}
+
+	tests := []struct {
+		name       string
+		accepted   []string
+		setupMocks func(*dbmocks.MockStore)
+		validate   func(*testing.T, sqlc.GroupAllowlist)
+	}{
+		{
+			name:     "first version in address order",
+			accepted: []string{aliceAddress, bobAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetLatestFinalizedPayoutOrder", mock.Anything, groupID).Return(sqlc.PayoutOrder{}, pgx.ErrNoRows)
+				ms.On("GetLatestGroupAllowlist", mock.Anything, groupID).Return(sqlc.GroupAllowlist{}, pgx.ErrNoRows)
+				stored(ms, 1, []string{aliceAddress, bobAddress}, pgtype.UUID{})
+			},
+			validate: func(t *testing.T, a sqlc.GroupAllowlist) {
+				assert.Equal(t, int32(1), a.Version)
+				assert.Equal(t, allowlistRoot(t, aliceAddress, bobAddress), a.Root)
+			},
+		},
+		{
+			name:     "unchanged membership keeps the version",
+			accepted: []string{aliceAddress, bobAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetLatestFinalizedPayoutOrder", mock.Anything, groupID).Return(sqlc.PayoutOrder{}, pgx.ErrNoRows)
+				ms.On("GetLatestGroupAllowlist", mock.Anything, groupID).Return(sqlc.GroupAllowlist{
+					Version: 2,
+					Root:    allowlistRoot(t, aliceAddress, bobAddress),
+					Members: []string{aliceAddress, bobAddress},
+				}, nil)
+			},
+			validate: func(t *testing.T, a sqlc.GroupAllowlist) {
+				assert.Equal(t, int32(2), a.Version)
+			},
+		},
+		{
+			name:     "removed member stores the next version",
+			accepted: []string{aliceAddress, carolAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetLatestFinalizedPayoutOrder", mock.Anything, groupID).Return(sqlc.PayoutOrder{}, pgx.ErrNoRows)
+				ms.On("GetLatestGroupAllowlist", mock.Anything, groupID).Return(sqlc.GroupAllowlist{
+					Version: 2,
+					Root:    allowlistRoot(t, aliceAddress, bobAddress, carolAddress),
+					Members: []string{aliceAddress, bobAddress, carolAddress},
+				}, nil)
+				stored(ms, 3, []string{aliceAddress, carolAddress}, pgtype.UUID{})
+			},
+			validate: func(t *testing.T, a sqlc.GroupAllowlist) {
+				assert.Equal(t, int32(3), a.Version)
+				assert.Len(t, a.Members, 2)
+			},
+		},
+		{
+			name:     "members in finalized payout order",
+			accepted: []string{aliceAddress, bobAddress, carolAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetLatestFinalizedPayoutOrder", mock.Anything, groupID).Return(sqlc.PayoutOrder{
+					ID:         orderID,
+					FinalOrder: []string{carolAddress, aliceAddress, bobAddress},
+				}, nil)
+				ms.On("GetLatestGroupAllowlist", mock.Anything, groupID).Return(sqlc.GroupAllowlist{}, pgx.ErrNoRows)
+				stored(ms, 1, []string{carolAddress, aliceAddress, bobAddress}, pgtype.UUID{Bytes: orderID, Valid: true})
+			},
+			validate: func(t *testing.T, a sqlc.GroupAllowlist) {
+				assert.Equal(t, []string{carolAddress, aliceAddress, bobAddress}, a.Members)
+				assert.True(t, a.PayoutOrderID.Valid)
+			},
+		},
+		{
+			name:     "payout order of other members is ignored",
+			accepted: []string{aliceAddress, bobAddress, carolAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetLatestFinalizedPayoutOrder", mock.Anything, groupID).Return(sqlc.PayoutOrder{
+					ID:         orderID,
+					FinalOrder: []string{bobAddress, aliceAddress},
+				}, nil)
+				ms.On("GetLatestGroupAllowlist", mock.Anything, groupID).Return(sqlc.GroupAllowlist{}, pgx.ErrNoRows)
+				stored(ms, 1, []string{aliceAddress, bobAddress, carolAddress}, pgtype.UUID{})
+			},
+			validate: func(t *testing.T, a sqlc.GroupAllowlist) {
+				assert.False(t, a.PayoutOrderID.Valid)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			mockStore.On("LockGroup", mock.Anything, groupID).Return(nil)
+			mockStore.On("ListAcceptedGroupMemberAddresses", mock.Anything, groupID).Return(tt.accepted, nil)
+			tt.setupMocks(mockStore)
+
+			allowlist, err := SyncAllowlist(context.Background(), mockStore, groupID)
+			require.NoError(t, err)
+			tt.validate(t, allowlist)
+		})
+	}
+}
+
+func TestService_GetAllowlistProof(t *testing.T) {
+	bob := createTestUser(bobAddress)
+	group := createTestGroup(uuid.New())
+	version := func(v int32) *int32 { return &v }
+
+	tests := []struct {
+		name             string
+		version          *int32
+		setupMocks       func(*dbmocks.MockStore)
+		expectedError    error
+		expectedVersion  int32
+		expectedPosition int
+	}{
+		{
+			name: "success - latest version",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetLatestGroupAllowlist", mock.Anything, group.ID).Return(sqlc.GroupAllowlist{
+					Version: 4,
+					Root:    allowlistRoot(t, aliceAddress, bobAddress, carolAddress),
+					Members: []string{aliceAddress, bobAddress, carolAddress},
+				}, nil)
+			},
+			expectedVersion:  4,
+			expectedPosition: 1,
+		},
+		{
+			name:    "success - earlier version",
+			version: version(2),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupAllowlist", mock.Anything, sqlc.GetGroupAllowlistParams{GroupID: group.ID, Version: 2}).Return(sqlc.GroupAllowlist{
+					Version: 2,
+					Root:    allowlistRoot(t, carolAddress, aliceAddress, bobAddress),
+					Members: []string{carolAddress, aliceAddress, bobAddress},
+				}, nil)
+			},
+			expectedVersion:  2,
+			expectedPosition: 2,
+		},
+		{
+			name:    "error - version not found",
+			version: version(9),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupAllowlist", mock.Anything, mock.Anything).Return(sqlc.GroupAllowlist{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrAllowlistNotFound,
+		},
+		{
+			name: "error - no version stored yet",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetLatestGroupAllowlist", mock.Anything, group.ID).Return(sqlc.GroupAllowlist{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrAllowlistNotFound,
+		},
+		{
+			name:    "error - joined after the version",
+			version: version(1),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupAllowlist", mock.Anything, mock.Anything).Return(sqlc.GroupAllowlist{
+					Version: 1,
+					Root:    allowlistRoot(t, aliceAddress),
+					Members: []string{aliceAddress},
+				}, nil)
+			},
+			expectedError: circaerrors.ErrNotInAllowlist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			mockStore.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			mockStore.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleMember), nil)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, testPaginator, nil)
+			result, err := service.GetAllowlistProof(context.Background(), group.ID, bob, tt.version)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVersion, result.Allowlist.Version)
+			assert.Equal(t, tt.expectedPosition, result.Position)
+			assert.Equal(t, merkle.MemberLeaf(common.HexToAddress(bobAddress), tt.expectedPosition), result.Leaf)
+			assert.True(t, merkle.Verify(result.Proof, common.HexToHash(result.Allowlist.Root), result.Leaf))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package group
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+// Obligation describes what a member still owes, or is still owed, in an
+// active round.
+type Obligation struct {
+	RoundID           uuid.UUID `json:"round_id"`
+	ContributionsOwed int       `json:"contributions_owed"`
+	AwaitingPayout    bool      `json:"awaiting_payout"`
+}
+
+// ObligationError is returned when a member cannot leave or be removed
+// because of outstanding obligations. It matches ErrOutstandingObligations
+// with errors.Is.
+type ObligationError struct {
+	Address     string
+	Obligations []Obligation
+}
+
+func (e *ObligationError) Error() string {
+	parts := make([]string, 0, len(e.Obligations))
+	for _, o := range e.Obligations {
+		var owed []string
+		if o.ContributionsOwed > 0 {
+			owed = append(owed, fmt.Sprintf("owes %d contribution(s)", o.ContributionsOwed))
+		}
+		if o.AwaitingPayout {
+			owed = append(owed, "has not received their payout")
+		}
+		parts = append(parts, fmt.Sprintf("round %s: %s", o.RoundID, strings.Join(owed, " and ")))
+	}
+	return fmt.Sprintf("member %s has outstanding obligations in active rounds (%s)", e.Address, strings.Join(parts, "; "))
+}
+
+func (e *ObligationError) Unwrap() error {
+	return circaerrors.ErrOutstandingObligations
+}
+
+type RemoveMemberParams struct {
+	GroupID       uuid.UUID
+	Actor         sqlc.User
+	MemberAddress string
+	Force         bool
+	Reason        *string
+}
+
+// ListGroupsParams filters the caller's groups. A non-empty Query switches
+// to a relevance-ordered search over group name and description.
+type ListGroupsParams struct {
+	UserID   uuid.UUID
+	Archived bool
+	Query    string
+	Limit    *int
+	Cursor   *string
+}
+
+type ListGroupsResult struct {
+	Groups     []sqlc.ListUserGroupsRow
+	NextCursor *string
+}
+
+type SearchMembersParams struct {
+	GroupID uuid.UUID
+	User    sqlc.User
+	Query   string
+	Limit   *int
+	Cursor  *string
+}
+
+type SearchMembersResult struct {
+	Members    []sqlc.SearchGroupMembersRow
+	NextCursor *string
+}
+
+// MemberProfile is a group member with the contributions flagged late or
+// missed in the group's rounds, most recent first.
+type MemberProfile struct {
+	Member        sqlc.GetGroupMemberDetailsRow
+	Delinquencies []sqlc.ListGroupMemberDelinquenciesRow
+}
+
+// AllowlistProof proves that a member is in a version of a group's
+// allowlist at a payout position.
+type AllowlistProof struct {
+	Allowlist sqlc.GroupAllowlist
+	Address   string
+	Position  int
+	Leaf      common.Hash
+	Proof     []common.Hash
+}
+
+type GroupService interface {
+	ListGroups(ctx context.Context, params ListGroupsParams) (*ListGroupsResult, error)
+	SearchMembers(ctx context.Context, params SearchMembersParams) (*SearchMembersResult, error)
+	GetMemberProfile(ctx context.Context, groupID uuid.UUID, user sqlc.User, address string) (*MemberProfile, error)
+	ArchiveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error
+	UnarchiveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error
+	RemoveMember(ctx context.Context, params RemoveMemberParams) error
+	LeaveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error
+	GetAllowlist(ctx context.Context, groupID uuid.UUID, user sqlc.User) (*sqlc.GroupAllowlist, error)
+	GetAllowlistProof(ctx context.Context, groupID uuid.UUID, user sqlc.User, version *int32) (*AllowlistProof, error)
+}
@@ -0,0 +1,680 @@
+package group
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/pagination"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	RoleOwner  = "owner"
+	RoleMember = "member"
+
+	StatusAccepted = "accepted"
+
+	removalKindLeft         = "left"
+	removalKindRemoved      = "removed"
+	removalKindForceRemoved = "force_removed"
+
+	// PurgeDeletedGroupsJob is the queue job type that hard-deletes groups
+	// soft-deleted longer than the retention period ago, or anonymizes them
+	// when rounds still belong to them.
+	PurgeDeletedGroupsJob = "purge_deleted_groups"
+
+	purgeBatchSize = 100
+
+	memberDelinquencyLimit = 100
+)
+
+type Service struct {
+	store     db.Store
+	paginator *pagination.Paginator
+	cache     indexer.Invalidator
+}
+
+// NewService creates a group service. The cache is invalidated after writes
+// that change what dashboards show, and may be nil.
+func NewService(store db.Store, paginator *pagination.Paginator, cache indexer.Invalidator) *Service {
+	return &Service{
+		store:     store,
+		paginator: paginator,
+		cache:     cache,
+	}
+}
+
+// ListGroups returns a page of the groups the user is an accepted member of,
+// newest first. Archived groups are only listed when Archived is set.
+func (s *Service) ListGroups(ctx context.Context, params ListGroupsParams) (*ListGroupsResult, error) {
+	limit, err := pagination.Limit(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := s.paginator.Decode(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if query := strings.TrimSpace(params.Query); query != "" {
+		return s.searchGroups(ctx, params, query, limit, cursor)
+	}
+
+	cursorCreatedAt, err := cursor.Timestamp()
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.store.ListUserGroups(ctx, sqlc.ListUserGroupsParams{
+		UserID:          params.UserID,
+		Archived:        params.Archived,
+		CursorCreatedAt: cursorCreatedAt,
+		CursorID:        cursor.UUID(),
+		PageSize:        pagination.PageSize(limit),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list groups")
+		return nil, err
+	}
+
+	groups, next := pagination.Paginate(s.paginator, groups, limit, func(g sqlc.ListUserGroupsRow) pagination.Cursor {
+		return pagination.AtTime(g.CreatedAt.Time, g.ID)
+	})
+	return &ListGroupsResult{Groups: groups, NextCursor: next}, nil
+}
+
+// searchGroups ranks the user's groups against the query. Pages are keyed on
+// (rank, id) so the cursor stays stable while the ranking is unchanged.
+func (s *Service) searchGroups(ctx context.Context, params ListGroupsParams, query string, limit int, cursor *pagination.Cursor) (*ListGroupsResult, error) {
+	cursorRank, err := cursor.Float8()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.store.SearchUserGroups(ctx, sqlc.SearchUserGroupsParams{
+		Query:      query,
+		UserID:     params.UserID,
+		Archived:   params.Archived,
+		CursorRank: cursorRank,
+		CursorID:   cursor.UUID(),
+		PageSize:   pagination.PageSize(limit),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to search groups")
+		return nil, err
+	}
+
+	rows, next := pagination.Paginate(s.paginator, rows, limit, func(g sqlc.SearchUserGroupsRow) pagination.Cursor {
+		return pagination.AtFloat(g.Rank, g.ID)
+	})
+
+	groups := make([]sqlc.ListUserGroupsRow, 0, len(rows))
+	for _, row := range rows {
+		groups = append(groups, sqlc.ListUserGroupsRow{
+			ID:          row.ID,
+			Name:        row.Name,
+			Description: row.Description,
+			AvatarUrl:   row.AvatarUrl,
+			OwnerID:     row.OwnerID,
+			ArchivedAt:  row.ArchivedAt,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			MemberCount: row.MemberCount,
+		})
+	}
+	return &ListGroupsResult{Groups: groups, NextCursor: next}, nil
+}
+
+// SearchMembers finds members of the group whose display name matches the
+// query or whose address starts with it. Only accepted members can search.
+func (s *Service) SearchMembers(ctx context.Context, params SearchMembersParams) (*SearchMembersResult, error) {
+	query := strings.TrimSpace(params.Query)
+	if query == "" {
+		return nil, errors.ErrInvalidSearchQuery
+	}
+	limit, err := pagination.Limit(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := s.paginator.Decode(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.getGroup(ctx, params.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAcceptedMember(ctx, group.ID, params.User); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.SearchGroupMembers(ctx, sqlc.SearchGroupMembersParams{
+		GroupID:       group.ID,
+		AddressPrefix: escapeLike(strings.ToLower(query)) + "%",
+		NamePattern:   "%" + escapeLike(query) + "%",
+		Query:         query,
+		CursorAddress: cursor.Text(),
+		CursorID:      cursor.UUID(),
+		PageSize:      pagination.PageSize(limit),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to search group members")
+		return nil, err
+	}
+
+	members, next := pagination.Paginate(s.paginator, members, limit, func(m sqlc.SearchGroupMembersRow) pagination.Cursor {
+		return pagination.AtString(m.Address, m.ID)
+	})
+	return &SearchMembersResult{Members: members, NextCursor: next}, nil
+}
+
+// GetMemberProfile returns a member of the group with the contributions
+// flagged late or missed in its rounds. Only accepted members can view
+// profiles.
+func (s *Service) GetMemberProfile(ctx context.Context, groupID uuid.UUID, user sqlc.User, address string) (*MemberProfile, error) {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireAcceptedMember(ctx, group.ID, user); err != nil {
+		return nil, err
+	}
+
+	address = strings.ToLower(address)
+	member, err := s.store.GetGroupMemberDetails(ctx, sqlc.GetGroupMemberDetailsParams{
+		GroupID: group.ID,
+		Address: address,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrMemberNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group member details")
+		return nil, err
+	}
+
+	delinquencies, err := s.store.ListGroupMemberDelinquencies(ctx, sqlc.ListGroupMemberDelinquenciesParams{
+		GroupID: group.ID,
+		Address: address,
+		Limit:   memberDelinquencyLimit,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list member delinquencies")
+		return nil, err
+	}
+
+	return &MemberProfile{Member: member, Delinquencies: delinquencies}, nil
+}
+
+// ArchiveGroup hides the group from the default listing and makes it
+// read-only. Groups with pending, active or paused rounds cannot be archived.
+func (s *Service) ArchiveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if group.OwnerID != user.ID {
+		return errors.ErrNotGroupOwner
+	}
+
+	if group.ArchivedAt.Valid {
+		return nil
+	}
+
+	unfinished, err := s.store.CountUnfinishedGroupRounds(ctx, group.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to count unfinished group rounds")
+		return err
+	}
+	if unfinished > 0 {
+		return errors.ErrGroupHasActiveRounds
+	}
+
+	if _, err := s.store.ArchiveGroup(ctx, group.ID); err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.ErrGroupNotFound
+		}
+		log.Error().Err(err).Msg("Failed to archive group")
+		return err
+	}
+
+	log.Info().Str("group_id", group.ID.String()).Msg("Group archived")
+	s.invalidate(ctx)
+	return nil
+}
+
+// UnarchiveGroup restores an archived group.
+func (s *Service) UnarchiveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if group.OwnerID != user.ID {
+		return errors.ErrNotGroupOwner
+	}
+
+	if !group.ArchivedAt.Valid {
+		return nil
+	}
+
+	if _, err := s.store.UnarchiveGroup(ctx, group.ID); err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.ErrGroupNotFound
+		}
+		log.Error().Err(err).Msg("Failed to unarchive group")
+		return err
+	}
+
+	log.Info().Str("group_id", group.ID.String()).Msg("Group unarchived")
+	s.invalidate(ctx)
+	return nil
+}
+
+// PurgeDeletedGroups permanently deletes groups soft-deleted before the given
+// time, together with their invites, memberships and removal records. Groups
+// that rounds still belong to lose the same records and are anonymized
+// instead of deleted, keeping their rounds. It returns the number of groups
+// purged and anonymized.
+func (s *Service) PurgeDeletedGroups(ctx context.Context, before time.Time) (purged, anonymized int, err error) {
+	for {
+		groups, err := s.store.ListPurgeableGroups(ctx, sqlc.ListPurgeableGroupsParams{
+			DeletedAt: pgtype.Timestamp{Time: before, Valid: true},
+			Limit:     purgeBatchSize,
+		})
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list purgeable groups")
+			return purged, anonymized, err
+		}
+
+		for _, g := range groups {
+			if err := s.purgeGroup(ctx, g); err != nil {
+				return purged, anonymized, err
+			}
+			if g.HasRounds {
+				anonymized++
+			} else {
+				purged++
+			}
+		}
+
+		if len(groups) < purgeBatchSize {
+			return purged, anonymized, nil
+		}
+	}
+}
+
+// HandlePurgeDeletedGroupsJob runs PurgeDeletedGroups for a queued job. The
+// payload carries the retention period as retention_days.
+func (s *Service) HandlePurgeDeletedGroupsJob(ctx context.Context, job *sqlc.Job) error {
+	var payload struct {
+		RetentionDays int `json:"retention_days"`
+	}
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+	if payload.RetentionDays < 1 {
+		payload.RetentionDays = 30
+	}
+
+	before := time.Now().AddDate(0, 0, -payload.RetentionDays)
+	purged, anonymized, err := s.PurgeDeletedGroups(ctx, before)
+	if err != nil {
+		return err
+	}
+
+	log.Info().
+		Int("purged", purged).
+		Int("anonymized", anonymized).
+		Time("deleted_before", before).
+		Msg("Purged deleted groups")
+	return nil
+}
+
+// RemoveMember removes a member on behalf of the group owner. Members with
+// outstanding obligations in an active round can only be removed with
+// Force set and a reason, which is recorded alongside what they still owed.
+func (s *Service) RemoveMember(ctx context.Context, params RemoveMemberParams) error {
+	group, err := s.getWritableGroup(ctx, params.GroupID)
+	if err != nil {
+		return err
+	}
+
+	if group.OwnerID != params.Actor.ID {
+		return errors.ErrNotGroupOwner
+	}
+
+	address := strings.ToLower(params.MemberAddress)
+	member, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: group.ID,
+		Address: address,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.ErrMemberNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return err
+	}
+
+	if member.Role == RoleOwner {
+		return errors.ErrOwnerCannotLeave
+	}
+
+	return s.removeMember(ctx, removal{
+		member:    member,
+		address:   address,
+		removedBy: params.Actor.ID,
+		kind:      removalKindRemoved,
+		force:     params.Force,
+		reason:    params.Reason,
+	})
+}
+
+// LeaveGroup removes the user from the group. The owner cannot leave, and
+// nobody can leave while they still owe contributions or are due a payout
+// in an active round.
+func (s *Service) LeaveGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) error {
+	group, err := s.getWritableGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	address := strings.ToLower(user.Address)
+	member, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: group.ID,
+		Address: address,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.ErrNotGroupMember
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return err
+	}
+
+	if member.Role == RoleOwner {
+		return errors.ErrOwnerCannotLeave
+	}
+
+	return s.removeMember(ctx, removal{
+		member:    member,
+		address:   address,
+		removedBy: user.ID,
+		kind:      removalKindLeft,
+	})
+}
+
+func (s *Service) getGroup(ctx context.Context, groupID uuid.UUID) (sqlc.Group, error) {
+	group, err := s.store.GetGroupByID(ctx, groupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return sqlc.Group{}, errors.ErrGroupNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group")
+		return sqlc.Group{}, err
+	}
+	return group, nil
+}
+
+// requireAcceptedMember checks that the user is an accepted member of the
+// group.
+func (s *Service) requireAcceptedMember(ctx context.Context, groupID uuid.UUID, user sqlc.User) error {
+	caller, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: groupID,
+		Address: strings.ToLower(user.Address),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.ErrNotGroupMember
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return err
+	}
+	if caller.Status != StatusAccepted {
+		return errors.ErrNotGroupMember
+	}
+	return nil
+}
+
+// getWritableGroup is getGroup for operations that change the group, which
+// archived groups reject.
+func (s *Service) getWritableGroup(ctx context.Context, groupID uuid.UUID) (sqlc.Group, error) {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return sqlc.Group{}, err
+	}
+	if group.ArchivedAt.Valid {
+		return sqlc.Group{}, errors.ErrGroupArchived
+	}
+	return group, nil
+}
+
+// obligationsFromRows keeps only the rounds where the member still has
+// contributions to make or has not yet received their payout. A rotation has
+// one period per participant, so everyone contributes once per period.
+func obligationsFromRows(rows []sqlc.ListActiveRoundObligationsRow) []Obligation {
+	var obligations []Obligation
+	for _, row := range rows {
+		owed := int(row.TotalPeriods) - int(row.ContributionsPaid)
+		if owed < 0 {
+			owed = 0
+		}
+		awaitingPayout := !row.PayoutReceivedAt.Valid
+		if owed == 0 && !awaitingPayout {
+			continue
+		}
+		obligations = append(obligations, Obligation{
+			RoundID:           row.RoundID,
+			ContributionsOwed: owed,
+			AwaitingPayout:    awaitingPayout,
+		})
+	}
+	return obligations
+}
+
+// removal describes removing a member from a group. Members with
+// outstanding obligations can only be removed with force set and a reason.
+type removal struct {
+	member    sqlc.GroupMember
+	address   string
+	removedBy uuid.UUID
+	kind      string
+	force     bool
+	reason    *string
+}
+
+func (s *Service) removeMember(ctx context.Context, r removal) error {
+	pgxStore, ok := s.store.(*db.PGXStore)
+	if !ok {
+		return errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to begin transaction")
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	kind, err := removeMemberRecords(ctx, pgxStore.Queries.WithTx(tx), r)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to commit transaction")
+		return err
+	}
+
+	log.Info().
+		Str("group_id", r.member.GroupID.String()).
+		Str("user_id", r.member.UserID.String()).
+		Str("kind", kind).
+		Msg("Group member removed")
+
+	s.invalidate(ctx)
+	return nil
+}
+
+// removeMemberRecords removes a member and records what they still owed,
+// returning the kind of removal recorded. The member row is locked before
+// their obligations are read, so concurrent removals of the same member
+// wait for each other and the check holds until the removal commits.
+func removeMemberRecords(ctx context.Context, q sqlc.Querier, r removal) (string, error) {
+	if _, err := q.LockGroupMember(ctx, r.member.ID); err != nil {
+		if err == pgx.ErrNoRows {
+			return "", errors.ErrMemberNotFound
+		}
+		log.Error().Err(err).Msg("Failed to lock group member")
+		return "", err
+	}
+
+	rows, err := q.ListActiveRoundObligations(ctx, sqlc.ListActiveRoundObligationsParams{
+		GroupID: r.member.GroupID,
+		Address: r.address,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list active round obligations")
+		return "", err
+	}
+
+	obligations := obligationsFromRows(rows)
+	kind := r.kind
+	if len(obligations) > 0 {
+		if !r.force {
+			return "", &ObligationError{Address: r.address, Obligations: obligations}
+		}
+		if r.reason == nil || strings.TrimSpace(*r.reason) == "" {
+			return "", errors.ErrRemovalReasonRequired
+		}
+		kind = removalKindForceRemoved
+	}
+	if obligations == nil {
+		obligations = []Obligation{}
+	}
+	outstanding, err := json.Marshal(obligations)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal outstanding obligations")
+		return "", err
+	}
+
+	if _, err := q.MarkGroupMemberRemoved(ctx, r.member.ID); err != nil {
+		if err == pgx.ErrNoRows {
+			return "", errors.ErrMemberNotFound
+		}
+		log.Error().Err(err).Msg("Failed to mark group member as removed")
+		return "", err
+	}
+
+	if _, err := q.CreateGroupMemberRemoval(ctx, sqlc.CreateGroupMemberRemovalParams{
+		GroupID:     r.member.GroupID,
+		UserID:      r.member.UserID,
+		RemovedBy:   r.removedBy,
+		Kind:        kind,
+		Reason:      r.reason,
+		Outstanding: outstanding,
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to record group member removal")
+		return "", err
+	}
+
+	if _, err := SyncAllowlist(ctx, q, r.member.GroupID); err != nil {
+		return "", err
+	}
+	return kind, nil
+}
+
+// invalidate drops cached dashboards after a committed write. Failures are
+// logged rather than returned, and the dashboards expire on their own.
+func (s *Service) invalidate(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Invalidate(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to invalidate dashboards")
+	}
+}
+
+func (s *Service) purgeGroup(ctx context.Context, g sqlc.ListPurgeableGroupsRow) error {
+	pgxStore, ok := s.store.(*db.PGXStore)
+	if !ok {
+		return errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to begin transaction")
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := purgeGroupRecords(ctx, pgxStore.Queries.WithTx(tx), g); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to commit transaction")
+		return err
+	}
+
+	if g.HasRounds {
+		log.Info().Str("group_id", g.ID.String()).Msg("Group anonymized, its rounds are kept")
+	} else {
+		log.Info().Str("group_id", g.ID.String()).Msg("Group purged")
+	}
+	return nil
+}
+
+// purgeGroupRecords deletes a group's invites, removal records, allowlists
+// and memberships, then the group itself. A group with rounds is kept for
+// them, without its name, description and avatar.
+func purgeGroupRecords(ctx context.Context, q sqlc.Querier, g sqlc.ListPurgeableGroupsRow) error {
+	if err := q.DeleteGroupInvites(ctx, g.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to delete group invites")
+		return err
+	}
+	if err := q.DeleteGroupMemberRemovals(ctx, g.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to delete group member removals")
+		return err
+	}
+	if err := q.DeleteGroupAllowlists(ctx, g.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to delete group allowlists")
+		return err
+	}
+	if err := q.DeleteGroupMembers(ctx, g.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to delete group members")
+		return err
+	}
+
+	if g.HasRounds {
+		if err := q.AnonymizeGroup(ctx, g.ID); err != nil {
+			log.Error().Err(err).Msg("Failed to anonymize group")
+			return err
+		}
+		return nil
+	}
+	if err := q.DeleteGroup(ctx, g.ID); err != nil {
+		log.Error().Err(err).Msg("Failed to delete group")
+		return err
+	}
+	return nil
+}
+
+// escapeLike escapes LIKE wildcards so user input only matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,802 @@
+package group
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/pagination"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const memberAddress = "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"
+
+var testPaginator = pagination.New("test-secret")
+
+func TestService_RemoveMember(t *testing.T) {
+	owner := createTestUser("0x1111111111111111111111111111111111111111")
+	group := createTestGroup(owner.ID)
+
+	tests := []struct {
+		name          string
+		params        RemoveMemberParams
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+	}{
+		{
+			name:   "error - group not found",
+			params: RemoveMemberParams{GroupID: group.ID, Actor: owner, MemberAddress: memberAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(sqlc.Group{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrGroupNotFound,
+		},
+		{
+			name:   "error - group is archived",
+			params: RemoveMemberParams{GroupID: group.ID, Actor: owner, MemberAddress: memberAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				archived := group
+				archived.ArchivedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(archived, nil)
+			},
+			expectedError: circaerrors.ErrGroupArchived,
+		},
+		{
+			name:   "error - actor is not the owner",
+			params: RemoveMemberParams{GroupID: group.ID, Actor: createTestUser(memberAddress), MemberAddress: memberAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			},
+			expectedError: circaerrors.ErrNotGroupOwner,
+		},
+		{
+			name:   "error - member not found",
+			params: RemoveMemberParams{GroupID: group.ID, Actor: owner, MemberAddress: memberAddress},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrMemberNotFound,
+		},
+		{
+			name:   "error - owner cannot be removed",
+			params: RemoveMemberParams{GroupID: group.ID, Actor: owner, MemberAddress: owner.Address},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleOwner), nil)
+			},
+			expectedError: circaerrors.ErrOwnerCannotLeave,
+		},
+		{
+			name:   "success path - reaches the store",
+			params: RemoveMemberParams{GroupID: group.ID, Actor: owner, MemberAddress: "0xABCDEFABCDEFABCDEFABCDEFABCDEFABCDEFABCD"},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, sqlc.GetActiveGroupMemberByAddressParams{
+					GroupID: group.ID,
+					Address: memberAddress,
+				}).Return(createTestMember(group.ID, RoleMember), nil)
+			},
+			expectedError: circaerrors.ErrInvalidStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, testPaginator, nil)
+
+			err := service.RemoveMember(context.Background(), tt.params)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.expectedError)
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_LeaveGroup(t *testing.T) {
+	owner := createTestUser("0x1111111111111111111111111111111111111111")
+	member := createTestUser(memberAddress)
+	group := createTestGroup(owner.ID)
+
+	tests := []struct {
+		name          string
+		user          sqlc.User
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+	}{
+		{
+			name: "error - group not found",
+			user: member,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(sqlc.Group{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrGroupNotFound,
+		},
+		{
+			name: "error - not a member",
+			user: member,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+		{
+			name: "error - owner cannot leave",
+			user: owner,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleOwner), nil)
+			},
+			expectedError: circaerrors.ErrOwnerCannotLeave,
+		},
+		{
+			name: "success path - reaches the store",
+			user: member,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleMember), nil)
+			},
+			expectedError: circaerrors.ErrInvalidStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, testPaginator, nil)
+
+			err := service.LeaveGroup(context.Background(), group.ID, tt.user)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.expectedError)
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_SearchMembers(t *testing.T) {
+	owner := createTestUser("0x1111111111111111111111111111111111111111")
+	group := createTestGroup(owner.ID)
+	firstMemberID := uuid.New()
+
+	tests := []struct {
+		name          string
+		params        SearchMembersParams
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		validate      func(*testing.T, *SearchMembersResult)
+	}{
+		{
+			name:          "error - empty query",
+			params:        SearchMembersParams{GroupID: group.ID, User: owner, Query: "   "},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidSearchQuery,
+		},
+		{
+			name:   "error - caller is not a member",
+			params: SearchMembersParams{GroupID: group.ID, User: owner, Query: "ada"},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+		{
+			name:   "error - caller is only invited",
+			params: SearchMembersParams{GroupID: group.ID, User: owner, Query: "ada"},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				invited := createTestMember(group.ID, RoleMember)
+				invited.Status = "invited"
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(invited, nil)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+		{
+			name:   "success - escapes wildcards and pages by address",
+			params: SearchMembersParams{GroupID: group.ID, User: owner, Query: "0xAB_%", Limit: intPtr(1)},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleOwner), nil)
+				ms.On("SearchGroupMembers", mock.Anything, sqlc.SearchGroupMembersParams{
+					GroupID:       group.ID,
+					AddressPrefix: `0xab\_\%%`,
+					NamePattern:   `%0xAB\_\%%`,
+					Query:         "0xAB_%",
+					PageSize:      2,
+				}).Return([]sqlc.SearchGroupMembersRow{
+					{ID: firstMemberID, Address: "0xab01", Role: RoleMember, Status: StatusAccepted},
+					{ID: uuid.New(), Address: "0xab02", Role: RoleMember, Status: StatusAccepted},
+				}, nil)
+			},
+			validate: func(t *testing.T, result *SearchMembersResult) {
+				require.Len(t, result.Members, 1)
+				require.NotNil(t, result.NextCursor)
+				cursor, err := testPaginator.Decode(result.NextCursor)
+				require.NoError(t, err)
+				assert.Equal(t, pagination.AtString("0xab01", firstMemberID), *cursor)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			result, err := NewService(mockStore, testPaginator, nil).SearchMembers(context.Background(), tt.params)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, result)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetMemberProfile(t *testing.T) {
+	owner := createTestUser("0x1111111111111111111111111111111111111111")
+	group := createTestGroup(owner.ID)
+	roundID := uuid.New()
+
+	tests := []struct {
+		name          string
+		address       string
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		validate      func(*testing.T, *MemberProfile)
+	}{
+		{
+			name:    "error - group not found",
+			address: memberAddress,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(sqlc.Group{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrGroupNotFound,
+		},
+		{
+			name:    "error - caller is not a member",
+			address: memberAddress,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+		{
+			name:    "error - member not found",
+			address: memberAddress,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleOwner), nil)
+				ms.On("GetGroupMemberDetails", mock.Anything, mock.Anything).Return(sqlc.GetGroupMemberDetailsRow{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrMemberNotFound,
+		},
+		{
+			name:    "success - member with delinquencies",
+			address: "0xABCDEFABCDEFABCDEFABCDEFABCDEFABCDEFABCD",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID, RoleOwner), nil)
+				ms.On("GetGroupMemberDetails", mock.Anything, sqlc.GetGroupMemberDetailsParams{
+					GroupID: group.ID,
+					Address: memberAddress,
+				}).Return(sqlc.GetGroupMemberDetailsRow{Address: memberAddress, Role: RoleMember, Status: StatusAccepted}, nil)
+				ms.On("ListGroupMemberDelinquencies", mock.Anything, sqlc.ListGroupMemberDelinquenciesParams{
+					GroupID: group.ID,
+					Address: memberAddress,
+					Limit:   memberDelinquencyLimit,
+				}).Return([]sqlc.ListGroupMemberDelinquenciesRow{
+					{RoundID: roundID, Period: 0, Status: "missed"},
+				}, nil)
+			},
+			validate: func(t *testing.T, profile *MemberProfile) {
+				assert.Equal(t, memberAddress, profile.Member.Address)
+				require.Len(t, profile.Delinquencies, 1)
+				assert.Equal(t, roundID, profile.Delinquencies[0].RoundID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			profile, err := NewService(mockStore, testPaginator, nil).GetMemberProfile(context.Background(), group.ID, owner, tt.address)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, profile)
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, profile)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ArchiveGroup(t *testing.T) {
+	owner := createTestUser("0x1111111111111111111111111111111111111111")
+	group := createTestGroup(owner.ID)
+	archived := group
+	archived.ArchivedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+
+	tests := []struct {
+		name          string
+		user          sqlc.User
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+	}{
+		{
+			name: "success - archives group",
+			user: owner,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("CountUnfinishedGroupRounds", mock.Anything, group.ID).Return(int64(0), nil)
+				ms.On("ArchiveGroup", mock.Anything, group.ID).Return(archived, nil)
+			},
+		},
+		{
+			name: "success - already archived is a no-op",
+			user: owner,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(archived, nil)
+			},
+		},
+		{
+			name: "error - not the owner",
+			user: createTestUser(memberAddress),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			},
+			expectedError: circaerrors.ErrNotGroupOwner,
+		},
+		{
+			name: "error - group has unfinished rounds",
+			user: owner,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("CountUnfinishedGroupRounds", mock.Anything, group.ID).Return(int64(1), nil)
+			},
+			expectedError: circaerrors.ErrGroupHasActiveRounds,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, testPaginator, nil)
+
+			err := service.ArchiveGroup(context.Background(), group.ID, tt.user)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_UnarchiveGroup(t *testing.T) {
+	owner := createTestUser("0x1111111111111111111111111111111111111111")
+	group := createTestGroup(owner.ID)
+	archived := group
+	archived.ArchivedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetGroupByID", mock.Anything, group.ID).Return(archived, nil)
+	mockStore.On("UnarchiveGroup", mock.Anything, group.ID).Return(group, nil)
+
+	service := NewService(mockStore, testPaginator, nil)
+	require.NoError(t, service.UnarchiveGroup(context.Background(), group.ID, owner))
+	assert.ErrorIs(t, service.UnarchiveGroup(context.Background(), group.ID, createTestUser(memberAddress)), circaerrors.ErrNotGroupOwner)
+
+	mockStore.AssertExpectations(t)
+}
+
+func TestService_ListGroups(t *testing.T) {
+	userID := uuid.New()
+	newer := sqlc.ListUserGroupsRow{ID: uuid.New(), Name: "Newer", CreatedAt: pgtype.Timestamp{Time: time.Now().UTC(), Valid: true}}
+	older := sqlc.ListUserGroupsRow{ID: uuid.New(), Name: "Older", CreatedAt: pgtype.Timestamp{Time: time.Now().UTC().Add(-time.Hour), Valid: true}}
+
+	t.Run("returns next cursor when more groups exist", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("ListUserGroups", mock.Anything, sqlc.ListUserGroupsParams{
+			UserID:   userID,
+			Archived: true,
+			PageSize: 2,
+		}).Return([]sqlc.ListUserGroupsRow{newer, older}, nil)
+
+		result, err := NewService(mockStore, testPaginator, nil).ListGroups(context.Background(), ListGroupsParams{
+			UserID:   userID,
+			Archived: true,
+			Limit:    intPtr(1),
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Groups, 1)
+		assert.Equal(t, newer.ID, result.Groups[0].ID)
+		require.NotNil(t, result.NextCursor)
+
+		cursor, err := testPaginator.Decode(result.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, newer.ID, cursor.ID)
+		createdAt, err := cursor.Timestamp()
+		require.NoError(t, err)
+		assert.True(t, newer.CreatedAt.Time.Equal(createdAt.Time))
+	})
+
+	t.Run("passes cursor position to the store", func(t *testing.T) {
+		cursor := testPaginator.Encode(pagination.AtTime(newer.CreatedAt.Time, newer.ID))
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("ListUserGroups", mock.Anything, mock.MatchedBy(func(p sqlc.ListUserGroupsParams) bool {
+			return p.PageSize == pagination.DefaultLimit+1 &&
+				p.CursorID.Valid && p.CursorID.Bytes == newer.ID &&
+				p.CursorCreatedAt.Valid && p.CursorCreatedAt.Time.Equal(newer.CreatedAt.Time)
+		})).Return([]sqlc.ListUserGroupsRow{older}, nil)
+
+		result, err := NewService(mockStore, testPaginator, nil).ListGroups(context.Background(), ListGroupsParams{
+			UserID: userID,
+			Cursor: &cursor,
+		})
+		require.NoError(t, err)
+		assert.Len(t, result.Groups, 1)
+		assert.Nil(t, result.NextCursor)
+	})
+
+	t.Run("searches by relevance when a query is given", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("SearchUserGroups", mock.Anything, sqlc.SearchUserGroupsParams{
+			Query:    "ajo",
+			UserID:   userID,
+			PageSize: 2,
+		}).Return([]sqlc.SearchUserGroupsRow{
+			{ID: newer.ID, Name: "Friday Ajo", MemberCount: 3, Rank: 0.75},
+			{ID: older.ID, Name: "Ajo Circle", Rank: 0.5},
+		}, nil)
+
+		result, err := NewService(mockStore, testPaginator, nil).ListGroups(context.Background(), ListGroupsParams{
+			UserID: userID,
+			Query:  "  ajo ",
+			Limit:  intPtr(1),
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Groups, 1)
+		assert.Equal(t, "Friday Ajo", result.Groups[0].Name)
+		assert.Equal(t, int64(3), result.Groups[0].MemberCount)
+		require.NotNil(t, result.NextCursor)
+
+		cursor, err := testPaginator.Decode(result.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, pagination.AtFloat(0.75, newer.ID), *cursor)
+	})
+
+	t.Run("search continues from rank cursor", func(t *testing.T) {
+		cursor := testPaginator.Encode(pagination.AtFloat(0.75, newer.ID))
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("SearchUserGroups", mock.Anything, mock.MatchedBy(func(p sqlc.SearchUserGroupsParams) bool {
+			return p.CursorRank.Valid && p.CursorRank.Float64 == 0.75 &&
+				p.CursorID.Valid && p.CursorID.Bytes == newer.ID
+		})).Return([]sqlc.SearchUserGroupsRow{}, nil)
+
+		result, err := NewService(mockStore, testPaginator, nil).ListGroups(context.Background(), ListGroupsParams{
+			UserID: userID,
+			Query:  "ajo",
+			Cursor: &cursor,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Groups)
+		assert.Nil(t, result.NextCursor)
+	})
+
+	t.Run("rejects out of range limit", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		_, err := NewService(mockStore, testPaginator, nil).ListGroups(context.Background(), ListGroupsParams{
+			UserID: userID,
+			Limit:  intPtr(201),
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidLimit)
+	})
+
+	t.Run("rejects malformed cursor", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		_, err := NewService(mockStore, testPaginator, nil).ListGroups(context.Background(), ListGroupsParams{
+			UserID: userID,
+			Cursor: stringPtr("not-a-cursor"),
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidCursor)
+	})
+}
+
+func TestService_PurgeDeletedGroups(t *testing.T) {
+	before := time.Now().AddDate(0, 0, -30)
+
+	t.Run("nothing to purge", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("ListPurgeableGroups", mock.Anything, sqlc.ListPurgeableGroupsParams{
+			DeletedAt: pgtype.Timestamp{Time: before, Valid: true},
+			Limit:     purgeBatchSize,
+		}).Return([]sqlc.ListPurgeableGroupsRow{}, nil)
+
+		purged, anonymized, err := NewService(mockStore, testPaginator, nil).PurgeDeletedGroups(context.Background(), before)
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged)
+		assert.Equal(t, 0, anonymized)
+	})
+
+	t.Run("deletes each group in a transaction", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("ListPurgeableGroups", mock.Anything, mock.Anything).
+			Return([]sqlc.ListPurgeableGroupsRow{{ID: uuid.New()}}, nil)
+
+		purged, anonymized, err := NewService(mockStore, testPaginator, nil).PurgeDeletedGroups(context.Background(), before)
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidStore)
+		assert.Equal(t, 0, purged)
+		assert.Equal(t, 0, anonymized)
+	})
+}
+
+func TestPurgeGroupRecords(t *testing.T) {
+	tests := []struct {
+		name      string
+		hasRounds bool
+		finalCall string
+		skipped   string
+	}{
+		{name: "group without rounds is deleted", finalCall: "DeleteGroup", skipped: "AnonymizeGroup"},
+		{name: "group with rounds is anonymized and kept", hasRounds: true, finalCall: "AnonymizeGroup", skipped: "DeleteGroup"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groupID := uuid.New()
+			mockStore := dbmocks.NewMockStore(t)
+			mockStore.On("DeleteGroupInvites", mock.Anything, groupID).Return(nil)
+			mockStore.On("DeleteGroupMemberRemovals", mock.Anything, groupID).Return(nil)
+			mockStore.On("DeleteGroupAllowlists", mock.Anything, groupID).Return(nil)
+			mockStore.On("DeleteGroupMembers", mock.Anything, groupID).Return(nil)
+			mockStore.On(tt.finalCall, mock.Anything, groupID).Return(nil)
+
+			err := purgeGroupRecords(context.Background(), mockStore, sqlc.ListPurgeableGroupsRow{
+				ID:        groupID,
+				HasRounds: tt.hasRounds,
+			})
+			require.NoError(t, err)
+			mockStore.AssertExpectations(t)
+			mockStore.AssertNotCalled(t, tt.skipped, mock.Anything, groupID)
+		})
+	}
+}
+
+func TestService_HandlePurgeDeletedGroupsJob(t *testing.T) {
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("ListPurgeableGroups", mock.Anything, mock.MatchedBy(func(p sqlc.ListPurgeableGroupsParams) bool {
+		cutoff := time.Now().AddDate(0, 0, -7)
+		return p.DeletedAt.Time.Sub(cutoff).Abs() < time.Minute
+	})).Return([]sqlc.ListPurgeableGroupsRow{}, nil)
+
+	err := NewService(mockStore, testPaginator, nil).HandlePurgeDeletedGroupsJob(context.Background(), &sqlc.Job{
+		Payload: []byte(`{"retention_days": 7}`),
+	})
+	require.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
+
+func TestRemoveMemberRecords(t *testing.T) {
+	group := createTestGroup(uuid.New())
+	member := createTestMember(group.ID, RoleMember)
+	roundID := uuid.New()
+	paidOut := pgtype.Timestamp{Time: time.Now(), Valid: true}
+
+	leaving := removal{member: member, address: memberAddress, removedBy: member.UserID, kind: removalKindLeft}
+	removed := removal{member: member, address: memberAddress, removedBy: group.OwnerID, kind: removalKindRemoved}
+	forced := removed
+	forced.force = true
+	forced.reason = stringPtr("Moved abroad")
+	forcedWithoutReason := forced
+	forcedWithoutReason.reason = stringPtr("  ")
+
+	locked := func(ms *dbmocks.MockStore) {
+		ms.On("LockGroupMember", mock.Anything, member.ID).Return(member, nil)
+	}
+	owing := func(ms *dbmocks.MockStore, rows ...sqlc.ListActiveRoundObligationsRow) {
+		ms.On("ListActiveRoundObligations", mock.Anything, sqlc.ListActiveRoundObligationsParams{
+			GroupID: group.ID,
+			Address: memberAddress,
+		}).Return(rows, nil).NotBefore(ms.On("LockGroupMember", mock.Anything, member.ID).Return(member, nil))
+	}
+	recorded := func(ms *dbmocks.MockStore, kind, outstanding string) {
+		ms.On("MarkGroupMemberRemoved", mock.Anything, member.ID).Return(member, nil)
+		ms.On("CreateGroupMemberRemoval", mock.Anything, mock.MatchedBy(func(p sqlc.CreateGroupMemberRemovalParams) bool {
+			return p.Kind == kind && string(p.Outstanding) == outstanding
+		})).Return(sqlc.GroupMemberRemoval{}, nil)
+		ms.On("LockGroup", mock.Anything, group.ID).Return(nil)
+		ms.On("ListAcceptedGroupMemberAddresses", mock.Anything, group.ID).Return([]string{aliceAddress}, nil)
+		ms.On("GetLatestFinalizedPayoutOrder", mock.Anything, group.ID).Return(sqlc.PayoutOrder{}, pgx.ErrNoRows)
+		ms.On("GetLatestGroupAllowlist", mock.Anything, group.ID).Return(sqlc.GroupAllowlist{
+			Version: 1,
+			Root:    allowlistRoot(t, aliceAddress),
+		}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		removal       removal
+		setupMocks    func(*dbmocks.MockStore)
+		expectedKind  string
+		expectedError error
+		validateError func(*testing.T, error)
+	}{
+		{
+			name:    "error - member removed concurrently",
+			removal: leaving,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("LockGroupMember", mock.Anything, member.ID).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrMemberNotFound,
+		},
+		{
+			name:    "error - paid out member still owes contributions",
+			removal: leaving,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				owing(ms, sqlc.ListActiveRoundObligationsRow{RoundID: roundID, ContributionsPaid: 1, PayoutReceivedAt: paidOut, TotalPeriods: 4})
+			},
+			expectedError: circaerrors.ErrOutstandingObligations,
+		},
+		{
+			name:    "error - outstanding obligations without force",
+			removal: removed,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				owing(ms, sqlc.ListActiveRoundObligationsRow{RoundID: roundID, ContributionsPaid: 2, TotalPeriods: 5})
+			},
+			expectedError: circaerrors.ErrOutstandingObligations,
+			validateError: func(t *testing.T, err error) {
+				var obligationErr *ObligationError
+				require.True(t, errors.As(err, &obligationErr))
+				require.Len(t, obligationErr.Obligations, 1)
+				assert.Equal(t, roundID, obligationErr.Obligations[0].RoundID)
+				assert.Equal(t, 3, obligationErr.Obligations[0].ContributionsOwed)
+				assert.True(t, obligationErr.Obligations[0].AwaitingPayout)
+				assert.Contains(t, err.Error(), "owes 3 contribution(s)")
+			},
+		},
+		{
+			name:    "error - force removal without a reason",
+			removal: forcedWithoutReason,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				owing(ms, sqlc.ListActiveRoundObligationsRow{RoundID: roundID, ContributionsPaid: 5, TotalPeriods: 5})
+			},
+			expectedError: circaerrors.ErrRemovalReasonRequired,
+		},
+		{
+			name:    "error - database error listing obligations",
+			removal: leaving,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				locked(ms)
+				ms.On("ListActiveRoundObligations", mock.Anything, mock.Anything).Return(nil, errors.New("database connection error"))
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "success - settled member leaves",
+			removal: leaving,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				owing(ms, sqlc.ListActiveRoundObligationsRow{RoundID: roundID, ContributionsPaid: 4, PayoutReceivedAt: paidOut, TotalPeriods: 4})
+				recorded(ms, removalKindLeft, "[]")
+			},
+			expectedKind: removalKindLeft,
+		},
+		{
+			name:    "success - force removal records what was owed",
+			removal: forced,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				owing(ms, sqlc.ListActiveRoundObligationsRow{RoundID: roundID, ContributionsPaid: 1, TotalPeriods: 5})
+				recorded(ms, removalKindForceRemoved, `[{"round_id":"`+roundID.String()+`","contributions_owed":4,"awaiting_payout":true}]`)
+			},
+			expectedKind: removalKindForceRemoved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			kind, err := removeMemberRecords(context.Background(), mockStore, tt.removal)
+
+			switch {
+			case tt.expectedKind != "":
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedKind, kind)
+			case tt.expectedError != nil:
+				assert.ErrorIs(t, err, tt.expectedError)
+				mockStore.AssertNotCalled(t, "MarkGroupMemberRemoved", mock.Anything, mock.Anything)
+			default:
+				assert.EqualError(t, err, "database connection error")
+			}
+			if tt.validateError != nil {
+				tt.validateError(t, err)
+			}
+		})
+	}
+}
+
+func TestObligationsFromRows(t *testing.T) {
+	paidOut := pgtype.Timestamp{Time: time.Now(), Valid: true}
+	settled := uuid.New()
+	owesOnly := uuid.New()
+	awaitingOnly := uuid.New()
+
+	obligations := obligationsFromRows([]sqlc.ListActiveRoundObligationsRow{
+		{RoundID: settled, ContributionsPaid: 3, PayoutReceivedAt: paidOut, TotalPeriods: 3},
+		{RoundID: owesOnly, ContributionsPaid: 1, PayoutReceivedAt: paidOut, TotalPeriods: 3},
+		{RoundID: awaitingOnly, ContributionsPaid: 3, TotalPeriods: 3},
+	})
+
+	assert.Equal(t, []Obligation{
+		{RoundID: owesOnly, ContributionsOwed: 2, AwaitingPayout: false},
+		{RoundID: awaitingOnly, ContributionsOwed: 0, AwaitingPayout: true},
+	}, obligations)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func createTestUser(address string) sqlc.User {
+	return sqlc.User{
+		ID:        uuid.New(),
+		Address:   address,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func createTestGroup(ownerID uuid.UUID) sqlc.Group {
+	return sqlc.Group{
+		ID:        uuid.New(),
+		Name:      "Friday Ajo",
+		OwnerID:   ownerID,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func createTestMember(groupID uuid.UUID, role string) sqlc.GroupMember {
+	return sqlc.GroupMember{
+		ID:       uuid.New(),
+		GroupID:  groupID,
+		UserID:   uuid.New(),
+		Role:     role,
+		Status:   "accepted",
+		JoinedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
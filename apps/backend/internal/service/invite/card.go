@@ -0,0 +1,43 @@
+package invite
+
+import "html/template"
+
+type cardData struct {
+	Name        string
+	Description *string
+	AvatarURL   *string
+	JoinURL     string
+	QRCode      template.HTML
+}
+
+// cardTemplate is sized for an A6 card so several can be printed per page.
+var cardTemplate = template.Must(template.New("card").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Join {{.Name}} on Circa</title>
+<style>
+  @page { size: A6; margin: 8mm; }
+  body { font-family: system-ui, sans-serif; margin: 0; color: #111; }
+  .card { width: 90mm; margin: 0 auto; padding: 6mm; text-align: center; border: 1px solid #ddd; border-radius: 4mm; }
+  .avatar { width: 18mm; height: 18mm; border-radius: 50%; object-fit: cover; }
+  h1 { font-size: 16pt; margin: 3mm 0 1mm; }
+  p { font-size: 9pt; margin: 0 0 3mm; color: #555; }
+  .qr svg { width: 50mm; height: 50mm; }
+  .code { height: 14mm; margin: 4mm 6mm 0; border: 1px dashed #999; border-radius: 2mm; }
+  .hint { font-size: 10pt; color: #111; margin-top: 3mm; }
+  @media print { .card { border: none; } }
+</style>
+</head>
+<body>
+<div class="card">
+  {{if .AvatarURL}}<img class="avatar" src="{{.AvatarURL}}" alt="">{{end}}
+  <h1>{{.Name}}</h1>
+  {{if .Description}}<p>{{.Description}}</p>{{end}}
+  <div class="qr">{{.QRCode}}</div>
+  <div class="hint">Scan or open {{.JoinURL}} and enter your invite code</div>
+  <div class="code"></div>
+</div>
+</body>
+</html>
+`))
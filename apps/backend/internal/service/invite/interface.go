@@ -0,0 +1,44 @@
+package invite
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type QRFormat string
+
+const (
+	QRFormatPNG QRFormat = "png"
+	QRFormatSVG QRFormat = "svg"
+)
+
+type CreateInviteParams struct {
+	GroupID   uuid.UUID
+	Actor     sqlc.User
+	MaxUses   *int
+	ExpiresAt *time.Time
+}
+
+// CreateInviteResult carries the plaintext code, its deep link and the QR
+// code of the link, which are only available at creation time.
+type CreateInviteResult struct {
+	Invite    sqlc.Invite
+	Code      string
+	DeepLink  string
+	QRCodePNG []byte
+	QRCodeSVG string
+}
+
+type QRCode struct {
+	ContentType string
+	Data        []byte
+}
+
+type InviteService interface {
+	CreateInvite(ctx context.Context, params CreateInviteParams) (*CreateInviteResult, error)
+	GetQRCode(ctx context.Context, groupID, inviteID uuid.UUID, user sqlc.User, format QRFormat) (*QRCode, error)
+	RenderCard(ctx context.Context, groupID, inviteID uuid.UUID, user sqlc.User) ([]byte, error)
+}
@@ -0,0 +1,213 @@
+package invite
+
+import (
+	"bytes"
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/qr"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const qrPNGSize = 512
+
+// codeEncoding avoids padding and lowercase so codes survive being read
+// aloud or typed by hand.
+var codeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type Service struct {
+	store       db.Store
+	frontendURL string
+}
+
+func NewService(store db.Store, frontendURL string) *Service {
+	return &Service{
+		store:       store,
+		frontendURL: frontendURL,
+	}
+}
+
+// CreateInvite creates an invite code for the group. Only the code hash is
+// stored, so the QR code of the deep link is rendered into the result and
+// never kept.
+func (s *Service) CreateInvite(ctx context.Context, params CreateInviteParams) (*CreateInviteResult, error) {
+	group, err := s.getOwnedGroup(ctx, params.GroupID, params.Actor)
+	if err != nil {
+		return nil, err
+	}
+	if group.ArchivedAt.Valid {
+		return nil, errors.ErrGroupArchived
+	}
+
+	maxUses := 1
+	if params.MaxUses != nil {
+		maxUses = *params.MaxUses
+	}
+	if maxUses < 1 {
+		return nil, errors.ErrInvalidMaxUses
+	}
+
+	var expiresAt pgtype.Timestamp
+	if params.ExpiresAt != nil {
+		if !params.ExpiresAt.After(time.Now()) {
+			return nil, errors.ErrInvalidInviteExpiry
+		}
+		expiresAt = pgtype.Timestamp{Time: *params.ExpiresAt, Valid: true}
+	}
+
+	codeBytes := make([]byte, 15)
+	if _, err := rand.Read(codeBytes); err != nil {
+		log.Error().Err(err).Msg("Failed to generate invite code")
+		return nil, err
+	}
+	code := codeEncoding.EncodeToString(codeBytes)
+	codeHash := sha256.Sum256([]byte(code))
+
+	deepLink := s.deepLink(code)
+	png, err := qr.PNG(deepLink, qrPNGSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to render invite QR code")
+		return nil, err
+	}
+	svg, err := qr.SVG(deepLink)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to render invite QR code")
+		return nil, err
+	}
+
+	invite, err := s.store.CreateInvite(ctx, sqlc.CreateInviteParams{
+		GroupID:   group.ID,
+		CodeHash:  hex.EncodeToString(codeHash[:]),
+		CreatedBy: params.Actor.ID,
+		MaxUses:   int32(maxUses),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create invite")
+		return nil, err
+	}
+
+	log.Info().
+		Str("group_id", group.ID.String()).
+		Str("invite_id", invite.ID.String()).
+		Msg("Invite created")
+
+	return &CreateInviteResult{
+		Invite:    invite,
+		Code:      code,
+		DeepLink:  deepLink,
+		QRCodePNG: png,
+		QRCodeSVG: svg,
+	}, nil
+}
+
+// GetQRCode returns the QR code of the group's join page for an active
+// invite. It carries no code, which only exists when the invite is created:
+// whoever scans it enters the code on the page.
+func (s *Service) GetQRCode(ctx context.Context, groupID, inviteID uuid.UUID, user sqlc.User, format QRFormat) (*QRCode, error) {
+	if _, err := s.getOwnedGroup(ctx, groupID, user); err != nil {
+		return nil, err
+	}
+	if err := s.checkActiveInvite(ctx, groupID, inviteID); err != nil {
+		return nil, err
+	}
+
+	if format == QRFormatSVG {
+		svg, err := qr.SVG(s.joinURL())
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to render invite QR code")
+			return nil, err
+		}
+		return &QRCode{ContentType: "image/svg+xml", Data: []byte(svg)}, nil
+	}
+
+	png, err := qr.PNG(s.joinURL(), qrPNGSize)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to render invite QR code")
+		return nil, err
+	}
+	return &QRCode{ContentType: "image/png", Data: png}, nil
+}
+
+// RenderCard renders a printable HTML invite card with the group name,
+// avatar and the QR code of the join page. The owner writes the invite code
+// on it by hand.
+func (s *Service) RenderCard(ctx context.Context, groupID, inviteID uuid.UUID, user sqlc.User) ([]byte, error) {
+	group, err := s.getOwnedGroup(ctx, groupID, user)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkActiveInvite(ctx, groupID, inviteID); err != nil {
+		return nil, err
+	}
+
+	svg, err := qr.SVG(s.joinURL())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to render invite QR code")
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := cardTemplate.Execute(&buf, cardData{
+		Name:        group.Name,
+		Description: group.Description,
+		AvatarURL:   group.AvatarUrl,
+		JoinURL:     s.joinURL(),
+		// The SVG is generated by the qr package, never from user input.
+		QRCode: template.HTML(svg),
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to render invite card")
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *Service) checkActiveInvite(ctx context.Context, groupID, inviteID uuid.UUID) error {
+	if _, err := s.store.GetActiveInvite(ctx, sqlc.GetActiveInviteParams{
+		ID:      inviteID,
+		GroupID: groupID,
+	}); err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.ErrInviteNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get invite")
+		return err
+	}
+	return nil
+}
+
+func (s *Service) joinURL() string {
+	return s.frontendURL + "/invite"
+}
+
+func (s *Service) deepLink(code string) string {
+	return fmt.Sprintf("%s/invite?code=%s", s.frontendURL, url.QueryEscape(code))
+}
+
+func (s *Service) getOwnedGroup(ctx context.Context, groupID uuid.UUID, user sqlc.User) (sqlc.Group, error) {
+	group, err := s.store.GetGroupByID(ctx, groupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return sqlc.Group{}, errors.ErrGroupNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group")
+		return sqlc.Group{}, err
+	}
+	if group.OwnerID != user.ID {
+		return sqlc.Group{}, errors.ErrNotGroupOwner
+	}
+	return group, nil
+}
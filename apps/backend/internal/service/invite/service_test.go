@@ -0,0 +1,261 @@
+package invite
+
+import (
+	"bytes"
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/qr"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const frontendURL = "https://app.circa.test"
+
+func TestService_CreateInvite(t *testing.T) {
+	owner := createTestUser()
+	group := createTestGroup(owner.ID)
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		params        CreateInviteParams
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		validate      func(*testing.T, *CreateInviteResult)
+	}{
+		{
+			name:   "error - group not found",
+			params: CreateInviteParams{GroupID: group.ID, Actor: owner},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(sqlc.Group{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrGroupNotFound,
+		},
+		{
+			name:   "error - actor is not the owner",
+			params: CreateInviteParams{GroupID: group.ID, Actor: createTestUser()},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			},
+			expectedError: circaerrors.ErrNotGroupOwner,
+		},
+		{
+			name:   "error - group is archived",
+			params: CreateInviteParams{GroupID: group.ID, Actor: owner},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				archived := group
+				archived.ArchivedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(archived, nil)
+			},
+			expectedError: circaerrors.ErrGroupArchived,
+		},
+		{
+			name:   "error - max uses below one",
+			params: CreateInviteParams{GroupID: group.ID, Actor: owner, MaxUses: intPtr(0)},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			},
+			expectedError: circaerrors.ErrInvalidMaxUses,
+		},
+		{
+			name:   "error - expiry in the past",
+			params: CreateInviteParams{GroupID: group.ID, Actor: owner, ExpiresAt: &past},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			},
+			expectedError: circaerrors.ErrInvalidInviteExpiry,
+		},
+		{
+			name:   "success - code, deep link and QR code",
+			params: CreateInviteParams{GroupID: group.ID, Actor: owner, MaxUses: intPtr(5), ExpiresAt: &future},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("CreateInvite", mock.Anything, mock.MatchedBy(func(p sqlc.CreateInviteParams) bool {
+					return p.GroupID == group.ID && p.MaxUses == 5 && p.ExpiresAt.Valid
+				})).Return(func(_ context.Context, p sqlc.CreateInviteParams) (sqlc.Invite, error) {
+					return sqlc.Invite{ID: uuid.New(), GroupID: p.GroupID, CodeHash: p.CodeHash, MaxUses: p.MaxUses}, nil
+				})
+			},
+			validate: func(t *testing.T, result *CreateInviteResult) {
+				hash := sha256.Sum256([]byte(result.Code))
+				assert.Equal(t, hex.EncodeToString(hash[:]), result.Invite.CodeHash, "only the code hash is stored")
+				assert.Equal(t, frontendURL+"/invite?code="+result.Code, result.DeepLink)
+				assert.True(t, bytes.HasPrefix(result.QRCodePNG, []byte("\x89PNG")))
+				assert.Contains(t, result.QRCodeSVG, "<svg")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, frontendURL)
+			result, err := service.CreateInvite(context.Background(), tt.params)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, result)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RenderCard(t *testing.T) {
+	owner := createTestUser()
+	group := createTestGroup(owner.ID)
+	group.Name = "Friday <Ajo>"
+	avatar := "https://cdn.circa.test/a.png"
+	group.AvatarUrl = &avatar
+	inviteID := uuid.New()
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+	mockStore.On("GetActiveInvite", mock.Anything, sqlc.GetActiveInviteParams{ID: inviteID, GroupID: group.ID}).
+		Return(sqlc.Invite{ID: inviteID, GroupID: group.ID, CodeHash: "hash"}, nil)
+
+	service := NewService(mockStore, frontendURL)
+	card, err := service.RenderCard(context.Background(), group.ID, inviteID, owner)
+	require.NoError(t, err)
+
+	html := string(card)
+	assert.Contains(t, html, "Friday &lt;Ajo&gt;")
+	assert.Contains(t, html, avatar)
+	assert.Contains(t, html, frontendURL+"/invite")
+	assert.Contains(t, html, "<svg", "the card carries the join page QR code")
+	assert.Contains(t, html, "@media print")
+
+	mockStore = dbmocks.NewMockStore(t)
+	mockStore.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+	mockStore.On("GetActiveInvite", mock.Anything, mock.Anything).Return(sqlc.Invite{}, pgx.ErrNoRows)
+
+	service = NewService(mockStore, frontendURL)
+	_, err = service.RenderCard(context.Background(), group.ID, inviteID, owner)
+	assert.ErrorIs(t, err, circaerrors.ErrInviteNotFound, "a revoked invite has no card")
+}
+
+func TestService_GetQRCode(t *testing.T) {
+	owner := createTestUser()
+	group := createTestGroup(owner.ID)
+	inviteID := uuid.New()
+
+	tests := []struct {
+		name          string
+		user          sqlc.User
+		format        QRFormat
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		validate      func(*testing.T, *QRCode)
+	}{
+		{
+			name:   "error - not owner",
+			user:   createTestUser(),
+			format: QRFormatPNG,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			},
+			expectedError: circaerrors.ErrNotGroupOwner,
+		},
+		{
+			name:   "error - revoked invite",
+			user:   owner,
+			format: QRFormatPNG,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveInvite", mock.Anything, mock.Anything).Return(sqlc.Invite{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrInviteNotFound,
+		},
+		{
+			name:   "success - png",
+			user:   owner,
+			format: QRFormatPNG,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveInvite", mock.Anything, sqlc.GetActiveInviteParams{ID: inviteID, GroupID: group.ID}).
+					Return(sqlc.Invite{ID: inviteID, GroupID: group.ID}, nil)
+			},
+			validate: func(t *testing.T, code *QRCode) {
+				assert.Equal(t, "image/png", code.ContentType)
+				assert.True(t, bytes.HasPrefix(code.Data, []byte("\x89PNG")))
+			},
+		},
+		{
+			name:   "success - svg of the join page without a code",
+			user:   owner,
+			format: QRFormatSVG,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveInvite", mock.Anything, mock.Anything).Return(sqlc.Invite{ID: inviteID, GroupID: group.ID}, nil)
+			},
+			validate: func(t *testing.T, code *QRCode) {
+				assert.Equal(t, "image/svg+xml", code.ContentType)
+				expected, err := qr.SVG(frontendURL + "/invite")
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(code.Data))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, frontendURL)
+			code, err := service.GetQRCode(context.Background(), group.ID, inviteID, tt.user, tt.format)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, code)
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, code)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeepLink(t *testing.T) {
+	service := NewService(nil, frontendURL)
+	assert.Equal(t, frontendURL+"/invite?code=ABC234", service.deepLink("ABC234"))
+}
+
+func createTestUser() sqlc.User {
+	return sqlc.User{
+		ID:        uuid.New(),
+		Address:   "0x1111111111111111111111111111111111111111",
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func createTestGroup(ownerID uuid.UUID) sqlc.Group {
+	return sqlc.Group{
+		ID:        uuid.New(),
+		Name:      "Friday Ajo",
+		OwnerID:   ownerID,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
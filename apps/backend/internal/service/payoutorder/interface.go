@@ -0,0 +1,109 @@
+package payoutorder
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Strategies decide how a payout order is reached:
+//   - fixed: the group owner sets the order.
+//   - consensus: participants propose orders and every participant has to
+//     approve the same revision.
+//   - random: the participants are shuffled with the hash of a block that
+//     was in the future when the order was created.
+//   - auction: participants submit sealed bids of the discount they give
+//     up to be paid out early; the highest discounts go first.
+const (
+	StrategyFixed     = "fixed"
+	StrategyConsensus = "consensus"
+	StrategyRandom    = "random"
+	StrategyAuction   = "auction"
+)
+
+const (
+	StatusOpen      = "open"
+	StatusFinalized = "finalized"
+)
+
+// SeedBlockDelay is how far past the chain head the random strategy's seed
+// block is chosen.
+const SeedBlockDelay = 20
+
+type CreateParams struct {
+	GroupID  uuid.UUID
+	Actor    sqlc.User
+	Strategy string
+	// Participants defaults to the group's accepted members.
+	Participants []string
+	// Order is required for the fixed strategy and is the first proposal
+	// for the consensus strategy.
+	Order []string
+	// ChainID is the chain the random strategy's seed block is taken from.
+	ChainID *int64
+	// BiddingEndsAt and RevealEndsAt bound the auction's commit and reveal
+	// phases.
+	BiddingEndsAt *time.Time
+	RevealEndsAt  *time.Time
+}
+
+type ProposeParams struct {
+	GroupID uuid.UUID
+	OrderID uuid.UUID
+	Actor   sqlc.User
+	Order   []string
+}
+
+type ApproveParams struct {
+	GroupID  uuid.UUID
+	OrderID  uuid.UUID
+	Actor    sqlc.User
+	Revision int32
+}
+
+type CommitBidParams struct {
+	GroupID    uuid.UUID
+	OrderID    uuid.UUID
+	Actor      sqlc.User
+	Commitment string
+}
+
+type RevealBidParams struct {
+	GroupID  uuid.UUID
+	OrderID  uuid.UUID
+	Actor    sqlc.User
+	Discount string
+	Salt     string
+}
+
+// Details is a payout order with its consensus approvals and auction bids.
+type Details struct {
+	Order     sqlc.PayoutOrder
+	Approvals []sqlc.PayoutOrderApproval
+	Bids      []sqlc.PayoutOrderBid
+}
+
+// Export is a finalized payout order in the shape a Round contract is
+// deployed with: members in payout order, checksummed, and the discount
+// each position gives up.
+type Export struct {
+	OrderID         uuid.UUID
+	Strategy        string
+	Members         []string
+	Discounts       []string
+	SeedBlockNumber *int64
+	SeedBlockHash   *string
+}
+
+type PayoutOrderService interface {
+	CreatePayoutOrder(ctx context.Context, params CreateParams) (*Details, error)
+	GetPayoutOrder(ctx context.Context, groupID, orderID uuid.UUID, user sqlc.User) (*Details, error)
+	ProposeOrder(ctx context.Context, params ProposeParams) (*Details, error)
+	ApproveOrder(ctx context.Context, params ApproveParams) (*Details, error)
+	CommitBid(ctx context.Context, params CommitBidParams) (*Details, error)
+	RevealBid(ctx context.Context, params RevealBidParams) (*Details, error)
+	FinalizePayoutOrder(ctx context.Context, groupID, orderID uuid.UUID, user sqlc.User) (*Details, error)
+	ExportPayoutOrder(ctx context.Context, groupID, orderID uuid.UUID, user sqlc.User) (*Export, error)
+}
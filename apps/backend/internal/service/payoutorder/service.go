@@ -0,0 +1,636 @@
+package payoutorder
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/service/group"
+	"context"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// maxDiscount bounds revealed discounts to what fits a uint256.
+var maxDiscount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+type Service struct {
+	store  db.Store
+	chains map[int64]indexer.Chain
+}
+
+// NewService creates a payout order service. The random strategy can only
+// seed from the given chains, and waits for their confirmation depth.
+func NewService(store db.Store, chains map[int64]indexer.Chain) *Service {
+	return &Service{
+		store:  store,
+		chains: chains,
+	}
+}
+
+// CreatePayoutOrder starts deciding the payout order of a group's next
+// round. Only the group owner can create one.
+func (s *Service) CreatePayoutOrder(ctx context.Context, params CreateParams) (*Details, error) {
+	g, err := s.getWritableGroupMember(ctx, params.GroupID, params.Actor)
+	if err != nil {
+		return nil, err
+	}
+	if g.OwnerID != params.Actor.ID {
+		return nil, errors.ErrNotGroupOwner
+	}
+
+	accepted, err := s.store.ListAcceptedGroupMemberAddresses(ctx, g.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list accepted group members")
+		return nil, err
+	}
+	participants := Canonical(accepted)
+	if len(params.Participants) > 0 {
+		participants = Canonical(params.Participants)
+		for _, p := range participants {
+			if !slices.Contains(accepted, p) {
+				return nil, errors.ErrInvalidParticipants
+			}
+		}
+	}
+	if len(participants) < 2 {
+		return nil, errors.ErrInvalidParticipants
+	}
+
+	create := sqlc.CreatePayoutOrderParams{
+		GroupID:       g.ID,
+		Strategy:      params.Strategy,
+		Participants:  participants,
+		ProposedOrder: []string{},
+		CreatedBy:     params.Actor.ID,
+	}
+
+	switch params.Strategy {
+	case StrategyFixed:
+		if !ValidOrder(participants, params.Order) {
+			return nil, errors.ErrInvalidPayoutOrder
+		}
+		create.ProposedOrder = lower(params.Order)
+	case StrategyConsensus:
+		create.ProposedOrder = participants
+		if params.Order != nil {
+			if !ValidOrder(participants, params.Order) {
+				return nil, errors.ErrInvalidPayoutOrder
+			}
+			create.ProposedOrder = lower(params.Order)
+		}
+	case StrategyRandom:
+		if params.ChainID == nil {
+			return nil, errors.ErrUnsupportedChain
+		}
+		chain, ok := s.chains[*params.ChainID]
+		if !ok {
+			return nil, errors.ErrUnsupportedChain
+		}
+		head, err := chain.Client.BlockNumber(ctx)
+		if err != nil {
+			log.Error().Err(err).Int64("chain_id", *params.ChainID).Msg("Failed to get chain head")
+			return nil, err
+		}
+		create.ChainID = pgtype.Int8{Int64: *params.ChainID, Valid: true}
+		create.SeedBlockNumber = pgtype.Int8{Int64: int64(head) + SeedBlockDelay, Valid: true}
+	case StrategyAuction:
+		if params.BiddingEndsAt == nil || params.RevealEndsAt == nil ||
+			!params.BiddingEndsAt.After(time.Now()) || !params.RevealEndsAt.After(*params.BiddingEndsAt) {
+			return nil, errors.ErrInvalidBiddingWindow
+		}
+		create.BiddingEndsAt = pgtype.Timestamp{Time: *params.BiddingEndsAt, Valid: true}
+		create.RevealEndsAt = pgtype.Timestamp{Time: *params.RevealEndsAt, Valid: true}
+	default:
+		return nil, errors.ErrInvalidPayoutStrategy
+	}
+
+	order, err := s.store.CreatePayoutOrder(ctx, create)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create payout order")
+		return nil, err
+	}
+
+	log.Info().
+		Str("payout_order_id", order.ID.String()).
+		Str("group_id", g.ID.String()).
+		Str("strategy", order.Strategy).
+		Msg("Payout order created")
+
+	return &Details{Order: order, Approvals: []sqlc.PayoutOrderApproval{}, Bids: []sqlc.PayoutOrderBid{}}, nil
+}
+
+// GetPayoutOrder returns a payout order of the group. Any accepted member
+// can view it.
+func (s *Service) GetPayoutOrder(ctx context.Context, groupID, orderID uuid.UUID, user sqlc.User) (*Details, error) {
+	if _, err := s.getGroupMember(ctx, groupID, user); err != nil {
+		return nil, err
+	}
+
+	order, err := s.getOrder(ctx, groupID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return s.details(ctx, order)
+}
+
+// ProposeOrder replaces the order under discussion. The owner sets the
+// order of a fixed payout order; any participant can propose one under
+// consensus, which starts a new revision that everyone has to approve.
+func (s *Service) ProposeOrder(ctx context.Context, params ProposeParams) (*Details, error) {
+	g, err := s.getWritableGroupMember(ctx, params.GroupID, params.Actor)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.getOpenOrder(ctx, params.GroupID, params.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch order.Strategy {
+	case StrategyFixed:
+		if g.OwnerID != params.Actor.ID {
+			return nil, errors.ErrNotGroupOwner
+		}
+	case StrategyConsensus:
+		if !isParticipant(order, params.Actor) {
+			return nil, errors.ErrNotPayoutParticipant
+		}
+	default:
+		return nil, errors.ErrPayoutActionNotAllowed
+	}
+
+	if !ValidOrder(order.Participants, params.Order) {
+		return nil, errors.ErrInvalidPayoutOrder
+	}
+
+	updated, err := s.store.UpdatePayoutOrderProposal(ctx, sqlc.UpdatePayoutOrderProposalParams{
+		ID:            order.ID,
+		ProposedOrder: lower(params.Order),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrPayoutOrderFinalized
+		}
+		log.Error().Err(err).Msg("Failed to update payout order proposal")
+		return nil, err
+	}
+	return s.details(ctx, updated)
+}
+
+// ApproveOrder records a participant's approval of a consensus proposal.
+// The order is finalized as soon as every participant has approved the
+// current revision.
+func (s *Service) ApproveOrder(ctx context.Context, params ApproveParams) (*Details, error) {
+	if _, err := s.getWritableGroupMember(ctx, params.GroupID, params.Actor); err != nil {
+		return nil, err
+	}
+	order, err := s.getOpenOrder(ctx, params.GroupID, params.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Strategy != StrategyConsensus {
+		return nil, errors.ErrPayoutActionNotAllowed
+	}
+	if !isParticipant(order, params.Actor) {
+		return nil, errors.ErrNotPayoutParticipant
+	}
+	if params.Revision != order.Revision {
+		return nil, errors.ErrStaleRevision
+	}
+
+	if err := s.store.UpsertPayoutOrderApproval(ctx, sqlc.UpsertPayoutOrderApprovalParams{
+		PayoutOrderID: order.ID,
+		Address:       strings.ToLower(params.Actor.Address),
+		Revision:      order.Revision,
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to approve payout order")
+		return nil, err
+	}
+
+	approvals, err := s.store.ListPayoutOrderApprovals(ctx, order.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list payout order approvals")
+		return nil, err
+	}
+	if !HasConsensus(order.Participants, approvalRevisions(approvals), order.Revision) {
+		return s.details(ctx, order)
+	}
+
+	finalized, err := s.finalize(ctx, order, sqlc.FinalizePayoutOrderParams{
+		ID:         order.ID,
+		FinalOrder: order.ProposedOrder,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.details(ctx, finalized)
+}
+
+// CommitBid records a participant's sealed bid while bidding is open. A new
+// commitment replaces the previous one.
+func (s *Service) CommitBid(ctx context.Context, params CommitBidParams) (*Details, error) {
+	order, err := s.getAuction(ctx, params.GroupID, params.OrderID, params.Actor)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(order.BiddingEndsAt.Time) {
+		return nil, errors.ErrPayoutActionNotAllowed
+	}
+
+	commitment, err := hexutil.Decode(params.Commitment)
+	if err != nil || len(commitment) != common.HashLength {
+		return nil, errors.ErrInvalidBid
+	}
+
+	if err := s.store.UpsertPayoutOrderBid(ctx, sqlc.UpsertPayoutOrderBidParams{
+		PayoutOrderID: order.ID,
+		Address:       strings.ToLower(params.Actor.Address),
+		Commitment:    hexutil.Encode(commitment),
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to commit payout order bid")
+		return nil, err
+	}
+	return s.details(ctx, order)
+}
+
+// RevealBid opens a participant's sealed bid between the end of bidding and
+// the reveal deadline. The discount and salt must match the commitment.
+// Bids that are never revealed do not count.
+func (s *Service) RevealBid(ctx context.Context, params RevealBidParams) (*Details, error) {
+	order, err := s.getAuction(ctx, params.GroupID, params.OrderID, params.Actor)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if now.Before(order.BiddingEndsAt.Time) || !now.Before(order.RevealEndsAt.Time) {
+		return nil, errors.ErrPayoutActionNotAllowed
+	}
+
+	address := strings.ToLower(params.Actor.Address)
+	bid, err := s.store.GetPayoutOrderBid(ctx, sqlc.GetPayoutOrderBidParams{
+		PayoutOrderID: order.ID,
+		Address:       address,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrInvalidBid
+		}
+		log.Error().Err(err).Msg("Failed to get payout order bid")
+		return nil, err
+	}
+
+	discount, ok := new(big.Int).SetString(params.Discount, 10)
+	if !ok || discount.Sign() < 0 || discount.Cmp(maxDiscount) > 0 {
+		return nil, errors.ErrInvalidBid
+	}
+	salt, err := hexutil.Decode(params.Salt)
+	if err != nil || len(salt) != common.HashLength {
+		return nil, errors.ErrInvalidBid
+	}
+	expected := BidCommitment(common.HexToAddress(address), discount, common.BytesToHash(salt))
+	if !strings.EqualFold(expected.Hex(), bid.Commitment) {
+		return nil, errors.ErrInvalidBid
+	}
+
+	revealed := discount.String()
+	if _, err := s.store.RevealPayoutOrderBid(ctx, sqlc.RevealPayoutOrderBidParams{
+		PayoutOrderID: order.ID,
+		Address:       address,
+		Discount:      &revealed,
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to reveal payout order bid")
+		return nil, err
+	}
+	return s.details(ctx, order)
+}
+
+// FinalizePayoutOrder fixes the payout order with its strategy. Only the
+// group owner can finalize. Consensus needs every participant's approval,
+// the random strategy needs its seed block to be confirmed and an auction
+// needs its reveal deadline to have passed.
+func (s *Service) FinalizePayoutOrder(ctx context.Context, groupID, orderID uuid.UUID, user sqlc.User) (*Details, error) {
+	g, err := s.getWritableGroupMember(ctx, groupID, user)
+	if err != nil {
+		return nil, err
+	}
+	if g.OwnerID != user.ID {
+		return nil, errors.ErrNotGroupOwner
+	}
+	order, err := s.getOpenOrder(ctx, groupID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := s.finalParams(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	finalized, err := s.finalize(ctx, order, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.details(ctx, finalized)
+}
+
+// finalParams decides the final order with the order's strategy.
+func (s *Service) finalParams(ctx context.Context, order sqlc.PayoutOrder) (sqlc.FinalizePayoutOrderParams, error) {
+	params := sqlc.FinalizePayoutOrderParams{ID: order.ID}
+	switch order.Strategy {
+	case StrategyFixed:
+		params.FinalOrder = order.ProposedOrder
+	case StrategyConsensus:
+		approvals, err := s.store.ListPayoutOrderApprovals(ctx, order.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list payout order approvals")
+			return sqlc.FinalizePayoutOrderParams{}, err
+		}
+		if !HasConsensus(order.Participants, approvalRevisions(approvals), order.Revision) {
+			return sqlc.FinalizePayoutOrderParams{}, errors.ErrPayoutOrderNotReady
+		}
+		params.FinalOrder = order.ProposedOrder
+	case StrategyRandom:
+		seed, err := s.seedHash(ctx, order)
+		if err != nil {
+			return sqlc.FinalizePayoutOrderParams{}, err
+		}
+		hash := seed.Hex()
+		params.FinalOrder = RandomOrder(order.Participants, seed)
+		params.SeedBlockHash = &hash
+	case StrategyAuction:
+		if time.Now().Before(order.RevealEndsAt.Time) {
+			return sqlc.FinalizePayoutOrderParams{}, errors.ErrPayoutOrderNotReady
+		}
+		bids, err := s.store.ListPayoutOrderBids(ctx, order.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list payout order bids")
+			return sqlc.FinalizePayoutOrderParams{}, err
+		}
+		revealed := make([]Bid, 0, len(bids))
+		for _, b := range bids {
+			if b.Discount == nil {
+				continue
+			}
+			discount, ok := new(big.Int).SetString(*b.Discount, 10)
+			if !ok {
+				continue
+			}
+			revealed = append(revealed, Bid{Address: b.Address, Discount: discount})
+		}
+		finalOrder, discounts := AuctionOrder(order.Participants, revealed)
+		params.FinalOrder = finalOrder
+		params.FinalDiscounts = make([]string, 0, len(discounts))
+		for _, d := range discounts {
+			params.FinalDiscounts = append(params.FinalDiscounts, d.String())
+		}
+	default:
+		return sqlc.FinalizePayoutOrderParams{}, errors.ErrInvalidPayoutStrategy
+	}
+	return params, nil
+}
+
+// ExportPayoutOrder returns a finalized payout order as Round contract
+// deployment parameters.
+func (s *Service) ExportPayoutOrder(ctx context.Context, groupID, orderID uuid.UUID, user sqlc.User) (*Export, error) {
+	if _, err := s.getGroupMember(ctx, groupID, user); err != nil {
+		return nil, err
+	}
+	order, err := s.getOrder(ctx, groupID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != StatusFinalized {
+		return nil, errors.ErrPayoutOrderNotReady
+	}
+
+	export := &Export{
+		OrderID:       order.ID,
+		Strategy:      order.Strategy,
+		Members:       make([]string, 0, len(order.FinalOrder)),
+		Discounts:     make([]string, 0, len(order.FinalOrder)),
+		SeedBlockHash: order.SeedBlockHash,
+	}
+	for i, address := range order.FinalOrder {
+		export.Members = append(export.Members, common.HexToAddress(address).Hex())
+		discount := "0"
+		if i < len(order.FinalDiscounts) {
+			discount = order.FinalDiscounts[i]
+		}
+		export.Discounts = append(export.Discounts, discount)
+	}
+	if order.SeedBlockNumber.Valid {
+		export.SeedBlockNumber = &order.SeedBlockNumber.Int64
+	}
+	return export, nil
+}
+
+// seedHash returns the hash of the random strategy's seed block once it is
+// deep enough not to be reorged away.
+func (s *Service) seedHash(ctx context.Context, order sqlc.PayoutOrder) (common.Hash, error) {
+	chain, ok := s.chains[order.ChainID.Int64]
+	if !ok || !order.SeedBlockNumber.Valid {
+		return common.Hash{}, errors.ErrUnsupportedChain
+	}
+
+	head, err := chain.Client.BlockNumber(ctx)
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", order.ChainID.Int64).Msg("Failed to get chain head")
+		return common.Hash{}, err
+	}
+	if head+1 < uint64(order.SeedBlockNumber.Int64)+max(chain.Confirmations, 1) {
+		return common.Hash{}, errors.ErrPayoutOrderNotReady
+	}
+
+	header, err := chain.Client.HeaderByNumber(ctx, big.NewInt(order.SeedBlockNumber.Int64))
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", order.ChainID.Int64).Msg("Failed to get seed block")
+		return common.Hash{}, err
+	}
+	return header.Hash(), nil
+}
+
+// finalize stores the final order and, in the same transaction, the group
+// allowlist version in that order.
+func (s *Service) finalize(ctx context.Context, order sqlc.PayoutOrder, params sqlc.FinalizePayoutOrderParams) (sqlc.PayoutOrder, error) {
+	pgxStore, ok := s.store.(*db.PGXStore)
+	if !ok {
+		return sqlc.PayoutOrder{}, errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to begin transaction")
+		return sqlc.PayoutOrder{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	finalized, err := finalizeOrder(ctx, pgxStore.Queries.WithTx(tx), order, params)
+	if err != nil {
+		return sqlc.PayoutOrder{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to commit transaction")
+		return sqlc.PayoutOrder{}, err
+	}
+
+	log.Info().
+		Str("payout_order_id", order.ID.String()).
+		Str("strategy", order.Strategy).
+		Msg("Payout order finalized")
+
+	return finalized, nil
+}
+
+func finalizeOrder(ctx context.Context, q sqlc.Querier, order sqlc.PayoutOrder, params sqlc.FinalizePayoutOrderParams) (sqlc.PayoutOrder, error) {
+	finalized, err := q.FinalizePayoutOrder(ctx, params)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return sqlc.PayoutOrder{}, errors.ErrPayoutOrderFinalized
+		}
+		log.Error().Err(err).Msg("Failed to finalize payout order")
+		return sqlc.PayoutOrder{}, err
+	}
+
+	if _, err := group.SyncAllowlist(ctx, q, order.GroupID); err != nil {
+		return sqlc.PayoutOrder{}, err
+	}
+	return finalized, nil
+}
+
+// details loads what the strategy of a payout order needs beyond the order
+// itself.
+func (s *Service) details(ctx context.Context, order sqlc.PayoutOrder) (*Details, error) {
+	d := &Details{Order: order, Approvals: []sqlc.PayoutOrderApproval{}, Bids: []sqlc.PayoutOrderBid{}}
+
+	var err error
+	switch order.Strategy {
+	case StrategyConsensus:
+		d.Approvals, err = s.store.ListPayoutOrderApprovals(ctx, order.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list payout order approvals")
+			return nil, err
+		}
+	case StrategyAuction:
+		d.Bids, err = s.store.ListPayoutOrderBids(ctx, order.ID)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to list payout order bids")
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// getGroupMember loads a group and checks that the user is an accepted
+// member of it.
+func (s *Service) getGroupMember(ctx context.Context, groupID uuid.UUID, user sqlc.User) (sqlc.Group, error) {
+	g, err := s.store.GetGroupByID(ctx, groupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return sqlc.Group{}, errors.ErrGroupNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group")
+		return sqlc.Group{}, err
+	}
+
+	member, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: g.ID,
+		Address: strings.ToLower(user.Address),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return sqlc.Group{}, errors.ErrNotGroupMember
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return sqlc.Group{}, err
+	}
+	if member.Status != group.StatusAccepted {
+		return sqlc.Group{}, errors.ErrNotGroupMember
+	}
+	return g, nil
+}
+
+// getWritableGroupMember is getGroupMember for operations that change a
+// payout order, which archived groups reject.
+func (s *Service) getWritableGroupMember(ctx context.Context, groupID uuid.UUID, user sqlc.User) (sqlc.Group, error) {
+	g, err := s.getGroupMember(ctx, groupID, user)
+	if err != nil {
+		return sqlc.Group{}, err
+	}
+	if g.ArchivedAt.Valid {
+		return sqlc.Group{}, errors.ErrGroupArchived
+	}
+	return g, nil
+}
+
+func (s *Service) getOrder(ctx context.Context, groupID, orderID uuid.UUID) (sqlc.PayoutOrder, error) {
+	order, err := s.store.GetPayoutOrder(ctx, sqlc.GetPayoutOrderParams{ID: orderID, GroupID: groupID})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return sqlc.PayoutOrder{}, errors.ErrPayoutOrderNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get payout order")
+		return sqlc.PayoutOrder{}, err
+	}
+	return order, nil
+}
+
+func (s *Service) getOpenOrder(ctx context.Context, groupID, orderID uuid.UUID) (sqlc.PayoutOrder, error) {
+	order, err := s.getOrder(ctx, groupID, orderID)
+	if err != nil {
+		return sqlc.PayoutOrder{}, err
+	}
+	if order.Status != StatusOpen {
+		return sqlc.PayoutOrder{}, errors.ErrPayoutOrderFinalized
+	}
+	return order, nil
+}
+
+// getAuction loads an open auction the user takes part in, for bidding on
+// it.
+func (s *Service) getAuction(ctx context.Context, groupID, orderID uuid.UUID, user sqlc.User) (sqlc.PayoutOrder, error) {
+	if _, err := s.getWritableGroupMember(ctx, groupID, user); err != nil {
+		return sqlc.PayoutOrder{}, err
+	}
+	order, err := s.getOpenOrder(ctx, groupID, orderID)
+	if err != nil {
+		return sqlc.PayoutOrder{}, err
+	}
+	if order.Strategy != StrategyAuction {
+		return sqlc.PayoutOrder{}, errors.ErrPayoutActionNotAllowed
+	}
+	if !isParticipant(order, user) {
+		return sqlc.PayoutOrder{}, errors.ErrNotPayoutParticipant
+	}
+	return order, nil
+}
+
+func isParticipant(order sqlc.PayoutOrder, user sqlc.User) bool {
+	return slices.Contains(order.Participants, strings.ToLower(user.Address))
+}
+
+func approvalRevisions(approvals []sqlc.PayoutOrderApproval) map[string]int32 {
+	revisions := make(map[string]int32, len(approvals))
+	for _, a := range approvals {
+		revisions[a.Address] = a.Revision
+	}
+	return revisions
+}
+
+func lower(addresses []string) []string {
+	out := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		out = append(out, strings.ToLower(a))
+	}
+	return out
+}
@@ -0,0 +1,565 @@
+package payoutorder
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/service/group"
+	"context"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID int64 = 8453
+
+// fakeChain serves block numbers and headers; log filtering is not used.
+type fakeChain struct {
+	indexer.ChainClient
+	head uint64
+}
+
+func (c *fakeChain) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.head, nil
+}
+
+func (c *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number}, nil
+}
+
+func testChains(head uint64) map[int64]indexer.Chain {
+	return map[int64]indexer.Chain{
+		testChainID: {Client: &fakeChain{head: head}, Confirmations: indexer.DefaultConfirmations},
+	}
+}
+
+func createTestGroup(owner sqlc.User) sqlc.Group {
+	return sqlc.Group{ID: uuid.New(), Name: "Friday Ajo", OwnerID: owner.ID}
+}
+
+func createTestOrder(groupID uuid.UUID, strategy string) sqlc.PayoutOrder {
+	return sqlc.PayoutOrder{
+		ID:            uuid.New(),
+		GroupID:       groupID,
+		Strategy:      strategy,
+		Status:        StatusOpen,
+		Participants:  []string{alice, bob, carol},
+		ProposedOrder: []string{alice, bob, carol},
+		Revision:      1,
+	}
+}
+
+func expectMember(ms *dbmocks.MockStore, g sqlc.Group) {
+	ms.On("GetGroupByID", mock.Anything, g.ID).Return(g, nil)
+	ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{
+		GroupID: g.ID,
+		Status:  group.StatusAccepted,
+	}, nil)
+}
+
+func TestService_CreatePayoutOrder(t *testing.T) {
+	owner := sqlc.User{ID: uuid.New(), Address: alice}
+	member := sqlc.User{ID: uuid.New(), Address: bob}
+	g := createTestGroup(owner)
+	future := time.Now().Add(time.Hour)
+	later := future.Add(time.Hour)
+	chainID := testChainID
+	otherChain := int64(1)
+
+	tests := []struct {
+		name      string
+		params    CreateParams
+		setup     func(ms *dbmocks.MockStore)
+		wantErr   error
+		checkArgs func(t *testing.T, p sqlc.CreatePayoutOrderParams)
+	}{
+		{
+			name:   "fixed order",
+			params: CreateParams{Actor: owner, Strategy: StrategyFixed, Order: []string{carol, alice, bob}},
+			checkArgs: func(t *testing.T, p sqlc.CreatePayoutOrderParams) {
+				assert.Equal(t, []string{alice, bob, carol}, p.Participants)
+				assert.Equal(t, []string{carol, alice, bob}, p.ProposedOrder)
+			},
+		},
+		{
+			name:   "consensus defaults to canonical order",
+			params: CreateParams{Actor: owner, Strategy: StrategyConsensus},
+			checkArgs: func(t *testing.T, p sqlc.CreatePayoutOrderParams) {
+				assert.Equal(t, []string{alice, bob, carol}, p.ProposedOrder)
+			},
+		},
+		{
+			name:   "random seeds from a future block",
+			params: CreateParams{Actor: owner, Strategy: StrategyRandom, ChainID: &chainID},
+			checkArgs: func(t *testing.T, p sqlc.CreatePayoutOrderParams) {
+				assert.Equal(t, pgtype.Int8{Int64: testChainID, Valid: true}, p.ChainID)
+				assert.Equal(t, pgtype.Int8{Int64: 100 + SeedBlockDelay, Valid: true}, p.SeedBlockNumber)
+			},
+		},
+		{
+			name:   "auction with bidding window",
+			params: CreateParams{Actor: owner, Strategy: StrategyAuction, BiddingEndsAt: &future, RevealEndsAt: &later},
+			checkArgs: func(t *testing.T, p sqlc.CreatePayoutOrderParams) {
+				assert.True(t, p.BiddingEndsAt.Valid)
+				assert.True(t, p.RevealEndsAt.Valid)
+			},
+		},
+		{
+			name:    "not owner",
+			params:  CreateParams{Actor: member, Strategy: StrategyConsensus},
+			wantErr: circaerrors.ErrNotGroupOwner,
+		},
+		{
+			name:    "unknown strategy",
+			params:  CreateParams{Actor: owner, Strategy: "lottery"},
+			wantErr: circaerrors.ErrInvalidPayoutStrategy,
+		},
+		{
+			name:    "fixed order missing a participant",
+			params:  CreateParams{Actor: owner, Strategy: StrategyFixed, Order: []string{alice, bob}},
+			wantErr: circaerrors.ErrInvalidPayoutOrder,
+		},
+		{
+			name:    "participant outside the group",
+			params:  CreateParams{Actor: owner, Strategy: StrategyConsensus, Participants: []string{alice, dave}},
+			wantErr: circaerrors.ErrInvalidParticipants,
+		},
+		{
+			name:    "single participant",
+			params:  CreateParams{Actor: owner, Strategy: StrategyConsensus, Participants: []string{alice}},
+			wantErr: circaerrors.ErrInvalidParticipants,
+		},
+		{
+			name:    "random on unconfigured chain",
+			params:  CreateParams{Actor: owner, Strategy: StrategyRandom, ChainID: &otherChain},
+			wantErr: circaerrors.ErrUnsupportedChain,
+		},
+		{
+			name:    "auction reveal before bidding ends",
+			params:  CreateParams{Actor: owner, Strategy: StrategyAuction, BiddingEndsAt: &later, RevealEndsAt: &future},
+			wantErr: circaerrors.ErrInvalidBiddingWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			expectMember(mockStore, g)
+			mockStore.On("ListAcceptedGroupMemberAddresses", mock.Anything, g.ID).Return([]string{alice, bob, carol}, nil).Maybe()
+
+			var captured sqlc.CreatePayoutOrderParams
+			mockStore.On("CreatePayoutOrder", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				captured = args.Get(1).(sqlc.CreatePayoutOrderParams)
+			}).Return(sqlc.PayoutOrder{ID: uuid.New(), GroupID: g.ID, Strategy: tt.params.Strategy}, nil).Maybe()
+
+			tt.params.GroupID = g.ID
+			svc := NewService(mockStore, testChains(100))
+			details, err := svc.CreatePayoutOrder(context.Background(), tt.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockStore.AssertNotCalled(t, "CreatePayoutOrder", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, details)
+			assert.Equal(t, owner.ID, captured.CreatedBy)
+			tt.checkArgs(t, captured)
+		})
+	}
+}
+
+func TestService_ApproveOrder(t *testing.T) {
+	owner := sqlc.User{ID: uuid.New(), Address: alice}
+	g := createTestGroup(owner)
+
+	t.Run("stale revision", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyConsensus)
+		order.Revision = 2
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, sqlc.GetPayoutOrderParams{ID: order.ID, GroupID: g.ID}).Return(order, nil)
+
+		_, err := NewService(mockStore, nil).ApproveOrder(context.Background(), ApproveParams{
+			GroupID: g.ID, OrderID: order.ID, Actor: owner, Revision: 1,
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrStaleRevision)
+	})
+
+	t.Run("not a participant", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyConsensus)
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+
+		_, err := NewService(mockStore, nil).ApproveOrder(context.Background(), ApproveParams{
+			GroupID: g.ID, OrderID: order.ID, Actor: sqlc.User{ID: uuid.New(), Address: dave}, Revision: 1,
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrNotPayoutParticipant)
+	})
+
+	t.Run("pending approvals", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyConsensus)
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+		mockStore.On("UpsertPayoutOrderApproval", mock.Anything, sqlc.UpsertPayoutOrderApprovalParams{
+			PayoutOrderID: order.ID, Address: alice, Revision: 1,
+		}).Return(nil)
+		mockStore.On("ListPayoutOrderApprovals", mock.Anything, order.ID).Return([]sqlc.PayoutOrderApproval{
+			{Address: alice, Revision: 1},
+			{Address: bob, Revision: 1},
+		}, nil)
+
+		details, err := NewService(mockStore, nil).ApproveOrder(context.Background(), ApproveParams{
+			GroupID: g.ID, OrderID: order.ID, Actor: owner, Revision: 1,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, StatusOpen, details.Order.Status)
+		assert.Len(t, details.Approvals, 2)
+		mockStore.AssertNotCalled(t, "FinalizePayoutOrder", mock.Anything, mock.Anything)
+	})
+
+	t.Run("last approval finalizes", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyConsensus)
+		order.ProposedOrder = []string{carol, alice, bob}
+		approvals := []sqlc.PayoutOrderApproval{
+			{Address: alice, Revision: 1},
+			{Address: bob, Revision: 1},
+			{Address: carol, Revision: 1},
+		}
+		finalized := order
+		finalized.Status = StatusFinalized
+		finalized.FinalOrder = order.ProposedOrder
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+		mockStore.On("UpsertPayoutOrderApproval", mock.Anything, mock.Anything).Return(nil)
+		mockStore.On("ListPayoutOrderApprovals", mock.Anything, order.ID).Return(approvals, nil)
+
+		// Finalizing needs a transaction, which the mock store cannot begin.
+		_, err := NewService(mockStore, nil).ApproveOrder(context.Background(), ApproveParams{
+			GroupID: g.ID, OrderID: order.ID, Actor: sqlc.User{ID: uuid.New(), Address: carol}, Revision: 1,
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidStore)
+	})
+}
+
+func TestService_RevealBid(t *testing.T) {
+	owner := sqlc.User{ID: uuid.New(), Address: alice}
+	bidder := sqlc.User{ID: uuid.New(), Address: bob}
+	g := createTestGroup(owner)
+	salt := common.HexToHash("0x01")
+	commitment := BidCommitment(common.HexToAddress(bob), big.NewInt(50), salt)
+
+	order := createTestOrder(g.ID, StrategyAuction)
+	order.BiddingEndsAt = pgtype.Timestamp{Time: time.Now().Add(-time.Hour), Valid: true}
+	order.RevealEndsAt = pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true}
+
+	tests := []struct {
+		name     string
+		discount string
+		salt     string
+		wantErr  error
+	}{
+		{name: "matching reveal", discount: "50", salt: salt.Hex()},
+		{name: "wrong discount", discount: "60", salt: salt.Hex(), wantErr: circaerrors.ErrInvalidBid},
+		{name: "wrong salt", discount: "50", salt: common.HexToHash("0x02").Hex(), wantErr: circaerrors.ErrInvalidBid},
+		{name: "negative discount", discount: "-1", salt: salt.Hex(), wantErr: circaerrors.ErrInvalidBid},
+		{name: "malformed salt", discount: "50", salt: "0x01", wantErr: circaerrors.ErrInvalidBid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			expectMember(mockStore, g)
+			mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+			mockStore.On("GetPayoutOrderBid", mock.Anything, sqlc.GetPayoutOrderBidParams{
+				PayoutOrderID: order.ID, Address: bob,
+			}).Return(sqlc.PayoutOrderBid{Address: bob, Commitment: commitment.Hex()}, nil)
+
+			if tt.wantErr == nil {
+				revealed := "50"
+				mockStore.On("RevealPayoutOrderBid", mock.Anything, sqlc.RevealPayoutOrderBidParams{
+					PayoutOrderID: order.ID, Address: bob, Discount: &revealed,
+				}).Return(sqlc.PayoutOrderBid{}, nil)
+				mockStore.On("ListPayoutOrderBids", mock.Anything, order.ID).Return([]sqlc.PayoutOrderBid{}, nil)
+			}
+
+			_, err := NewService(mockStore, nil).RevealBid(context.Background(), RevealBidParams{
+				GroupID: g.ID, OrderID: order.ID, Actor: bidder, Discount: tt.discount, Salt: tt.salt,
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("bidding still open", func(t *testing.T) {
+		open := order
+		open.BiddingEndsAt = pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true}
+		open.RevealEndsAt = pgtype.Timestamp{Time: time.Now().Add(2 * time.Hour), Valid: true}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(open, nil)
+
+		_, err := NewService(mockStore, nil).RevealBid(context.Background(), RevealBidParams{
+			GroupID: g.ID, OrderID: order.ID, Actor: bidder, Discount: "50", Salt: salt.Hex(),
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrPayoutActionNotAllowed)
+	})
+}
+
+func TestService_FinalizePayoutOrder(t *testing.T) {
+	owner := sqlc.User{ID: uuid.New(), Address: alice}
+	g := createTestGroup(owner)
+
+	t.Run("not owner", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+
+		_, err := NewService(mockStore, nil).FinalizePayoutOrder(context.Background(), g.ID, uuid.New(), sqlc.User{ID: uuid.New(), Address: bob})
+		assert.ErrorIs(t, err, circaerrors.ErrNotGroupOwner)
+	})
+
+	t.Run("already finalized", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyFixed)
+		order.Status = StatusFinalized
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+
+		_, err := NewService(mockStore, nil).FinalizePayoutOrder(context.Background(), g.ID, order.ID, owner)
+		assert.ErrorIs(t, err, circaerrors.ErrPayoutOrderFinalized)
+	})
+
+	t.Run("consensus without every approval", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyConsensus)
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+		mockStore.On("ListPayoutOrderApprovals", mock.Anything, order.ID).Return([]sqlc.PayoutOrderApproval{
+			{Address: alice, Revision: 1},
+		}, nil)
+
+		_, err := NewService(mockStore, nil).FinalizePayoutOrder(context.Background(), g.ID, order.ID, owner)
+		assert.ErrorIs(t, err, circaerrors.ErrPayoutOrderNotReady)
+	})
+
+	t.Run("random seed block not confirmed", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyRandom)
+		order.ChainID = pgtype.Int8{Int64: testChainID, Valid: true}
+		order.SeedBlockNumber = pgtype.Int8{Int64: 120, Valid: true}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+
+		chains := testChains(120 + indexer.DefaultConfirmations - 2)
+		_, err := NewService(mockStore, chains).FinalizePayoutOrder(context.Background(), g.ID, order.ID, owner)
+		assert.ErrorIs(t, err, circaerrors.ErrPayoutOrderNotReady)
+	})
+
+	t.Run("random shuffles with the seed block hash", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyRandom)
+		order.ChainID = pgtype.Int8{Int64: testChainID, Valid: true}
+		order.SeedBlockNumber = pgtype.Int8{Int64: 120, Valid: true}
+
+		seed := (&types.Header{Number: big.NewInt(120)}).Hash()
+		hash := seed.Hex()
+
+		chains := testChains(120 + indexer.DefaultConfirmations - 1)
+		params, err := NewService(dbmocks.NewMockStore(t), chains).finalParams(context.Background(), order)
+		require.NoError(t, err)
+		assert.Equal(t, sqlc.FinalizePayoutOrderParams{
+			ID:            order.ID,
+			FinalOrder:    RandomOrder(order.Participants, seed),
+			SeedBlockHash: &hash,
+		}, params)
+	})
+
+	t.Run("auction before reveal deadline", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyAuction)
+		order.RevealEndsAt = pgtype.Timestamp{Time: time.Now().Add(time.Hour), Valid: true}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+
+		_, err := NewService(mockStore, nil).FinalizePayoutOrder(context.Background(), g.ID, order.ID, owner)
+		assert.ErrorIs(t, err, circaerrors.ErrPayoutOrderNotReady)
+	})
+
+	t.Run("auction ignores unrevealed bids", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyAuction)
+		order.RevealEndsAt = pgtype.Timestamp{Time: time.Now().Add(-time.Minute), Valid: true}
+		ten, twenty := "10", "20"
+
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("ListPayoutOrderBids", mock.Anything, order.ID).Return([]sqlc.PayoutOrderBid{
+			{Address: alice, Commitment: "0x01"},
+			{Address: bob, Commitment: "0x02", Discount: &ten},
+			{Address: carol, Commitment: "0x03", Discount: &twenty},
+		}, nil)
+
+		params, err := NewService(mockStore, nil).finalParams(context.Background(), order)
+		require.NoError(t, err)
+		assert.Equal(t, sqlc.FinalizePayoutOrderParams{
+			ID:             order.ID,
+			FinalOrder:     []string{carol, bob, alice},
+			FinalDiscounts: []string{"20", "10", "0"},
+		}, params)
+	})
+}
+
+func TestFinalizeOrder(t *testing.T) {
+	owner := sqlc.User{ID: uuid.New(), Address: alice}
+	g := createTestGroup(owner)
+
+	t.Run("versions the allowlist in the final order", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyFixed)
+		params := sqlc.FinalizePayoutOrderParams{ID: order.ID, FinalOrder: []string{carol, alice, bob}}
+		finalized := order
+		finalized.Status = StatusFinalized
+		finalized.FinalOrder = params.FinalOrder
+
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("FinalizePayoutOrder", mock.Anything, params).Return(finalized, nil)
+		mockStore.On("LockGroup", mock.Anything, g.ID).Return(nil)
+		mockStore.On("ListAcceptedGroupMemberAddresses", mock.Anything, g.ID).Return([]string{alice, bob, carol}, nil)
+		mockStore.On("GetLatestFinalizedPayoutOrder", mock.Anything, g.ID).Return(finalized, nil)
+		mockStore.On("GetLatestGroupAllowlist", mock.Anything, g.ID).Return(sqlc.GroupAllowlist{Version: 1}, nil)
+		mockStore.On("CreateGroupAllowlist", mock.Anything, mock.MatchedBy(func(p sqlc.CreateGroupAllowlistParams) bool {
+			return slices.Equal(p.Members, []string{carol, alice, bob}) && p.PayoutOrderID.Bytes == order.ID
+		})).Return(sqlc.GroupAllowlist{Version: 2}, nil)
+
+		result, err := finalizeOrder(context.Background(), mockStore, order, params)
+		require.NoError(t, err)
+		assert.Equal(t, StatusFinalized, result.Status)
+	})
+
+	t.Run("finalized concurrently", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyFixed)
+
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("FinalizePayoutOrder", mock.Anything, mock.Anything).Return(sqlc.PayoutOrder{}, pgx.ErrNoRows)
+
+		_, err := finalizeOrder(context.Background(), mockStore, order, sqlc.FinalizePayoutOrderParams{ID: order.ID})
+		assert.ErrorIs(t, err, circaerrors.ErrPayoutOrderFinalized)
+		mockStore.AssertNotCalled(t, "CreateGroupAllowlist", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_ArchivedGroup(t *testing.T) {
+	owner := sqlc.User{ID: uuid.New(), Address: alice}
+	g := createTestGroup(owner)
+	g.ArchivedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+	orderID := uuid.New()
+	salt := common.HexToHash("0x01").Hex()
+
+	tests := []struct {
+		name string
+		call func(*Service) error
+	}{
+		{name: "propose", call: func(s *Service) error {
+			_, err := s.ProposeOrder(context.Background(), ProposeParams{GroupID: g.ID, OrderID: orderID, Actor: owner, Order: []string{bob, alice, carol}})
+			return err
+		}},
+		{name: "approve", call: func(s *Service) error {
+			_, err := s.ApproveOrder(context.Background(), ApproveParams{GroupID: g.ID, OrderID: orderID, Actor: owner, Revision: 1})
+			return err
+		}},
+		{name: "commit bid", call: func(s *Service) error {
+			_, err := s.CommitBid(context.Background(), CommitBidParams{GroupID: g.ID, OrderID: orderID, Actor: owner, Commitment: salt})
+			return err
+		}},
+		{name: "reveal bid", call: func(s *Service) error {
+			_, err := s.RevealBid(context.Background(), RevealBidParams{GroupID: g.ID, OrderID: orderID, Actor: owner, Discount: "50", Salt: salt})
+			return err
+		}},
+		{name: "finalize", call: func(s *Service) error {
+			_, err := s.FinalizePayoutOrder(context.Background(), g.ID, orderID, owner)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			expectMember(mockStore, g)
+
+			assert.ErrorIs(t, tt.call(NewService(mockStore, nil)), circaerrors.ErrGroupArchived)
+			mockStore.AssertNotCalled(t, "GetPayoutOrder", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_ExportPayoutOrder(t *testing.T) {
+	owner := sqlc.User{ID: uuid.New(), Address: alice}
+	g := createTestGroup(owner)
+
+	t.Run("not finalized", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyFixed)
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+
+		_, err := NewService(mockStore, nil).ExportPayoutOrder(context.Background(), g.ID, order.ID, owner)
+		assert.ErrorIs(t, err, circaerrors.ErrPayoutOrderNotReady)
+	})
+
+	t.Run("checksummed members with discounts", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyAuction)
+		order.Status = StatusFinalized
+		order.FinalOrder = []string{carol, alice, bob}
+		order.FinalDiscounts = []string{"20", "0", "0"}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+
+		export, err := NewService(mockStore, nil).ExportPayoutOrder(context.Background(), g.ID, order.ID, owner)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			common.HexToAddress(carol).Hex(),
+			common.HexToAddress(alice).Hex(),
+			common.HexToAddress(bob).Hex(),
+		}, export.Members)
+		assert.Equal(t, []string{"20", "0", "0"}, export.Discounts)
+		assert.Nil(t, export.SeedBlockNumber)
+	})
+
+	t.Run("fixed order has no discounts", func(t *testing.T) {
+		order := createTestOrder(g.ID, StrategyFixed)
+		order.Status = StatusFinalized
+		order.FinalOrder = []string{bob, alice, carol}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, g)
+		mockStore.On("GetPayoutOrder", mock.Anything, mock.Anything).Return(order, nil)
+
+		export, err := NewService(mockStore, nil).ExportPayoutOrder(context.Background(), g.ID, order.ID, owner)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0", "0", "0"}, export.Discounts)
+	})
+}
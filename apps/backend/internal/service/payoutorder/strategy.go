@@ -0,0 +1,109 @@
+package payoutorder
+
+import (
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The strategies are pure functions of their inputs so that anyone can
+// recompute and check a finalized order.
+
+// Canonical returns the participants lowercased and sorted, without
+// duplicates. Every strategy starts from this order so the result depends
+// only on the set of participants.
+func Canonical(participants []string) []string {
+	out := make([]string, 0, len(participants))
+	for _, p := range participants {
+		out = append(out, strings.ToLower(p))
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// ValidOrder reports whether order lists every participant exactly once.
+// Addresses are compared case-insensitively.
+func ValidOrder(participants, order []string) bool {
+	canonical := Canonical(order)
+	return len(canonical) == len(order) && slices.Equal(Canonical(participants), canonical)
+}
+
+// HasConsensus reports whether every participant approved revision.
+func HasConsensus(participants []string, approvals map[string]int32, revision int32) bool {
+	for _, p := range participants {
+		if approvals[strings.ToLower(p)] != revision {
+			return false
+		}
+	}
+	return len(participants) > 0
+}
+
+// RandomOrder shuffles the participants with Fisher-Yates, drawing the swap
+// index for position i from keccak256(seed || uint256(i)) mod (i+1). The
+// seed is the hash of a block that was in the future when the order was
+// created, so nobody could predict the result, and the shuffle is easy to
+// reproduce in Solidity.
+func RandomOrder(participants []string, seed common.Hash) []string {
+	order := Canonical(participants)
+	for i := len(order) - 1; i > 0; i-- {
+		index := common.BigToHash(big.NewInt(int64(i)))
+		draw := new(big.Int).SetBytes(crypto.Keccak256(seed.Bytes(), index.Bytes()))
+		j := int(draw.Mod(draw, big.NewInt(int64(i+1))).Int64())
+		order[i], order[j] = order[j], order[i]
+	}
+	return order
+}
+
+// Bid is a revealed sealed bid: the discount, in token base units, the
+// bidder gives up to be paid out earlier.
+type Bid struct {
+	Address  string
+	Discount *big.Int
+}
+
+// AuctionOrder orders bidders by discount, highest first, breaking ties by
+// address. Participants without a revealed bid follow in canonical order
+// with no discount. It returns the order and each position's discount.
+func AuctionOrder(participants []string, bids []Bid) ([]string, []*big.Int) {
+	discounts := map[string]*big.Int{}
+	for _, b := range bids {
+		if b.Discount != nil {
+			discounts[strings.ToLower(b.Address)] = b.Discount
+		}
+	}
+
+	order := Canonical(participants)
+	slices.SortStableFunc(order, func(a, b string) int {
+		da, aok := discounts[a]
+		db, bok := discounts[b]
+		switch {
+		case aok && bok:
+			return db.Cmp(da)
+		case aok:
+			return -1
+		case bok:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	amounts := make([]*big.Int, len(order))
+	for i, address := range order {
+		if d, ok := discounts[address]; ok {
+			amounts[i] = d
+		} else {
+			amounts[i] = new(big.Int)
+		}
+	}
+	return order, amounts
+}
+
+// BidCommitment is keccak256(abi.encodePacked(bidder, uint256(discount),
+// salt)), the value a bidder submits before revealing.
+func BidCommitment(bidder common.Address, discount *big.Int, salt common.Hash) common.Hash {
+	return crypto.Keccak256Hash(bidder.Bytes(), common.BigToHash(discount).Bytes(), salt.Bytes())
+}
@@ -0,0 +1,91 @@
+package payoutorder
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	alice = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	bob   = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	carol = "0xcccccccccccccccccccccccccccccccccccccccc"
+	dave  = "0xdddddddddddddddddddddddddddddddddddddddd"
+)
+
+func TestCanonical(t *testing.T) {
+	assert.Equal(t, []string{alice, bob, carol}, Canonical([]string{carol, "0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", bob, alice}))
+}
+
+func TestValidOrder(t *testing.T) {
+	participants := []string{alice, bob, carol}
+
+	tests := []struct {
+		name     string
+		order    []string
+		expected bool
+	}{
+		{name: "permutation", order: []string{carol, alice, bob}, expected: true},
+		{name: "mixed case", order: []string{"0xCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC", alice, bob}, expected: true},
+		{name: "missing participant", order: []string{carol, alice}},
+		{name: "duplicate participant", order: []string{carol, alice, alice}},
+		{name: "outsider", order: []string{carol, alice, dave}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ValidOrder(participants, tt.order))
+		})
+	}
+}
+
+func TestHasConsensus(t *testing.T) {
+	participants := []string{alice, bob}
+
+	assert.True(t, HasConsensus(participants, map[string]int32{alice: 2, bob: 2}, 2))
+	assert.False(t, HasConsensus(participants, map[string]int32{alice: 2, bob: 1}, 2), "stale approval")
+	assert.False(t, HasConsensus(participants, map[string]int32{alice: 2}, 2), "missing approval")
+	assert.False(t, HasConsensus(nil, map[string]int32{}, 1), "no participants")
+}
+
+func TestRandomOrder(t *testing.T) {
+	participants := []string{dave, carol, bob, alice}
+	seed := common.HexToHash("0x6a5c3e1d0f9b8a7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c")
+
+	order := RandomOrder(participants, seed)
+
+	assert.True(t, ValidOrder(participants, order))
+	assert.Equal(t, order, RandomOrder([]string{alice, bob, carol, dave}, seed), "input order must not matter")
+	assert.Equal(t, []string{dave, alice, carol, bob}, order, "shuffle must stay reproducible")
+
+	other := RandomOrder(participants, common.HexToHash("0x01"))
+	assert.True(t, ValidOrder(participants, other))
+	assert.NotEqual(t, order, other)
+}
+
+func TestAuctionOrder(t *testing.T) {
+	participants := []string{alice, bob, carol, dave}
+
+	order, discounts := AuctionOrder(participants, []Bid{
+		{Address: "0xCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC", Discount: big.NewInt(50)},
+		{Address: dave, Discount: big.NewInt(80)},
+		{Address: alice, Discount: big.NewInt(50)},
+		{Address: bob},
+	})
+
+	assert.Equal(t, []string{dave, alice, carol, bob}, order)
+	assert.Equal(t, []*big.Int{big.NewInt(80), big.NewInt(50), big.NewInt(50), big.NewInt(0)}, discounts)
+}
+
+func TestBidCommitment(t *testing.T) {
+	bidder := common.HexToAddress(alice)
+	salt := common.HexToHash("0x02")
+
+	commitment := BidCommitment(bidder, big.NewInt(100), salt)
+
+	assert.Equal(t, commitment, BidCommitment(bidder, big.NewInt(100), salt))
+	assert.NotEqual(t, commitment, BidCommitment(bidder, big.NewInt(101), salt))
+	assert.NotEqual(t, commitment, BidCommitment(common.HexToAddress(bob), big.NewInt(100), salt))
+}
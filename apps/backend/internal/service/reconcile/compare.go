@@ -0,0 +1,93 @@
+package reconcile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	paid   = "paid"
+	unpaid = "unpaid"
+)
+
+// Compare returns where the contract state differs from the database,
+// ordered by key. Balances are only compared when both are known. It has
+// no side effects, so the rules can be tested without a chain or database.
+func Compare(onChain, offChain State) []Discrepancy {
+	var discrepancies []Discrepancy
+
+	chainPaid, dbPaid := paidSet(onChain), paidSet(offChain)
+	for c := range chainPaid {
+		if !dbPaid[c] {
+			discrepancies = append(discrepancies, paidDiscrepancy(c, paid, unpaid))
+		}
+	}
+	for c := range dbPaid {
+		if !chainPaid[c] {
+			discrepancies = append(discrepancies, paidDiscrepancy(c, unpaid, paid))
+		}
+	}
+
+	if onChain.PayoutIndex != offChain.PayoutIndex {
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind:     KindPayoutIndex,
+			Key:      KindPayoutIndex,
+			OnChain:  fmt.Sprint(onChain.PayoutIndex),
+			OffChain: fmt.Sprint(offChain.PayoutIndex),
+		})
+	}
+
+	if onChain.Balance != nil && offChain.Balance != nil && onChain.Balance.Cmp(offChain.Balance) != 0 {
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind:     KindBalance,
+			Key:      KindBalance,
+			OnChain:  onChain.Balance.String(),
+			OffChain: offChain.Balance.String(),
+		})
+	}
+
+	sort.Slice(discrepancies, func(i, j int) bool {
+		return discrepancies[i].Key < discrepancies[j].Key
+	})
+	return discrepancies
+}
+
+// paidSet returns the paid contributions of a state with lowercase
+// addresses.
+func paidSet(s State) map[Contribution]bool {
+	set := make(map[Contribution]bool, len(s.Paid))
+	for c, ok := range s.Paid {
+		if ok {
+			set[Contribution{Address: strings.ToLower(c.Address), Period: c.Period}] = true
+		}
+	}
+	return set
+}
+
+func paidDiscrepancy(c Contribution, onChain, offChain string) Discrepancy {
+	period := c.Period
+	return Discrepancy{
+		Kind:     KindPaid,
+		Key:      fmt.Sprintf("%s:%d:%s", KindPaid, c.Period, c.Address),
+		Period:   &period,
+		Address:  c.Address,
+		OnChain:  onChain,
+		OffChain: offChain,
+	}
+}
+
+// String describes the discrepancy for the people alerted about it.
+func (d Discrepancy) String() string {
+	switch d.Kind {
+	case KindPaid:
+		// Periods are 0-indexed in the API but numbered from 1 for people.
+		return fmt.Sprintf("contribution of %s for period %d is %s on chain but %s in the database",
+			d.Address, *d.Period+1, d.OnChain, d.OffChain)
+	case KindPayoutIndex:
+		return fmt.Sprintf("payout index is %s on chain but %s in the database", d.OnChain, d.OffChain)
+	case KindBalance:
+		return fmt.Sprintf("pot holds %s on chain but %s in the ledger", d.OnChain, d.OffChain)
+	}
+	return fmt.Sprintf("%s is %s on chain but %s in the database", d.Key, d.OnChain, d.OffChain)
+}
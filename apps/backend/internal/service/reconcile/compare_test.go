@@ -0,0 +1,90 @@
+package reconcile
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	alice := "0x00000000000000000000000000000000000000a1"
+	bob := "0x00000000000000000000000000000000000000b2"
+
+	tests := []struct {
+		name     string
+		onChain  State
+		offChain State
+		expected []string
+	}{
+		{
+			name: "in sync",
+			onChain: State{
+				Paid:        map[Contribution]bool{{alice, 0}: true, {bob, 0}: true},
+				PayoutIndex: 1,
+				Balance:     big.NewInt(0),
+			},
+			offChain: State{
+				Paid:        map[Contribution]bool{{"0x00000000000000000000000000000000000000A1", 0}: true, {bob, 0}: true},
+				PayoutIndex: 1,
+				Balance:     big.NewInt(0),
+			},
+		},
+		{
+			name: "missed contribution log",
+			onChain: State{
+				Paid:    map[Contribution]bool{{alice, 0}: true, {bob, 0}: true, {alice, 1}: false},
+				Balance: big.NewInt(200),
+			},
+			offChain: State{
+				Paid:    map[Contribution]bool{{alice, 0}: true},
+				Balance: big.NewInt(100),
+			},
+			expected: []string{"balance", "paid:0:" + bob},
+		},
+		{
+			name:     "contribution the contract does not have",
+			onChain:  State{Paid: map[Contribution]bool{}},
+			offChain: State{Paid: map[Contribution]bool{{alice, 2}: true}},
+			expected: []string{"paid:2:" + alice},
+		},
+		{
+			name:     "payout index and unknown balance",
+			onChain:  State{PayoutIndex: 2, Balance: big.NewInt(5)},
+			offChain: State{PayoutIndex: 1},
+			expected: []string{"payout_index"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var keys []string
+			for _, d := range Compare(tt.onChain, tt.offChain) {
+				keys = append(keys, d.Key)
+			}
+			assert.Equal(t, tt.expected, keys)
+		})
+	}
+}
+
+func TestCompare_Describes(t *testing.T) {
+	bob := "0x00000000000000000000000000000000000000b2"
+	discrepancies := Compare(
+		State{Paid: map[Contribution]bool{{bob, 0}: true}, PayoutIndex: 1, Balance: big.NewInt(200)},
+		State{Paid: map[Contribution]bool{}, Balance: big.NewInt(100)},
+	)
+	require.Len(t, discrepancies, 3)
+
+	assert.Equal(t, KindBalance, discrepancies[0].Kind)
+	assert.Equal(t, "pot holds 200 on chain but 100 in the ledger", discrepancies[0].String())
+
+	assert.Equal(t, KindPaid, discrepancies[1].Kind)
+	assert.Equal(t, int64(0), *discrepancies[1].Period)
+	assert.Equal(t, bob, discrepancies[1].Address)
+	assert.Equal(t, "paid", discrepancies[1].OnChain)
+	assert.Equal(t, "unpaid", discrepancies[1].OffChain)
+	assert.Equal(t, "contribution of "+bob+" for period 1 is paid on chain but unpaid in the database", discrepancies[1].String())
+
+	assert.Equal(t, "payout index is 1 on chain but 0 in the database", discrepancies[2].String())
+}
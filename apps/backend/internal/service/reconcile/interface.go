@@ -0,0 +1,57 @@
+package reconcile
+
+import (
+	"circa/internal/indexer"
+	"context"
+	"math/big"
+)
+
+// Discrepancy kinds.
+const (
+	KindPaid        = "paid"
+	KindPayoutIndex = "payout_index"
+	KindBalance     = "balance"
+)
+
+const (
+	// ReconcileRoundsJob is the recurring queue job type that compares
+	// every open round with its contract.
+	ReconcileRoundsJob = "reconcile_rounds"
+
+	// SendAlertJob is the queue job type that emails a discrepancy alert.
+	SendAlertJob = "send_discrepancy_alert"
+)
+
+// Contribution is a member's contribution to a period.
+type Contribution struct {
+	Address string
+	Period  int64
+}
+
+// State is the part of a round's state that is reconciled, as read from its
+// contract or from the database. Paid holds the contributions known to be
+// paid; anything missing is unpaid. Balance is the token balance of the
+// pot, and nil for rounds without a known token.
+type State struct {
+	Paid        map[Contribution]bool
+	PayoutIndex int64
+	Balance     *big.Int
+}
+
+// Discrepancy is a difference between a round contract and the database.
+// Key identifies what differs, so the same difference found on later runs
+// is recognised. Period and Address are set for paid flags.
+type Discrepancy struct {
+	Kind     string
+	Key      string
+	Period   *int64
+	Address  string
+	OnChain  string
+	OffChain string
+}
+
+// Resyncer resets a contract's indexed events so they are read from the
+// chain again. The indexer repository implements it.
+type Resyncer interface {
+	Resync(ctx context.Context, contract indexer.Contract, from uint64) error
+}
@@ -0,0 +1,372 @@
+package reconcile
+
+import (
+	"circa/internal/contracts"
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/ledger"
+	"circa/internal/queue"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+const alertRetries = 3
+
+type Service struct {
+	store         db.Store
+	queueService  *queue.Service
+	emailService  email.EmailService
+	callers       map[int64]contracts.Caller
+	resyncer      Resyncer
+	operatorEmail string
+}
+
+// NewService returns a reconciliation service. Rounds on chains without a
+// caller are skipped, and operatorEmail may be empty to alert group owners
+// only.
+func NewService(store db.Store, queueService *queue.Service, emailService email.EmailService, callers map[int64]contracts.Caller, resyncer Resyncer, operatorEmail string) *Service {
+	return &Service{
+		store:         store,
+		queueService:  queueService,
+		emailService:  emailService,
+		callers:       callers,
+		resyncer:      resyncer,
+		operatorEmail: operatorEmail,
+	}
+}
+
+// HandleReconcileRoundsJob runs Reconcile over every open round for a queued
+// job.
+func (s *Service) HandleReconcileRoundsJob(ctx context.Context, job *sqlc.Job) error {
+	opened, err := s.Reconcile(ctx, nil, time.Now())
+	if err != nil {
+		return err
+	}
+
+	log.Info().Int("opened", opened).Msg("Reconciled rounds with their contracts")
+	return nil
+}
+
+// Reconcile compares rounds with their contracts: the paid flag of every
+// member for every period that can have been paid, the payout index and
+// the token balance of the pot. Contracts are read at the block the round
+// is indexed up to, so events still to be indexed are not reported.
+// Differences are recorded as open discrepancies, and the group owner and
+// the operator are emailed about new ones. Open discrepancies that are no
+// longer found are resolved. It checks roundID, whatever its status, or
+// every pending, active and paused round when roundID is nil, and returns
+// the number of new discrepancies. A failing round does not stop the
+// others.
+func (s *Service) Reconcile(ctx context.Context, roundID *uuid.UUID, now time.Time) (int, error) {
+	rounds, err := s.store.ListReconcileRounds(ctx, pgUUID(roundID))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list rounds to reconcile")
+		return 0, err
+	}
+	if roundID != nil && len(rounds) == 0 {
+		return 0, circaerrors.ErrRoundNotFound
+	}
+
+	var (
+		opened int
+		errs   []error
+	)
+	for _, r := range rounds {
+		n, err := s.reconcileRound(ctx, r, now)
+		opened += n
+		if err != nil {
+			log.Error().Err(err).Str("round_id", r.ID.String()).Msg("Failed to reconcile round")
+			errs = append(errs, fmt.Errorf("round %s: %w", r.ID, err))
+		}
+	}
+	return opened, errors.Join(errs...)
+}
+
+func (s *Service) reconcileRound(ctx context.Context, r sqlc.ListReconcileRoundsRow, now time.Time) (int, error) {
+	caller, ok := s.callers[r.ChainID]
+	if !ok || !common.IsHexAddress(r.ContractAddress) {
+		log.Warn().
+			Str("round_id", r.ID.String()).
+			Int64("chain_id", r.ChainID).
+			Msg("Skipping round that cannot be read from the chain")
+		return 0, nil
+	}
+
+	cursor, err := s.store.GetIndexerCursor(ctx, sqlc.GetIndexerCursorParams{
+		ChainID:         r.ChainID,
+		ContractAddress: strings.ToLower(r.ContractAddress),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// Not indexed yet: there is nothing to compare with.
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	offChain, err := s.readModel(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+	onChain, err := s.readContract(ctx, caller, r, big.NewInt(cursor), offChain, now)
+	if err != nil {
+		return 0, err
+	}
+
+	opened, err := s.record(ctx, r.ID, cursor, Compare(onChain, offChain))
+	if err != nil {
+		return 0, err
+	}
+	if len(opened) > 0 {
+		s.alert(ctx, r, opened)
+	}
+	return len(opened), nil
+}
+
+// readModel reads the reconciled state from the database.
+func (s *Service) readModel(ctx context.Context, r sqlc.ListReconcileRoundsRow) (State, error) {
+	state := State{Paid: map[Contribution]bool{}}
+
+	rows, err := s.store.ListRoundContributionPeriods(ctx, r.ID)
+	if err != nil {
+		return State{}, err
+	}
+	for _, row := range rows {
+		state.Paid[Contribution{Address: row.Address, Period: row.Period}] = true
+	}
+
+	events, err := s.store.ListRoundEvents(ctx, r.ID)
+	if err != nil {
+		return State{}, err
+	}
+	paidOut := map[int64]bool{}
+	for _, e := range events {
+		if e.EventType == ledger.EntryPayout {
+			paidOut[e.Period] = true
+		}
+	}
+	state.PayoutIndex = int64(len(paidOut))
+
+	if r.TokenAddress != nil {
+		journal, err := ledger.Load(ctx, s.store, r.ID)
+		if err != nil {
+			return State{}, err
+		}
+		state.Balance = journal.Balance(ledger.Pot())
+	}
+	return state, nil
+}
+
+// readContract reads the reconciled state from the contract as of block.
+// Paid flags are read for every member up to the latest period that can
+// have been paid, and for every contribution the database has.
+func (s *Service) readContract(ctx context.Context, caller contracts.Caller, r sqlc.ListReconcileRoundsRow, block *big.Int, offChain State, now time.Time) (State, error) {
+	address := common.HexToAddress(r.ContractAddress)
+	state := State{Paid: map[Contribution]bool{}}
+
+	members, err := s.store.ListRoundMembers(ctx, r.ID)
+	if err != nil {
+		return State{}, err
+	}
+
+	index, err := contracts.ReadPayoutIndex(ctx, caller, address, block)
+	if err != nil {
+		return State{}, err
+	}
+	state.PayoutIndex = index.Int64()
+
+	checks := map[Contribution]bool{}
+	last := min(max(state.PayoutIndex, currentPeriod(r, now)), int64(r.MemberCount)-1)
+	for period := int64(0); period <= last; period++ {
+		for _, m := range members {
+			checks[Contribution{Address: strings.ToLower(m.Address), Period: period}] = true
+		}
+	}
+	for c := range offChain.Paid {
+		checks[Contribution{Address: strings.ToLower(c.Address), Period: c.Period}] = true
+	}
+
+	for c := range checks {
+		if c.Period < 0 || !common.IsHexAddress(c.Address) {
+			continue
+		}
+		paid, err := contracts.ReadPaid(ctx, caller, address, uint64(c.Period), common.HexToAddress(c.Address), block)
+		if err != nil {
+			return State{}, err
+		}
+		if paid {
+			state.Paid[c] = true
+		}
+	}
+
+	if r.TokenAddress != nil {
+		balance, err := contracts.ReadBalanceAt(ctx, caller, common.HexToAddress(*r.TokenAddress), address, block)
+		if err != nil {
+			return State{}, err
+		}
+		state.Balance = balance
+	}
+	return state, nil
+}
+
+// currentPeriod returns the 0-indexed period in progress at now, or -1
+// before the round starts.
+func currentPeriod(r sqlc.ListReconcileRoundsRow, now time.Time) int64 {
+	if !r.StartedAt.Valid || r.PeriodDurationSeconds < 1 || now.Before(r.StartedAt.Time) {
+		return -1
+	}
+	return int64(now.Sub(r.StartedAt.Time) / (time.Duration(r.PeriodDurationSeconds) * time.Second))
+}
+
+// record stores the discrepancies found at block and resolves the open ones
+// that were not found again. It returns the discrepancies that were not
+// already open.
+func (s *Service) record(ctx context.Context, roundID uuid.UUID, block int64, discrepancies []Discrepancy) ([]Discrepancy, error) {
+	var opened []Discrepancy
+	// Not nil: an empty key list must still resolve every open discrepancy.
+	seen := make([]string, 0, len(discrepancies))
+	for _, d := range discrepancies {
+		params := sqlc.UpsertRoundDiscrepancyParams{
+			RoundID:     roundID,
+			Kind:        d.Kind,
+			Key:         d.Key,
+			OnChain:     d.OnChain,
+			OffChain:    d.OffChain,
+			BlockNumber: block,
+		}
+		if d.Period != nil {
+			params.Period = pgtype.Int8{Int64: *d.Period, Valid: true}
+		}
+		if d.Address != "" {
+			params.Address = &d.Address
+		}
+		isNew, err := s.store.UpsertRoundDiscrepancy(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if isNew {
+			opened = append(opened, d)
+		}
+		seen = append(seen, d.Key)
+	}
+
+	resolved, err := s.store.ResolveRoundDiscrepancies(ctx, sqlc.ResolveRoundDiscrepanciesParams{
+		RoundID:  roundID,
+		SeenKeys: seen,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resolved > 0 {
+		log.Info().Str("round_id", roundID.String()).Int64("resolved", resolved).Msg("Resolved round discrepancies")
+	}
+	return opened, nil
+}
+
+// alert enqueues an email about new discrepancies to the group owner and
+// the operator. Failures are logged rather than returned: the discrepancies
+// are recorded and will not be emailed about again.
+func (s *Service) alert(ctx context.Context, r sqlc.ListReconcileRoundsRow, opened []Discrepancy) {
+	alert := email.DiscrepancyAlert{
+		GroupName:       r.GroupName,
+		RoundID:         r.ID.String(),
+		ChainID:         r.ChainID,
+		ContractAddress: r.ContractAddress,
+	}
+	for _, d := range opened {
+		alert.Discrepancies = append(alert.Discrepancies, d.String())
+	}
+
+	owner, err := s.store.GetUserByID(ctx, r.OwnerID)
+	switch {
+	case err == nil && owner.Email.Valid:
+		alert.ToEmail = owner.Email.String
+		alert.ToName = owner.Address
+		if owner.DisplayName != nil {
+			alert.ToName = *owner.DisplayName
+		}
+		s.enqueueAlert(ctx, alert)
+	case err != nil && err != pgx.ErrNoRows:
+		log.Error().Err(err).Str("round_id", r.ID.String()).Msg("Failed to get group owner")
+	}
+
+	if s.operatorEmail != "" && s.operatorEmail != alert.ToEmail {
+		alert.ToEmail = s.operatorEmail
+		alert.ToName = "operator"
+		s.enqueueAlert(ctx, alert)
+	}
+}
+
+func (s *Service) enqueueAlert(ctx context.Context, alert email.DiscrepancyAlert) {
+	if s.queueService == nil {
+		return
+	}
+	retries := alertRetries
+	if _, err := s.queueService.Enqueue(ctx, SendAlertJob, queue.JobPayload{"alert": alert}, &retries); err != nil {
+		log.Error().Err(err).Str("round_id", alert.RoundID).Msg("Failed to enqueue discrepancy alert")
+	}
+}
+
+// HandleSendAlertJob emails a discrepancy alert for a queued job.
+func (s *Service) HandleSendAlertJob(ctx context.Context, job *sqlc.Job) error {
+	var payload struct {
+		Alert email.DiscrepancyAlert `json:"alert"`
+	}
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+	if s.emailService == nil {
+		return errors.New("email service not configured")
+	}
+	return s.emailService.SendDiscrepancyAlert(ctx, payload.Alert)
+}
+
+// ListOpen returns the open discrepancies of roundID, or of every round
+// when it is nil.
+func (s *Service) ListOpen(ctx context.Context, roundID *uuid.UUID) ([]sqlc.RoundDiscrepancy, error) {
+	return s.store.ListOpenRoundDiscrepancies(ctx, pgUUID(roundID))
+}
+
+// Resync drops a round's indexed events from block from on and rewinds its
+// indexer cursor, so the indexer reads them from the chain again on its
+// next poll. Read-model totals and the ledger follow the events. Resyncing
+// from block 0 re-indexes the whole round.
+func (s *Service) Resync(ctx context.Context, roundID uuid.UUID, from uint64) error {
+	r, err := s.store.GetRoundByID(ctx, roundID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return circaerrors.ErrRoundNotFound
+		}
+		return err
+	}
+	if !common.IsHexAddress(r.ContractAddress) {
+		return fmt.Errorf("round %s has an invalid contract address %q", r.ID, r.ContractAddress)
+	}
+
+	return s.resyncer.Resync(ctx, indexer.Contract{
+		RoundID: r.ID,
+		ChainID: r.ChainID,
+		Address: common.HexToAddress(r.ContractAddress),
+	}, from)
+}
+
+func pgUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
@@ -0,0 +1,361 @@
+package reconcile
+
+import (
+	"circa/internal/contracts"
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/ledger"
+	"circa/internal/queue"
+	emailmocks "circa/internal/queue/mocks"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ownerAddress    = "0x1111111111111111111111111111111111111111"
+	memberAddress   = "0x2222222222222222222222222222222222222222"
+	contractAddress = "0x00000000000000000000000000000000000000C1"
+	tokenAddress    = "0x00000000000000000000000000000000000000D1"
+	testChainID     = int64(84532)
+	testCursor      = int64(120)
+)
+
+var (
+	testStart  = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	testPeriod = 7 * 24 * time.Hour
+)
+
+func createTestRound(ownerID uuid.UUID) sqlc.ListReconcileRoundsRow {
+	token := tokenAddress
+	return sqlc.ListReconcileRoundsRow{
+		ID:                    uuid.New(),
+		GroupName:             "Savings Circle",
+		OwnerID:               ownerID,
+		ChainID:               testChainID,
+		ContractAddress:       contractAddress,
+		TokenAddress:          &token,
+		PeriodDurationSeconds: int64(testPeriod / time.Second),
+		StartedAt:             pgtype.Timestamp{Time: testStart, Valid: true},
+		MemberCount:           2,
+	}
+}
+
+// expectReadModel sets up a two-member round where the database has both
+// members paid for the first period and nothing paid out.
+func expectReadModel(ms *dbmocks.MockStore, r sqlc.ListReconcileRoundsRow) {
+	ms.On("GetIndexerCursor", mock.Anything, sqlc.GetIndexerCursorParams{
+		ChainID:         testChainID,
+		ContractAddress: strings.ToLower(contractAddress),
+	}).Return(testCursor, nil)
+	ms.On("ListRoundContributionPeriods", mock.Anything, r.ID).Return([]sqlc.ListRoundContributionPeriodsRow{
+		{Address: ownerAddress, Period: 0},
+		{Address: memberAddress, Period: 0},
+	}, nil)
+	ms.On("ListRoundEvents", mock.Anything, r.ID).Return([]sqlc.RoundEvent{
+		{EventType: ledger.EntryContribution, Address: ownerAddress, Period: 0, Amount: "100"},
+		{EventType: ledger.EntryContribution, Address: memberAddress, Period: 0, Amount: "100"},
+	}, nil)
+	entry := uuid.New()
+	ms.On("ListRoundLedgerPostings", mock.Anything, r.ID).Return([]sqlc.ListRoundLedgerPostingsRow{
+		{EntryID: entry, Kind: ledger.EntryContribution, AccountKind: ledger.AccountContributed, Member: ownerAddress, Amount: "-200"},
+		{EntryID: entry, Kind: ledger.EntryContribution, AccountKind: ledger.AccountPot, Amount: "200"},
+	}, nil)
+	ms.On("ListRoundMembers", mock.Anything, r.ID).Return([]sqlc.RoundMember{
+		{RoundID: r.ID, Address: ownerAddress, PayoutPosition: 0},
+		{RoundID: r.ID, Address: memberAddress, PayoutPosition: 1},
+	}, nil)
+}
+
+// inSync returns a contract matching expectReadModel.
+func inSync() *fakeRound {
+	return &fakeRound{
+		paid: map[Contribution]bool{
+			{Address: ownerAddress, Period: 0}:  true,
+			{Address: memberAddress, Period: 0}: true,
+		},
+		payoutIndex: 0,
+		balance:     big.NewInt(200),
+	}
+}
+
+func TestService_Reconcile(t *testing.T) {
+	owner := sqlc.User{
+		ID:      uuid.New(),
+		Address: ownerAddress,
+		Email:   pgtype.Text{String: "owner@example.com", Valid: true},
+	}
+	r := createTestRound(owner.ID)
+	now := testStart.Add(testPeriod + testPeriod/2)
+
+	tests := []struct {
+		name           string
+		chain          *fakeRound
+		setupMocks     func(*dbmocks.MockStore)
+		expectedOpened int
+		expectedError  string
+		validateJobs   func(*testing.T, []sqlc.CreateJobParams)
+	}{
+		{
+			name:  "success - in sync resolves what was open",
+			chain: inSync(),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReconcileRounds", mock.Anything, pgtype.UUID{}).Return([]sqlc.ListReconcileRoundsRow{r}, nil)
+				expectReadModel(ms, r)
+				ms.On("ResolveRoundDiscrepancies", mock.Anything, sqlc.ResolveRoundDiscrepanciesParams{
+					RoundID:  r.ID,
+					SeenKeys: []string{},
+				}).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "success - new discrepancies alert the owner and operator",
+			chain: func() *fakeRound {
+				chain := inSync()
+				chain.paid[Contribution{Address: ownerAddress, Period: 1}] = true
+				chain.balance = big.NewInt(300)
+				return chain
+			}(),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReconcileRounds", mock.Anything, pgtype.UUID{}).Return([]sqlc.ListReconcileRoundsRow{r}, nil)
+				expectReadModel(ms, r)
+				ms.On("UpsertRoundDiscrepancy", mock.Anything, sqlc.UpsertRoundDiscrepancyParams{
+					RoundID:     r.ID,
+					Kind:        KindBalance,
+					Key:         KindBalance,
+					OnChain:     "300",
+					OffChain:    "200",
+					BlockNumber: testCursor,
+				}).Return(true, nil)
+				ms.On("UpsertRoundDiscrepancy", mock.Anything, mock.MatchedBy(func(p sqlc.UpsertRoundDiscrepancyParams) bool {
+					return p.Kind == KindPaid && p.Period.Valid && p.Period.Int64 == 1 && *p.Address == ownerAddress
+				})).Return(true, nil)
+				ms.On("ResolveRoundDiscrepancies", mock.Anything, sqlc.ResolveRoundDiscrepanciesParams{
+					RoundID:  r.ID,
+					SeenKeys: []string{KindBalance, "paid:1:" + ownerAddress},
+				}).Return(int64(0), nil)
+				ms.On("GetUserByID", mock.Anything, owner.ID).Return(owner, nil)
+			},
+			expectedOpened: 2,
+			validateJobs: func(t *testing.T, jobs []sqlc.CreateJobParams) {
+				require.Len(t, jobs, 2)
+				alerts := alertsIn(jobs)
+				assert.Equal(t, "owner@example.com", alerts[0].ToEmail)
+				assert.Equal(t, "ops@example.com", alerts[1].ToEmail)
+				for i, job := range jobs {
+					assert.Equal(t, SendAlertJob, job.Type)
+					assert.Equal(t, int32(alertRetries), job.MaxRetries)
+					assert.Equal(t, r.ID.String(), alerts[i].RoundID)
+					assert.Equal(t, []string{
+						"pot holds 300 on chain but 200 in the ledger",
+						"contribution of " + ownerAddress + " for period 2 is paid on chain but unpaid in the database",
+					}, alerts[i].Discrepancies)
+				}
+			},
+		},
+		{
+			name: "success - discrepancies already open are not alerted again",
+			chain: func() *fakeRound {
+				chain := inSync()
+				chain.payoutIndex = 1
+				return chain
+			}(),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReconcileRounds", mock.Anything, pgtype.UUID{}).Return([]sqlc.ListReconcileRoundsRow{r}, nil)
+				expectReadModel(ms, r)
+				ms.On("UpsertRoundDiscrepancy", mock.Anything, mock.MatchedBy(func(p sqlc.UpsertRoundDiscrepancyParams) bool {
+					return p.Key == KindPayoutIndex && p.OnChain == "1" && p.OffChain == "0"
+				})).Return(false, nil)
+				ms.On("ResolveRoundDiscrepancies", mock.Anything, sqlc.ResolveRoundDiscrepanciesParams{
+					RoundID:  r.ID,
+					SeenKeys: []string{KindPayoutIndex},
+				}).Return(int64(0), nil)
+			},
+		},
+		{
+			name:  "success - rounds not indexed yet are skipped",
+			chain: inSync(),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReconcileRounds", mock.Anything, pgtype.UUID{}).Return([]sqlc.ListReconcileRoundsRow{r}, nil)
+				ms.On("GetIndexerCursor", mock.Anything, mock.Anything).Return(int64(0), pgx.ErrNoRows)
+			},
+		},
+		{
+			name:  "error - contract read fails",
+			chain: &fakeRound{err: errors.New("missing trie node")},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReconcileRounds", mock.Anything, pgtype.UUID{}).Return([]sqlc.ListReconcileRoundsRow{r}, nil)
+				expectReadModel(ms, r)
+			},
+			expectedError: "missing trie node",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			var jobs []sqlc.CreateJobParams
+			mockStore.On("CreateJob", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					jobs = append(jobs, args.Get(1).(sqlc.CreateJobParams))
+				}).
+				Return(sqlc.Job{}, nil).
+				Maybe()
+
+			callers := map[int64]contracts.Caller{testChainID: tt.chain}
+			service := NewService(mockStore, queue.NewService(mockStore), nil, callers, nil, "ops@example.com")
+			opened, err := service.Reconcile(context.Background(), nil, now)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOpened, opened)
+			for _, block := range tt.chain.blocks {
+				assert.Equal(t, testCursor, block.Int64(), "contract read at the indexed block")
+			}
+			if tt.validateJobs != nil {
+				tt.validateJobs(t, jobs)
+			} else {
+				assert.Empty(t, jobs)
+			}
+		})
+	}
+}
+
+func TestService_Reconcile_RoundNotFound(t *testing.T) {
+	roundID := uuid.New()
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("ListReconcileRounds", mock.Anything, pgtype.UUID{Bytes: roundID, Valid: true}).
+		Return([]sqlc.ListReconcileRoundsRow{}, nil)
+
+	service := NewService(mockStore, nil, nil, nil, nil, "")
+	_, err := service.Reconcile(context.Background(), &roundID, time.Now())
+	assert.ErrorIs(t, err, circaerrors.ErrRoundNotFound)
+}
+
+func TestService_HandleSendAlertJob(t *testing.T) {
+	alert := email.DiscrepancyAlert{
+		ToEmail:       "owner@example.com",
+		ToName:        "Owner",
+		GroupName:     "Savings Circle",
+		RoundID:       uuid.NewString(),
+		Discrepancies: []string{"payout index is 1 on chain but 0 in the database"},
+	}
+	payload, err := json.Marshal(queue.JobPayload{"alert": alert})
+	require.NoError(t, err)
+
+	mockEmail := emailmocks.NewMockEmailService(t)
+	mockEmail.On("SendDiscrepancyAlert", mock.Anything, alert).Return(nil)
+
+	service := NewService(dbmocks.NewMockStore(t), nil, mockEmail, nil, nil, "")
+	require.NoError(t, service.HandleSendAlertJob(context.Background(), &sqlc.Job{Payload: payload}))
+}
+
+func TestService_Resync(t *testing.T) {
+	round := sqlc.Round{ID: uuid.New(), ChainID: testChainID, ContractAddress: contractAddress}
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+	missing := uuid.New()
+	mockStore.On("GetRoundByID", mock.Anything, missing).Return(sqlc.Round{}, pgx.ErrNoRows)
+
+	resyncer := &fakeResyncer{}
+	service := NewService(mockStore, nil, nil, nil, resyncer, "")
+
+	require.NoError(t, service.Resync(context.Background(), round.ID, 100))
+	assert.Equal(t, indexer.Contract{
+		RoundID: round.ID,
+		ChainID: testChainID,
+		Address: common.HexToAddress(contractAddress),
+	}, resyncer.contract)
+	assert.Equal(t, uint64(100), resyncer.from)
+
+	assert.ErrorIs(t, service.Resync(context.Background(), missing, 0), circaerrors.ErrRoundNotFound)
+}
+
+// alertsIn decodes the alerts of enqueued jobs.
+func alertsIn(jobs []sqlc.CreateJobParams) []email.DiscrepancyAlert {
+	var alerts []email.DiscrepancyAlert
+	for _, job := range jobs {
+		var payload struct {
+			Alert email.DiscrepancyAlert `json:"alert"`
+		}
+		if err := json.Unmarshal(job.Payload, &payload); err == nil {
+			alerts = append(alerts, payload.Alert)
+		}
+	}
+	return alerts
+}
+
+// fakeRound answers Round and ERC-20 view calls from fixed values and
+// records the blocks they were made at.
+type fakeRound struct {
+	paid        map[Contribution]bool
+	payoutIndex int64
+	balance     *big.Int
+	err         error
+	blocks      []*big.Int
+}
+
+func (f *fakeRound) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeRound) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.blocks = append(f.blocks, blockNumber)
+
+	if method, err := contracts.RoundABI.MethodById(msg.Data[:4]); err == nil {
+		switch method.Name {
+		case "hasPaid":
+			args, err := method.Inputs.Unpack(msg.Data[4:])
+			if err != nil {
+				return nil, err
+			}
+			c := Contribution{
+				Address: strings.ToLower(args[1].(common.Address).Hex()),
+				Period:  args[0].(*big.Int).Int64(),
+			}
+			return method.Outputs.Pack(f.paid[c])
+		case "payoutIndex":
+			return method.Outputs.Pack(big.NewInt(f.payoutIndex))
+		}
+	}
+	if method, err := contracts.ERC20ABI.MethodById(msg.Data[:4]); err == nil && method.Name == "balanceOf" {
+		return method.Outputs.Pack(f.balance)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+type fakeResyncer struct {
+	contract indexer.Contract
+	from     uint64
+}
+
+func (f *fakeResyncer) Resync(ctx context.Context, contract indexer.Contract, from uint64) error {
+	f.contract, f.from = contract, from
+	return nil
+}
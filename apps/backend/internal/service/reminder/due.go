@@ -0,0 +1,39 @@
+package reminder
+
+import "time"
+
+// Deadline returns the period a round clock is in at now and the deadline
+// for contributing to it, which is the end of the period. It returns false
+// before the round starts and after its last period.
+func Deadline(startedAt time.Time, periodDuration time.Duration, periods int, now time.Time) (int, time.Time, bool) {
+	if periodDuration <= 0 || now.Before(startedAt) {
+		return 0, time.Time{}, false
+	}
+	period := int(now.Sub(startedAt) / periodDuration)
+	if period >= periods {
+		return 0, time.Time{}, false
+	}
+	return period, startedAt.Add(time.Duration(period+1) * periodDuration), true
+}
+
+// Due returns the lead time of the reminder to send for a contribution due
+// at deadline: the shortest one whose window has opened. Longer lead times
+// that were never sent, because the scheduler was down or the period is
+// shorter than them, are skipped rather than sent late. It returns false
+// when no window has opened or the deadline has passed.
+func Due(deadline time.Time, leadTimes []time.Duration, now time.Time) (time.Duration, bool) {
+	if !now.Before(deadline) {
+		return 0, false
+	}
+	remaining := deadline.Sub(now)
+
+	var due time.Duration
+	found := false
+	for _, lead := range leadTimes {
+		if lead >= remaining && (!found || lead < due) {
+			due = lead
+			found = true
+		}
+	}
+	return due, found
+}
@@ -0,0 +1,70 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadline(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+
+	tests := []struct {
+		name             string
+		now              time.Time
+		expectedPeriod   int
+		expectedDeadline time.Time
+		expectedOK       bool
+	}{
+		{name: "before start", now: start.Add(-time.Second)},
+		{name: "first period", now: start, expectedPeriod: 0, expectedDeadline: start.Add(week), expectedOK: true},
+		{name: "last period", now: start.Add(2*week + time.Hour), expectedPeriod: 2, expectedDeadline: start.Add(3 * week), expectedOK: true},
+		{name: "after last period", now: start.Add(3 * week)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, deadline, ok := Deadline(start, week, 3, tt.now)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedPeriod, period)
+			assert.Equal(t, tt.expectedDeadline, deadline)
+		})
+	}
+}
+
+func TestDue(t *testing.T) {
+	deadline := time.Date(2026, 2, 8, 0, 0, 0, 0, time.UTC)
+	leadTimes := DefaultPreferences().LeadTimes
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		leadTimes  []time.Duration
+		expected   time.Duration
+		expectedOK bool
+	}{
+		{name: "no window open yet", now: deadline.Add(-73 * time.Hour), leadTimes: leadTimes},
+		{name: "72h window opens", now: deadline.Add(-72 * time.Hour), leadTimes: leadTimes, expected: 72 * time.Hour, expectedOK: true},
+		{name: "inside 24h window", now: deadline.Add(-23 * time.Hour), leadTimes: leadTimes, expected: 24 * time.Hour, expectedOK: true},
+		{name: "inside 2h window", now: deadline.Add(-time.Minute), leadTimes: leadTimes, expected: 2 * time.Hour, expectedOK: true},
+		{name: "deadline passed", now: deadline, leadTimes: leadTimes},
+		{name: "no lead times", now: deadline.Add(-time.Minute)},
+		{
+			name:       "unsorted lead times",
+			now:        deadline.Add(-90 * time.Minute),
+			leadTimes:  []time.Duration{2 * time.Hour, 48 * time.Hour, 3 * time.Hour},
+			expected:   2 * time.Hour,
+			expectedOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lead, ok := Due(deadline, tt.leadTimes, tt.now)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expected, lead)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package reminder
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"time"
+)
+
+const ChannelEmail = "email"
+
+const (
+	// SendRemindersJob is the recurring queue job type that finds
+	// contribution reminders that have come due and enqueues them.
+	SendRemindersJob = "send_contribution_reminders"
+
+	// SendReminderEmailJob is the queue job type that emails one reminder.
+	SendReminderEmailJob = "send_contribution_reminder_email"
+)
+
+const (
+	MinLeadTime  = 15 * time.Minute
+	MaxLeadTime  = 7 * 24 * time.Hour
+	MaxLeadTimes = 5
+)
+
+// Preferences are how a user wants to be reminded of contributions: on which
+// channels and how long before each deadline. No channels means no
+// reminders.
+type Preferences struct {
+	Channels  []string
+	LeadTimes []time.Duration
+}
+
+// DefaultPreferences applies to users who never set their own.
+func DefaultPreferences() Preferences {
+	return Preferences{
+		Channels:  []string{ChannelEmail},
+		LeadTimes: []time.Duration{72 * time.Hour, 24 * time.Hour, 2 * time.Hour},
+	}
+}
+
+type ReminderService interface {
+	GetPreferences(ctx context.Context, user sqlc.User) (*Preferences, error)
+	UpdatePreferences(ctx context.Context, user sqlc.User, prefs Preferences) (*Preferences, error)
+}
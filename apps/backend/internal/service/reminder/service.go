@@ -0,0 +1,298 @@
+package reminder
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	circaerrors "circa/internal/errors"
+	"circa/internal/prices"
+	"circa/internal/queue"
+	"circa/internal/tokens"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+const emailRetries = 3
+
+type Service struct {
+	store        db.Store
+	queueService *queue.Service
+	emailService email.EmailService
+	tokens       *tokens.Registry
+	prices       *prices.Converter
+}
+
+func NewService(store db.Store, queueService *queue.Service, emailService email.EmailService, registry *tokens.Registry, converter *prices.Converter) *Service {
+	return &Service{
+		store:        store,
+		queueService: queueService,
+		emailService: emailService,
+		tokens:       registry,
+		prices:       converter,
+	}
+}
+
+// GetPreferences returns the user's reminder preferences, or the defaults
+// when they never set any.
+func (s *Service) GetPreferences(ctx context.Context, user sqlc.User) (*Preferences, error) {
+	row, err := s.store.GetNotificationPreferences(ctx, user.ID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			prefs := DefaultPreferences()
+			return &prefs, nil
+		}
+		log.Error().Err(err).Msg("Failed to get notification preferences")
+		return nil, err
+	}
+
+	prefs := toPreferences(row.ReminderChannels, row.ReminderLeadTimesSeconds)
+	return &prefs, nil
+}
+
+// UpdatePreferences replaces the user's reminder preferences. Duplicates are
+// dropped and lead times are stored longest first.
+func (s *Service) UpdatePreferences(ctx context.Context, user sqlc.User, prefs Preferences) (*Preferences, error) {
+	channels := []string{}
+	for _, channel := range prefs.Channels {
+		if channel != ChannelEmail {
+			return nil, circaerrors.ErrInvalidReminderChannel
+		}
+		if !slices.Contains(channels, channel) {
+			channels = append(channels, channel)
+		}
+	}
+
+	leadTimes := []int64{}
+	for _, lead := range prefs.LeadTimes {
+		if lead < MinLeadTime || lead > MaxLeadTime || lead%time.Second != 0 {
+			return nil, circaerrors.ErrInvalidReminderLeadTime
+		}
+		if seconds := int64(lead / time.Second); !slices.Contains(leadTimes, seconds) {
+			leadTimes = append(leadTimes, seconds)
+		}
+	}
+	if len(leadTimes) > MaxLeadTimes {
+		return nil, circaerrors.ErrInvalidReminderLeadTime
+	}
+	slices.SortFunc(leadTimes, func(a, b int64) int { return cmp.Compare(b, a) })
+
+	row, err := s.store.UpsertNotificationPreferences(ctx, sqlc.UpsertNotificationPreferencesParams{
+		UserID:                   user.ID,
+		ReminderChannels:         channels,
+		ReminderLeadTimesSeconds: leadTimes,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update notification preferences")
+		return nil, err
+	}
+
+	updated := toPreferences(row.ReminderChannels, row.ReminderLeadTimesSeconds)
+	return &updated, nil
+}
+
+func toPreferences(channels []string, leadTimesSeconds []int64) Preferences {
+	prefs := Preferences{
+		Channels:  append([]string{}, channels...),
+		LeadTimes: make([]time.Duration, 0, len(leadTimesSeconds)),
+	}
+	for _, seconds := range leadTimesSeconds {
+		prefs.LeadTimes = append(prefs.LeadTimes, time.Duration(seconds)*time.Second)
+	}
+	return prefs
+}
+
+// HandleSendRemindersJob runs SendReminders for a queued job.
+func (s *Service) HandleSendRemindersJob(ctx context.Context, job *sqlc.Job) error {
+	sent, err := s.SendReminders(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if sent > 0 {
+		log.Info().Int("reminders", sent).Msg("Enqueued contribution reminders")
+	}
+	return nil
+}
+
+// SendReminders enqueues a reminder for every member of an active round who
+// has not contributed to the current period and whose reminder window has
+// opened, on each channel they chose. Each lead time is sent at most once
+// per period, so the job can run as often as needed. It returns the number
+// of reminders enqueued. A failing round does not stop the others.
+func (s *Service) SendReminders(ctx context.Context, now time.Time) (int, error) {
+	rounds, err := s.store.ListReminderRounds(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list rounds to send reminders for")
+		return 0, err
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+	for _, r := range rounds {
+		n, err := s.sendRoundReminders(ctx, r, now)
+		sent += n
+		if err != nil {
+			log.Error().Err(err).Str("round_id", r.ID.String()).Msg("Failed to send round reminders")
+			errs = append(errs, fmt.Errorf("round %s: %w", r.ID, err))
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+func (s *Service) sendRoundReminders(ctx context.Context, r sqlc.ListReminderRoundsRow, now time.Time) (int, error) {
+	period, deadline, ok := Deadline(
+		r.StartedAt.Time,
+		time.Duration(r.PeriodDurationSeconds)*time.Second,
+		int(r.MemberCount),
+		now,
+	)
+	if !ok {
+		return 0, nil
+	}
+
+	contributions, err := s.store.ListRoundContributionPeriods(ctx, r.ID)
+	if err != nil {
+		return 0, err
+	}
+	paid := map[string]bool{}
+	for _, c := range contributions {
+		if c.Period == int64(period) {
+			paid[strings.ToLower(c.Address)] = true
+		}
+	}
+
+	recipients, err := s.store.ListReminderRecipients(ctx, r.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	amount := s.tokens.FormatAmount(r.ChainID, r.TokenAddress, r.ContributionAmount, r.CurrencySymbol)
+	// Recipients share a handful of currencies; price each once.
+	fiat := map[string]string{}
+
+	sent := 0
+	for _, recipient := range recipients {
+		address := strings.ToLower(recipient.Address)
+		if paid[address] {
+			continue
+		}
+
+		prefs := DefaultPreferences()
+		if recipient.ReminderChannels != nil {
+			prefs = toPreferences(recipient.ReminderChannels, recipient.ReminderLeadTimesSeconds)
+		}
+		lead, ok := Due(deadline, prefs.LeadTimes, now)
+		if !ok {
+			continue
+		}
+
+		// Email is the only channel so far.
+		if !slices.Contains(prefs.Channels, ChannelEmail) || !recipient.Email.Valid {
+			continue
+		}
+		n, err := s.store.InsertContributionReminder(ctx, sqlc.InsertContributionReminderParams{
+			RoundID:         r.ID,
+			Address:         address,
+			Period:          int64(period),
+			LeadTimeSeconds: int64(lead / time.Second),
+			Channel:         ChannelEmail,
+		})
+		if err != nil {
+			return sent, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		name := address
+		if recipient.DisplayName != nil {
+			name = *recipient.DisplayName
+		}
+		currency := s.prices.Currency(recipient.PreferredCurrency)
+		fiatAmount, ok := fiat[currency]
+		if !ok {
+			if a, ok := s.prices.Convert(ctx, r.ChainID, r.TokenAddress, r.ContributionAmount, currency, now); ok {
+				fiatAmount = a.String()
+			}
+			fiat[currency] = fiatAmount
+		}
+		if s.enqueueEmail(ctx, r.ID, address, email.ContributionReminder{
+			ToEmail:    recipient.Email.String,
+			ToName:     name,
+			GroupName:  r.GroupName,
+			Period:     period,
+			Amount:     amount,
+			FiatAmount: fiatAmount,
+			Deadline:   deadline,
+		}) {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// enqueueEmail enqueues a reminder email. Failures are logged rather than
+// returned: the reminder is recorded and will not be sent again.
+func (s *Service) enqueueEmail(ctx context.Context, roundID uuid.UUID, address string, reminder email.ContributionReminder) bool {
+	if s.queueService == nil {
+		return false
+	}
+
+	retries := emailRetries
+	_, err := s.queueService.Enqueue(ctx, SendReminderEmailJob, queue.JobPayload{
+		"round_id": roundID,
+		"address":  address,
+		"reminder": reminder,
+	}, &retries)
+	if err != nil {
+		log.Error().Err(err).Str("round_id", roundID.String()).Str("address", address).Msg("Failed to enqueue contribution reminder")
+		return false
+	}
+	return true
+}
+
+// HandleSendReminderEmailJob emails a queued reminder, unless the
+// contribution has been indexed since it was enqueued.
+func (s *Service) HandleSendReminderEmailJob(ctx context.Context, job *sqlc.Job) error {
+	var payload struct {
+		RoundID  uuid.UUID                  `json:"round_id"`
+		Address  string                     `json:"address"`
+		Reminder email.ContributionReminder `json:"reminder"`
+	}
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return err
+	}
+
+	paid, err := s.store.RoundContributionExists(ctx, sqlc.RoundContributionExistsParams{
+		RoundID: payload.RoundID,
+		Address: payload.Address,
+		Period:  int64(payload.Reminder.Period),
+	})
+	if err != nil {
+		return err
+	}
+	if paid {
+		log.Info().
+			Str("round_id", payload.RoundID.String()).
+			Str("address", payload.Address).
+			Msg("Contribution already made, skipping reminder")
+		return nil
+	}
+
+	if s.emailService == nil {
+		return errors.New("email service not configured")
+	}
+	return s.emailService.SendContributionReminder(ctx, payload.Reminder)
+}
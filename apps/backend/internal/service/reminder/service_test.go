@@ -0,0 +1,392 @@
+package reminder
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/email"
+	circaerrors "circa/internal/errors"
+	"circa/internal/prices"
+	"circa/internal/queue"
+	emailmocks "circa/internal/queue/mocks"
+	"circa/internal/tokens"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ownerAddress  = "0x1111111111111111111111111111111111111111"
+	memberAddress = "0x2222222222222222222222222222222222222222"
+)
+
+var (
+	testStart  = time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	testPeriod = 7 * 24 * time.Hour
+)
+
+func createTestUser() sqlc.User {
+	return sqlc.User{ID: uuid.New(), Address: memberAddress}
+}
+
+func createTestRound() sqlc.ListReminderRoundsRow {
+	symbol := "USDC"
+	return sqlc.ListReminderRoundsRow{
+		ID:                    uuid.New(),
+		GroupName:             "Friday Ajo",
+		ContributionAmount:    "100",
+		CurrencySymbol:        &symbol,
+		PeriodDurationSeconds: int64(testPeriod / time.Second),
+		StartedAt:             pgtype.Timestamp{Time: testStart, Valid: true},
+		MemberCount:           2,
+	}
+}
+
+func recipient(address, mail string) sqlc.ListReminderRecipientsRow {
+	return sqlc.ListReminderRecipientsRow{
+		Address: address,
+		Email:   pgtype.Text{String: mail, Valid: mail != ""},
+	}
+}
+
+func TestService_GetPreferences(t *testing.T) {
+	user := createTestUser()
+
+	t.Run("defaults when never set", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("GetNotificationPreferences", mock.Anything, user.ID).Return(sqlc.NotificationPreference{}, pgx.ErrNoRows)
+
+		prefs, err := NewService(mockStore, nil, nil, nil, nil).GetPreferences(context.Background(), user)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultPreferences(), *prefs)
+	})
+
+	t.Run("stored preferences", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("GetNotificationPreferences", mock.Anything, user.ID).Return(sqlc.NotificationPreference{
+			UserID:                   user.ID,
+			ReminderChannels:         []string{},
+			ReminderLeadTimesSeconds: []int64{3600},
+		}, nil)
+
+		prefs, err := NewService(mockStore, nil, nil, nil, nil).GetPreferences(context.Background(), user)
+		require.NoError(t, err)
+		assert.Empty(t, prefs.Channels)
+		assert.Equal(t, []time.Duration{time.Hour}, prefs.LeadTimes)
+	})
+}
+
+func TestService_UpdatePreferences(t *testing.T) {
+	user := createTestUser()
+
+	tests := []struct {
+		name          string
+		prefs         Preferences
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		expected      Preferences
+	}{
+		{
+			name: "success - deduplicates and sorts lead times",
+			prefs: Preferences{
+				Channels:  []string{ChannelEmail, ChannelEmail},
+				LeadTimes: []time.Duration{time.Hour, 48 * time.Hour, time.Hour},
+			},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("UpsertNotificationPreferences", mock.Anything, sqlc.UpsertNotificationPreferencesParams{
+					UserID:                   user.ID,
+					ReminderChannels:         []string{ChannelEmail},
+					ReminderLeadTimesSeconds: []int64{172800, 3600},
+				}).Return(sqlc.NotificationPreference{
+					UserID:                   user.ID,
+					ReminderChannels:         []string{ChannelEmail},
+					ReminderLeadTimesSeconds: []int64{172800, 3600},
+				}, nil)
+			},
+			expected: Preferences{
+				Channels:  []string{ChannelEmail},
+				LeadTimes: []time.Duration{48 * time.Hour, time.Hour},
+			},
+		},
+		{
+			name:  "success - no channels turns reminders off",
+			prefs: Preferences{},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("UpsertNotificationPreferences", mock.Anything, sqlc.UpsertNotificationPreferencesParams{
+					UserID:                   user.ID,
+					ReminderChannels:         []string{},
+					ReminderLeadTimesSeconds: []int64{},
+				}).Return(sqlc.NotificationPreference{UserID: user.ID}, nil)
+			},
+			expected: Preferences{Channels: []string{}, LeadTimes: []time.Duration{}},
+		},
+		{
+			name:          "error - unknown channel",
+			prefs:         Preferences{Channels: []string{"sms"}},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidReminderChannel,
+		},
+		{
+			name:          "error - lead time too short",
+			prefs:         Preferences{LeadTimes: []time.Duration{time.Minute}},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidReminderLeadTime,
+		},
+		{
+			name:          "error - lead time too long",
+			prefs:         Preferences{LeadTimes: []time.Duration{8 * 24 * time.Hour}},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidReminderLeadTime,
+		},
+		{
+			name: "error - too many lead times",
+			prefs: Preferences{LeadTimes: []time.Duration{
+				time.Hour, 2 * time.Hour, 3 * time.Hour, 4 * time.Hour, 5 * time.Hour, 6 * time.Hour,
+			}},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidReminderLeadTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			prefs, err := NewService(mockStore, nil, nil, nil, nil).UpdatePreferences(context.Background(), user, tt.prefs)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, prefs)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, *prefs)
+			}
+		})
+	}
+}
+
+func TestService_SendReminders(t *testing.T) {
+	r := createTestRound()
+	deadline := testStart.Add(testPeriod)
+
+	token := "0x0000000000000000000000000000000000000abc"
+	registry, err := tokens.NewRegistry([]tokens.Token{
+		{ChainID: 1, Address: common.HexToAddress(token), Symbol: "USDC", Decimals: 6},
+	})
+	require.NoError(t, err)
+	feed, err := prices.NewStaticFeed(map[string]map[string]string{"USDC": {"NGN": "1500", "USD": "1"}})
+	require.NoError(t, err)
+	converter := prices.NewConverter(feed, registry, "NGN")
+	priced := createTestRound()
+	priced.ChainID = 1
+	priced.TokenAddress = &token
+	priced.ContributionAmount = "100000000"
+
+	tests := []struct {
+		name          string
+		now           time.Time
+		setupMocks    func(*dbmocks.MockStore)
+		expectedSent  int
+		expectedError string
+		validateJobs  func(*testing.T, []sqlc.CreateJobParams)
+	}{
+		{
+			name: "success - reminds members who have not paid",
+			now:  deadline.Add(-23 * time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReminderRounds", mock.Anything).Return([]sqlc.ListReminderRoundsRow{r}, nil)
+				ms.On("ListRoundContributionPeriods", mock.Anything, r.ID).Return([]sqlc.ListRoundContributionPeriodsRow{
+					{Address: ownerAddress, Period: 0},
+				}, nil)
+				ms.On("ListReminderRecipients", mock.Anything, r.ID).Return([]sqlc.ListReminderRecipientsRow{
+					recipient(ownerAddress, "owner@example.com"),
+					recipient(memberAddress, "member@example.com"),
+				}, nil)
+				ms.On("InsertContributionReminder", mock.Anything, sqlc.InsertContributionReminderParams{
+					RoundID:         r.ID,
+					Address:         memberAddress,
+					Period:          0,
+					LeadTimeSeconds: 86400,
+					Channel:         ChannelEmail,
+				}).Return(int64(1), nil)
+			},
+			expectedSent: 1,
+			validateJobs: func(t *testing.T, jobs []sqlc.CreateJobParams) {
+				require.Len(t, jobs, 1)
+				assert.Equal(t, SendReminderEmailJob, jobs[0].Type)
+
+				var payload struct {
+					Address  string                     `json:"address"`
+					Reminder email.ContributionReminder `json:"reminder"`
+				}
+				require.NoError(t, json.Unmarshal(jobs[0].Payload, &payload))
+				assert.Equal(t, memberAddress, payload.Address)
+				assert.Equal(t, "member@example.com", payload.Reminder.ToEmail)
+				assert.Equal(t, "100 USDC", payload.Reminder.Amount)
+				assert.True(t, payload.Reminder.Deadline.Equal(deadline))
+			},
+		},
+		{
+			name: "success - fiat amounts are in each recipient's currency",
+			now:  deadline.Add(-23 * time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				usd := "USD"
+				owner := recipient(ownerAddress, "owner@example.com")
+				owner.PreferredCurrency = &usd
+
+				ms.On("ListReminderRounds", mock.Anything).Return([]sqlc.ListReminderRoundsRow{priced}, nil)
+				ms.On("ListRoundContributionPeriods", mock.Anything, priced.ID).Return([]sqlc.ListRoundContributionPeriodsRow{}, nil)
+				ms.On("ListReminderRecipients", mock.Anything, priced.ID).Return([]sqlc.ListReminderRecipientsRow{
+					owner,
+					recipient(memberAddress, "member@example.com"),
+				}, nil)
+				ms.On("InsertContributionReminder", mock.Anything, mock.Anything).Return(int64(1), nil)
+			},
+			expectedSent: 2,
+			validateJobs: func(t *testing.T, jobs []sqlc.CreateJobParams) {
+				require.Len(t, jobs, 2)
+				var fiat []string
+				for _, job := range jobs {
+					var payload struct {
+						Reminder email.ContributionReminder `json:"reminder"`
+					}
+					require.NoError(t, json.Unmarshal(job.Payload, &payload))
+					assert.Equal(t, "100 USDC", payload.Reminder.Amount)
+					fiat = append(fiat, payload.Reminder.FiatAmount)
+				}
+				assert.Equal(t, []string{"USD 100.00", "NGN 150000.00"}, fiat)
+			},
+		},
+		{
+			name: "success - honours per-user preferences",
+			now:  deadline.Add(-23 * time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				optedOut := recipient(ownerAddress, "owner@example.com")
+				optedOut.ReminderChannels = []string{}
+				optedOut.ReminderLeadTimesSeconds = []int64{86400}
+				later := recipient(memberAddress, "member@example.com")
+				later.ReminderChannels = []string{ChannelEmail}
+				later.ReminderLeadTimesSeconds = []int64{3600}
+
+				ms.On("ListReminderRounds", mock.Anything).Return([]sqlc.ListReminderRoundsRow{r}, nil)
+				ms.On("ListRoundContributionPeriods", mock.Anything, r.ID).Return([]sqlc.ListRoundContributionPeriodsRow{}, nil)
+				ms.On("ListReminderRecipients", mock.Anything, r.ID).Return([]sqlc.ListReminderRecipientsRow{optedOut, later}, nil)
+			},
+		},
+		{
+			name: "success - already sent reminders are skipped",
+			now:  deadline.Add(-time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReminderRounds", mock.Anything).Return([]sqlc.ListReminderRoundsRow{r}, nil)
+				ms.On("ListRoundContributionPeriods", mock.Anything, r.ID).Return([]sqlc.ListRoundContributionPeriodsRow{}, nil)
+				ms.On("ListReminderRecipients", mock.Anything, r.ID).Return([]sqlc.ListReminderRecipientsRow{
+					recipient(memberAddress, "member@example.com"),
+				}, nil)
+				ms.On("InsertContributionReminder", mock.Anything, mock.Anything).Return(int64(0), nil)
+			},
+		},
+		{
+			name: "success - rounds past their last period are skipped",
+			now:  testStart.Add(2 * testPeriod),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListReminderRounds", mock.Anything).Return([]sqlc.ListReminderRoundsRow{r}, nil)
+			},
+		},
+		{
+			name: "error - failing round does not stop the others",
+			now:  deadline.Add(-time.Hour),
+			setupMocks: func(ms *dbmocks.MockStore) {
+				broken := createTestRound()
+				ms.On("ListReminderRounds", mock.Anything).Return([]sqlc.ListReminderRoundsRow{broken, r}, nil)
+				ms.On("ListRoundContributionPeriods", mock.Anything, broken.ID).Return(nil, errors.New("database error"))
+				ms.On("ListRoundContributionPeriods", mock.Anything, r.ID).Return([]sqlc.ListRoundContributionPeriodsRow{}, nil)
+				ms.On("ListReminderRecipients", mock.Anything, r.ID).Return([]sqlc.ListReminderRecipientsRow{
+					recipient(memberAddress, "member@example.com"),
+				}, nil)
+				ms.On("InsertContributionReminder", mock.Anything, mock.Anything).Return(int64(1), nil)
+			},
+			expectedSent:  1,
+			expectedError: "database error",
+			validateJobs: func(t *testing.T, jobs []sqlc.CreateJobParams) {
+				assert.Len(t, jobs, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			var jobs []sqlc.CreateJobParams
+			mockStore.On("CreateJob", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					jobs = append(jobs, args.Get(1).(sqlc.CreateJobParams))
+				}).
+				Return(sqlc.Job{}, nil).
+				Maybe()
+
+			service := NewService(mockStore, queue.NewService(mockStore), nil, registry, converter)
+			sent, err := service.SendReminders(context.Background(), tt.now)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedSent, sent)
+			if tt.validateJobs != nil {
+				tt.validateJobs(t, jobs)
+			} else {
+				assert.Empty(t, jobs)
+			}
+		})
+	}
+}
+
+func TestService_HandleSendReminderEmailJob(t *testing.T) {
+	roundID := uuid.New()
+	reminder := email.ContributionReminder{
+		ToEmail:   "member@example.com",
+		ToName:    "Member",
+		GroupName: "Friday Ajo",
+		Period:    1,
+		Amount:    "100 USDC",
+		Deadline:  testStart.Add(2 * testPeriod),
+	}
+	payload, err := json.Marshal(queue.JobPayload{"round_id": roundID, "address": memberAddress, "reminder": reminder})
+	require.NoError(t, err)
+	params := sqlc.RoundContributionExistsParams{RoundID: roundID, Address: memberAddress, Period: 1}
+
+	t.Run("sends when still unpaid", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("RoundContributionExists", mock.Anything, params).Return(false, nil)
+		mockEmail := emailmocks.NewMockEmailService(t)
+		mockEmail.On("SendContributionReminder", mock.Anything, mock.MatchedBy(func(r email.ContributionReminder) bool {
+			return r.ToEmail == reminder.ToEmail && r.Deadline.Equal(reminder.Deadline)
+		})).Return(nil)
+
+		err := NewService(mockStore, nil, mockEmail, nil, nil).HandleSendReminderEmailJob(context.Background(), &sqlc.Job{Payload: payload})
+		require.NoError(t, err)
+	})
+
+	t.Run("suppressed once the contribution is indexed", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("RoundContributionExists", mock.Anything, params).Return(true, nil)
+		mockEmail := emailmocks.NewMockEmailService(t)
+
+		err := NewService(mockStore, nil, mockEmail, nil, nil).HandleSendReminderEmailJob(context.Background(), &sqlc.Job{Payload: payload})
+		require.NoError(t, err)
+		mockEmail.AssertNotCalled(t, "SendContributionReminder", mock.Anything, mock.Anything)
+	})
+}
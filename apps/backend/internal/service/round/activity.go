@@ -0,0 +1,212 @@
+package round
+
+import (
+	"bytes"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/pagination"
+	"circa/internal/tokens"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var activityEventTypes = map[string]string{
+	ActivityPayment: indexer.EventContribution,
+	ActivityPayout:  indexer.EventPayout,
+	ActivitySwap:    indexer.EventSwap,
+}
+
+// ListActivity returns a page of the round's payments, payouts and swaps, newest
+// first. Only accepted members of the round's group may read it.
+func (s *Service) ListActivity(ctx context.Context, params ListActivityParams) (*ListActivityResult, error) {
+	eventType, err := eventTypeFilter(params.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pagination.Limit(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.paginator.Decode(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	cursorBlock, cursorLogIndex, err := cursor.Log()
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := s.getMemberRound(ctx, params.RoundID, params.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.store.ListRoundActivity(ctx, sqlc.ListRoundActivityParams{
+		RoundID:           round.ID,
+		EventType:         eventType,
+		CursorBlockNumber: cursorBlock,
+		CursorLogIndex:    cursorLogIndex,
+		CursorID:          cursor.UUID(),
+		PageSize:          pagination.PageSize(limit),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round activity")
+		return nil, err
+	}
+
+	rows, next := pagination.Paginate(s.paginator, rows, limit, func(r sqlc.ListRoundActivityRow) pagination.Cursor {
+		return pagination.AtLog(r.BlockNumber, r.LogIndex, r.ID)
+	})
+
+	items := make([]ActivityItem, 0, len(rows))
+	for _, r := range rows {
+		item := ActivityItemFromRow(sqlc.ExportRoundActivityRow(r))
+		FormatActivityAmount(&item, s.tokens, round.ChainID, round.TokenAddress, round.CurrencySymbol)
+		items = append(items, item)
+	}
+
+	return &ListActivityResult{
+		Items:      items,
+		NextCursor: next,
+	}, nil
+}
+
+// ExportActivity returns every payment, payout and swap of the round in
+// chain order as CSV.
+func (s *Service) ExportActivity(ctx context.Context, params ExportActivityParams) ([]byte, error) {
+	eventType, err := eventTypeFilter(params.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := s.getMemberRound(ctx, params.RoundID, params.User)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.store.ExportRoundActivity(ctx, sqlc.ExportRoundActivityParams{
+		RoundID:   round.ID,
+		EventType: eventType,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to export round activity")
+		return nil, err
+	}
+
+	currency := ""
+	if round.CurrencySymbol != nil {
+		currency = *round.CurrencySymbol
+	}
+
+	// Amounts are in whole tokens when the round's token is registered, and
+	// in base units otherwise. amount_base_units is always exact.
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{
+		"timestamp", "type", "status", "period", "address", "display_name",
+		"amount", "currency", "transaction_hash", "block_number", "counterparty",
+		"amount_base_units",
+	})
+	for _, r := range rows {
+		item := ActivityItemFromRow(r)
+		amount, symbol := item.Amount, currency
+		if formatted, tokenSymbol, ok := s.tokens.Display(round.ChainID, round.TokenAddress, item.Amount); ok {
+			amount, symbol = formatted, tokenSymbol
+		}
+		displayName := ""
+		if item.DisplayName != nil {
+			displayName = *item.DisplayName
+		}
+		counterparty := ""
+		if item.Counterparty != nil {
+			counterparty = *item.Counterparty
+		}
+		w.Write([]string{
+			item.Timestamp.UTC().Format(time.RFC3339),
+			item.Type,
+			item.Status,
+			strconv.FormatInt(item.Period, 10),
+			item.Address,
+			csvSafe(displayName),
+			amount,
+			csvSafe(symbol),
+			item.TxHash,
+			strconv.FormatInt(item.BlockNumber, 10),
+			counterparty,
+			item.Amount,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// eventTypeFilter maps an API activity type to the indexed event type. A nil
+// type matches every event.
+func eventTypeFilter(activityType *string) (*string, error) {
+	if activityType == nil {
+		return nil, nil
+	}
+	eventType, ok := activityEventTypes[*activityType]
+	if !ok {
+		return nil, errors.ErrInvalidActivityType
+	}
+	return &eventType, nil
+}
+
+// ActivityItemFromRow converts an indexed event row to an activity item.
+func ActivityItemFromRow(r sqlc.ExportRoundActivityRow) ActivityItem {
+	activityType := ActivityPayment
+	switch r.EventType {
+	case indexer.EventPayout:
+		activityType = ActivityPayout
+	case indexer.EventSwap:
+		activityType = ActivitySwap
+	}
+	status := ActivityStatusPending
+	if r.Confirmed {
+		status = ActivityStatusConfirmed
+	}
+	return ActivityItem{
+		ID:           r.ID,
+		Type:         activityType,
+		Status:       status,
+		Address:      r.Address,
+		DisplayName:  r.DisplayName,
+		Counterparty: r.Counterparty,
+		Amount:       r.Amount,
+		TxHash:       r.TxHash,
+		BlockNumber:  r.BlockNumber,
+		Period:       r.Period,
+		Timestamp:    r.BlockTime.Time,
+	}
+}
+
+// FormatActivityAmount sets the item's FormattedAmount from the token of the
+// round it belongs to.
+func FormatActivityAmount(item *ActivityItem, registry *tokens.Registry, chainID int64, token, currencySymbol *string) {
+	if item.Type == ActivitySwap {
+		return
+	}
+	item.FormattedAmount = registry.FormatAmount(chainID, token, item.Amount, currencySymbol)
+}
+
+// csvSafe stops spreadsheet applications from evaluating user-controlled
+// text, such as display names, as formulas.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
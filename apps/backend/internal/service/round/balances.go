@@ -0,0 +1,74 @@
+package round
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/ledger"
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// GetRoundBalances returns the pot and every member's position from the
+// round's ledger account balances. Only accepted members of the round's
+// group may read it.
+func (s *Service) GetRoundBalances(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*Balances, error) {
+	round, err := s.getMemberRound(ctx, roundID, user)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.ListRoundMembers(ctx, round.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round members")
+		return nil, err
+	}
+
+	accounts, err := ledger.LoadBalances(ctx, s.store, round.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load round ledger balances")
+		return nil, err
+	}
+
+	amount, ok := new(big.Int).SetString(round.ContributionAmount, 10)
+	if !ok {
+		amount = new(big.Int)
+	}
+	due := periodsDue(*round, time.Now())
+
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].PayoutPosition < members[j].PayoutPosition
+	})
+	balances := &Balances{
+		Pot:     accounts.Balance(ledger.Pot()),
+		Totals:  accounts.Totals(),
+		Members: make([]MemberBalance, 0, len(members)),
+	}
+	for _, m := range members {
+		address := strings.ToLower(m.Address)
+		position := accounts.Position(address)
+		balances.Members = append(balances.Members, MemberBalance{
+			Address:  address,
+			Position: position,
+			Arrears:  position.Arrears(due, amount),
+		})
+	}
+	return balances, nil
+}
+
+// periodsDue is the number of periods whose end has passed, each of which
+// every member owes a contribution for.
+func periodsDue(r sqlc.Round, now time.Time) int64 {
+	if r.Status == StatusCompleted {
+		return int64(r.MemberCount)
+	}
+	if !r.StartedAt.Valid || r.PeriodDurationSeconds < 1 || now.Before(r.StartedAt.Time) {
+		return 0
+	}
+	elapsed := int64(now.Sub(r.StartedAt.Time) / (time.Duration(r.PeriodDurationSeconds) * time.Second))
+	return min(elapsed, int64(r.MemberCount))
+}
@@ -0,0 +1,204 @@
+package round
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/ledger"
+	"circa/internal/prices"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusActive    = "active"
+	StatusCompleted = "completed"
+	StatusPaused    = "paused"
+	StatusDefaulted = "defaulted"
+	StatusCancelled = "cancelled"
+)
+
+// Trigger is what causes a round status transition. It is recorded as the
+// cause in the round's status history.
+type Trigger string
+
+const (
+	// TriggerContributionIndexed fires when the first contribution of a
+	// round is indexed.
+	TriggerContributionIndexed Trigger = "contribution_indexed"
+	// TriggerFinalPayoutIndexed fires when every member has been paid out.
+	TriggerFinalPayoutIndexed Trigger = "final_payout_indexed"
+
+	TriggerOwnerPause   Trigger = "owner_pause"
+	TriggerOwnerResume  Trigger = "owner_resume"
+	TriggerOwnerCancel  Trigger = "owner_cancel"
+	TriggerOwnerDefault Trigger = "owner_default"
+)
+
+// Owner actions accepted by TransitionRound.
+const (
+	ActionPause   = "pause"
+	ActionResume  = "resume"
+	ActionCancel  = "cancel"
+	ActionDefault = "default"
+)
+
+type TransitionRoundParams struct {
+	RoundID uuid.UUID
+	Actor   sqlc.User
+	Action  string
+	Reason  *string
+}
+
+type CreateRoundParams struct {
+	GroupID               uuid.UUID
+	Actor                 sqlc.User
+	ChainID               int64
+	ContractAddress       string
+	ContributionAmount    string
+	CurrencySymbol        *string
+	PeriodDurationSeconds int64
+	// DeploymentBlock is where the round's events are indexed from. When
+	// nil, indexing starts at the chain's last confirmed block.
+	DeploymentBlock *uint64
+}
+
+// FieldError is a rejected request field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// VerificationError lists every request field that is invalid or does not
+// match the deployed contract. It matches ErrRoundVerificationFailed with
+// errors.Is.
+type VerificationError struct {
+	Fields []FieldError
+}
+
+func (e *VerificationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", f.Field, f.Message))
+	}
+	return fmt.Sprintf("%s (%s)", circaerrors.ErrRoundVerificationFailed, strings.Join(parts, "; "))
+}
+
+func (e *VerificationError) Unwrap() error {
+	return circaerrors.ErrRoundVerificationFailed
+}
+
+const (
+	ActivityPayment = "payment"
+	ActivityPayout  = "payout"
+	ActivitySwap    = "swap"
+
+	ActivityStatusPending   = "pending"
+	ActivityStatusConfirmed = "confirmed"
+)
+
+// ActivityItem is an indexed payment, payout or slot swap. Counterparty is
+// set for swaps only.
+type ActivityItem struct {
+	ID           uuid.UUID
+	Type         string
+	Status       string
+	Address      string
+	DisplayName  *string
+	Counterparty *string
+	Amount       string
+	// FormattedAmount is Amount with the round's token decimals and symbol,
+	// such as "12.5 USDC". Empty for swaps, which move no money.
+	FormattedAmount string
+	TxHash          string
+	BlockNumber     int64
+	Period          int64
+	Timestamp       time.Time
+}
+
+type ListActivityParams struct {
+	RoundID uuid.UUID
+	User    sqlc.User
+	Type    *string
+	Limit   *int
+	Cursor  *string
+}
+
+type ListActivityResult struct {
+	Items      []ActivityItem
+	NextCursor *string
+}
+
+type ExportActivityParams struct {
+	RoundID uuid.UUID
+	User    sqlc.User
+	Type    *string
+}
+
+// RoundSummary is a round with the aggregates shown in listings.
+type RoundSummary struct {
+	Round     sqlc.Round
+	GroupName string
+	// CurrentPeriod is nil before the round starts and after its last period.
+	CurrentPeriod *int
+	// PaidCount is the number of members that have paid the current period.
+	PaidCount *int
+}
+
+// RoundDetail is a round with every aggregate shown on its page. The fiat
+// amounts are in the user's currency and nil when they cannot be priced;
+// each contribution in TotalContributedFiat is priced when it was made.
+type RoundDetail struct {
+	RoundSummary
+	NextPayoutAddress      *string
+	TotalContributed       *string
+	ContributionAmountFiat *prices.Amount
+	TotalContributedFiat   *prices.Amount
+}
+
+type ListRoundsParams struct {
+	User    sqlc.User
+	Status  *string
+	GroupID *uuid.UUID
+	Limit   *int
+	Cursor  *string
+}
+
+type ListRoundsResult struct {
+	Items      []RoundSummary
+	NextCursor *string
+}
+
+// Balances are a round's ledger balances. Members lists every round member,
+// in payout order, including those with no entries yet.
+type Balances struct {
+	Pot     *big.Int
+	Totals  ledger.Position
+	Members []MemberBalance
+}
+
+// MemberBalance is a member's ledger position with the contributions they
+// are behind on.
+type MemberBalance struct {
+	Address string
+	ledger.Position
+	Arrears *big.Int
+}
+
+type RoundService interface {
+	CreateRound(ctx context.Context, params CreateRoundParams) (*sqlc.Round, error)
+	ListRounds(ctx context.Context, params ListRoundsParams) (*ListRoundsResult, error)
+	GetRound(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*RoundDetail, error)
+	// TransitionRound applies an owner action to the round's status.
+	TransitionRound(ctx context.Context, params TransitionRoundParams) (*sqlc.Round, error)
+	GetRoundPeriods(ctx context.Context, roundID uuid.UUID, user sqlc.User) ([]Period, error)
+	GetRoundBalances(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*Balances, error)
+	ListActivity(ctx context.Context, params ListActivityParams) (*ListActivityResult, error)
+	// ExportActivity returns the round's activity in chain order as CSV.
+	ExportActivity(ctx context.Context, params ExportActivityParams) ([]byte, error)
+}
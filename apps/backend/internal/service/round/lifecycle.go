@@ -0,0 +1,203 @@
+package round
+
+import (
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// transitions is the round lifecycle. A round starts pending, becomes active
+// with its first contribution and completes once every member has been paid
+// out. The group owner can pause and resume an active round, cancel a round
+// that has not started or is paused, and declare a round defaulted when
+// members stop paying. Completed, defaulted and cancelled are final.
+//
+// The chain is the source of truth, so a paused round still completes when
+// its last payout is indexed.
+var transitions = map[string]map[Trigger]string{
+	StatusPending: {
+		TriggerContributionIndexed: StatusActive,
+		TriggerOwnerCancel:         StatusCancelled,
+	},
+	StatusActive: {
+		TriggerFinalPayoutIndexed: StatusCompleted,
+		TriggerOwnerPause:         StatusPaused,
+		TriggerOwnerDefault:       StatusDefaulted,
+	},
+	StatusPaused: {
+		TriggerFinalPayoutIndexed: StatusCompleted,
+		TriggerOwnerResume:        StatusActive,
+		TriggerOwnerCancel:        StatusCancelled,
+		TriggerOwnerDefault:       StatusDefaulted,
+	},
+}
+
+var ownerActions = map[string]Trigger{
+	ActionPause:   TriggerOwnerPause,
+	ActionResume:  TriggerOwnerResume,
+	ActionCancel:  TriggerOwnerCancel,
+	ActionDefault: TriggerOwnerDefault,
+}
+
+// NextStatus returns the status a round in status from moves to when
+// trigger fires, or ErrInvalidRoundTransition when the lifecycle does not
+// allow it.
+func NextStatus(from string, trigger Trigger) (string, error) {
+	to, ok := transitions[from][trigger]
+	if !ok {
+		return "", fmt.Errorf("%w: %s on a %s round", errors.ErrInvalidRoundTransition, trigger, from)
+	}
+	return to, nil
+}
+
+// IsFinal reports whether a round in status can no longer change status.
+func IsFinal(status string) bool {
+	return status == StatusCompleted || status == StatusDefaulted || status == StatusCancelled
+}
+
+// TransitionRound applies an owner action to a round. Only the owner of the
+// round's group may change its status.
+func (s *Service) TransitionRound(ctx context.Context, params TransitionRoundParams) (*sqlc.Round, error) {
+	trigger, ok := ownerActions[params.Action]
+	if !ok {
+		return nil, errors.ErrInvalidRoundAction
+	}
+
+	round, err := s.store.GetRoundByID(ctx, params.RoundID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrRoundNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get round")
+		return nil, err
+	}
+
+	g, err := s.store.GetGroupByID(ctx, round.GroupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrRoundNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group")
+		return nil, err
+	}
+	if g.OwnerID != params.Actor.ID {
+		return nil, errors.ErrNotGroupOwner
+	}
+
+	pgxStore, ok := s.store.(*db.PGXStore)
+	if !ok {
+		return nil, errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to begin transaction")
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	updated, err := transition(ctx, pgxStore.Queries.WithTx(tx), round, trigger, statusChange{
+		ActorID: pgtype.UUID{Bytes: params.Actor.ID, Valid: true},
+		Reason:  params.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to commit transaction")
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+	return &updated, nil
+}
+
+// SyncStatus advances a round's status from its indexed events: the first
+// contribution activates it and the last payout completes it. The indexer
+// calls it inside the transaction that stored the events, after the round's
+// counters were synced. Events that the lifecycle does not allow to move the
+// round, such as contributions to a cancelled round, leave it unchanged.
+func (s *Service) SyncStatus(ctx context.Context, q sqlc.Querier, roundID uuid.UUID) error {
+	round, err := q.GetRoundByID(ctx, roundID)
+	if err != nil {
+		return err
+	}
+
+	if round.Status == StatusPending && round.StartedAt.Valid {
+		if round, err = transition(ctx, q, round, TriggerContributionIndexed, statusChange{}); err != nil {
+			return err
+		}
+	}
+
+	if (round.Status == StatusActive || round.Status == StatusPaused) && round.MemberCount > 0 {
+		// Every member has been paid out once no next payout is left.
+		_, err := q.GetNextPayoutAddress(ctx, round.ID)
+		if err == nil {
+			return nil
+		}
+		if err != pgx.ErrNoRows {
+			return err
+		}
+		_, err = transition(ctx, q, round, TriggerFinalPayoutIndexed, statusChange{})
+		return err
+	}
+	return nil
+}
+
+// statusChange is who asked for a transition, and why. Both are empty for
+// transitions driven by indexed events.
+type statusChange struct {
+	ActorID pgtype.UUID
+	Reason  *string
+}
+
+// transition moves a round along the lifecycle and records the change in
+// its status history. It fails with ErrInvalidRoundTransition when the round
+// changed status concurrently.
+func transition(ctx context.Context, q sqlc.Querier, round sqlc.Round, trigger Trigger, change statusChange) (sqlc.Round, error) {
+	to, err := NextStatus(round.Status, trigger)
+	if err != nil {
+		return round, err
+	}
+
+	updated, err := q.UpdateRoundStatus(ctx, sqlc.UpdateRoundStatusParams{
+		ToStatus:   to,
+		ID:         round.ID,
+		FromStatus: round.Status,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return round, fmt.Errorf("%w: round status changed concurrently", errors.ErrInvalidRoundTransition)
+		}
+		log.Error().Err(err).Msg("Failed to update round status")
+		return round, err
+	}
+
+	if _, err := q.CreateRoundStatusHistory(ctx, sqlc.CreateRoundStatusHistoryParams{
+		RoundID:    round.ID,
+		FromStatus: round.Status,
+		ToStatus:   to,
+		Cause:      string(trigger),
+		ActorID:    change.ActorID,
+		Reason:     change.Reason,
+	}); err != nil {
+		log.Error().Err(err).Msg("Failed to record round status history")
+		return round, err
+	}
+
+	log.Info().
+		Str("round_id", round.ID.String()).
+		Str("from_status", round.Status).
+		Str("to_status", to).
+		Str("cause", string(trigger)).
+		Msg("Round status changed")
+	return updated, nil
+}
@@ -0,0 +1,241 @@
+package round
+
+import (
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	allStatuses = []string{StatusPending, StatusActive, StatusPaused, StatusCompleted, StatusDefaulted, StatusCancelled}
+	allTriggers = []Trigger{
+		TriggerContributionIndexed, TriggerFinalPayoutIndexed,
+		TriggerOwnerPause, TriggerOwnerResume, TriggerOwnerCancel, TriggerOwnerDefault,
+	}
+)
+
+func TestNextStatus(t *testing.T) {
+	// Every allowed edge of the lifecycle. Any other status and trigger pair
+	// must be rejected.
+	allowed := []struct {
+		from    string
+		trigger Trigger
+		to      string
+	}{
+		{from: StatusPending, trigger: TriggerContributionIndexed, to: StatusActive},
+		{from: StatusPending, trigger: TriggerOwnerCancel, to: StatusCancelled},
+		{from: StatusActive, trigger: TriggerFinalPayoutIndexed, to: StatusCompleted},
+		{from: StatusActive, trigger: TriggerOwnerPause, to: StatusPaused},
+		{from: StatusActive, trigger: TriggerOwnerDefault, to: StatusDefaulted},
+		{from: StatusPaused, trigger: TriggerFinalPayoutIndexed, to: StatusCompleted},
+		{from: StatusPaused, trigger: TriggerOwnerResume, to: StatusActive},
+		{from: StatusPaused, trigger: TriggerOwnerCancel, to: StatusCancelled},
+		{from: StatusPaused, trigger: TriggerOwnerDefault, to: StatusDefaulted},
+	}
+
+	expected := map[string]map[Trigger]string{}
+	for _, edge := range allowed {
+		if expected[edge.from] == nil {
+			expected[edge.from] = map[Trigger]string{}
+		}
+		expected[edge.from][edge.trigger] = edge.to
+	}
+
+	for _, from := range allStatuses {
+		for _, trigger := range allTriggers {
+			to, legal := expected[from][trigger]
+			t.Run(fmt.Sprintf("%s on %s", trigger, from), func(t *testing.T) {
+				next, err := NextStatus(from, trigger)
+				if legal {
+					require.NoError(t, err)
+					assert.Equal(t, to, next)
+				} else {
+					assert.ErrorIs(t, err, circaerrors.ErrInvalidRoundTransition)
+					assert.Empty(t, next)
+				}
+			})
+		}
+	}
+}
+
+func TestIsFinal(t *testing.T) {
+	for _, status := range allStatuses {
+		t.Run(status, func(t *testing.T) {
+			// A status is final exactly when nothing leads out of it.
+			assert.Equal(t, len(transitions[status]) == 0, IsFinal(status))
+		})
+	}
+}
+
+func TestService_SyncStatus(t *testing.T) {
+	startedAt := pgtype.Timestamp{Time: time.Now().Add(-time.Hour), Valid: true}
+
+	tests := []struct {
+		name          string
+		round         sqlc.Round
+		setupMocks    func(*dbmocks.MockStore, sqlc.Round)
+		expectedError error
+	}{
+		{
+			name:  "success - pending round without contributions stays pending",
+			round: sqlc.Round{Status: StatusPending, MemberCount: 2},
+		},
+		{
+			name:  "success - first contribution activates the round",
+			round: sqlc.Round{Status: StatusPending, StartedAt: startedAt, MemberCount: 2},
+			setupMocks: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				expectTransition(ms, r, StatusActive, TriggerContributionIndexed)
+				ms.On("GetNextPayoutAddress", mock.Anything, r.ID).Return(memberAddress, nil)
+			},
+		},
+		{
+			name:  "success - active round with payouts left stays active",
+			round: sqlc.Round{Status: StatusActive, StartedAt: startedAt, MemberCount: 2},
+			setupMocks: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				ms.On("GetNextPayoutAddress", mock.Anything, r.ID).Return(memberAddress, nil)
+			},
+		},
+		{
+			name:  "success - last payout completes the round",
+			round: sqlc.Round{Status: StatusActive, StartedAt: startedAt, MemberCount: 2},
+			setupMocks: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				ms.On("GetNextPayoutAddress", mock.Anything, r.ID).Return("", pgx.ErrNoRows)
+				expectTransition(ms, r, StatusCompleted, TriggerFinalPayoutIndexed)
+			},
+		},
+		{
+			name:  "success - last payout completes a paused round",
+			round: sqlc.Round{Status: StatusPaused, StartedAt: startedAt, MemberCount: 2},
+			setupMocks: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				ms.On("GetNextPayoutAddress", mock.Anything, r.ID).Return("", pgx.ErrNoRows)
+				expectTransition(ms, r, StatusCompleted, TriggerFinalPayoutIndexed)
+			},
+		},
+		{
+			name:  "success - cancelled round ignores contributions",
+			round: sqlc.Round{Status: StatusCancelled, StartedAt: startedAt, MemberCount: 2},
+		},
+		{
+			name:  "error - status changed concurrently",
+			round: sqlc.Round{Status: StatusPending, StartedAt: startedAt, MemberCount: 2},
+			setupMocks: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				ms.On("UpdateRoundStatus", mock.Anything, mock.Anything).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrInvalidRoundTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.round
+			r.ID = uuid.New()
+
+			mockStore := dbmocks.NewMockStore(t)
+			mockStore.On("GetRoundByID", mock.Anything, r.ID).Return(r, nil)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStore, r)
+			}
+
+			service := NewService(mockStore, nil, nil, nil, Verification{}, nil, nil, nil)
+			err := service.SyncStatus(context.Background(), mockStore, r.ID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_TransitionRound(t *testing.T) {
+	owner := createTestUser(ownerAddress)
+	group := createTestGroup(owner.ID)
+	r := sqlc.Round{ID: uuid.New(), GroupID: group.ID, Status: StatusActive}
+
+	tests := []struct {
+		name          string
+		params        TransitionRoundParams
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+	}{
+		{
+			name:          "error - unknown action",
+			params:        TransitionRoundParams{RoundID: r.ID, Actor: owner, Action: "complete"},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidRoundAction,
+		},
+		{
+			name:   "error - round not found",
+			params: TransitionRoundParams{RoundID: r.ID, Actor: owner, Action: ActionPause},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, r.ID).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrRoundNotFound,
+		},
+		{
+			name:   "error - actor is not the group owner",
+			params: TransitionRoundParams{RoundID: r.ID, Actor: createTestUser(memberAddress), Action: ActionPause},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, r.ID).Return(r, nil)
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+			},
+			expectedError: circaerrors.ErrNotGroupOwner,
+		},
+		{
+			name:   "error - database failure",
+			params: TransitionRoundParams{RoundID: r.ID, Actor: owner, Action: ActionPause},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, r.ID).Return(sqlc.Round{}, errors.New("db down"))
+			},
+			expectedError: errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, nil, nil, nil, Verification{}, nil, nil, nil)
+			updated, err := service.TransitionRound(context.Background(), tt.params)
+
+			require.EqualError(t, err, tt.expectedError.Error())
+			assert.Nil(t, updated)
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+// expectTransition expects r to move to status, recorded with trigger as
+// the cause and no actor.
+func expectTransition(ms *dbmocks.MockStore, r sqlc.Round, status string, trigger Trigger) {
+	updated := r
+	updated.Status = status
+	ms.On("UpdateRoundStatus", mock.Anything, sqlc.UpdateRoundStatusParams{
+		ToStatus:   status,
+		ID:         r.ID,
+		FromStatus: r.Status,
+	}).Return(updated, nil).Once()
+	ms.On("CreateRoundStatusHistory", mock.Anything, sqlc.CreateRoundStatusHistoryParams{
+		RoundID:    r.ID,
+		FromStatus: r.Status,
+		ToStatus:   status,
+		Cause:      string(trigger),
+	}).Return(sqlc.RoundStatusHistory{}, nil).Once()
+}
@@ -0,0 +1,160 @@
+package round
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/indexer"
+	"circa/internal/service/delinquency"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Period is the derived state of one contribution period. Periods are
+// 0-indexed and there is one per round member.
+type Period struct {
+	Number int
+	Status string
+	// Start and End are nil until the round clock has started.
+	Start         *time.Time
+	End           *time.Time
+	PaidAddresses []string
+	// LateAddresses and MissedAddresses list members flagged as overdue who
+	// have not contributed since.
+	LateAddresses         []string
+	MissedAddresses       []string
+	PayoutAddress         *string
+	PayoutTransactionHash *string
+}
+
+// ComputePeriods derives every period of a round from its members and indexed
+// events as of now. It has no side effects, so the period rules can be
+// tested without a database or a chain.
+//
+// Delinquency flags are applied on top: a member flagged missed is listed as
+// missed, otherwise as late, unless their contribution has been indexed.
+//
+// A period is completed once its payout has been indexed or its end time has
+// passed, active while now is inside it, and pending otherwise. Until a payout
+// is indexed, the payout address is the member whose payout position matches
+// the period.
+func ComputePeriods(r sqlc.Round, members []sqlc.RoundMember, events []sqlc.RoundEvent, delinquencies []sqlc.RoundDelinquency, now time.Time) []Period {
+	order := append([]sqlc.RoundMember(nil), members...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].PayoutPosition < order[j].PayoutPosition
+	})
+
+	ordered := append([]sqlc.RoundEvent(nil), events...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].BlockNumber != ordered[j].BlockNumber {
+			return ordered[i].BlockNumber < ordered[j].BlockNumber
+		}
+		return ordered[i].LogIndex < ordered[j].LogIndex
+	})
+
+	periods := make([]Period, len(order))
+	paid := make([]map[string]bool, len(order))
+	for i, m := range order {
+		address := strings.ToLower(m.Address)
+		periods[i] = Period{
+			Number:          i,
+			PaidAddresses:   []string{},
+			LateAddresses:   []string{},
+			MissedAddresses: []string{},
+			PayoutAddress:   &address,
+		}
+		paid[i] = map[string]bool{}
+	}
+
+	for _, e := range ordered {
+		if e.Period < 0 || e.Period >= int64(len(periods)) {
+			continue
+		}
+		p := &periods[e.Period]
+		address := strings.ToLower(e.Address)
+		switch e.EventType {
+		case indexer.EventContribution:
+			if !paid[e.Period][address] {
+				paid[e.Period][address] = true
+				p.PaidAddresses = append(p.PaidAddresses, address)
+			}
+		case indexer.EventPayout:
+			if p.PayoutTransactionHash == nil {
+				txHash := e.TxHash
+				p.PayoutAddress = &address
+				p.PayoutTransactionHash = &txHash
+			}
+		}
+	}
+
+	flags := make([]map[string]string, len(periods))
+	for _, d := range delinquencies {
+		if d.Period < 0 || d.Period >= int64(len(periods)) {
+			continue
+		}
+		address := strings.ToLower(d.Address)
+		if paid[d.Period][address] {
+			continue
+		}
+		if flags[d.Period] == nil {
+			flags[d.Period] = map[string]string{}
+		}
+		if flags[d.Period][address] != delinquency.StatusMissed {
+			flags[d.Period][address] = d.Status
+		}
+	}
+	for i, byAddress := range flags {
+		addresses := make([]string, 0, len(byAddress))
+		for address := range byAddress {
+			addresses = append(addresses, address)
+		}
+		sort.Strings(addresses)
+		for _, address := range addresses {
+			if byAddress[address] == delinquency.StatusMissed {
+				periods[i].MissedAddresses = append(periods[i].MissedAddresses, address)
+			} else {
+				periods[i].LateAddresses = append(periods[i].LateAddresses, address)
+			}
+		}
+	}
+
+	duration := time.Duration(r.PeriodDurationSeconds) * time.Second
+	for i := range periods {
+		p := &periods[i]
+		if r.StartedAt.Valid {
+			start := r.StartedAt.Time.Add(time.Duration(i) * duration)
+			end := start.Add(duration)
+			p.Start = &start
+			p.End = &end
+		}
+		p.Status = periodStatus(r, *p, now)
+	}
+
+	return periods
+}
+
+func periodStatus(r sqlc.Round, p Period, now time.Time) string {
+	switch {
+	case r.Status == StatusCompleted, p.PayoutTransactionHash != nil:
+		return StatusCompleted
+	case p.Start == nil, now.Before(*p.Start):
+		return StatusPending
+	case now.Before(*p.End):
+		return StatusActive
+	default:
+		return StatusCompleted
+	}
+}
+
+// CurrentPeriod returns the period the round clock is in at now, or nil
+// before the round starts, after its last period and once it has ended.
+func CurrentPeriod(r sqlc.Round, now time.Time) *int {
+	if !r.StartedAt.Valid || IsFinal(r.Status) || r.PeriodDurationSeconds < 1 || now.Before(r.StartedAt.Time) {
+		return nil
+	}
+	duration := time.Duration(r.PeriodDurationSeconds) * time.Second
+	period := int(now.Sub(r.StartedAt.Time) / duration)
+	if period >= int(r.MemberCount) {
+		return nil
+	}
+	return &period
+}
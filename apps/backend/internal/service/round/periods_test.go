@@ -0,0 +1,341 @@
+package round
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/indexer"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alice = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	bob   = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	carol = "0xcccccccccccccccccccccccccccccccccccccccc"
+)
+
+func TestComputePeriods(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	roundID := uuid.New()
+
+	startedRound := sqlc.Round{
+		ID:                    roundID,
+		PeriodDurationSeconds: int64(week / time.Second),
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: start, Valid: true},
+	}
+	notStarted := startedRound
+	notStarted.Status = StatusPending
+	notStarted.StartedAt = pgtype.Timestamp{}
+	completedRound := startedRound
+	completedRound.Status = StatusCompleted
+
+	// Deliberately out of payout order to check the sort.
+	members := []sqlc.RoundMember{
+		{RoundID: roundID, Address: carol, PayoutPosition: 2},
+		{RoundID: roundID, Address: alice, PayoutPosition: 0},
+		{RoundID: roundID, Address: bob, PayoutPosition: 1},
+	}
+
+	tests := []struct {
+		name     string
+		round    sqlc.Round
+		events   []sqlc.RoundEvent
+		now      time.Time
+		expected []expectedPeriod
+	}{
+		{
+			name:  "round not started - every period pending without times",
+			round: notStarted,
+			now:   start,
+			expected: []expectedPeriod{
+				{status: StatusPending, payout: alice, noTimes: true},
+				{status: StatusPending, payout: bob, noTimes: true},
+				{status: StatusPending, payout: carol, noTimes: true},
+			},
+		},
+		{
+			name:  "first instant of the round - first period active",
+			round: startedRound,
+			now:   start,
+			expected: []expectedPeriod{
+				{status: StatusActive, payout: alice},
+				{status: StatusPending, payout: bob},
+				{status: StatusPending, payout: carol},
+			},
+		},
+		{
+			name:  "period end is exclusive",
+			round: startedRound,
+			now:   start.Add(week),
+			expected: []expectedPeriod{
+				{status: StatusCompleted, payout: alice},
+				{status: StatusActive, payout: bob},
+				{status: StatusPending, payout: carol},
+			},
+		},
+		{
+			name:  "contributions are grouped by period and deduplicated",
+			round: startedRound,
+			now:   start.Add(time.Hour),
+			events: []sqlc.RoundEvent{
+				contribution(bob, 0, 10, 0),
+				contribution("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", 0, 10, 1),
+				contribution(bob, 0, 11, 0),
+				contribution(carol, 1, 12, 0),
+			},
+			expected: []expectedPeriod{
+				{status: StatusActive, payout: alice, paid: []string{bob, alice}},
+				{status: StatusPending, payout: bob, paid: []string{carol}},
+				{status: StatusPending, payout: carol},
+			},
+		},
+		{
+			name:  "paid addresses follow chain order, not input order",
+			round: startedRound,
+			now:   start.Add(time.Hour),
+			events: []sqlc.RoundEvent{
+				contribution(carol, 0, 12, 0),
+				contribution(alice, 0, 11, 3),
+				contribution(bob, 0, 11, 1),
+			},
+			expected: []expectedPeriod{
+				{status: StatusActive, payout: alice, paid: []string{bob, alice, carol}},
+				{status: StatusPending, payout: bob},
+				{status: StatusPending, payout: carol},
+			},
+		},
+		{
+			name:  "indexed payout completes the period early and overrides the planned recipient",
+			round: startedRound,
+			now:   start.Add(time.Hour),
+			events: []sqlc.RoundEvent{
+				contribution(alice, 0, 10, 0),
+				contribution(bob, 0, 10, 1),
+				contribution(carol, 0, 10, 2),
+				payout(bob, 0, 11, 0, "0xpayout0"),
+				payout(carol, 0, 12, 0, "0xpayout-duplicate"),
+			},
+			expected: []expectedPeriod{
+				{status: StatusCompleted, payout: bob, txHash: "0xpayout0", paid: []string{alice, bob, carol}},
+				{status: StatusPending, payout: bob},
+				{status: StatusPending, payout: carol},
+			},
+		},
+		{
+			name:  "events outside the member count are ignored",
+			round: startedRound,
+			now:   start.Add(time.Hour),
+			events: []sqlc.RoundEvent{
+				contribution(alice, 3, 10, 0),
+				contribution(alice, -1, 10, 1),
+				payout(alice, 7, 10, 2, "0xstray"),
+			},
+			expected: []expectedPeriod{
+				{status: StatusActive, payout: alice},
+				{status: StatusPending, payout: bob},
+				{status: StatusPending, payout: carol},
+			},
+		},
+		{
+			name:  "every period elapsed",
+			round: startedRound,
+			now:   start.Add(3 * week),
+			expected: []expectedPeriod{
+				{status: StatusCompleted, payout: alice},
+				{status: StatusCompleted, payout: bob},
+				{status: StatusCompleted, payout: carol},
+			},
+		},
+		{
+			name:  "completed round completes every period",
+			round: completedRound,
+			now:   start,
+			expected: []expectedPeriod{
+				{status: StatusCompleted, payout: alice},
+				{status: StatusCompleted, payout: bob},
+				{status: StatusCompleted, payout: carol},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := ComputePeriods(tt.round, members, tt.events, nil, tt.now)
+			require.Len(t, periods, len(tt.expected))
+
+			for i, expected := range tt.expected {
+				p := periods[i]
+				assert.Equal(t, i, p.Number)
+				assert.Equal(t, expected.status, p.Status, "period %d status", i)
+
+				require.NotNil(t, p.PayoutAddress)
+				assert.Equal(t, expected.payout, *p.PayoutAddress, "period %d payout address", i)
+
+				if expected.txHash == "" {
+					assert.Nil(t, p.PayoutTransactionHash, "period %d payout tx", i)
+				} else {
+					require.NotNil(t, p.PayoutTransactionHash)
+					assert.Equal(t, expected.txHash, *p.PayoutTransactionHash)
+				}
+
+				paid := expected.paid
+				if paid == nil {
+					paid = []string{}
+				}
+				assert.Equal(t, paid, p.PaidAddresses, "period %d paid addresses", i)
+
+				if expected.noTimes {
+					assert.Nil(t, p.Start)
+					assert.Nil(t, p.End)
+				} else {
+					require.NotNil(t, p.Start)
+					require.NotNil(t, p.End)
+					assert.Equal(t, start.Add(time.Duration(i)*week), *p.Start)
+					assert.Equal(t, start.Add(time.Duration(i+1)*week), *p.End)
+				}
+			}
+		})
+	}
+}
+
+func TestComputePeriods_NoMembers(t *testing.T) {
+	periods := ComputePeriods(sqlc.Round{Status: StatusPending}, nil, []sqlc.RoundEvent{contribution(alice, 0, 1, 0)}, nil, time.Now())
+	assert.Empty(t, periods)
+}
+
+func TestComputePeriods_Delinquencies(t *testing.T) {
+	roundID := uuid.New()
+	members := []sqlc.RoundMember{
+		{RoundID: roundID, Address: alice, PayoutPosition: 0},
+		{RoundID: roundID, Address: bob, PayoutPosition: 1},
+		{RoundID: roundID, Address: carol, PayoutPosition: 2},
+	}
+	events := []sqlc.RoundEvent{
+		contribution(alice, 0, 1, 0),
+		contribution(bob, 0, 5, 0),
+	}
+	delinquencies := []sqlc.RoundDelinquency{
+		{RoundID: roundID, Address: bob, Period: 0, Status: "late"},
+		{RoundID: roundID, Address: carol, Period: 0, Status: "late"},
+		{RoundID: roundID, Address: carol, Period: 0, Status: "missed"},
+		{RoundID: roundID, Address: "0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB", Period: 1, Status: "late"},
+		{RoundID: roundID, Address: alice, Period: 7, Status: "missed"},
+	}
+
+	periods := ComputePeriods(sqlc.Round{Status: StatusActive}, members, events, delinquencies, time.Now())
+	require.Len(t, periods, 3)
+
+	// Bob paid after being flagged late, so only carol is still overdue.
+	assert.Empty(t, periods[0].LateAddresses)
+	assert.Equal(t, []string{carol}, periods[0].MissedAddresses)
+	assert.Equal(t, []string{bob}, periods[1].LateAddresses)
+	assert.Empty(t, periods[1].MissedAddresses)
+	assert.Empty(t, periods[2].LateAddresses)
+	assert.Empty(t, periods[2].MissedAddresses)
+}
+
+func TestCurrentPeriod(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	r := sqlc.Round{
+		PeriodDurationSeconds: 3600,
+		MemberCount:           3,
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: start, Valid: true},
+	}
+	completed := r
+	completed.Status = StatusCompleted
+	notStarted := r
+	notStarted.StartedAt = pgtype.Timestamp{}
+
+	tests := []struct {
+		name     string
+		round    sqlc.Round
+		now      time.Time
+		expected *int
+	}{
+		{name: "not started", round: notStarted, now: start, expected: nil},
+		{name: "before start", round: r, now: start.Add(-time.Second), expected: nil},
+		{name: "first instant", round: r, now: start, expected: intPtr(0)},
+		{name: "last instant of the first period", round: r, now: start.Add(time.Hour - time.Nanosecond), expected: intPtr(0)},
+		{name: "second period", round: r, now: start.Add(time.Hour), expected: intPtr(1)},
+		{name: "last period", round: r, now: start.Add(150 * time.Minute), expected: intPtr(2)},
+		{name: "after the last period", round: r, now: start.Add(3 * time.Hour), expected: nil},
+		{name: "completed round", round: completed, now: start, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CurrentPeriod(tt.round, tt.now))
+		})
+	}
+}
+
+func TestPeriodsDue(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := sqlc.Round{
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: start, Valid: true},
+		PeriodDurationSeconds: 3600,
+		MemberCount:           3,
+	}
+	completed := r
+	completed.Status = StatusCompleted
+	notStarted := r
+	notStarted.StartedAt = pgtype.Timestamp{}
+
+	tests := []struct {
+		name     string
+		round    sqlc.Round
+		now      time.Time
+		expected int64
+	}{
+		{name: "not started", round: notStarted, now: start, expected: 0},
+		{name: "first period", round: r, now: start.Add(30 * time.Minute), expected: 0},
+		{name: "first period ended", round: r, now: start.Add(time.Hour), expected: 1},
+		{name: "after the last period", round: r, now: start.Add(5 * time.Hour), expected: 3},
+		{name: "completed round", round: completed, now: start, expected: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, periodsDue(tt.round, tt.now))
+		})
+	}
+}
+
+type expectedPeriod struct {
+	status  string
+	payout  string
+	txHash  string
+	paid    []string
+	noTimes bool
+}
+
+func contribution(address string, period, block int64, logIndex int32) sqlc.RoundEvent {
+	return sqlc.RoundEvent{
+		EventType:   indexer.EventContribution,
+		Address:     address,
+		Period:      period,
+		Amount:      "1000000",
+		BlockNumber: block,
+		LogIndex:    logIndex,
+	}
+}
+
+func payout(address string, period, block int64, logIndex int32, txHash string) sqlc.RoundEvent {
+	return sqlc.RoundEvent{
+		EventType:   indexer.EventPayout,
+		Address:     address,
+		Period:      period,
+		Amount:      "3000000",
+		BlockNumber: block,
+		LogIndex:    logIndex,
+		TxHash:      txHash,
+	}
+}
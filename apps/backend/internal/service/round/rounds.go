@@ -0,0 +1,208 @@
+package round
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/ledger"
+	"circa/internal/pagination"
+	"circa/internal/prices"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var roundStatuses = map[string]bool{
+	StatusPending:   true,
+	StatusActive:    true,
+	StatusCompleted: true,
+	StatusPaused:    true,
+	StatusDefaulted: true,
+	StatusCancelled: true,
+}
+
+// periodKey identifies a period of a round in the period totals.
+type periodKey struct {
+	roundID uuid.UUID
+	period  int64
+}
+
+// ListRounds returns the rounds of every group the user is an accepted member
+// of, newest first.
+func (s *Service) ListRounds(ctx context.Context, params ListRoundsParams) (*ListRoundsResult, error) {
+	if params.Status != nil && !roundStatuses[*params.Status] {
+		return nil, errors.ErrInvalidRoundStatus
+	}
+
+	limit, err := pagination.Limit(params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.paginator.Decode(params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	cursorCreatedAt, err := cursor.Timestamp()
+	if err != nil {
+		return nil, err
+	}
+
+	var groupID pgtype.UUID
+	if params.GroupID != nil {
+		groupID = pgtype.UUID{Bytes: *params.GroupID, Valid: true}
+	}
+
+	rows, err := s.store.ListUserRounds(ctx, sqlc.ListUserRoundsParams{
+		UserID:          params.User.ID,
+		Status:          params.Status,
+		GroupID:         groupID,
+		CursorCreatedAt: cursorCreatedAt,
+		CursorID:        cursor.UUID(),
+		PageSize:        pagination.PageSize(limit),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list user rounds")
+		return nil, err
+	}
+
+	rows, next := pagination.Paginate(s.paginator, rows, limit, func(r sqlc.ListUserRoundsRow) pagination.Cursor {
+		return pagination.AtTime(r.CreatedAt.Time, r.ID)
+	})
+
+	roundIDs := make([]uuid.UUID, 0, len(rows))
+	for _, r := range rows {
+		roundIDs = append(roundIDs, r.ID)
+	}
+	totals, err := s.periodTotals(ctx, roundIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	items := make([]RoundSummary, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, summarize(sqlc.Round{
+			ID:                    r.ID,
+			GroupID:               r.GroupID,
+			ChainID:               r.ChainID,
+			ContractAddress:       r.ContractAddress,
+			ContributionAmount:    r.ContributionAmount,
+			CurrencySymbol:        r.CurrencySymbol,
+			TokenAddress:          r.TokenAddress,
+			PeriodDurationSeconds: r.PeriodDurationSeconds,
+			Status:                r.Status,
+			StartedAt:             r.StartedAt,
+			CreatedAt:             r.CreatedAt,
+			MemberCount:           r.MemberCount,
+		}, r.GroupName, totals, now))
+	}
+
+	return &ListRoundsResult{
+		Items:      items,
+		NextCursor: next,
+	}, nil
+}
+
+// GetRound returns a round with its aggregates. Only accepted members of the
+// round's group may read it.
+func (s *Service) GetRound(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*RoundDetail, error) {
+	round, err := s.getMemberRound(ctx, roundID, user)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := s.store.GetGroupByID(ctx, round.GroupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrRoundNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group")
+		return nil, err
+	}
+
+	totals, err := s.periodTotals(ctx, []uuid.UUID{round.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := ledger.LoadBalances(ctx, s.store, round.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load round ledger balances")
+		return nil, err
+	}
+	contributed := balances.Totals().Contributed.String()
+
+	detail := &RoundDetail{
+		RoundSummary:     summarize(*round, g.Name, totals, time.Now()),
+		TotalContributed: &contributed,
+	}
+
+	next, err := s.store.GetNextPayoutAddress(ctx, round.ID)
+	if err == nil {
+		detail.NextPayoutAddress = &next
+	} else if err != pgx.ErrNoRows {
+		log.Error().Err(err).Msg("Failed to get next payout address")
+		return nil, err
+	}
+
+	if currency := s.prices.Currency(user.PreferredCurrency); currency != "" && s.prices.Supports(round.ChainID, round.TokenAddress) {
+		detail.ContributionAmountFiat, _ = s.prices.Convert(ctx, round.ChainID, round.TokenAddress, round.ContributionAmount, currency, time.Now())
+		detail.TotalContributedFiat, err = s.contributedFiat(ctx, round, currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return detail, nil
+}
+
+// contributedFiat prices the contributions to a round at the hour they were
+// made, from the ledger's hourly totals, and sums them.
+func (s *Service) contributedFiat(ctx context.Context, round *sqlc.Round, currency string) (*prices.Amount, error) {
+	rows, err := s.store.ListRoundHourlyContributions(ctx, round.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round hourly contributions")
+		return nil, err
+	}
+
+	amounts := make([]prices.Dated, 0, len(rows))
+	for _, r := range rows {
+		amounts = append(amounts, prices.Dated{Amount: r.Amount, At: r.Hour.Time})
+	}
+	total, _ := s.prices.Total(ctx, round.ChainID, round.TokenAddress, currency, amounts)
+	return total, nil
+}
+
+func (s *Service) periodTotals(ctx context.Context, roundIDs []uuid.UUID) (map[periodKey]int, error) {
+	totals := make(map[periodKey]int)
+	if len(roundIDs) == 0 {
+		return totals, nil
+	}
+
+	rows, err := s.store.ListRoundPeriodTotals(ctx, roundIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round period totals")
+		return nil, err
+	}
+	for _, row := range rows {
+		totals[periodKey{roundID: row.RoundID, period: row.Period}] = int(row.PaidCount)
+	}
+	return totals, nil
+}
+
+func summarize(r sqlc.Round, groupName string, totals map[periodKey]int, now time.Time) RoundSummary {
+	summary := RoundSummary{
+		Round:         r,
+		GroupName:     groupName,
+		CurrentPeriod: CurrentPeriod(r, now),
+	}
+	if summary.CurrentPeriod != nil {
+		paid := totals[periodKey{roundID: r.ID, period: int64(*summary.CurrentPeriod)}]
+		summary.PaidCount = &paid
+	}
+	return summary
+}
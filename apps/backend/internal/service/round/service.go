@@ -0,0 +1,513 @@
+package round
+
+import (
+	"circa/internal/contracts"
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/pagination"
+	"circa/internal/prices"
+	"circa/internal/service/group"
+	"circa/internal/tokens"
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// Verification lists what a contract must match to be registered as a
+// round: either its runtime bytecode hash or the factory that deployed it.
+// Factories are keyed by chain ID, since a factory address on one chain says
+// nothing about the same address on another.
+type Verification struct {
+	CodeHashes []common.Hash
+	Factories  map[int64][]common.Address
+}
+
+type Service struct {
+	store      db.Store
+	paginator  *pagination.Paginator
+	callers    map[int64]contracts.Caller
+	chains     map[int64]indexer.Chain
+	codeHashes map[common.Hash]bool
+	factories  map[int64]map[common.Address]bool
+	tokens     *tokens.Registry
+	prices     *prices.Converter
+	cache      indexer.Invalidator
+}
+
+// NewService creates a round service. The cache is invalidated after writes
+// that change what dashboards show, and may be nil.
+func NewService(store db.Store, paginator *pagination.Paginator, callers map[int64]contracts.Caller, chains map[int64]indexer.Chain, verification Verification, registry *tokens.Registry, converter *prices.Converter, cache indexer.Invalidator) *Service {
+	s := &Service{
+		store:      store,
+		paginator:  paginator,
+		callers:    callers,
+		chains:     chains,
+		codeHashes: make(map[common.Hash]bool),
+		factories:  make(map[int64]map[common.Address]bool),
+		tokens:     registry,
+		prices:     converter,
+		cache:      cache,
+	}
+	for _, hash := range verification.CodeHashes {
+		s.codeHashes[hash] = true
+	}
+	for chainID, factories := range verification.Factories {
+		s.factories[chainID] = make(map[common.Address]bool)
+		for _, factory := range factories {
+			s.factories[chainID][factory] = true
+		}
+	}
+	return s
+}
+
+// CreateRound registers a deployed Round contract for a group. The contract
+// is read on-chain and must be a known Round whose members, contribution
+// amount and period duration match the group and the request.
+func (s *Service) CreateRound(ctx context.Context, params CreateRoundParams) (*sqlc.Round, error) {
+	amount, fieldErrs := validateParams(params)
+	if len(fieldErrs) > 0 {
+		return nil, &VerificationError{Fields: fieldErrs}
+	}
+	address := common.HexToAddress(params.ContractAddress)
+	contractKey := strings.ToLower(address.Hex())
+
+	g, err := s.store.GetGroupByID(ctx, params.GroupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrGroupNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group")
+		return nil, err
+	}
+	if g.ArchivedAt.Valid {
+		return nil, errors.ErrGroupArchived
+	}
+
+	member, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: g.ID,
+		Address: strings.ToLower(params.Actor.Address),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrNotGroupMember
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return nil, err
+	}
+	if member.Status != group.StatusAccepted {
+		return nil, errors.ErrNotGroupMember
+	}
+
+	if _, err := s.store.GetRoundByContract(ctx, sqlc.GetRoundByContractParams{
+		ChainID:         params.ChainID,
+		ContractAddress: contractKey,
+	}); err == nil {
+		return nil, errors.ErrRoundAlreadyExists
+	} else if err != pgx.ErrNoRows {
+		log.Error().Err(err).Msg("Failed to get round by contract")
+		return nil, err
+	}
+
+	state, err := s.verifyContract(ctx, params, address, amount, g.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	startBlock, err := s.startBlock(ctx, params.ChainID, params.DeploymentBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenAddress *string
+	if state.Token != nil {
+		token := strings.ToLower(state.Token.Hex())
+		tokenAddress = &token
+	}
+
+	return s.createRound(ctx, sqlc.CreateRoundParams{
+		GroupID:               g.ID,
+		ChainID:               params.ChainID,
+		ContractAddress:       contractKey,
+		ContributionAmount:    amount.String(),
+		CurrencySymbol:        params.CurrencySymbol,
+		PeriodDurationSeconds: params.PeriodDurationSeconds,
+		MemberCount:           int32(len(state.Members)),
+		TokenAddress:          tokenAddress,
+		StartBlock:            int64(startBlock),
+	}, state.Members)
+}
+
+// startBlock returns the block a round registered by hand is indexed from:
+// its deployment block when given, otherwise the chain's last confirmed
+// block.
+func (s *Service) startBlock(ctx context.Context, chainID int64, deploymentBlock *uint64) (uint64, error) {
+	chain, ok := s.chains[chainID]
+	if !ok {
+		return 0, fieldError("chainId", "chain is not supported")
+	}
+
+	head, err := chain.Client.BlockNumber(ctx)
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", chainID).Msg("Failed to get block number")
+		return 0, err
+	}
+
+	if deploymentBlock != nil {
+		if *deploymentBlock > head {
+			return 0, fieldError("deploymentBlock", "block has not been mined yet")
+		}
+		return *deploymentBlock, nil
+	}
+
+	confirmations := max(chain.Confirmations, 1)
+	if head+1 < confirmations {
+		return 0, nil
+	}
+	return head + 1 - confirmations, nil
+}
+
+// CreateDiscoveredRound registers a Round contract that a known factory
+// deployed for a group, with the contribution amount and period duration
+// read from the contract. Its members must be the group's accepted
+// members. The currency symbol is the registered token's, if any. The
+// round is indexed from the block it was deployed in.
+func (s *Service) CreateDiscoveredRound(ctx context.Context, chainID int64, factory, address common.Address, groupID uuid.UUID, deploymentBlock uint64) (*sqlc.Round, error) {
+	contractKey := strings.ToLower(address.Hex())
+
+	g, err := s.store.GetGroupByID(ctx, groupID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrGroupNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get group")
+		return nil, err
+	}
+	if g.ArchivedAt.Valid {
+		return nil, errors.ErrGroupArchived
+	}
+
+	if _, err := s.store.GetRoundByContract(ctx, sqlc.GetRoundByContractParams{
+		ChainID:         chainID,
+		ContractAddress: contractKey,
+	}); err == nil {
+		return nil, errors.ErrRoundAlreadyExists
+	} else if err != pgx.ErrNoRows {
+		log.Error().Err(err).Msg("Failed to get round by contract")
+		return nil, err
+	}
+
+	caller, ok := s.callers[chainID]
+	if !ok {
+		return nil, errors.ErrUnsupportedChain
+	}
+	state, err := contracts.ReadRound(ctx, caller, address)
+	if err != nil {
+		log.Error().Err(err).Str("contract_address", address.Hex()).Msg("Failed to read discovered round")
+		return nil, err
+	}
+	if !s.factories[chainID][factory] || state.Factory == nil || *state.Factory != factory {
+		return nil, fieldError("contractAddress", "contract was not deployed by a known factory")
+	}
+	if !state.PeriodDuration.IsInt64() || state.PeriodDuration.Sign() <= 0 {
+		return nil, fieldError("periodDurationSeconds", "contract period duration is out of range")
+	}
+
+	groupMembers, err := s.store.ListAcceptedGroupMemberAddresses(ctx, g.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list group members")
+		return nil, err
+	}
+	if !sameMembers(state.Members, groupMembers) {
+		return nil, fieldError("contractAddress", "contract members do not match the group's accepted members")
+	}
+
+	var tokenAddress, currencySymbol *string
+	if state.Token != nil {
+		token := strings.ToLower(state.Token.Hex())
+		tokenAddress = &token
+		if registered, ok := s.tokens.Lookup(chainID, token); ok {
+			currencySymbol = &registered.Symbol
+		}
+	}
+
+	return s.createRound(ctx, sqlc.CreateRoundParams{
+		GroupID:               g.ID,
+		ChainID:               chainID,
+		ContractAddress:       contractKey,
+		ContributionAmount:    state.ContributionAmount.String(),
+		CurrencySymbol:        currencySymbol,
+		PeriodDurationSeconds: state.PeriodDuration.Int64(),
+		MemberCount:           int32(len(state.Members)),
+		TokenAddress:          tokenAddress,
+		StartBlock:            int64(deploymentBlock),
+	}, state.Members)
+}
+
+// createRound stores a round and its members. The contract's member order
+// is the payout order.
+func (s *Service) createRound(ctx context.Context, params sqlc.CreateRoundParams, members []common.Address) (*sqlc.Round, error) {
+	pgxStore, ok := s.store.(*db.PGXStore)
+	if !ok {
+		return nil, errors.ErrInvalidStore
+	}
+
+	tx, err := pgxStore.GetDB().Begin(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to begin transaction")
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	round, err := insertRound(ctx, pgxStore.Queries.WithTx(tx), params, members)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to commit transaction")
+		return nil, err
+	}
+
+	log.Info().
+		Str("round_id", round.ID.String()).
+		Str("group_id", round.GroupID.String()).
+		Int64("chain_id", round.ChainID).
+		Str("contract_address", round.ContractAddress).
+		Msg("Round created")
+
+	s.invalidate(ctx)
+	return &round, nil
+}
+
+// invalidate drops cached dashboards after a committed write. Failures are
+// logged rather than returned, and the dashboards expire on their own.
+func (s *Service) invalidate(ctx context.Context) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Invalidate(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to invalidate dashboards")
+	}
+}
+
+// insertRound inserts a round and its members. A contract registered
+// concurrently, by hand or by factory discovery, fails the unique
+// (chain_id, contract_address) constraint and is reported as already
+// existing.
+func insertRound(ctx context.Context, q sqlc.Querier, params sqlc.CreateRoundParams, members []common.Address) (sqlc.Round, error) {
+	round, err := q.CreateRound(ctx, params)
+	if err != nil {
+		if db.IsUniqueViolation(err) {
+			return sqlc.Round{}, errors.ErrRoundAlreadyExists
+		}
+		log.Error().Err(err).Msg("Failed to create round")
+		return sqlc.Round{}, err
+	}
+
+	for i, m := range members {
+		if err := q.CreateRoundMember(ctx, sqlc.CreateRoundMemberParams{
+			RoundID:        round.ID,
+			Address:        strings.ToLower(m.Hex()),
+			PayoutPosition: int32(i),
+		}); err != nil {
+			log.Error().Err(err).Msg("Failed to create round member")
+			return sqlc.Round{}, err
+		}
+	}
+	return round, nil
+}
+
+// GetRoundPeriods returns the derived state of every period of a round. Only
+// accepted members of the round's group may read it.
+func (s *Service) GetRoundPeriods(ctx context.Context, roundID uuid.UUID, user sqlc.User) ([]Period, error) {
+	round, err := s.getMemberRound(ctx, roundID, user)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.ListRoundMembers(ctx, round.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round members")
+		return nil, err
+	}
+
+	events, err := s.store.ListRoundEvents(ctx, round.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round events")
+		return nil, err
+	}
+
+	delinquencies, err := s.store.ListRoundDelinquencies(ctx, round.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round delinquencies")
+		return nil, err
+	}
+
+	return ComputePeriods(*round, members, events, delinquencies, time.Now()), nil
+}
+
+// getMemberRound loads a round and checks that the user is an accepted member
+// of its group.
+func (s *Service) getMemberRound(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*sqlc.Round, error) {
+	round, err := s.store.GetRoundByID(ctx, roundID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrRoundNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get round")
+		return nil, err
+	}
+
+	member, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: round.GroupID,
+		Address: strings.ToLower(user.Address),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrNotGroupMember
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return nil, err
+	}
+	if member.Status != group.StatusAccepted {
+		return nil, errors.ErrNotGroupMember
+	}
+
+	return &round, nil
+}
+
+// verifyContract reads the contract and checks it against the request and
+// the group's accepted members.
+func (s *Service) verifyContract(ctx context.Context, params CreateRoundParams, address common.Address, amount *big.Int, groupID uuid.UUID) (*contracts.RoundState, error) {
+	caller, ok := s.callers[params.ChainID]
+	if !ok {
+		return nil, fieldError("chainId", "chain is not supported")
+	}
+
+	code, err := caller.CodeAt(ctx, address, nil)
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", params.ChainID).Msg("Failed to get contract code")
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fieldError("contractAddress", "no contract is deployed at this address")
+	}
+
+	state, err := contracts.ReadRound(ctx, caller, address)
+	if err != nil {
+		log.Warn().Err(err).Str("contract_address", address.Hex()).Msg("Contract is not a Round")
+		return nil, fieldError("contractAddress", "contract does not implement the Round interface")
+	}
+
+	if !s.codeHashes[crypto.Keccak256Hash(code)] {
+		deployed, err := s.deployedByFactory(ctx, params.ChainID, caller, state.Factory, address)
+		if err != nil {
+			return nil, err
+		}
+		if !deployed {
+			return nil, fieldError("contractAddress", "contract is not a known Circa round")
+		}
+	}
+
+	var fields []FieldError
+
+	groupMembers, err := s.store.ListAcceptedGroupMemberAddresses(ctx, groupID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list group members")
+		return nil, err
+	}
+	if !sameMembers(state.Members, groupMembers) {
+		fields = append(fields, FieldError{
+			Field:   "contractAddress",
+			Message: "contract members do not match the group's accepted members",
+		})
+	}
+
+	if state.ContributionAmount.Cmp(amount) != 0 {
+		fields = append(fields, FieldError{
+			Field:   "contributionAmount",
+			Message: "does not match the contract contribution amount " + state.ContributionAmount.String(),
+		})
+	}
+
+	if !state.PeriodDuration.IsInt64() || state.PeriodDuration.Int64() != params.PeriodDurationSeconds {
+		fields = append(fields, FieldError{
+			Field:   "periodDurationSeconds",
+			Message: "does not match the contract period duration " + state.PeriodDuration.String(),
+		})
+	}
+
+	if len(fields) > 0 {
+		return nil, &VerificationError{Fields: fields}
+	}
+	return state, nil
+}
+
+// deployedByFactory reports whether the factory a round names is a known
+// one on the round's chain and lists the round as its own deployment. The
+// round's factory() only says which factory to ask, since any contract can
+// return a known address.
+func (s *Service) deployedByFactory(ctx context.Context, chainID int64, caller contracts.Caller, factory *common.Address, address common.Address) (bool, error) {
+	if factory == nil || !s.factories[chainID][*factory] {
+		return false, nil
+	}
+	deployed, err := contracts.IsFactoryRound(ctx, caller, *factory, address)
+	if err != nil {
+		log.Error().Err(err).Str("factory", factory.Hex()).Msg("Failed to check factory deployment")
+		return false, err
+	}
+	return deployed, nil
+}
+
+func validateParams(params CreateRoundParams) (*big.Int, []FieldError) {
+	var fields []FieldError
+
+	if !common.IsHexAddress(params.ContractAddress) {
+		fields = append(fields, FieldError{Field: "contractAddress", Message: "must be a 0x-prefixed address"})
+	}
+
+	amount, ok := new(big.Int).SetString(params.ContributionAmount, 10)
+	if !ok || amount.Sign() <= 0 {
+		fields = append(fields, FieldError{Field: "contributionAmount", Message: "must be a positive integer in smallest units"})
+	}
+
+	if params.PeriodDurationSeconds < 1 {
+		fields = append(fields, FieldError{Field: "periodDurationSeconds", Message: "must be at least 1"})
+	}
+
+	return amount, fields
+}
+
+// sameMembers reports whether the contract members are exactly the group
+// members, ignoring order and address case. Duplicate contract members never
+// match.
+func sameMembers(contractMembers []common.Address, groupMembers []string) bool {
+	if len(contractMembers) != len(groupMembers) {
+		return false
+	}
+	remaining := make(map[string]bool, len(groupMembers))
+	for _, m := range groupMembers {
+		remaining[strings.ToLower(m)] = true
+	}
+	for _, m := range contractMembers {
+		key := strings.ToLower(m.Hex())
+		if !remaining[key] {
+			return false
+		}
+		delete(remaining, key)
+	}
+	return len(remaining) == 0
+}
+
+func fieldError(field, message string) error {
+	return &VerificationError{Fields: []FieldError{{Field: field, Message: message}}}
+}
@@ -0,0 +1,1193 @@
+package round
+
+import (
+	"bytes"
+	"circa/internal/contracts"
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/indexer"
+	"circa/internal/ledger"
+	"circa/internal/pagination"
+	"circa/internal/prices"
+	"circa/internal/tokens"
+	"context"
+	"encoding/csv"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID         = 31337
+	testHead            = 1000
+	testContractAddress = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	ownerAddress        = "0x1111111111111111111111111111111111111111"
+	memberAddress       = "0x2222222222222222222222222222222222222222"
+)
+
+var (
+	roundCode    = []byte{0x60, 0x80, 0x60, 0x40}
+	knownFactory = common.HexToAddress("0x9999999999999999999999999999999999999999")
+)
+
+func TestService_CreateRound(t *testing.T) {
+	owner := createTestUser(ownerAddress)
+	group := createTestGroup(owner.ID)
+	validParams := CreateRoundParams{
+		GroupID:               group.ID,
+		Actor:                 owner,
+		ChainID:               testChainID,
+		ContractAddress:       testContractAddress,
+		ContributionAmount:    "1000000",
+		PeriodDurationSeconds: 604800,
+	}
+	members := []common.Address{common.HexToAddress(memberAddress), common.HexToAddress(ownerAddress)}
+
+	// happyStore sets up a group whose accepted members match the contract.
+	happyStore := func(ms *dbmocks.MockStore) {
+		ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+		ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+		ms.On("GetRoundByContract", mock.Anything, sqlc.GetRoundByContractParams{
+			ChainID:         testChainID,
+			ContractAddress: strings.ToLower(testContractAddress),
+		}).Return(sqlc.Round{}, pgx.ErrNoRows)
+		ms.On("ListAcceptedGroupMemberAddresses", mock.Anything, group.ID).Return([]string{ownerAddress, memberAddress}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		params         func() CreateRoundParams
+		caller         *fakeCaller
+		verification   Verification
+		setupMocks     func(*dbmocks.MockStore)
+		expectedError  error
+		expectedFields []string
+	}{
+		{
+			name: "error - invalid request fields",
+			params: func() CreateRoundParams {
+				p := validParams
+				p.ContractAddress = "not-an-address"
+				p.ContributionAmount = "0"
+				p.PeriodDurationSeconds = 0
+				return p
+			},
+			setupMocks:     func(ms *dbmocks.MockStore) {},
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress", "contributionAmount", "periodDurationSeconds"},
+		},
+		{
+			name:   "error - group not found",
+			params: func() CreateRoundParams { return validParams },
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(sqlc.Group{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrGroupNotFound,
+		},
+		{
+			name:   "error - caller is not a member",
+			params: func() CreateRoundParams { return validParams },
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+		{
+			name:   "error - contract already registered",
+			params: func() CreateRoundParams { return validParams },
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+				ms.On("GetRoundByContract", mock.Anything, mock.Anything).Return(sqlc.Round{ID: uuid.New()}, nil)
+			},
+			expectedError: circaerrors.ErrRoundAlreadyExists,
+		},
+		{
+			name: "error - unsupported chain",
+			params: func() CreateRoundParams {
+				p := validParams
+				p.ChainID = 1
+				return p
+			},
+			caller: &fakeCaller{code: roundCode},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+				ms.On("GetRoundByContract", mock.Anything, mock.Anything).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"chainId"},
+		},
+		{
+			name:   "error - no code at address",
+			params: func() CreateRoundParams { return validParams },
+			caller: &fakeCaller{},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+				ms.On("GetRoundByContract", mock.Anything, mock.Anything).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress"},
+		},
+		{
+			name:   "error - unknown bytecode and factory",
+			params: func() CreateRoundParams { return validParams },
+			caller: &fakeCaller{code: roundCode, members: members, amount: big.NewInt(1000000), period: big.NewInt(604800)},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+				ms.On("GetRoundByContract", mock.Anything, mock.Anything).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress"},
+		},
+		{
+			name:   "error - contract does not match request and group",
+			params: func() CreateRoundParams { return validParams },
+			caller: &fakeCaller{
+				code:     roundCode,
+				members:  []common.Address{common.HexToAddress(ownerAddress)},
+				amount:   big.NewInt(5),
+				period:   big.NewInt(86400),
+				factory:  &knownFactory,
+				deployed: []common.Address{common.HexToAddress(testContractAddress)},
+			},
+			verification:   Verification{Factories: map[int64][]common.Address{testChainID: {knownFactory}}},
+			setupMocks:     happyStore,
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress", "contributionAmount", "periodDurationSeconds"},
+		},
+		{
+			name:         "error - contract names a known factory that did not deploy it",
+			params:       func() CreateRoundParams { return validParams },
+			caller:       &fakeCaller{code: roundCode, members: members, amount: big.NewInt(1000000), period: big.NewInt(604800), factory: &knownFactory},
+			verification: Verification{Factories: map[int64][]common.Address{testChainID: {knownFactory}}},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+				ms.On("GetRoundByContract", mock.Anything, mock.Anything).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress"},
+		},
+		{
+			name:   "error - factory is only known on another chain",
+			params: func() CreateRoundParams { return validParams },
+			caller: &fakeCaller{
+				code:     roundCode,
+				members:  members,
+				amount:   big.NewInt(1000000),
+				period:   big.NewInt(604800),
+				factory:  &knownFactory,
+				deployed: []common.Address{common.HexToAddress(testContractAddress)},
+			},
+			verification: Verification{Factories: map[int64][]common.Address{1: {knownFactory}}},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+				ms.On("GetRoundByContract", mock.Anything, mock.Anything).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress"},
+		},
+		{
+			name:   "valid factory deployment reaches the transaction",
+			params: func() CreateRoundParams { return validParams },
+			caller: &fakeCaller{
+				code:     roundCode,
+				members:  members,
+				amount:   big.NewInt(1000000),
+				period:   big.NewInt(604800),
+				factory:  &knownFactory,
+				deployed: []common.Address{common.HexToAddress(testContractAddress)},
+			},
+			verification:  Verification{Factories: map[int64][]common.Address{testChainID: {knownFactory}}},
+			setupMocks:    happyStore,
+			expectedError: circaerrors.ErrInvalidStore,
+		},
+		{
+			name: "error - deployment block not mined yet",
+			params: func() CreateRoundParams {
+				p := validParams
+				block := uint64(testHead + 1)
+				p.DeploymentBlock = &block
+				return p
+			},
+			caller:         &fakeCaller{code: roundCode, members: members, amount: big.NewInt(1000000), period: big.NewInt(604800)},
+			verification:   Verification{CodeHashes: []common.Hash{crypto.Keccak256Hash(roundCode)}},
+			setupMocks:     happyStore,
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"deploymentBlock"},
+		},
+		{
+			name:          "valid bytecode hash reaches the transaction",
+			params:        func() CreateRoundParams { return validParams },
+			caller:        &fakeCaller{code: roundCode, members: members, amount: big.NewInt(1000000), period: big.NewInt(604800)},
+			verification:  Verification{CodeHashes: []common.Hash{crypto.Keccak256Hash(roundCode)}},
+			setupMocks:    happyStore,
+			expectedError: circaerrors.ErrInvalidStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			callers := map[int64]contracts.Caller{}
+			if tt.caller != nil {
+				callers[testChainID] = tt.caller
+			}
+			chains := map[int64]indexer.Chain{testChainID: {Client: fakeChain{head: testHead}}}
+
+			service := NewService(mockStore, pagination.New("secret"), callers, chains, tt.verification, nil, nil, nil)
+			round, err := service.CreateRound(context.Background(), tt.params())
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Nil(t, round)
+			if tt.expectedFields != nil {
+				var verificationErr *VerificationError
+				require.True(t, errors.As(err, &verificationErr))
+				fields := make([]string, 0, len(verificationErr.Fields))
+				for _, f := range verificationErr.Fields {
+					fields = append(fields, f.Field)
+				}
+				assert.ElementsMatch(t, tt.expectedFields, fields)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_CreateDiscoveredRound(t *testing.T) {
+	owner := createTestUser(ownerAddress)
+	group := createTestGroup(owner.ID)
+	address := common.HexToAddress(testContractAddress)
+	members := []common.Address{common.HexToAddress(memberAddress), common.HexToAddress(ownerAddress)}
+	otherFactory := common.HexToAddress("0x00000000000000000000000000000000000000F2")
+	mainnetFactory := common.HexToAddress("0x00000000000000000000000000000000000000F3")
+
+	newRound := func(ms *dbmocks.MockStore) {
+		ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+		ms.On("GetRoundByContract", mock.Anything, sqlc.GetRoundByContractParams{
+			ChainID:         testChainID,
+			ContractAddress: strings.ToLower(testContractAddress),
+		}).Return(sqlc.Round{}, pgx.ErrNoRows)
+	}
+
+	tests := []struct {
+		name           string
+		factory        common.Address
+		caller         *fakeCaller
+		setupMocks     func(*dbmocks.MockStore)
+		expectedError  error
+		expectedFields []string
+	}{
+		{
+			name:    "error - group not found",
+			factory: knownFactory,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(sqlc.Group{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrGroupNotFound,
+		},
+		{
+			name:    "error - already registered by hand",
+			factory: knownFactory,
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("GetRoundByContract", mock.Anything, mock.Anything).Return(sqlc.Round{ID: uuid.New()}, nil)
+			},
+			expectedError: circaerrors.ErrRoundAlreadyExists,
+		},
+		{
+			name:          "error - chain not supported",
+			factory:       knownFactory,
+			setupMocks:    newRound,
+			expectedError: circaerrors.ErrUnsupportedChain,
+		},
+		{
+			name:           "error - deployed by another factory",
+			factory:        otherFactory,
+			caller:         &fakeCaller{code: roundCode, members: members, amount: big.NewInt(1000000), period: big.NewInt(604800), factory: &otherFactory},
+			setupMocks:     newRound,
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress"},
+		},
+		{
+			name:           "error - factory is only known on another chain",
+			factory:        mainnetFactory,
+			caller:         &fakeCaller{code: roundCode, members: members, amount: big.NewInt(1000000), period: big.NewInt(604800), factory: &mainnetFactory},
+			setupMocks:     newRound,
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress"},
+		},
+		{
+			name:    "error - members do not match the group",
+			factory: knownFactory,
+			caller:  &fakeCaller{code: roundCode, members: members[:1], amount: big.NewInt(1000000), period: big.NewInt(604800), factory: &knownFactory},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				newRound(ms)
+				ms.On("ListAcceptedGroupMemberAddresses", mock.Anything, group.ID).Return([]string{ownerAddress, memberAddress}, nil)
+			},
+			expectedError:  circaerrors.ErrRoundVerificationFailed,
+			expectedFields: []string{"contractAddress"},
+		},
+		{
+			name:    "valid deployment reaches the transaction",
+			factory: knownFactory,
+			caller:  &fakeCaller{code: roundCode, members: members, amount: big.NewInt(1000000), period: big.NewInt(604800), factory: &knownFactory},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				newRound(ms)
+				ms.On("ListAcceptedGroupMemberAddresses", mock.Anything, group.ID).Return([]string{ownerAddress, memberAddress}, nil)
+			},
+			expectedError: circaerrors.ErrInvalidStore,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			callers := map[int64]contracts.Caller{}
+			if tt.caller != nil {
+				callers[testChainID] = tt.caller
+			}
+
+			verification := Verification{Factories: map[int64][]common.Address{
+				testChainID: {knownFactory},
+				1:           {mainnetFactory},
+			}}
+			service := NewService(mockStore, pagination.New("secret"), callers, nil, verification, nil, nil, nil)
+			round, err := service.CreateDiscoveredRound(context.Background(), testChainID, tt.factory, address, group.ID, testHead)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Nil(t, round)
+			if tt.expectedFields != nil {
+				var verificationErr *VerificationError
+				require.True(t, errors.As(err, &verificationErr))
+				fields := make([]string, 0, len(verificationErr.Fields))
+				for _, f := range verificationErr.Fields {
+					fields = append(fields, f.Field)
+				}
+				assert.ElementsMatch(t, tt.expectedFields, fields)
+			}
+		})
+	}
+}
+
+func TestInsertRound(t *testing.T) {
+	params := sqlc.CreateRoundParams{GroupID: uuid.New(), ChainID: testChainID, ContractAddress: strings.ToLower(testContractAddress)}
+	members := []common.Address{common.HexToAddress(ownerAddress), common.HexToAddress(memberAddress)}
+
+	t.Run("members are stored in payout order", func(t *testing.T) {
+		roundID := uuid.New()
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("CreateRound", mock.Anything, params).Return(sqlc.Round{ID: roundID}, nil)
+		mockStore.On("CreateRoundMember", mock.Anything, sqlc.CreateRoundMemberParams{RoundID: roundID, Address: ownerAddress, PayoutPosition: 0}).Return(nil)
+		mockStore.On("CreateRoundMember", mock.Anything, sqlc.CreateRoundMemberParams{RoundID: roundID, Address: memberAddress, PayoutPosition: 1}).Return(nil)
+
+		round, err := insertRound(context.Background(), mockStore, params, members)
+		require.NoError(t, err)
+		assert.Equal(t, roundID, round.ID)
+	})
+
+	t.Run("contract registered concurrently", func(t *testing.T) {
+		mockStore := dbmocks.NewMockStore(t)
+		mockStore.On("CreateRound", mock.Anything, params).Return(sqlc.Round{}, &pgconn.PgError{Code: "23505"})
+
+		_, err := insertRound(context.Background(), mockStore, params, members)
+		assert.ErrorIs(t, err, circaerrors.ErrRoundAlreadyExists)
+	})
+}
+
+func TestService_StartBlock(t *testing.T) {
+	deployed := uint64(900)
+	unmined := uint64(testHead + 1)
+
+	tests := []struct {
+		name            string
+		chainID         int64
+		confirmations   uint64
+		deploymentBlock *uint64
+		expected        uint64
+		expectedFields  []string
+	}{
+		{name: "deployment block is used when given", chainID: testChainID, confirmations: 12, deploymentBlock: &deployed, expected: deployed},
+		{name: "last confirmed block otherwise", chainID: testChainID, confirmations: 12, expected: testHead - 11},
+		{name: "chain shorter than its confirmation depth", chainID: testChainID, confirmations: testHead + 5, expected: 0},
+		{name: "deployment block past the head", chainID: testChainID, deploymentBlock: &unmined, expectedFields: []string{"deploymentBlock"}},
+		{name: "unsupported chain", chainID: 1, expectedFields: []string{"chainId"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chains := map[int64]indexer.Chain{testChainID: {Client: fakeChain{head: testHead}, Confirmations: tt.confirmations}}
+			service := NewService(nil, nil, nil, chains, Verification{}, nil, nil, nil)
+
+			block, err := service.startBlock(context.Background(), tt.chainID, tt.deploymentBlock)
+			if tt.expectedFields != nil {
+				var verificationErr *VerificationError
+				require.True(t, errors.As(err, &verificationErr))
+				assert.Equal(t, tt.expectedFields[0], verificationErr.Fields[0].Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, block)
+		})
+	}
+}
+
+func TestService_GetRoundPeriods(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	round := sqlc.Round{
+		ID:                    uuid.New(),
+		GroupID:               uuid.New(),
+		PeriodDurationSeconds: 60,
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: time.Now().Add(-90 * time.Second), Valid: true},
+	}
+	member := createTestMember(round.GroupID)
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		expectedLen   int
+	}{
+		{
+			name: "success - periods derived from members and events",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, sqlc.GetActiveGroupMemberByAddressParams{
+					GroupID: round.GroupID,
+					Address: ownerAddress,
+				}).Return(member, nil)
+				ms.On("ListRoundMembers", mock.Anything, round.ID).Return([]sqlc.RoundMember{
+					{RoundID: round.ID, Address: ownerAddress, PayoutPosition: 0},
+					{RoundID: round.ID, Address: memberAddress, PayoutPosition: 1},
+				}, nil)
+				ms.On("ListRoundEvents", mock.Anything, round.ID).Return([]sqlc.RoundEvent{}, nil)
+				ms.On("ListRoundDelinquencies", mock.Anything, round.ID).Return([]sqlc.RoundDelinquency{
+					{RoundID: round.ID, Address: memberAddress, Period: 0, Status: "late"},
+				}, nil)
+			},
+			expectedLen: 2,
+		},
+		{
+			name: "error - round not found",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrRoundNotFound,
+		},
+		{
+			name: "error - not a group member",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+		{
+			name: "error - invited but not accepted",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				invited := member
+				invited.Status = "invited"
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(invited, nil)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, pagination.New("secret"), nil, nil, Verification{}, nil, nil, nil)
+			periods, err := service.GetRoundPeriods(context.Background(), round.ID, user)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, periods)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, periods, tt.expectedLen)
+				assert.Equal(t, StatusCompleted, periods[0].Status)
+				assert.Equal(t, StatusActive, periods[1].Status)
+				assert.Equal(t, []string{memberAddress}, periods[0].LateAddresses)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetRoundBalances(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	// Three hourly periods: the first has ended, the second is running.
+	round := sqlc.Round{
+		ID:                    uuid.New(),
+		GroupID:               uuid.New(),
+		ContributionAmount:    "100",
+		PeriodDurationSeconds: 3600,
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: time.Now().Add(-90 * time.Minute), Valid: true},
+		MemberCount:           3,
+	}
+	thirdAddress := "0x3333333333333333333333333333333333333333"
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+	mockStore.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(round.GroupID), nil)
+	mockStore.On("ListRoundMembers", mock.Anything, round.ID).Return([]sqlc.RoundMember{
+		{RoundID: round.ID, Address: thirdAddress, PayoutPosition: 2},
+		{RoundID: round.ID, Address: ownerAddress, PayoutPosition: 0},
+		{RoundID: round.ID, Address: memberAddress, PayoutPosition: 1},
+	}, nil)
+	mockStore.On("ListRoundLedgerAccounts", mock.Anything, round.ID).Return(ledgerAccounts(t,
+		ledger.Contribution(ownerAddress, big.NewInt(100)),
+		ledger.Contribution(memberAddress, big.NewInt(100)),
+		ledger.Payout(ownerAddress, big.NewInt(200)),
+		ledger.Contribution(memberAddress, big.NewInt(100)),
+	), nil)
+
+	service := NewService(mockStore, pagination.New("secret"), nil, nil, Verification{}, nil, nil, nil)
+	balances, err := service.GetRoundBalances(context.Background(), round.ID, user)
+	require.NoError(t, err)
+
+	assert.Equal(t, "100", balances.Pot.String())
+	assert.Equal(t, "300", balances.Totals.Contributed.String())
+	assert.Equal(t, "200", balances.Totals.Received.String())
+
+	require.Len(t, balances.Members, 3)
+	owner, member, third := balances.Members[0], balances.Members[1], balances.Members[2]
+	assert.Equal(t, ownerAddress, owner.Address)
+	assert.Equal(t, "-100", owner.Net().String(), "the owner was paid out more than they put in")
+	assert.Equal(t, "0", owner.Arrears.String())
+	assert.Equal(t, memberAddress, member.Address)
+	assert.Equal(t, "200", member.Net().String())
+	assert.Equal(t, "0", member.Arrears.String(), "paying ahead is not arrears")
+	assert.Equal(t, thirdAddress, third.Address)
+	assert.Equal(t, "0", third.Contributed.String())
+	assert.Equal(t, "100", third.Arrears.String(), "the first period has ended unpaid")
+}
+
+func TestService_ListActivity(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	round := sqlc.Round{ID: uuid.New(), GroupID: uuid.New()}
+	member := createTestMember(round.GroupID)
+	paginator := pagination.New("secret")
+	blockTime := time.Date(2026, 2, 3, 10, 0, 0, 0, time.UTC)
+	name := "Ada"
+
+	rows := []sqlc.ListRoundActivityRow{
+		{ID: uuid.New(), EventType: "payout", Address: memberAddress, Period: 0, Amount: "3000", BlockNumber: 12, LogIndex: 0, TxHash: "0xc", BlockTime: pgtype.Timestamptz{Time: blockTime, Valid: true}},
+		{ID: uuid.New(), EventType: "contribution", Address: ownerAddress, DisplayName: &name, Period: 0, Amount: "1000", BlockNumber: 11, LogIndex: 2, TxHash: "0xb", BlockTime: pgtype.Timestamptz{Time: blockTime, Valid: true}, Confirmed: true},
+		{ID: uuid.New(), EventType: "contribution", Address: memberAddress, Period: 0, Amount: "1000", BlockNumber: 11, LogIndex: 1, TxHash: "0xa", BlockTime: pgtype.Timestamptz{Time: blockTime, Valid: true}, Confirmed: true},
+	}
+	cursor := paginator.Encode(pagination.AtLog(11, 2, rows[1].ID))
+
+	allowMember := func(ms *dbmocks.MockStore) {
+		ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+		ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(member, nil)
+	}
+
+	tests := []struct {
+		name          string
+		params        ListActivityParams
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		expected      func(*testing.T, *ListActivityResult)
+	}{
+		{
+			name:   "success - first page maps events and returns a cursor",
+			params: ListActivityParams{RoundID: round.ID, User: user, Limit: intPtr(2)},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				allowMember(ms)
+				ms.On("ListRoundActivity", mock.Anything, sqlc.ListRoundActivityParams{
+					RoundID:  round.ID,
+					PageSize: 3,
+				}).Return(rows, nil)
+			},
+			expected: func(t *testing.T, result *ListActivityResult) {
+				require.Len(t, result.Items, 2)
+				assert.Equal(t, ActivityPayout, result.Items[0].Type)
+				assert.Equal(t, ActivityStatusPending, result.Items[0].Status)
+				assert.Equal(t, ActivityPayment, result.Items[1].Type)
+				assert.Equal(t, ActivityStatusConfirmed, result.Items[1].Status)
+				assert.Equal(t, &name, result.Items[1].DisplayName)
+
+				require.NotNil(t, result.NextCursor)
+				next, err := paginator.Decode(result.NextCursor)
+				require.NoError(t, err)
+				block, logIndex, err := next.Log()
+				require.NoError(t, err)
+				assert.Equal(t, int64(11), block.Int64)
+				assert.Equal(t, int32(2), logIndex.Int32)
+				assert.Equal(t, rows[1].ID, next.ID)
+			},
+		},
+		{
+			name:   "success - type filter and cursor are passed to the query",
+			params: ListActivityParams{RoundID: round.ID, User: user, Type: stringPtr(ActivityPayment), Cursor: &cursor},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				allowMember(ms)
+				ms.On("ListRoundActivity", mock.Anything, sqlc.ListRoundActivityParams{
+					RoundID:           round.ID,
+					EventType:         stringPtr("contribution"),
+					CursorBlockNumber: pgtype.Int8{Int64: 11, Valid: true},
+					CursorLogIndex:    pgtype.Int4{Int32: 2, Valid: true},
+					CursorID:          pgtype.UUID{Bytes: rows[1].ID, Valid: true},
+					PageSize:          pagination.DefaultLimit + 1,
+				}).Return(rows[2:], nil)
+			},
+			expected: func(t *testing.T, result *ListActivityResult) {
+				require.Len(t, result.Items, 1)
+				assert.Nil(t, result.NextCursor)
+			},
+		},
+		{
+			name:          "error - unknown type",
+			params:        ListActivityParams{RoundID: round.ID, User: user, Type: stringPtr("refund")},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidActivityType,
+		},
+		{
+			name:          "error - tampered cursor",
+			params:        ListActivityParams{RoundID: round.ID, User: user, Cursor: stringPtr(cursor + "x")},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidCursor,
+		},
+		{
+			name:          "error - limit out of range",
+			params:        ListActivityParams{RoundID: round.ID, User: user, Limit: intPtr(500)},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidLimit,
+		},
+		{
+			name:   "error - not a group member",
+			params: ListActivityParams{RoundID: round.ID, User: user},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, paginator, nil, nil, Verification{}, nil, nil, nil)
+			result, err := service.ListActivity(context.Background(), tt.params)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				tt.expected(t, result)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ExportActivity(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	currency := "USDC"
+	round := sqlc.Round{ID: uuid.New(), GroupID: uuid.New(), CurrencySymbol: &currency}
+	blockTime := time.Date(2026, 2, 3, 10, 0, 0, 0, time.UTC)
+	formula := "=HYPERLINK(\"http://evil\")"
+	counterparty := ownerAddress
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+	mockStore.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(round.GroupID), nil)
+	mockStore.On("ExportRoundActivity", mock.Anything, sqlc.ExportRoundActivityParams{RoundID: round.ID}).Return([]sqlc.ExportRoundActivityRow{
+		{ID: uuid.New(), EventType: "contribution", Address: ownerAddress, DisplayName: &formula, Period: 0, Amount: "1000", BlockNumber: 11, LogIndex: 1, TxHash: "0xa", BlockTime: pgtype.Timestamptz{Time: blockTime, Valid: true}, Confirmed: true},
+		{ID: uuid.New(), EventType: "payout", Address: memberAddress, Period: 0, Amount: "2000", BlockNumber: 12, LogIndex: 0, TxHash: "0xb", BlockTime: pgtype.Timestamptz{Time: blockTime.Add(time.Minute), Valid: true}},
+		{ID: uuid.New(), EventType: "swap", Address: memberAddress, Counterparty: &counterparty, Period: 1, Amount: "0", BlockNumber: 13, LogIndex: 0, TxHash: "0xc", BlockTime: pgtype.Timestamptz{Time: blockTime.Add(2 * time.Minute), Valid: true}},
+	}, nil)
+
+	service := NewService(mockStore, pagination.New("secret"), nil, nil, Verification{}, nil, nil, nil)
+	data, err := service.ExportActivity(context.Background(), ExportActivityParams{RoundID: round.ID, User: user})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{"timestamp", "type", "status", "period", "address", "display_name", "amount", "currency", "transaction_hash", "block_number", "counterparty", "amount_base_units"}, records[0])
+	assert.Equal(t, []string{"2026-02-03T10:00:00Z", "payment", "confirmed", "0", ownerAddress, "'" + formula, "1000", "USDC", "0xa", "11", "", "1000"}, records[1])
+	assert.Equal(t, []string{"2026-02-03T10:01:00Z", "payout", "pending", "0", memberAddress, "", "2000", "USDC", "0xb", "12", "", "2000"}, records[2])
+	assert.Equal(t, []string{"2026-02-03T10:02:00Z", "swap", "pending", "1", memberAddress, "", "0", "USDC", "0xc", "13", ownerAddress, "0"}, records[3])
+	mockStore.AssertExpectations(t)
+}
+
+func TestService_ExportActivityRegisteredToken(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	token := "0x0000000000000000000000000000000000000abc"
+	currency := "usd"
+	round := sqlc.Round{ID: uuid.New(), GroupID: uuid.New(), ChainID: 1, TokenAddress: &token, CurrencySymbol: &currency}
+	blockTime := time.Date(2026, 2, 3, 10, 0, 0, 0, time.UTC)
+
+	registry, err := tokens.NewRegistry([]tokens.Token{
+		{ChainID: 1, Address: common.HexToAddress(token), Symbol: "USDC", Decimals: 6},
+	})
+	require.NoError(t, err)
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+	mockStore.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(round.GroupID), nil)
+	mockStore.On("ExportRoundActivity", mock.Anything, sqlc.ExportRoundActivityParams{RoundID: round.ID}).Return([]sqlc.ExportRoundActivityRow{
+		{ID: uuid.New(), EventType: "contribution", Address: ownerAddress, Period: 0, Amount: "12500000", BlockNumber: 11, TxHash: "0xa", BlockTime: pgtype.Timestamptz{Time: blockTime, Valid: true}, Confirmed: true},
+	}, nil)
+
+	service := NewService(mockStore, pagination.New("secret"), nil, nil, Verification{}, registry, nil, nil)
+	data, err := service.ExportActivity(context.Background(), ExportActivityParams{RoundID: round.ID, User: user})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"2026-02-03T10:00:00Z", "payment", "confirmed", "0", ownerAddress, "", "12.5", "USDC", "0xa", "11", "", "12500000"}, records[1])
+}
+
+func TestService_ListRounds(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	paginator := pagination.New("secret")
+	groupID := uuid.New()
+	now := time.Now()
+
+	active := sqlc.ListUserRoundsRow{
+		ID:                    uuid.New(),
+		GroupID:               groupID,
+		GroupName:             "Friday Ajo",
+		ContributionAmount:    "1000",
+		PeriodDurationSeconds: 3600,
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: now.Add(-90 * time.Minute), Valid: true},
+		MemberCount:           3,
+		CreatedAt:             pgtype.Timestamp{Time: now, Valid: true},
+	}
+	pending := sqlc.ListUserRoundsRow{
+		ID:                    uuid.New(),
+		GroupID:               groupID,
+		GroupName:             "Friday Ajo",
+		ContributionAmount:    "1000",
+		PeriodDurationSeconds: 3600,
+		Status:                StatusPending,
+		MemberCount:           3,
+		CreatedAt:             pgtype.Timestamp{Time: now.Add(-time.Hour), Valid: true},
+	}
+
+	tests := []struct {
+		name          string
+		params        ListRoundsParams
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		expected      func(*testing.T, *ListRoundsResult)
+	}{
+		{
+			name:   "success - aggregates come from period totals",
+			params: ListRoundsParams{User: user, Status: stringPtr(StatusActive), GroupID: &groupID, Limit: intPtr(1)},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListUserRounds", mock.Anything, sqlc.ListUserRoundsParams{
+					UserID:   user.ID,
+					Status:   stringPtr(StatusActive),
+					GroupID:  pgtype.UUID{Bytes: groupID, Valid: true},
+					PageSize: 2,
+				}).Return([]sqlc.ListUserRoundsRow{active, pending}, nil)
+				ms.On("ListRoundPeriodTotals", mock.Anything, []uuid.UUID{active.ID}).Return([]sqlc.RoundPeriodTotal{
+					{RoundID: active.ID, Period: 0, PaidCount: 3},
+					{RoundID: active.ID, Period: 1, PaidCount: 2},
+				}, nil)
+			},
+			expected: func(t *testing.T, result *ListRoundsResult) {
+				require.Len(t, result.Items, 1)
+				item := result.Items[0]
+				assert.Equal(t, "Friday Ajo", item.GroupName)
+				assert.Equal(t, int32(3), item.Round.MemberCount)
+				assert.Equal(t, intPtr(1), item.CurrentPeriod)
+				assert.Equal(t, intPtr(2), item.PaidCount)
+				assert.NotNil(t, result.NextCursor)
+			},
+		},
+		{
+			name:   "success - unstarted rounds have no current period",
+			params: ListRoundsParams{User: user},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListUserRounds", mock.Anything, mock.Anything).Return([]sqlc.ListUserRoundsRow{pending}, nil)
+				ms.On("ListRoundPeriodTotals", mock.Anything, []uuid.UUID{pending.ID}).Return([]sqlc.RoundPeriodTotal{}, nil)
+			},
+			expected: func(t *testing.T, result *ListRoundsResult) {
+				require.Len(t, result.Items, 1)
+				assert.Nil(t, result.Items[0].CurrentPeriod)
+				assert.Nil(t, result.Items[0].PaidCount)
+				assert.Nil(t, result.NextCursor)
+			},
+		},
+		{
+			name:   "success - no rounds skips the totals query",
+			params: ListRoundsParams{User: user},
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("ListUserRounds", mock.Anything, mock.Anything).Return([]sqlc.ListUserRoundsRow{}, nil)
+			},
+			expected: func(t *testing.T, result *ListRoundsResult) {
+				assert.Empty(t, result.Items)
+			},
+		},
+		{
+			name:          "error - unknown status",
+			params:        ListRoundsParams{User: user, Status: stringPtr("archived")},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidRoundStatus,
+		},
+		{
+			name:          "error - invalid cursor",
+			params:        ListRoundsParams{User: user, Cursor: stringPtr("garbage")},
+			setupMocks:    func(ms *dbmocks.MockStore) {},
+			expectedError: circaerrors.ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, paginator, nil, nil, Verification{}, nil, nil, nil)
+			result, err := service.ListRounds(context.Background(), tt.params)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				tt.expected(t, result)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetRound(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	group := createTestGroup(uuid.New())
+	round := sqlc.Round{
+		ID:                    uuid.New(),
+		GroupID:               group.ID,
+		ContributionAmount:    "250000000000000000000",
+		PeriodDurationSeconds: 3600,
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: time.Now().Add(-30 * time.Minute), Valid: true},
+		MemberCount:           2,
+		ContributionCount:     3,
+	}
+	member := createTestMember(group.ID)
+	amount, _ := new(big.Int).SetString(round.ContributionAmount, 10)
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*dbmocks.MockStore)
+		expectedError error
+		expected      func(*testing.T, *RoundDetail)
+	}{
+		{
+			name: "success - detail with aggregates",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(member, nil)
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("ListRoundPeriodTotals", mock.Anything, []uuid.UUID{round.ID}).Return([]sqlc.RoundPeriodTotal{
+					{RoundID: round.ID, Period: 0, PaidCount: 1},
+				}, nil)
+				ms.On("ListRoundLedgerAccounts", mock.Anything, round.ID).Return(ledgerAccounts(t,
+					ledger.Contribution(ownerAddress, amount),
+					ledger.Contribution(memberAddress, amount),
+					ledger.Payout(memberAddress, new(big.Int).Mul(amount, big.NewInt(2))),
+					ledger.Contribution(ownerAddress, amount),
+				), nil)
+				ms.On("GetNextPayoutAddress", mock.Anything, round.ID).Return(memberAddress, nil)
+			},
+			expected: func(t *testing.T, detail *RoundDetail) {
+				assert.Equal(t, group.Name, detail.GroupName)
+				assert.Equal(t, intPtr(0), detail.CurrentPeriod)
+				assert.Equal(t, intPtr(1), detail.PaidCount)
+				assert.Equal(t, stringPtr(memberAddress), detail.NextPayoutAddress)
+				assert.Equal(t, stringPtr("750000000000000000000"), detail.TotalContributed)
+			},
+		},
+		{
+			name: "success - every member paid out",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(member, nil)
+				ms.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+				ms.On("ListRoundPeriodTotals", mock.Anything, mock.Anything).Return([]sqlc.RoundPeriodTotal{}, nil)
+				ms.On("ListRoundLedgerAccounts", mock.Anything, round.ID).Return([]sqlc.LedgerAccount{}, nil)
+				ms.On("GetNextPayoutAddress", mock.Anything, round.ID).Return("", pgx.ErrNoRows)
+			},
+			expected: func(t *testing.T, detail *RoundDetail) {
+				assert.Nil(t, detail.NextPayoutAddress)
+				assert.Equal(t, intPtr(0), detail.PaidCount)
+				assert.Equal(t, stringPtr("0"), detail.TotalContributed)
+			},
+		},
+		{
+			name: "error - round not found",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(sqlc.Round{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrRoundNotFound,
+		},
+		{
+			name: "error - not a member",
+			setupMocks: func(ms *dbmocks.MockStore) {
+				ms.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+				ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{}, pgx.ErrNoRows)
+			},
+			expectedError: circaerrors.ErrNotGroupMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := dbmocks.NewMockStore(t)
+			tt.setupMocks(mockStore)
+
+			service := NewService(mockStore, pagination.New("secret"), nil, nil, Verification{}, nil, nil, nil)
+			detail, err := service.GetRound(context.Background(), round.ID, user)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, detail)
+			} else {
+				require.NoError(t, err)
+				tt.expected(t, detail)
+			}
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+// ledgerAccounts returns the stored accounts of a round with one journal
+// entry per argument.
+func ledgerAccounts(t *testing.T, entries ...[]ledger.Posting) []sqlc.LedgerAccount {
+	journal := ledger.NewJournal()
+	for _, postings := range entries {
+		require.NoError(t, journal.Post(ledger.Entry{Postings: postings}))
+	}
+
+	accounts := []sqlc.LedgerAccount{}
+	for account, balance := range journal.Balances() {
+		accounts = append(accounts, sqlc.LedgerAccount{
+			Kind:    account.Kind,
+			Member:  account.Member,
+			Balance: balance.String(),
+		})
+	}
+	return accounts
+}
+
+// hourlyFeed prices USDC in NGN at fixed hours and at 1600 otherwise.
+type hourlyFeed map[time.Time]int64
+
+func (f hourlyFeed) Price(_ context.Context, symbol, currency string, at time.Time) (*big.Rat, error) {
+	if symbol != "USDC" || currency != "NGN" {
+		return nil, circaerrors.ErrPriceUnavailable
+	}
+	if price, ok := f[at]; ok {
+		return new(big.Rat).SetInt64(price), nil
+	}
+	return new(big.Rat).SetInt64(1600), nil
+}
+
+func TestService_GetRoundFiat(t *testing.T) {
+	user := createTestUser(ownerAddress)
+	group := createTestGroup(uuid.New())
+	token := "0x0000000000000000000000000000000000000abc"
+	round := sqlc.Round{
+		ID:                    uuid.New(),
+		GroupID:               group.ID,
+		ChainID:               1,
+		TokenAddress:          &token,
+		ContributionAmount:    "10000000",
+		PeriodDurationSeconds: 3600,
+		Status:                StatusActive,
+		StartedAt:             pgtype.Timestamp{Time: time.Now().Add(-30 * time.Minute), Valid: true},
+		MemberCount:           2,
+		ContributionCount:     2,
+	}
+	first := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	registry, err := tokens.NewRegistry([]tokens.Token{
+		{ChainID: 1, Address: common.HexToAddress(token), Symbol: "USDC", Decimals: 6},
+	})
+	require.NoError(t, err)
+	converter := prices.NewConverter(hourlyFeed{first: 1500, second: 1550}, registry, "NGN")
+
+	mockStore := dbmocks.NewMockStore(t)
+	mockStore.On("GetRoundByID", mock.Anything, round.ID).Return(round, nil)
+	mockStore.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(createTestMember(group.ID), nil)
+	mockStore.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+	mockStore.On("ListRoundPeriodTotals", mock.Anything, mock.Anything).Return([]sqlc.RoundPeriodTotal{}, nil)
+	mockStore.On("ListRoundLedgerAccounts", mock.Anything, round.ID).Return([]sqlc.LedgerAccount{}, nil)
+	mockStore.On("GetNextPayoutAddress", mock.Anything, round.ID).Return(memberAddress, nil)
+	mockStore.On("ListRoundHourlyContributions", mock.Anything, round.ID).Return([]sqlc.LedgerHourlyContribution{
+		{RoundID: round.ID, Hour: pgtype.Timestamptz{Time: first, Valid: true}, Amount: "10000000"},
+		{RoundID: round.ID, Hour: pgtype.Timestamptz{Time: second, Valid: true}, Amount: "10000000"},
+	}, nil)
+
+	service := NewService(mockStore, pagination.New("secret"), nil, nil, Verification{}, registry, converter, nil)
+	detail, err := service.GetRound(context.Background(), round.ID, user)
+	require.NoError(t, err)
+
+	require.NotNil(t, detail.ContributionAmountFiat)
+	assert.Equal(t, prices.Amount{Currency: "NGN", Value: "16000.00"}, *detail.ContributionAmountFiat)
+	require.NotNil(t, detail.TotalContributedFiat)
+	assert.Equal(t, prices.Amount{Currency: "NGN", Value: "30500.00"}, *detail.TotalContributedFiat, "each hour of contributions is priced at that hour")
+
+	// A currency the feed does not know leaves the fiat amounts out.
+	usd := "USD"
+	user.PreferredCurrency = &usd
+	detail, err = service.GetRound(context.Background(), round.ID, user)
+	require.NoError(t, err)
+	assert.Nil(t, detail.ContributionAmountFiat)
+	assert.Nil(t, detail.TotalContributedFiat)
+}
+
+func TestSameMembers(t *testing.T) {
+	a := common.HexToAddress(ownerAddress)
+	b := common.HexToAddress(memberAddress)
+
+	assert.True(t, sameMembers([]common.Address{b, a}, []string{ownerAddress, memberAddress}))
+	assert.False(t, sameMembers([]common.Address{a}, []string{ownerAddress, memberAddress}))
+	assert.False(t, sameMembers([]common.Address{a, a}, []string{ownerAddress, memberAddress}))
+	assert.False(t, sameMembers([]common.Address{a, b}, []string{ownerAddress, "0x3333333333333333333333333333333333333333"}))
+}
+
+// fakeChain is a chain whose head is fixed. It has no logs.
+type fakeChain struct {
+	head uint64
+}
+
+func (f fakeChain) BlockNumber(ctx context.Context) (uint64, error) {
+	return f.head, nil
+}
+
+func (f fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (f fakeChain) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (f fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return nil, errors.New("not found")
+}
+
+// fakeCaller answers Round view calls from fixed values.
+type fakeCaller struct {
+	code    []byte
+	members []common.Address
+	amount  *big.Int
+	period  *big.Int
+	factory *common.Address
+	// deployed lists the rounds the factory reports as its own.
+	deployed []common.Address
+}
+
+func (f *fakeCaller) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return f.code, nil
+}
+
+func (f *fakeCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if method, err := contracts.FactoryABI.MethodById(msg.Data[:4]); err == nil && method.Name == "isRound" {
+		args, err := method.Inputs.Unpack(msg.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(slices.Contains(f.deployed, args[0].(common.Address)))
+	}
+
+	method, err := contracts.RoundABI.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "members":
+		return method.Outputs.Pack(f.members)
+	case "contributionAmount":
+		return method.Outputs.Pack(f.amount)
+	case "periodDuration":
+		return method.Outputs.Pack(f.period)
+	case "factory":
+		if f.factory == nil {
+			return nil, errors.New("execution reverted")
+		}
+		return method.Outputs.Pack(*f.factory)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func createTestUser(address string) sqlc.User {
+	return sqlc.User{
+		ID:        uuid.New(),
+		Address:   address,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func createTestGroup(ownerID uuid.UUID) sqlc.Group {
+	return sqlc.Group{
+		ID:        uuid.New(),
+		Name:      "Friday Ajo",
+		OwnerID:   ownerID,
+		CreatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func createTestMember(groupID uuid.UUID) sqlc.GroupMember {
+	return sqlc.GroupMember{
+		ID:       uuid.New(),
+		GroupID:  groupID,
+		UserID:   uuid.New(),
+		Role:     "member",
+		Status:   "accepted",
+		JoinedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,68 @@
+package slotswap
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/google/uuid"
+)
+
+// A swap is proposed by a member, accepted by the counterparty's signature
+// and applied once the proposer submits it to the round contract and its
+// SlotsSwapped event is indexed. Open swaps past their deadline read as
+// expired.
+const (
+	StatusProposed  = "proposed"
+	StatusAccepted  = "accepted"
+	StatusApplied   = "applied"
+	StatusDeclined  = "declined"
+	StatusCancelled = "cancelled"
+	StatusExpired   = "expired"
+)
+
+// SwapTTL is how long a proposed swap can be accepted and submitted.
+const SwapTTL = 72 * time.Hour
+
+// listLimit caps the swaps returned for a round.
+const listLimit = 100
+
+type ProposeParams struct {
+	RoundID             uuid.UUID
+	User                sqlc.User
+	CounterpartyAddress string
+}
+
+type AcceptParams struct {
+	RoundID   uuid.UUID
+	SwapID    uuid.UUID
+	User      sqlc.User
+	Signature string
+}
+
+// Payload is the swapSlots transaction the proposer submits to the round
+// contract.
+type Payload struct {
+	To      string
+	ChainID int64
+	Data    string
+}
+
+// Swap is a slot swap with the typed data the counterparty signs and, once
+// accepted, the transaction that applies it.
+type Swap struct {
+	Swap sqlc.SlotSwap
+	// Status is the stored status, or expired when an open swap is past its
+	// deadline.
+	Status    string
+	TypedData apitypes.TypedData
+	Payload   *Payload
+}
+
+type SlotSwapService interface {
+	ProposeSwap(ctx context.Context, params ProposeParams) (*Swap, error)
+	ListSwaps(ctx context.Context, roundID uuid.UUID, user sqlc.User) ([]Swap, error)
+	AcceptSwap(ctx context.Context, params AcceptParams) (*Swap, error)
+	CancelSwap(ctx context.Context, roundID, swapID uuid.UUID, user sqlc.User) (*Swap, error)
+}
@@ -0,0 +1,346 @@
+package slotswap
+
+import (
+	"circa/internal/contracts"
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	"circa/internal/errors"
+	"circa/internal/service/group"
+	"circa/internal/service/round"
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	store db.Store
+}
+
+func NewService(store db.Store) *Service {
+	return &Service{store: store}
+}
+
+// ProposeSwap proposes exchanging the caller's payout slot with another
+// member's. Neither member may have been paid out yet.
+func (s *Service) ProposeSwap(ctx context.Context, params ProposeParams) (*Swap, error) {
+	r, err := s.getMemberRound(ctx, params.RoundID, params.User)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != round.StatusPending && r.Status != round.StatusActive {
+		return nil, errors.ErrRoundNotSwappable
+	}
+
+	proposerAddress := strings.ToLower(params.User.Address)
+	counterpartyAddress := strings.ToLower(params.CounterpartyAddress)
+	if proposerAddress == counterpartyAddress {
+		return nil, errors.ErrInvalidSwapCounterparty
+	}
+
+	members, err := s.roundMembers(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	proposer, ok := members[proposerAddress]
+	if !ok {
+		return nil, errors.ErrNotRoundMember
+	}
+	counterparty, ok := members[counterpartyAddress]
+	if !ok {
+		return nil, errors.ErrInvalidSwapCounterparty
+	}
+	if proposer.PayoutReceivedAt.Valid || counterparty.PayoutReceivedAt.Valid {
+		return nil, errors.ErrSlotAlreadyPaid
+	}
+
+	open, err := s.store.OpenSlotSwapExists(ctx, sqlc.OpenSlotSwapExistsParams{
+		RoundID:             r.ID,
+		ProposerAddress:     proposerAddress,
+		CounterpartyAddress: counterpartyAddress,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check open slot swaps")
+		return nil, err
+	}
+	if open {
+		return nil, errors.ErrSwapAlreadyOpen
+	}
+
+	nonce, err := randomNonce()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to generate slot swap nonce")
+		return nil, err
+	}
+
+	swap, err := s.store.CreateSlotSwap(ctx, sqlc.CreateSlotSwapParams{
+		RoundID:             r.ID,
+		ProposerAddress:     proposerAddress,
+		CounterpartyAddress: counterpartyAddress,
+		ProposerSlot:        proposer.PayoutPosition,
+		CounterpartySlot:    counterparty.PayoutPosition,
+		Nonce:               nonce.String(),
+		Deadline:            pgtype.Timestamptz{Time: time.Now().Add(SwapTTL).Truncate(time.Second), Valid: true},
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create slot swap")
+		return nil, err
+	}
+
+	log.Info().
+		Str("swap_id", swap.ID.String()).
+		Str("round_id", r.ID.String()).
+		Msg("Slot swap proposed")
+
+	return s.toSwap(r, swap)
+}
+
+// ListSwaps returns the round's most recent swaps, newest first. Any
+// accepted group member can read them.
+func (s *Service) ListSwaps(ctx context.Context, roundID uuid.UUID, user sqlc.User) ([]Swap, error) {
+	r, err := s.getMemberRound(ctx, roundID, user)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.store.ListRoundSlotSwaps(ctx, sqlc.ListRoundSlotSwapsParams{
+		RoundID: r.ID,
+		Limit:   listLimit,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list slot swaps")
+		return nil, err
+	}
+
+	swaps := make([]Swap, 0, len(rows))
+	for _, row := range rows {
+		swap, err := s.toSwap(r, row)
+		if err != nil {
+			return nil, err
+		}
+		swaps = append(swaps, *swap)
+	}
+	return swaps, nil
+}
+
+// AcceptSwap records the counterparty's EIP-712 signature of a proposed
+// swap and returns the transaction the proposer submits to apply it.
+func (s *Service) AcceptSwap(ctx context.Context, params AcceptParams) (*Swap, error) {
+	r, err := s.getMemberRound(ctx, params.RoundID, params.User)
+	if err != nil {
+		return nil, err
+	}
+	swap, err := s.getSwap(ctx, r.ID, params.SwapID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(params.User.Address) != swap.CounterpartyAddress {
+		return nil, errors.ErrNotSwapParty
+	}
+	if swap.Status != StatusProposed {
+		return nil, errors.ErrSwapClosed
+	}
+	if !time.Now().Before(swap.Deadline.Time) {
+		return nil, errors.ErrSwapExpired
+	}
+
+	// The contract checks the slots again; catching a stale swap here saves
+	// the proposer a reverted transaction.
+	members, err := s.roundMembers(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	if members[swap.ProposerAddress].PayoutPosition != swap.ProposerSlot ||
+		members[swap.CounterpartyAddress].PayoutPosition != swap.CounterpartySlot {
+		return nil, errors.ErrSwapStale
+	}
+
+	signature, err := hexutil.Decode(params.Signature)
+	if err != nil {
+		return nil, errors.ErrInvalidSwapSignature
+	}
+	signer, err := contracts.RecoverSlotSwapSigner(r.ChainID, common.HexToAddress(r.ContractAddress), contractSwap(swap), signature)
+	if err != nil || !strings.EqualFold(signer.Hex(), swap.CounterpartyAddress) {
+		return nil, errors.ErrInvalidSwapSignature
+	}
+
+	encoded := hexutil.Encode(signature)
+	accepted, err := s.store.AcceptSlotSwap(ctx, sqlc.AcceptSlotSwapParams{
+		ID:        swap.ID,
+		Signature: &encoded,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrSwapClosed
+		}
+		log.Error().Err(err).Msg("Failed to accept slot swap")
+		return nil, err
+	}
+
+	log.Info().
+		Str("swap_id", swap.ID.String()).
+		Str("round_id", r.ID.String()).
+		Msg("Slot swap accepted")
+
+	return s.toSwap(r, accepted)
+}
+
+// CancelSwap closes a swap that has not been applied. The proposer cancels
+// it, even after acceptance; the counterparty can only decline it before
+// signing, since a signature cannot be taken back.
+func (s *Service) CancelSwap(ctx context.Context, roundID, swapID uuid.UUID, user sqlc.User) (*Swap, error) {
+	r, err := s.getMemberRound(ctx, roundID, user)
+	if err != nil {
+		return nil, err
+	}
+	swap, err := s.getSwap(ctx, r.ID, swapID)
+	if err != nil {
+		return nil, err
+	}
+
+	var status string
+	switch strings.ToLower(user.Address) {
+	case swap.ProposerAddress:
+		status = StatusCancelled
+	case swap.CounterpartyAddress:
+		if swap.Status != StatusProposed {
+			return nil, errors.ErrSwapClosed
+		}
+		status = StatusDeclined
+	default:
+		return nil, errors.ErrNotSwapParty
+	}
+
+	closed, err := s.store.CloseSlotSwap(ctx, sqlc.CloseSlotSwapParams{
+		ID:     swap.ID,
+		Status: status,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrSwapClosed
+		}
+		log.Error().Err(err).Msg("Failed to close slot swap")
+		return nil, err
+	}
+	return s.toSwap(r, closed)
+}
+
+func (s *Service) toSwap(r *sqlc.Round, row sqlc.SlotSwap) (*Swap, error) {
+	contract := common.HexToAddress(r.ContractAddress)
+	swap := &Swap{
+		Swap:      row,
+		Status:    row.Status,
+		TypedData: contracts.SlotSwapTypedData(r.ChainID, contract, contractSwap(row)),
+	}
+	open := row.Status == StatusProposed || row.Status == StatusAccepted
+	if open && !time.Now().Before(row.Deadline.Time) {
+		swap.Status = StatusExpired
+	}
+
+	if swap.Status == StatusAccepted && row.Signature != nil {
+		signature, err := hexutil.Decode(*row.Signature)
+		if err != nil {
+			log.Error().Err(err).Str("swap_id", row.ID.String()).Msg("Invalid stored slot swap signature")
+			return nil, err
+		}
+		data, err := contracts.PackSwapSlots(contractSwap(row), signature)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to encode swapSlots call")
+			return nil, err
+		}
+		swap.Payload = &Payload{
+			To:      contract.Hex(),
+			ChainID: r.ChainID,
+			Data:    hexutil.Encode(data),
+		}
+	}
+	return swap, nil
+}
+
+func (s *Service) roundMembers(ctx context.Context, roundID uuid.UUID) (map[string]sqlc.RoundMember, error) {
+	rows, err := s.store.ListRoundMembers(ctx, roundID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round members")
+		return nil, err
+	}
+	members := make(map[string]sqlc.RoundMember, len(rows))
+	for _, m := range rows {
+		members[strings.ToLower(m.Address)] = m
+	}
+	return members, nil
+}
+
+func (s *Service) getSwap(ctx context.Context, roundID, swapID uuid.UUID) (sqlc.SlotSwap, error) {
+	swap, err := s.store.GetSlotSwap(ctx, sqlc.GetSlotSwapParams{ID: swapID, RoundID: roundID})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return sqlc.SlotSwap{}, errors.ErrSlotSwapNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get slot swap")
+		return sqlc.SlotSwap{}, err
+	}
+	return swap, nil
+}
+
+// getMemberRound loads a round and checks that the user is an accepted
+// member of its group.
+func (s *Service) getMemberRound(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*sqlc.Round, error) {
+	r, err := s.store.GetRoundByID(ctx, roundID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrRoundNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get round")
+		return nil, err
+	}
+
+	member, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: r.GroupID,
+		Address: strings.ToLower(user.Address),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.ErrNotGroupMember
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return nil, err
+	}
+	if member.Status != group.StatusAccepted {
+		return nil, errors.ErrNotGroupMember
+	}
+
+	return &r, nil
+}
+
+func contractSwap(row sqlc.SlotSwap) contracts.SlotSwap {
+	nonce, _ := new(big.Int).SetString(row.Nonce, 10)
+	if nonce == nil {
+		nonce = new(big.Int)
+	}
+	return contracts.SlotSwap{
+		Member:           common.HexToAddress(row.ProposerAddress),
+		Counterparty:     common.HexToAddress(row.CounterpartyAddress),
+		MemberSlot:       big.NewInt(int64(row.ProposerSlot)),
+		CounterpartySlot: big.NewInt(int64(row.CounterpartySlot)),
+		Nonce:            nonce,
+		Deadline:         big.NewInt(row.Deadline.Time.Unix()),
+	}
+}
+
+// randomNonce returns a random uint256, so nonces cannot be guessed or
+// collide across rounds.
+func randomNonce() (*big.Int, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b[:]), nil
+}
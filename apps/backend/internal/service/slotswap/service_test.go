@@ -0,0 +1,364 @@
+package slotswap
+
+import (
+	"circa/internal/contracts"
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/round"
+	"context"
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	proposerAddress = "0x1111111111111111111111111111111111111111"
+	thirdAddress    = "0x3333333333333333333333333333333333333333"
+	contractAddress = "0x5555555555555555555555555555555555555555"
+)
+
+type counterparty struct {
+	key     *ecdsa.PrivateKey
+	address string
+}
+
+func newCounterparty(t *testing.T) counterparty {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return counterparty{key: key, address: strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())}
+}
+
+func (c counterparty) sign(t *testing.T, r sqlc.Round, swap sqlc.SlotSwap) string {
+	t.Helper()
+	hash, err := contracts.SlotSwapHash(r.ChainID, common.HexToAddress(r.ContractAddress), contractSwap(swap))
+	require.NoError(t, err)
+	sig, err := crypto.Sign(hash.Bytes(), c.key)
+	require.NoError(t, err)
+	sig[64] += 27
+	return hexutil.Encode(sig)
+}
+
+func createTestRound(status string) sqlc.Round {
+	return sqlc.Round{
+		ID:              uuid.New(),
+		GroupID:         uuid.New(),
+		ChainID:         8453,
+		ContractAddress: contractAddress,
+		Status:          status,
+	}
+}
+
+func createTestSwap(roundID uuid.UUID, counterpartyAddress string) sqlc.SlotSwap {
+	return sqlc.SlotSwap{
+		ID:                  uuid.New(),
+		RoundID:             roundID,
+		ProposerAddress:     proposerAddress,
+		CounterpartyAddress: counterpartyAddress,
+		ProposerSlot:        3,
+		CounterpartySlot:    1,
+		Nonce:               "42",
+		Deadline:            pgtype.Timestamptz{Time: time.Now().Add(time.Hour).Truncate(time.Second), Valid: true},
+		Status:              StatusProposed,
+	}
+}
+
+func roundMembers(roundID uuid.UUID, counterpartyAddress string) []sqlc.RoundMember {
+	return []sqlc.RoundMember{
+		{RoundID: roundID, Address: thirdAddress, PayoutPosition: 0},
+		{RoundID: roundID, Address: counterpartyAddress, PayoutPosition: 1},
+		{RoundID: roundID, Address: proposerAddress, PayoutPosition: 3},
+	}
+}
+
+func expectMember(ms *dbmocks.MockStore, r sqlc.Round) {
+	ms.On("GetRoundByID", mock.Anything, r.ID).Return(r, nil)
+	ms.On("GetActiveGroupMemberByAddress", mock.Anything, mock.Anything).Return(sqlc.GroupMember{
+		GroupID: r.GroupID,
+		Status:  "accepted",
+	}, nil)
+}
+
+func TestService_ProposeSwap(t *testing.T) {
+	cp := newCounterparty(t)
+	proposer := sqlc.User{ID: uuid.New(), Address: proposerAddress}
+
+	tests := []struct {
+		name         string
+		status       string
+		counterparty string
+		setup        func(ms *dbmocks.MockStore, r sqlc.Round)
+		wantErr      error
+	}{
+		{
+			name:         "proposed with current slots",
+			status:       round.StatusActive,
+			counterparty: strings.ToUpper(cp.address[:2]) + cp.address[2:],
+			setup: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				ms.On("ListRoundMembers", mock.Anything, r.ID).Return(roundMembers(r.ID, cp.address), nil)
+				ms.On("OpenSlotSwapExists", mock.Anything, sqlc.OpenSlotSwapExistsParams{
+					RoundID: r.ID, ProposerAddress: proposerAddress, CounterpartyAddress: cp.address,
+				}).Return(false, nil)
+				ms.On("CreateSlotSwap", mock.Anything, mock.MatchedBy(func(p sqlc.CreateSlotSwapParams) bool {
+					return p.ProposerSlot == 3 && p.CounterpartySlot == 1 && p.Nonce != "" &&
+						p.Deadline.Time.After(time.Now().Add(SwapTTL-time.Minute))
+				})).Return(createTestSwap(r.ID, cp.address), nil)
+			},
+		},
+		{
+			name:         "round completed",
+			status:       round.StatusCompleted,
+			counterparty: cp.address,
+			setup:        func(ms *dbmocks.MockStore, r sqlc.Round) {},
+			wantErr:      circaerrors.ErrRoundNotSwappable,
+		},
+		{
+			name:         "swap with self",
+			status:       round.StatusActive,
+			counterparty: proposerAddress,
+			setup:        func(ms *dbmocks.MockStore, r sqlc.Round) {},
+			wantErr:      circaerrors.ErrInvalidSwapCounterparty,
+		},
+		{
+			name:         "counterparty outside the round",
+			status:       round.StatusActive,
+			counterparty: "0x9999999999999999999999999999999999999999",
+			setup: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				ms.On("ListRoundMembers", mock.Anything, r.ID).Return(roundMembers(r.ID, cp.address), nil)
+			},
+			wantErr: circaerrors.ErrInvalidSwapCounterparty,
+		},
+		{
+			name:         "counterparty already paid out",
+			status:       round.StatusActive,
+			counterparty: cp.address,
+			setup: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				members := roundMembers(r.ID, cp.address)
+				members[1].PayoutReceivedAt = pgtype.Timestamp{Time: time.Now(), Valid: true}
+				ms.On("ListRoundMembers", mock.Anything, r.ID).Return(members, nil)
+			},
+			wantErr: circaerrors.ErrSlotAlreadyPaid,
+		},
+		{
+			name:         "open swap exists",
+			status:       round.StatusPending,
+			counterparty: cp.address,
+			setup: func(ms *dbmocks.MockStore, r sqlc.Round) {
+				ms.On("ListRoundMembers", mock.Anything, r.ID).Return(roundMembers(r.ID, cp.address), nil)
+				ms.On("OpenSlotSwapExists", mock.Anything, mock.Anything).Return(true, nil)
+			},
+			wantErr: circaerrors.ErrSwapAlreadyOpen,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := createTestRound(tt.status)
+			mockStore := dbmocks.NewMockStore(t)
+			expectMember(mockStore, r)
+			tt.setup(mockStore, r)
+
+			swap, err := NewService(mockStore).ProposeSwap(context.Background(), ProposeParams{
+				RoundID:             r.ID,
+				User:                proposer,
+				CounterpartyAddress: tt.counterparty,
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, StatusProposed, swap.Status)
+			assert.Equal(t, "SlotSwap", swap.TypedData.PrimaryType)
+			assert.Equal(t, common.HexToAddress(contractAddress).Hex(), swap.TypedData.Domain.VerifyingContract)
+			assert.Nil(t, swap.Payload)
+		})
+	}
+}
+
+func TestService_AcceptSwap(t *testing.T) {
+	cp := newCounterparty(t)
+	counterpartyUser := sqlc.User{ID: uuid.New(), Address: cp.address}
+
+	t.Run("valid signature returns the swapSlots payload", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		swap := createTestSwap(r.ID, cp.address)
+		signature := cp.sign(t, r, swap)
+		accepted := swap
+		accepted.Status = StatusAccepted
+		accepted.Signature = &signature
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, r)
+		mockStore.On("GetSlotSwap", mock.Anything, sqlc.GetSlotSwapParams{ID: swap.ID, RoundID: r.ID}).Return(swap, nil)
+		mockStore.On("ListRoundMembers", mock.Anything, r.ID).Return(roundMembers(r.ID, cp.address), nil)
+		mockStore.On("AcceptSlotSwap", mock.Anything, sqlc.AcceptSlotSwapParams{ID: swap.ID, Signature: &signature}).Return(accepted, nil)
+
+		result, err := NewService(mockStore).AcceptSwap(context.Background(), AcceptParams{
+			RoundID: r.ID, SwapID: swap.ID, User: counterpartyUser, Signature: signature,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, StatusAccepted, result.Status)
+		require.NotNil(t, result.Payload)
+		assert.Equal(t, common.HexToAddress(contractAddress).Hex(), result.Payload.To)
+		assert.Equal(t, int64(8453), result.Payload.ChainID)
+
+		data, err := hexutil.Decode(result.Payload.Data)
+		require.NoError(t, err)
+		assert.Equal(t, contracts.RoundABI.Methods["swapSlots"].ID, data[:4])
+	})
+
+	t.Run("signed by someone else", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		swap := createTestSwap(r.ID, cp.address)
+		other := newCounterparty(t)
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, r)
+		mockStore.On("GetSlotSwap", mock.Anything, mock.Anything).Return(swap, nil)
+		mockStore.On("ListRoundMembers", mock.Anything, r.ID).Return(roundMembers(r.ID, cp.address), nil)
+
+		_, err := NewService(mockStore).AcceptSwap(context.Background(), AcceptParams{
+			RoundID: r.ID, SwapID: swap.ID, User: counterpartyUser, Signature: other.sign(t, r, swap),
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidSwapSignature)
+	})
+
+	t.Run("signature for another round contract", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		swap := createTestSwap(r.ID, cp.address)
+		otherRound := r
+		otherRound.ContractAddress = "0x6666666666666666666666666666666666666666"
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, r)
+		mockStore.On("GetSlotSwap", mock.Anything, mock.Anything).Return(swap, nil)
+		mockStore.On("ListRoundMembers", mock.Anything, r.ID).Return(roundMembers(r.ID, cp.address), nil)
+
+		_, err := NewService(mockStore).AcceptSwap(context.Background(), AcceptParams{
+			RoundID: r.ID, SwapID: swap.ID, User: counterpartyUser, Signature: cp.sign(t, otherRound, swap),
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrInvalidSwapSignature)
+	})
+
+	t.Run("slots changed since proposal", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		swap := createTestSwap(r.ID, cp.address)
+		members := roundMembers(r.ID, cp.address)
+		members[1].PayoutPosition, members[0].PayoutPosition = 0, 1
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, r)
+		mockStore.On("GetSlotSwap", mock.Anything, mock.Anything).Return(swap, nil)
+		mockStore.On("ListRoundMembers", mock.Anything, r.ID).Return(members, nil)
+
+		_, err := NewService(mockStore).AcceptSwap(context.Background(), AcceptParams{
+			RoundID: r.ID, SwapID: swap.ID, User: counterpartyUser, Signature: cp.sign(t, r, swap),
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrSwapStale)
+	})
+
+	t.Run("past deadline", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		swap := createTestSwap(r.ID, cp.address)
+		swap.Deadline = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, r)
+		mockStore.On("GetSlotSwap", mock.Anything, mock.Anything).Return(swap, nil)
+
+		_, err := NewService(mockStore).AcceptSwap(context.Background(), AcceptParams{
+			RoundID: r.ID, SwapID: swap.ID, User: counterpartyUser, Signature: "0x00",
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrSwapExpired)
+	})
+
+	t.Run("only the counterparty accepts", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		swap := createTestSwap(r.ID, cp.address)
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectMember(mockStore, r)
+		mockStore.On("GetSlotSwap", mock.Anything, mock.Anything).Return(swap, nil)
+
+		_, err := NewService(mockStore).AcceptSwap(context.Background(), AcceptParams{
+			RoundID: r.ID, SwapID: swap.ID, User: sqlc.User{Address: proposerAddress}, Signature: "0x00",
+		})
+		assert.ErrorIs(t, err, circaerrors.ErrNotSwapParty)
+	})
+}
+
+func TestService_CancelSwap(t *testing.T) {
+	cp := newCounterparty(t)
+
+	tests := []struct {
+		name       string
+		user       string
+		status     string
+		wantStatus string
+		wantErr    error
+	}{
+		{name: "proposer cancels", user: proposerAddress, status: StatusProposed, wantStatus: StatusCancelled},
+		{name: "proposer cancels after acceptance", user: proposerAddress, status: StatusAccepted, wantStatus: StatusCancelled},
+		{name: "counterparty declines", user: cp.address, status: StatusProposed, wantStatus: StatusDeclined},
+		{name: "counterparty cannot take back a signature", user: cp.address, status: StatusAccepted, wantErr: circaerrors.ErrSwapClosed},
+		{name: "third member", user: thirdAddress, status: StatusProposed, wantErr: circaerrors.ErrNotSwapParty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := createTestRound(round.StatusActive)
+			swap := createTestSwap(r.ID, cp.address)
+			swap.Status = tt.status
+
+			mockStore := dbmocks.NewMockStore(t)
+			expectMember(mockStore, r)
+			mockStore.On("GetSlotSwap", mock.Anything, mock.Anything).Return(swap, nil)
+			if tt.wantErr == nil {
+				closed := swap
+				closed.Status = tt.wantStatus
+				mockStore.On("CloseSlotSwap", mock.Anything, sqlc.CloseSlotSwapParams{ID: swap.ID, Status: tt.wantStatus}).Return(closed, nil)
+			}
+
+			result, err := NewService(mockStore).CancelSwap(context.Background(), r.ID, swap.ID, sqlc.User{Address: tt.user})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, result.Status)
+		})
+	}
+}
+
+func TestService_ListSwaps_Expired(t *testing.T) {
+	r := createTestRound(round.StatusActive)
+	open := createTestSwap(r.ID, thirdAddress)
+	stale := createTestSwap(r.ID, thirdAddress)
+	stale.Deadline = pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}
+	applied := stale
+	applied.Status = StatusApplied
+
+	mockStore := dbmocks.NewMockStore(t)
+	expectMember(mockStore, r)
+	mockStore.On("ListRoundSlotSwaps", mock.Anything, sqlc.ListRoundSlotSwapsParams{RoundID: r.ID, Limit: listLimit}).
+		Return([]sqlc.SlotSwap{open, stale, applied}, nil)
+
+	swaps, err := NewService(mockStore).ListSwaps(context.Background(), r.ID, sqlc.User{Address: proposerAddress})
+	require.NoError(t, err)
+	require.Len(t, swaps, 3)
+	assert.Equal(t, StatusProposed, swaps[0].Status)
+	assert.Equal(t, StatusExpired, swaps[1].Status)
+	assert.Equal(t, StatusApplied, swaps[2].Status)
+}
@@ -0,0 +1,69 @@
+package txbuilder
+
+import (
+	sqlc "circa/internal/db/sqlc/generated"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+)
+
+// Kinds of transaction the builder prepares.
+const (
+	KindApprove     = "approve"
+	KindContribute  = "contribute"
+	KindClaimPayout = "claimPayout"
+)
+
+// gasBufferPercent pads gas estimates, which are taken against the latest
+// block and can fall short by the time the transaction is mined.
+const gasBufferPercent = 20
+
+// ChainClient reads contract state and suggests gas for a chain. Both
+// ethclient.Client and the simulated backend satisfy it.
+type ChainClient interface {
+	ethereum.ContractCaller
+	ethereum.GasEstimator
+	ethereum.GasPricer
+	ethereum.GasPricer1559
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Transaction is an unsigned transaction for the member's wallet to sign
+// and send as is.
+type Transaction struct {
+	Kind    string
+	ChainID int64
+	From    common.Address
+	To      common.Address
+	Data    []byte
+	Value   *big.Int
+	// Gas is the padded gas estimate, or nil when the transaction can only
+	// be estimated once the ones before it are mined.
+	Gas *uint64
+	// MaxFeePerGas and MaxPriorityFeePerGas are set on EIP-1559 chains,
+	// GasPrice on the others.
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	GasPrice             *big.Int
+}
+
+// ContributionPlan is what a member sends to pay the current period: a
+// token approval when the round contract's allowance is short, then the
+// contribution itself.
+type ContributionPlan struct {
+	Token        common.Address
+	Amount       *big.Int
+	Allowance    *big.Int
+	Balance      *big.Int
+	Transactions []Transaction
+}
+
+type TxBuilderService interface {
+	BuildContribution(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*ContributionPlan, error)
+	BuildPayoutClaim(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*Transaction, error)
+}
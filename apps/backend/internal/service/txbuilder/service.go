@@ -0,0 +1,227 @@
+package txbuilder
+
+import (
+	"circa/internal/contracts"
+	"circa/internal/db"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/group"
+	"circa/internal/service/round"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	store   db.Store
+	clients map[int64]ChainClient
+}
+
+func NewService(store db.Store, clients map[int64]ChainClient) *Service {
+	return &Service{
+		store:   store,
+		clients: clients,
+	}
+}
+
+// BuildContribution prepares the caller's contribution to a pending or
+// active round; a round only becomes active once its first contribution is
+// indexed. The contribution is preceded by an approval of exactly one contribution
+// when the round contract cannot pull it from the caller's allowance yet.
+func (s *Service) BuildContribution(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*ContributionPlan, error) {
+	r, _, err := s.getRoundMember(ctx, roundID, user)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != round.StatusPending && r.Status != round.StatusActive {
+		return nil, circaerrors.ErrRoundNotActive
+	}
+	client, ok := s.clients[r.ChainID]
+	if !ok {
+		return nil, circaerrors.ErrUnsupportedChain
+	}
+
+	amount, ok := new(big.Int).SetString(r.ContributionAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("round %s has invalid contribution amount %q", r.ID, r.ContributionAmount)
+	}
+	from := common.HexToAddress(user.Address)
+	roundAddress := common.HexToAddress(r.ContractAddress)
+
+	token, err := contracts.ReadRoundToken(ctx, client, roundAddress)
+	if err != nil {
+		log.Error().Err(err).Str("round_id", r.ID.String()).Msg("Failed to read round token")
+		return nil, err
+	}
+	allowance, err := contracts.ReadAllowance(ctx, client, token, from, roundAddress)
+	if err != nil {
+		log.Error().Err(err).Str("round_id", r.ID.String()).Msg("Failed to read token allowance")
+		return nil, err
+	}
+	balance, err := contracts.ReadBalance(ctx, client, token, from)
+	if err != nil {
+		log.Error().Err(err).Str("round_id", r.ID.String()).Msg("Failed to read token balance")
+		return nil, err
+	}
+
+	plan := &ContributionPlan{
+		Token:     token,
+		Amount:    amount,
+		Allowance: allowance,
+		Balance:   balance,
+	}
+
+	needsApproval := allowance.Cmp(amount) < 0
+	if needsApproval {
+		data, err := contracts.PackApprove(roundAddress, amount)
+		if err != nil {
+			return nil, err
+		}
+		approve, err := s.build(ctx, client, r.ChainID, KindApprove, from, token, data, true)
+		if err != nil {
+			return nil, err
+		}
+		plan.Transactions = append(plan.Transactions, *approve)
+	}
+
+	data, err := contracts.PackContribute()
+	if err != nil {
+		return nil, err
+	}
+	contribute, err := s.build(ctx, client, r.ChainID, KindContribute, from, roundAddress, data, !needsApproval)
+	if err != nil {
+		return nil, err
+	}
+	plan.Transactions = append(plan.Transactions, *contribute)
+
+	return plan, nil
+}
+
+// BuildPayoutClaim prepares the caller's payout claim. The round contract
+// decides whether the payout is due; a claim it would reject is reported
+// as ErrTransactionWouldRevert.
+func (s *Service) BuildPayoutClaim(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*Transaction, error) {
+	r, member, err := s.getRoundMember(ctx, roundID, user)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != round.StatusActive {
+		return nil, circaerrors.ErrRoundNotActive
+	}
+	if member.PayoutReceivedAt.Valid {
+		return nil, circaerrors.ErrPayoutAlreadyReceived
+	}
+	client, ok := s.clients[r.ChainID]
+	if !ok {
+		return nil, circaerrors.ErrUnsupportedChain
+	}
+
+	data, err := contracts.PackClaimPayout()
+	if err != nil {
+		return nil, err
+	}
+	return s.build(ctx, client, r.ChainID, KindClaimPayout, common.HexToAddress(user.Address), common.HexToAddress(r.ContractAddress), data, true)
+}
+
+// build assembles a transaction with the chain's suggested fees and, when
+// estimate is set, a padded gas limit.
+func (s *Service) build(ctx context.Context, client ChainClient, chainID int64, kind string, from, to common.Address, data []byte, estimate bool) (*Transaction, error) {
+	tx := &Transaction{
+		Kind:    kind,
+		ChainID: chainID,
+		From:    from,
+		To:      to,
+		Data:    data,
+		Value:   new(big.Int),
+	}
+
+	if estimate {
+		gas, err := client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &to, Data: data})
+		if err != nil {
+			var dataErr rpc.DataError
+			if errors.As(err, &dataErr) {
+				return nil, fmt.Errorf("%w: %s", circaerrors.ErrTransactionWouldRevert, err)
+			}
+			log.Error().Err(err).Int64("chain_id", chainID).Str("kind", kind).Msg("Failed to estimate gas")
+			return nil, err
+		}
+		gas += gas * gasBufferPercent / 100
+		tx.Gas = &gas
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", chainID).Msg("Failed to get latest block")
+		return nil, err
+	}
+	if head.BaseFee == nil {
+		gasPrice, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			log.Error().Err(err).Int64("chain_id", chainID).Msg("Failed to suggest gas price")
+			return nil, err
+		}
+		tx.GasPrice = gasPrice
+		return tx, nil
+	}
+
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", chainID).Msg("Failed to suggest gas tip")
+		return nil, err
+	}
+	// Twice the base fee keeps the transaction includable through several
+	// full blocks; the unused part is refunded.
+	tx.MaxPriorityFeePerGas = tip
+	tx.MaxFeePerGas = new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), tip)
+	return tx, nil
+}
+
+// getRoundMember returns the round and the caller's slot in it. The caller
+// must be an accepted member of the round's group and one of its members.
+func (s *Service) getRoundMember(ctx context.Context, roundID uuid.UUID, user sqlc.User) (*sqlc.Round, *sqlc.RoundMember, error) {
+	r, err := s.store.GetRoundByID(ctx, roundID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil, circaerrors.ErrRoundNotFound
+		}
+		log.Error().Err(err).Msg("Failed to get round")
+		return nil, nil, err
+	}
+
+	address := strings.ToLower(user.Address)
+	groupMember, err := s.store.GetActiveGroupMemberByAddress(ctx, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: r.GroupID,
+		Address: address,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil, circaerrors.ErrNotGroupMember
+		}
+		log.Error().Err(err).Msg("Failed to get group member")
+		return nil, nil, err
+	}
+	if groupMember.Status != group.StatusAccepted {
+		return nil, nil, circaerrors.ErrNotGroupMember
+	}
+
+	members, err := s.store.ListRoundMembers(ctx, r.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to list round members")
+		return nil, nil, err
+	}
+	for i := range members {
+		if strings.EqualFold(members[i].Address, address) {
+			return &r, &members[i], nil
+		}
+	}
+	return nil, nil, circaerrors.ErrNotRoundMember
+}
@@ -0,0 +1,258 @@
+package txbuilder
+
+import (
+	"circa/internal/contracts"
+	dbmocks "circa/internal/db/mocks"
+	sqlc "circa/internal/db/sqlc/generated"
+	circaerrors "circa/internal/errors"
+	"circa/internal/service/round"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	memberAddress = "0x1111111111111111111111111111111111111111"
+	roundAddress  = "0x5555555555555555555555555555555555555555"
+	tokenAddress  = "0x7777777777777777777777777777777777777777"
+	testChainID   = int64(8453)
+)
+
+// revertError mimics the JSON-RPC error returned for a reverting call.
+type revertError struct{}
+
+func (revertError) Error() string          { return "execution reverted: payout not due" }
+func (revertError) ErrorCode() int         { return 3 }
+func (revertError) ErrorData() interface{} { return "0x" }
+
+// fakeClient answers token and Round calls from fixed values.
+type fakeClient struct {
+	allowance *big.Int
+	balance   *big.Int
+	gas       uint64
+	gasErr    error
+	baseFee   *big.Int
+	estimated []ethereum.CallMsg
+}
+
+func (f *fakeClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x60}, nil
+}
+
+func (f *fakeClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if method, err := contracts.RoundABI.MethodById(msg.Data[:4]); err == nil && method.Name == "token" {
+		return method.Outputs.Pack(common.HexToAddress(tokenAddress))
+	}
+	method, err := contracts.ERC20ABI.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "allowance":
+		return method.Outputs.Pack(f.allowance)
+	case "balanceOf":
+		return method.Outputs.Pack(f.balance)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func (f *fakeClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	f.estimated = append(f.estimated, msg)
+	return f.gas, f.gasErr
+}
+
+func (f *fakeClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(3_000_000_000), nil
+}
+
+func (f *fakeClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1_000_000), nil
+}
+
+func (f *fakeClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(100), BaseFee: f.baseFee}, nil
+}
+
+func createTestRound(status string) sqlc.Round {
+	return sqlc.Round{
+		ID:                 uuid.New(),
+		GroupID:            uuid.New(),
+		ChainID:            testChainID,
+		ContractAddress:    roundAddress,
+		ContributionAmount: "1000000",
+		Status:             status,
+	}
+}
+
+func expectRoundMember(ms *dbmocks.MockStore, r sqlc.Round, members []sqlc.RoundMember) {
+	ms.On("GetRoundByID", mock.Anything, r.ID).Return(r, nil)
+	ms.On("GetActiveGroupMemberByAddress", mock.Anything, sqlc.GetActiveGroupMemberByAddressParams{
+		GroupID: r.GroupID,
+		Address: memberAddress,
+	}).Return(sqlc.GroupMember{GroupID: r.GroupID, Status: "accepted"}, nil)
+	ms.On("ListRoundMembers", mock.Anything, r.ID).Return(members, nil)
+}
+
+func TestService_BuildContribution(t *testing.T) {
+	user := sqlc.User{ID: uuid.New(), Address: memberAddress}
+
+	t.Run("approves first when the allowance is short", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		client := &fakeClient{allowance: big.NewInt(400_000), balance: big.NewInt(5_000_000), gas: 50_000, baseFee: big.NewInt(10_000_000)}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectRoundMember(mockStore, r, []sqlc.RoundMember{{RoundID: r.ID, Address: memberAddress}})
+
+		plan, err := NewService(mockStore, map[int64]ChainClient{testChainID: client}).BuildContribution(context.Background(), r.ID, user)
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToAddress(tokenAddress), plan.Token)
+		assert.Equal(t, big.NewInt(1_000_000), plan.Amount)
+		assert.Equal(t, big.NewInt(400_000), plan.Allowance)
+		require.Len(t, plan.Transactions, 2)
+
+		approve := plan.Transactions[0]
+		assert.Equal(t, KindApprove, approve.Kind)
+		assert.Equal(t, common.HexToAddress(tokenAddress), approve.To)
+		assert.Equal(t, common.HexToAddress(memberAddress), approve.From)
+		args, err := contracts.ERC20ABI.Methods["approve"].Inputs.Unpack(approve.Data[4:])
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToAddress(roundAddress), args[0])
+		assert.Equal(t, big.NewInt(1_000_000), args[1])
+		require.NotNil(t, approve.Gas)
+		assert.Equal(t, uint64(60_000), *approve.Gas)
+		assert.Equal(t, big.NewInt(21_000_000), approve.MaxFeePerGas)
+		assert.Equal(t, big.NewInt(1_000_000), approve.MaxPriorityFeePerGas)
+		assert.Nil(t, approve.GasPrice)
+
+		contribute := plan.Transactions[1]
+		assert.Equal(t, KindContribute, contribute.Kind)
+		assert.Equal(t, common.HexToAddress(roundAddress), contribute.To)
+		assert.Equal(t, contracts.RoundABI.Methods["contribute"].ID, contribute.Data)
+		assert.Nil(t, contribute.Gas, "contribute cannot be estimated before the approval is mined")
+		assert.Equal(t, int64(0), contribute.Value.Int64())
+		assert.Len(t, client.estimated, 1)
+	})
+
+	t.Run("contributes directly on a legacy chain", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		client := &fakeClient{allowance: big.NewInt(1_000_000), balance: big.NewInt(1_000_000), gas: 100_000}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectRoundMember(mockStore, r, []sqlc.RoundMember{{RoundID: r.ID, Address: memberAddress}})
+
+		plan, err := NewService(mockStore, map[int64]ChainClient{testChainID: client}).BuildContribution(context.Background(), r.ID, user)
+		require.NoError(t, err)
+		require.Len(t, plan.Transactions, 1)
+		contribute := plan.Transactions[0]
+		assert.Equal(t, KindContribute, contribute.Kind)
+		require.NotNil(t, contribute.Gas)
+		assert.Equal(t, uint64(120_000), *contribute.Gas)
+		assert.Equal(t, big.NewInt(3_000_000_000), contribute.GasPrice)
+		assert.Nil(t, contribute.MaxFeePerGas)
+	})
+
+	t.Run("first contribution to a pending round", func(t *testing.T) {
+		r := createTestRound(round.StatusPending)
+		client := &fakeClient{allowance: big.NewInt(1_000_000), balance: big.NewInt(1_000_000), gas: 100_000, baseFee: big.NewInt(10_000_000)}
+
+		mockStore := dbmocks.NewMockStore(t)
+		expectRoundMember(mockStore, r, []sqlc.RoundMember{{RoundID: r.ID, Address: memberAddress}})
+
+		plan, err := NewService(mockStore, map[int64]ChainClient{testChainID: client}).BuildContribution(context.Background(), r.ID, user)
+		require.NoError(t, err)
+		require.Len(t, plan.Transactions, 1)
+		assert.Equal(t, KindContribute, plan.Transactions[0].Kind)
+	})
+
+	t.Run("round not active", func(t *testing.T) {
+		r := createTestRound(round.StatusPaused)
+		mockStore := dbmocks.NewMockStore(t)
+		expectRoundMember(mockStore, r, []sqlc.RoundMember{{RoundID: r.ID, Address: memberAddress}})
+
+		_, err := NewService(mockStore, nil).BuildContribution(context.Background(), r.ID, user)
+		assert.ErrorIs(t, err, circaerrors.ErrRoundNotActive)
+	})
+
+	t.Run("group member outside the round", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		mockStore := dbmocks.NewMockStore(t)
+		expectRoundMember(mockStore, r, []sqlc.RoundMember{{RoundID: r.ID, Address: "0x2222222222222222222222222222222222222222"}})
+
+		_, err := NewService(mockStore, nil).BuildContribution(context.Background(), r.ID, user)
+		assert.ErrorIs(t, err, circaerrors.ErrNotRoundMember)
+	})
+
+	t.Run("chain without a client", func(t *testing.T) {
+		r := createTestRound(round.StatusActive)
+		mockStore := dbmocks.NewMockStore(t)
+		expectRoundMember(mockStore, r, []sqlc.RoundMember{{RoundID: r.ID, Address: memberAddress}})
+
+		_, err := NewService(mockStore, map[int64]ChainClient{}).BuildContribution(context.Background(), r.ID, user)
+		assert.ErrorIs(t, err, circaerrors.ErrUnsupportedChain)
+	})
+}
+
+func TestService_BuildPayoutClaim(t *testing.T) {
+	user := sqlc.User{ID: uuid.New(), Address: memberAddress}
+
+	tests := []struct {
+		name    string
+		member  sqlc.RoundMember
+		client  *fakeClient
+		wantErr error
+	}{
+		{
+			name:   "claim with estimated gas",
+			client: &fakeClient{gas: 80_000, baseFee: big.NewInt(10)},
+		},
+		{
+			name:    "already paid out",
+			member:  sqlc.RoundMember{PayoutReceivedAt: pgtype.Timestamp{Time: time.Now(), Valid: true}},
+			client:  &fakeClient{},
+			wantErr: circaerrors.ErrPayoutAlreadyReceived,
+		},
+		{
+			name:    "contract rejects the claim",
+			client:  &fakeClient{gasErr: revertError{}, baseFee: big.NewInt(10)},
+			wantErr: circaerrors.ErrTransactionWouldRevert,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := createTestRound(round.StatusActive)
+			member := tt.member
+			member.RoundID = r.ID
+			member.Address = memberAddress
+
+			mockStore := dbmocks.NewMockStore(t)
+			expectRoundMember(mockStore, r, []sqlc.RoundMember{member})
+
+			tx, err := NewService(mockStore, map[int64]ChainClient{testChainID: tt.client}).BuildPayoutClaim(context.Background(), r.ID, user)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, KindClaimPayout, tx.Kind)
+			assert.Equal(t, testChainID, tx.ChainID)
+			assert.Equal(t, common.HexToAddress(roundAddress), tx.To)
+			assert.Equal(t, contracts.RoundABI.Methods["claimPayout"].ID, tx.Data)
+			require.NotNil(t, tx.Gas)
+			assert.Equal(t, uint64(96_000), *tx.Gas)
+			require.Len(t, tt.client.estimated, 1)
+			assert.Equal(t, common.HexToAddress(memberAddress), tt.client.estimated[0].From)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package tokens is the registry of ERC-20 tokens rounds are denominated
+// in, so amounts can be shown with the right symbol and decimals.
+package tokens
+
+import (
+	"circa/internal/contracts"
+	"circa/internal/money"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// Token is a registered ERC-20 token.
+type Token struct {
+	ChainID  int64          `json:"chainId"`
+	Address  common.Address `json:"address"`
+	Symbol   string         `json:"symbol"`
+	Decimals uint8          `json:"decimals"`
+	LogoURL  string         `json:"logoUrl,omitempty"`
+}
+
+type key struct {
+	chainID int64
+	address common.Address
+}
+
+// Registry looks tokens up by chain and address. A nil Registry knows no
+// tokens.
+type Registry struct {
+	tokens map[key]Token
+}
+
+// NewRegistry builds a registry, rejecting tokens without a symbol or
+// registered twice.
+func NewRegistry(tokens []Token) (*Registry, error) {
+	r := &Registry{tokens: make(map[key]Token, len(tokens))}
+	for _, t := range tokens {
+		if t.ChainID <= 0 || t.Address == (common.Address{}) || strings.TrimSpace(t.Symbol) == "" {
+			return nil, fmt.Errorf("token %s on chain %d needs a chain, an address and a symbol", t.Address.Hex(), t.ChainID)
+		}
+		k := key{chainID: t.ChainID, address: t.Address}
+		if _, ok := r.tokens[k]; ok {
+			return nil, fmt.Errorf("token %s on chain %d is registered twice", t.Address.Hex(), t.ChainID)
+		}
+		r.tokens[k] = t
+	}
+	return r, nil
+}
+
+// LoadFile reads a registry from a JSON array of tokens.
+func LoadFile(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read token registry: %w", err)
+	}
+	var tokens []Token
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("parse token registry %s: %w", path, err)
+	}
+	return NewRegistry(tokens)
+}
+
+// Lookup returns the token at address on a chain.
+func (r *Registry) Lookup(chainID int64, address string) (Token, bool) {
+	if r == nil || !common.IsHexAddress(address) {
+		return Token{}, false
+	}
+	t, ok := r.tokens[key{chainID: chainID, address: common.HexToAddress(address)}]
+	return t, ok
+}
+
+// Tokens returns every registered token, ordered by chain and symbol.
+func (r *Registry) Tokens() []Token {
+	if r == nil {
+		return nil
+	}
+	tokens := make([]Token, 0, len(r.tokens))
+	for _, t := range r.tokens {
+		tokens = append(tokens, t)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].ChainID != tokens[j].ChainID {
+			return tokens[i].ChainID < tokens[j].ChainID
+		}
+		if tokens[i].Symbol != tokens[j].Symbol {
+			return tokens[i].Symbol < tokens[j].Symbol
+		}
+		return tokens[i].Address.Hex() < tokens[j].Address.Hex()
+	})
+	return tokens
+}
+
+// Verify checks every token on a chain with a caller against what the
+// contract reports. Tokens on other chains are skipped.
+func (r *Registry) Verify(ctx context.Context, callers map[int64]contracts.Caller) error {
+	for _, t := range r.Tokens() {
+		caller, ok := callers[t.ChainID]
+		if !ok {
+			log.Warn().
+				Int64("chain_id", t.ChainID).
+				Str("token", t.Address.Hex()).
+				Msg("Skipping token verification, chain has no RPC endpoint")
+			continue
+		}
+		metadata, err := contracts.ReadTokenMetadata(ctx, caller, t.Address)
+		if err != nil {
+			return fmt.Errorf("verify token %s on chain %d: %w", t.Address.Hex(), t.ChainID, err)
+		}
+		if metadata.Decimals != t.Decimals {
+			return fmt.Errorf("token %s on chain %d has %d decimals, registry says %d", t.Address.Hex(), t.ChainID, metadata.Decimals, t.Decimals)
+		}
+		if metadata.Symbol != t.Symbol {
+			return fmt.Errorf("token %s on chain %d has symbol %q, registry says %q", t.Address.Hex(), t.ChainID, metadata.Symbol, t.Symbol)
+		}
+	}
+	return nil
+}
+
+// Display returns a base unit amount of a round's token in whole tokens,
+// with the token's symbol. It reports false when the token is not
+// registered.
+func (r *Registry) Display(chainID int64, token *string, amount string) (string, string, bool) {
+	if token == nil {
+		return "", "", false
+	}
+	t, ok := r.Lookup(chainID, *token)
+	if !ok {
+		return "", "", false
+	}
+	formatted, err := money.FormatUnits(amount, t.Decimals)
+	if err != nil {
+		return "", "", false
+	}
+	return formatted, t.Symbol, true
+}
+
+// FormatAmount renders a base unit amount of a round's token for people,
+// such as "12.5 USDC". Amounts of unregistered tokens are left in base
+// units, followed by the round's own currency symbol when it has one.
+func (r *Registry) FormatAmount(chainID int64, token *string, amount string, currencySymbol *string) string {
+	if formatted, symbol, ok := r.Display(chainID, token, amount); ok {
+		return formatted + " " + symbol
+	}
+	if currencySymbol != nil && *currencySymbol != "" {
+		return amount + " " + *currencySymbol
+	}
+	return amount
+}
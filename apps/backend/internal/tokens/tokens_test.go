@@ -0,0 +1,147 @@
+package tokens
+
+import (
+	"circa/internal/contracts"
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	usdcBase = "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913"
+	daiBase  = "0x50c5725949A6F0c72E6C4a641F24049A917DB0Cb"
+)
+
+func testRegistry(t *testing.T) *Registry {
+	t.Helper()
+	r, err := NewRegistry([]Token{
+		{ChainID: 8453, Address: common.HexToAddress(usdcBase), Symbol: "USDC", Decimals: 6, LogoURL: "https://example.com/usdc.png"},
+		{ChainID: 8453, Address: common.HexToAddress(daiBase), Symbol: "DAI", Decimals: 18},
+	})
+	require.NoError(t, err)
+	return r
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{"chainId": 8453, "address": "`+usdcBase+`", "symbol": "USDC", "decimals": 6, "logoUrl": "https://example.com/usdc.png"}
+	]`), 0o600))
+
+	r, err := LoadFile(path)
+	require.NoError(t, err)
+
+	token, ok := r.Lookup(8453, "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913")
+	require.True(t, ok)
+	assert.Equal(t, "USDC", token.Symbol)
+	assert.Equal(t, uint8(6), token.Decimals)
+	assert.Equal(t, "https://example.com/usdc.png", token.LogoURL)
+
+	_, ok = r.Lookup(1, usdcBase)
+	assert.False(t, ok)
+}
+
+func TestNewRegistry_Invalid(t *testing.T) {
+	usdc := Token{ChainID: 8453, Address: common.HexToAddress(usdcBase), Symbol: "USDC", Decimals: 6}
+
+	_, err := NewRegistry([]Token{usdc, usdc})
+	assert.ErrorContains(t, err, "registered twice")
+
+	_, err = NewRegistry([]Token{{ChainID: 8453, Address: common.HexToAddress(usdcBase), Decimals: 6}})
+	assert.Error(t, err)
+}
+
+func TestRegistry_Tokens(t *testing.T) {
+	tokens := testRegistry(t).Tokens()
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "DAI", tokens[0].Symbol)
+	assert.Equal(t, "USDC", tokens[1].Symbol)
+
+	var nilRegistry *Registry
+	assert.Empty(t, nilRegistry.Tokens())
+}
+
+func TestRegistry_FormatAmount(t *testing.T) {
+	r := testRegistry(t)
+	usdc := usdcBase
+	unknown := "0x0000000000000000000000000000000000000001"
+	symbol := "cUSD"
+
+	assert.Equal(t, "12.5 USDC", r.FormatAmount(8453, &usdc, "12500000", &symbol))
+	assert.Equal(t, "0.000000000000000001 DAI", r.FormatAmount(8453, strPtr(daiBase), "1", nil))
+	assert.Equal(t, "12500000 cUSD", r.FormatAmount(8453, &unknown, "12500000", &symbol))
+	assert.Equal(t, "12500000 cUSD", r.FormatAmount(8453, nil, "12500000", &symbol))
+	assert.Equal(t, "12500000", r.FormatAmount(1, &usdc, "12500000", nil))
+
+	var nilRegistry *Registry
+	assert.Equal(t, "12500000 cUSD", nilRegistry.FormatAmount(8453, &usdc, "12500000", &symbol))
+}
+
+func TestRegistry_Verify(t *testing.T) {
+	r := testRegistry(t)
+
+	t.Run("matches", func(t *testing.T) {
+		caller := &fakeCaller{metadata: map[common.Address]contracts.TokenMetadata{
+			common.HexToAddress(usdcBase): {Symbol: "USDC", Decimals: 6},
+			common.HexToAddress(daiBase):  {Symbol: "DAI", Decimals: 18},
+		}}
+		assert.NoError(t, r.Verify(context.Background(), map[int64]contracts.Caller{8453: caller}))
+	})
+
+	t.Run("wrong decimals", func(t *testing.T) {
+		caller := &fakeCaller{metadata: map[common.Address]contracts.TokenMetadata{
+			common.HexToAddress(usdcBase): {Symbol: "USDC", Decimals: 18},
+			common.HexToAddress(daiBase):  {Symbol: "DAI", Decimals: 18},
+		}}
+		err := r.Verify(context.Background(), map[int64]contracts.Caller{8453: caller})
+		assert.ErrorContains(t, err, "has 18 decimals, registry says 6")
+	})
+
+	t.Run("not a token", func(t *testing.T) {
+		caller := &fakeCaller{metadata: map[common.Address]contracts.TokenMetadata{}}
+		assert.Error(t, r.Verify(context.Background(), map[int64]contracts.Caller{8453: caller}))
+	})
+
+	t.Run("chain without a caller is skipped", func(t *testing.T) {
+		assert.NoError(t, r.Verify(context.Background(), map[int64]contracts.Caller{}))
+	})
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// fakeCaller answers ERC-20 metadata calls from fixed values.
+type fakeCaller struct {
+	metadata map[common.Address]contracts.TokenMetadata
+}
+
+func (f *fakeCaller) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	metadata, ok := f.metadata[*msg.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	method, err := contracts.ERC20ABI.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "symbol":
+		return method.Outputs.Pack(metadata.Symbol)
+	case "decimals":
+		return method.Outputs.Pack(metadata.Decimals)
+	}
+	return nil, errors.New("execution reverted")
+}